@@ -271,6 +271,9 @@ func (u *Unpacker) UnpackLayers(dir string, image v1.Image) ([]string, error) {
 				finalPass = true
 			}
 
+			// Uncompressed tells gzip and zstd compressed layers apart by their magic
+			// bytes rather than their media type and decompresses them while
+			// streaming, so layers are never buffered as a whole.
 			reader, err := layer.Uncompressed()
 			if err != nil {
 				return nil, fmt.Errorf("failed to uncompress layer: %w", err)
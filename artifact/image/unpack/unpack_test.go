@@ -174,6 +174,14 @@ func TestUnpackSquashed(t *testing.T) {
 			"sample.txt":        {content: "sample text file\n", mode: fs.FileMode(0644)},
 			"larger-sample.txt": {content: strings.Repeat("sample text file\n", 400), mode: fs.FileMode(0644)},
 		},
+	}, {
+		name:  "zstd compressed layer",
+		cfg:   unpack.DefaultUnpackerConfig(),
+		dir:   mustMkdirTemp(t),
+		image: mustImageFromPath(t, filepath.Join("testdata", "zstd.tar")),
+		want: map[string]contentAndMode{
+			"zstd-sample.txt": {content: "sample text file compressed with zstd\n", mode: fs.FileMode(0644)},
+		},
 	}, {
 		name:  "large files are skipped",
 		cfg:   unpack.DefaultUnpackerConfig().WithMaxFileBytes(1024),
@@ -382,6 +390,22 @@ func TestUnpackLayers(t *testing.T) {
 				"larger-sample.txt": {content: strings.Repeat("sample text file\n", 400), mode: fs.FileMode(0644)},
 			},
 		}},
+	}, {
+		name:  "zstd compressed layer",
+		cfg:   unpack.DefaultUnpackerConfig(),
+		dir:   mustMkdirTemp(t),
+		image: mustImageFromPath(t, filepath.Join("testdata", "zstd.tar")),
+		want: []digestAndContent{{
+			digest: "SQUASHED",
+			content: map[string]contentAndMode{
+				"zstd-sample.txt": {content: "sample text file compressed with zstd\n", mode: fs.FileMode(0644)},
+			},
+		}, {
+			digest: "sha256:81f97cd863aa4f5ece35164af03f8571c80257ca247f5c3136bb62020777c5e1",
+			content: map[string]contentAndMode{
+				"zstd-sample.txt": {content: "sample text file compressed with zstd\n", mode: fs.FileMode(0644)},
+			},
+		}},
 	}, {
 		name:  "symlink",
 		cfg:   unpack.DefaultUnpackerConfig(),
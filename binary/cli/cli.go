@@ -18,10 +18,13 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"regexp"
 	"slices"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/google/go-containerregistry/pkg/authn"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
@@ -36,6 +39,7 @@ import (
 	"github.com/google/osv-scalibr/detector"
 	"github.com/google/osv-scalibr/detector/govulncheck/binary"
 	dl "github.com/google/osv-scalibr/detector/list"
+	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem"
 	el "github.com/google/osv-scalibr/extractor/filesystem/list"
 	"github.com/google/osv-scalibr/extractor/standalone"
@@ -123,6 +127,13 @@ type Flags struct {
 	FilesToExtract        []string
 	DirsToSkip            []string
 	SkipDirRegex          string
+	UseIgnoreFiles        bool
+	OneFileSystem         bool
+	SkipPseudoFilesystems bool
+	MaxDepth              int
+	MinConfidence         int
+	MergeLocations        bool
+	Timeout               time.Duration
 	RemoteImage           string
 	ImagePlatform         string
 	GovulncheckDBPath     string
@@ -137,6 +148,10 @@ type Flags struct {
 	FilterByCapabilities  bool
 	StoreAbsolutePath     bool
 	WindowsAllDrives      bool
+	// Reproducible makes the outputs free of random IDs and sets their
+	// timestamps to ReproducibleTimestamp, or the Unix epoch if it's unset.
+	Reproducible          bool
+	ReproducibleTimestamp time.Time
 }
 
 var supportedOutputFormats = []string{
@@ -177,6 +192,15 @@ func ValidateFlags(flags *Flags) error {
 	if err := validateRegex(flags.SkipDirRegex); err != nil {
 		return fmt.Errorf("--skip-dir-regex: %w", err)
 	}
+	if flags.MaxDepth < 0 {
+		return errors.New("--max-depth must be -1 or greater")
+	}
+	if flags.MinConfidence < 0 || flags.MinConfidence > int(extractor.ConfidenceMax) {
+		return errors.New("--min-confidence must be between 0 and 100")
+	}
+	if flags.Timeout < 0 {
+		return errors.New("--timeout must not be negative")
+	}
 	if err := validateDetectorDependency(flags.DetectorsToRun, flags.ExtractorsToRun, flags.ExplicitExtractors); err != nil {
 		return err
 	}
@@ -316,15 +340,22 @@ func (f *Flags) GetScanConfig() (*scalibr.ScanConfig, error) {
 	}
 
 	return &scalibr.ScanConfig{
-		ScanRoots:            scanRoots,
-		FilesystemExtractors: extractors,
-		StandaloneExtractors: standaloneExtractors,
-		Detectors:            detectors,
-		Capabilities:         capab,
-		FilesToExtract:       f.FilesToExtract,
-		DirsToSkip:           f.dirsToSkip(scanRoots),
-		SkipDirRegex:         skipDirRegex,
-		StoreAbsolutePath:    f.StoreAbsolutePath,
+		ScanRoots:             scanRoots,
+		FilesystemExtractors:  extractors,
+		StandaloneExtractors:  standaloneExtractors,
+		Detectors:             detectors,
+		Capabilities:          capab,
+		FilesToExtract:        f.FilesToExtract,
+		DirsToSkip:            f.dirsToSkip(scanRoots),
+		SkipDirRegex:          skipDirRegex,
+		UseIgnoreFiles:        f.UseIgnoreFiles,
+		OneFileSystem:         f.OneFileSystem,
+		SkipPseudoFilesystems: f.SkipPseudoFilesystems,
+		MaxDepth:              f.MaxDepth,
+		MinConfidence:         extractor.Confidence(f.MinConfidence),
+		MergeLocations:        f.MergeLocations,
+		Timeout:               f.Timeout,
+		StoreAbsolutePath:     f.StoreAbsolutePath,
 	}, nil
 }
 
@@ -346,6 +377,8 @@ func (f *Flags) GetSPDXConfig() converter.SPDXConfig {
 		DocumentName:      f.SPDXDocumentName,
 		DocumentNamespace: f.SPDXDocumentNamespace,
 		Creators:          creators,
+		Reproducible:      f.Reproducible,
+		Timestamp:         f.ReproducibleTimestamp,
 	}
 }
 
@@ -355,11 +388,16 @@ func (f *Flags) GetCDXConfig() converter.CDXConfig {
 		ComponentName:    f.CDXComponentName,
 		ComponentVersion: f.CDXComponentVersion,
 		Authors:          strings.Split(f.CDXAuthors, ","),
+		Reproducible:     f.Reproducible,
+		Timestamp:        f.ReproducibleTimestamp,
 	}
 }
 
 // WriteScanResults writes SCALIBR scan results to files specified by the CLI flags.
 func (f *Flags) WriteScanResults(result *scalibr.ScanResult) error {
+	if f.Reproducible {
+		result = withFixedTimes(result, f.ReproducibleTimestamp)
+	}
 	if len(f.ResultFile) > 0 {
 		log.Infof("Writing scan results to %s", f.ResultFile)
 		resultProto, err := proto.ScanResultToProto(result)
@@ -400,7 +438,62 @@ func (f *Flags) WriteScanResults(result *scalibr.ScanResult) error {
 	return nil
 }
 
+// withFixedTimes returns a copy of the scan results with the start and end
+// times set to the given timestamp, or the Unix epoch if it's unset.
+func withFixedTimes(result *scalibr.ScanResult, timestamp time.Time) *scalibr.ScanResult {
+	if timestamp.IsZero() {
+		timestamp = time.Unix(0, 0)
+	}
+	r := *result
+	r.StartTime = timestamp
+	r.EndTime = timestamp
+	if r.Provenance != nil {
+		p := *r.Provenance
+		p.StartTime = timestamp
+		r.Provenance = &p
+	}
+	return &r
+}
+
 // TODO(b/279413691): Allow commas in argument names.
+// ListExtractors writes a table of all available extractors with the PURL
+// types of the inventory they create and the OS they need to run on.
+func ListExtractors(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tVERSION\tKIND\tPURL TYPES\tOS")
+	for _, l := range []struct {
+		kind  string
+		descs []*extractor.Descriptor
+	}{
+		{kind: "filesystem", descs: el.Descriptors()},
+		{kind: "standalone", descs: sl.Descriptors()},
+	} {
+		for _, d := range l.descs {
+			purlTypes := strings.Join(d.PURLTypes, ",")
+			if purlTypes == "" {
+				purlTypes = "-"
+			}
+			fmt.Fprintf(tw, "%s\t%d\t%s\t%s\t%s\n", d.Name, d.Version, l.kind, purlTypes, osName(d.Requirements.OS))
+		}
+	}
+	return tw.Flush()
+}
+
+func osName(o plugin.OS) string {
+	switch o {
+	case plugin.OSLinux:
+		return "linux"
+	case plugin.OSWindows:
+		return "windows"
+	case plugin.OSMac:
+		return "mac"
+	case plugin.OSUnix:
+		return "unix"
+	default:
+		return "any"
+	}
+}
+
 func (f *Flags) extractorsToRun() ([]filesystem.Extractor, []standalone.Extractor, error) {
 	if len(f.ExtractorsToRun) == 0 {
 		return []filesystem.Extractor{}, []standalone.Extractor{}, nil
@@ -15,17 +15,22 @@
 package cli_test
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	scalibr "github.com/google/osv-scalibr"
 	"github.com/google/osv-scalibr/binary/cli"
 	"github.com/google/osv-scalibr/detector/govulncheck/binary"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/python/wheelegg"
 	"github.com/google/osv-scalibr/plugin"
 )
 
@@ -160,6 +165,33 @@ func TestValidateFlags(t *testing.T) {
 			},
 			wantErr: cmpopts.AnyError,
 		},
+		{
+			desc: "Negative max depth",
+			flags: &cli.Flags{
+				Root:       "/",
+				ResultFile: "result.textproto",
+				MaxDepth:   -1,
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Min confidence out of range",
+			flags: &cli.Flags{
+				Root:          "/",
+				ResultFile:    "result.textproto",
+				MinConfidence: 101,
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Negative timeout",
+			flags: &cli.Flags{
+				Root:       "/",
+				ResultFile: "result.textproto",
+				Timeout:    -time.Second,
+			},
+			wantErr: cmpopts.AnyError,
+		},
 		{
 			desc: "Invalid SPDX creator format",
 			flags: &cli.Flags{
@@ -498,3 +530,81 @@ func TestWriteScanResults(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteScanResults_Reproducible(t *testing.T) {
+	pipEx := wheelegg.New(wheelegg.DefaultConfig())
+	newResult := func(start time.Time) *scalibr.ScanResult {
+		return &scalibr.ScanResult{
+			Version:   "1.2.3",
+			StartTime: start,
+			EndTime:   start.Add(time.Minute),
+			Status:    &plugin.ScanStatus{Status: plugin.ScanStatusSucceeded},
+			Inventories: []*extractor.Inventory{
+				{Name: "requests", Version: "2.31.0", Extractor: pipEx, Locations: extractor.LocationsFromPaths("a/METADATA")},
+				{Name: "urllib3", Version: "2.2.1", Extractor: pipEx, Locations: extractor.LocationsFromPaths("b/METADATA")},
+			},
+			Provenance: &scalibr.Provenance{ScannerVersion: "1.2.3", StartTime: start},
+		}
+	}
+	formats := []string{"textproto", "spdx23-json", "spdx23-tag-value", "cdx-json", "cdx-xml"}
+
+	for _, timestamp := range []time.Time{{}, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)} {
+		var outputs [2]map[string][]byte
+		for run, start := range []time.Time{time.Now(), time.Now().Add(time.Hour)} {
+			dir := t.TempDir()
+			flags := &cli.Flags{Reproducible: true, ReproducibleTimestamp: timestamp}
+			for _, f := range formats {
+				flags.Output = append(flags.Output, f+"="+filepath.Join(dir, "result."+f))
+			}
+			if err := flags.WriteScanResults(newResult(start)); err != nil {
+				t.Fatalf("WriteScanResults(): %v", err)
+			}
+			outputs[run] = map[string][]byte{}
+			for _, f := range formats {
+				content, err := os.ReadFile(filepath.Join(dir, "result."+f))
+				if err != nil {
+					t.Fatalf("os.ReadFile(%s): %v", f, err)
+				}
+				outputs[run][f] = content
+			}
+		}
+
+		wantTime := "1970-01-01T00:00:00Z"
+		if !timestamp.IsZero() {
+			wantTime = "2024-01-02T03:04:05Z"
+		}
+		for _, f := range formats {
+			if string(outputs[0][f]) != string(outputs[1][f]) {
+				t.Errorf("WriteScanResults() with timestamp %v: %s outputs of two runs differ:\n%s\n%s", timestamp, f, outputs[0][f], outputs[1][f])
+			}
+		}
+		for _, f := range []string{"spdx23-json", "cdx-json"} {
+			if !strings.Contains(string(outputs[0][f]), wantTime) {
+				t.Errorf("WriteScanResults() with timestamp %v: %s output doesn't contain %s:\n%s", timestamp, f, wantTime, outputs[0][f])
+			}
+		}
+	}
+}
+
+func TestListExtractors(t *testing.T) {
+	var out bytes.Buffer
+	if err := cli.ListExtractors(&out); err != nil {
+		t.Fatalf("cli.ListExtractors(): %v", err)
+	}
+	for _, want := range [][]string{
+		{"NAME", "VERSION", "KIND", "PURL", "TYPES", "OS"},
+		{"dotnet/packageslockjson", "0", "filesystem", "nuget", "any"},
+		{"os/homebrew", "0", "filesystem", "brew", "mac"},
+	} {
+		found := false
+		for _, line := range strings.Split(out.String(), "\n") {
+			if slices.Equal(strings.Fields(line), want) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("cli.ListExtractors(): no line %q in output:\n%s", strings.Join(want, " "), out.String())
+		}
+	}
+}
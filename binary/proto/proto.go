@@ -31,12 +31,24 @@ import (
 
 	scalibr "github.com/google/osv-scalibr"
 	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/binary/elfdeps"
+	"github.com/google/osv-scalibr/extractor/filesystem/cicd/githubactions"
 	ctrdfs "github.com/google/osv-scalibr/extractor/filesystem/containers/containerd"
+	"github.com/google/osv-scalibr/extractor/filesystem/containers/dockerfile"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/cpp/vendoredheaders"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/depsjson"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/packageslockjson"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/runtimeconfig"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/java/archive"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/java/javalockfile"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/packagejson"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/julia/manifest"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/perl/cpan"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/python/condameta"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/python/requirements"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/python/wheelegg"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/r/description"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/r/renvlock"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/apk"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/cos"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/dpkg"
@@ -44,11 +56,15 @@ import (
 	"github.com/google/osv-scalibr/extractor/filesystem/os/macapps"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/rpm"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/snap"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/wmiproducts"
 	"github.com/google/osv-scalibr/extractor/filesystem/osv"
 	"github.com/google/osv-scalibr/extractor/filesystem/sbom/cdx"
 	"github.com/google/osv-scalibr/extractor/filesystem/sbom/spdx"
+	"github.com/google/osv-scalibr/extractor/filesystem/vcs/gitmodules"
 	ctrdruntime "github.com/google/osv-scalibr/extractor/standalone/containers/containerd"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/appx"
 	winmetadata "github.com/google/osv-scalibr/extractor/standalone/windows/common/metadata"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/syspath"
 	"github.com/google/osv-scalibr/plugin"
 	"github.com/google/osv-scalibr/purl"
 
@@ -182,9 +198,24 @@ func ScanResultToProto(r *scalibr.ScanResult) (*spb.ScanResult, error) {
 		PluginStatus: pluginStatus,
 		Inventories:  inventories,
 		Findings:     findings,
+		TimedOut:     r.TimedOut,
+		Provenance:   provenanceToProto(r.Provenance),
 	}, nil
 }
 
+func provenanceToProto(p *scalibr.Provenance) *spb.Provenance {
+	if p == nil {
+		return nil
+	}
+	return &spb.Provenance{
+		ScannerVersion: p.ScannerVersion,
+		StartTime:      timestamppb.New(p.StartTime),
+		ScanRoots:      p.ScanRoots,
+		Extractors:     p.Extractors,
+		Detectors:      p.Detectors,
+	}
+}
+
 func scanStatusToProto(s *plugin.ScanStatus) *spb.ScanStatus {
 	var e spb.ScanStatus_ScanStatusEnum
 	switch s.Status {
@@ -218,10 +249,12 @@ func inventoryToProto(i *extractor.Inventory) (*spb.Inventory, error) {
 		Version:     i.Version,
 		SourceCode:  sourceCodeIdentifierToProto(i.SourceCode),
 		Purl:        purlToProto(p),
-		Ecosystem:   i.Ecosystem(),
-		Locations:   i.Locations,
+		Ecosystem:   i.EffectiveEcosystem(),
+		Locations:   i.LocationPaths(),
 		Extractor:   i.Extractor.Name(),
+		ScanRoot:    i.ScanRoot,
 		Annotations: annotationsToProto(i.Annotations),
+		Confidence:  int32(i.Confidence),
 	}
 	setProtoMetadata(i.Metadata, inventoryProto)
 	return inventoryProto, nil
@@ -232,8 +265,9 @@ func setProtoMetadata(meta any, i *spb.Inventory) {
 	case *wheelegg.PythonPackageMetadata:
 		i.Metadata = &spb.Inventory_PythonMetadata{
 			PythonMetadata: &spb.PythonPackageMetadata{
-				Author:      m.Author,
-				AuthorEmail: m.AuthorEmail,
+				Author:       m.Author,
+				AuthorEmail:  m.AuthorEmail,
+				Dependencies: m.Dependencies,
 			},
 		}
 	case *packagejson.JavascriptPackageJSONMetadata:
@@ -254,6 +288,8 @@ func setProtoMetadata(meta any, i *spb.Inventory) {
 				Maintainer:   m.Maintainer,
 				Architecture: m.Architecture,
 				License:      m.License,
+				Provides:     m.Provides,
+				Depends:      m.Depends,
 			},
 		}
 	case *dpkg.Metadata:
@@ -395,6 +431,143 @@ func setProtoMetadata(meta any, i *spb.Inventory) {
 				GroupId:    m.GroupID,
 			},
 		}
+	case *packageslockjson.Metadata:
+		i.Metadata = &spb.Inventory_NugetLockfileMetadata{
+			NugetLockfileMetadata: &spb.NuGetLockfileMetadata{
+				Framework:        m.Framework,
+				DependencyType:   m.DependencyType,
+				ContentHash:      m.ContentHash,
+				ProjectReference: m.ProjectReference,
+				Semver:           m.SemVer,
+				Requested:        m.Requested,
+			},
+		}
+	case *githubactions.Metadata:
+		i.Metadata = &spb.Inventory_GithubActionMetadata{
+			GithubActionMetadata: &spb.GitHubActionMetadata{
+				Path: m.Path,
+			},
+		}
+	case *dockerfile.Metadata:
+		i.Metadata = &spb.Inventory_DockerfileBaseImageMetadata{
+			DockerfileBaseImageMetadata: &spb.DockerfileBaseImageMetadata{
+				Repository: m.Repository,
+				Tag:        m.Tag,
+				Digest:     m.Digest,
+				Stage:      m.Stage,
+			},
+		}
+	case *vendoredheaders.Metadata:
+		i.Metadata = &spb.Inventory_VendoredHeaderMetadata{
+			VendoredHeaderMetadata: &spb.VendoredHeaderMetadata{
+				Macro: m.Macro,
+				Cpe:   m.CPE,
+			},
+		}
+	case *condameta.Metadata:
+		i.Metadata = &spb.Inventory_CondaMetaMetadata{
+			CondaMetaMetadata: &spb.CondaMetaMetadata{
+				Build:   m.Build,
+				Channel: m.Channel,
+				Subdir:  m.Subdir,
+				Depends: m.Depends,
+			},
+		}
+	case *runtimeconfig.Metadata:
+		i.Metadata = &spb.Inventory_DotnetRuntimeConfigMetadata{
+			DotnetRuntimeConfigMetadata: &spb.DotnetRuntimeConfigMetadata{
+				Tfm:         m.TFM,
+				RollForward: m.RollForward,
+			},
+		}
+	case *depsjson.AssemblyMetadata:
+		i.Metadata = &spb.Inventory_DotnetAssemblyMetadata{
+			DotnetAssemblyMetadata: &spb.DotnetAssemblyMetadata{
+				Path:            m.Path,
+				AssemblyVersion: m.AssemblyVersion,
+				FileVersion:     m.FileVersion,
+				Library:         m.Library,
+				LibraryVersion:  m.LibraryVersion,
+			},
+		}
+	case *wmiproducts.Metadata:
+		i.Metadata = &spb.Inventory_WindowsWmiProductMetadata{
+			WindowsWmiProductMetadata: &spb.WindowsWmiProductMetadata{
+				Class:             m.Class,
+				Vendor:            m.Vendor,
+				IdentifyingNumber: m.IdentifyingNumber,
+			},
+		}
+	case *elfdeps.Metadata:
+		i.Metadata = &spb.Inventory_ElfSharedLibraryMetadata{
+			ElfSharedLibraryMetadata: &spb.ElfSharedLibraryMetadata{
+				Library:    m.Library,
+				AbiVersion: m.ABIVersion,
+			},
+		}
+	case *syspath.Metadata:
+		issues := make([]string, 0, len(m.Issues))
+		for _, is := range m.Issues {
+			issues = append(issues, string(is))
+		}
+		i.Metadata = &spb.Inventory_WindowsPathDirectoryMetadata{
+			WindowsPathDirectoryMetadata: &spb.WindowsPathDirectoryMetadata{
+				Index:    int32(m.Index),
+				Expanded: m.Expanded,
+				Issues:   issues,
+			},
+		}
+	case *appx.Metadata:
+		i.Metadata = &spb.Inventory_WindowsAppxMetadata{
+			WindowsAppxMetadata: &spb.WindowsAppxMetadata{
+				PackageFullName:   m.PackageFullName,
+				PackageFamilyName: m.PackageFamilyName,
+				Publisher:         m.Publisher,
+				PublisherId:       m.PublisherID,
+				Architecture:      m.Architecture,
+				ResourceId:        m.ResourceID,
+				InstallLocation:   m.InstallLocation,
+			},
+		}
+	case *cpan.Metadata:
+		i.Metadata = &spb.Inventory_PerlRequirementMetadata{
+			PerlRequirementMetadata: &spb.PerlRequirementMetadata{
+				Phase:              m.Phase,
+				Relationship:       m.Relationship,
+				VersionRequirement: m.VersionRequirement,
+				Feature:            m.Feature,
+			},
+		}
+	case *renvlock.Metadata:
+		i.Metadata = &spb.Inventory_RenvLockMetadata{
+			RenvLockMetadata: &spb.RenvLockMetadata{
+				Source:    m.Source,
+				RemoteRef: m.RemoteRef,
+				RemoteSha: m.RemoteSHA,
+			},
+		}
+	case *description.Metadata:
+		i.Metadata = &spb.Inventory_RDescriptionMetadata{
+			RDescriptionMetadata: &spb.RDescriptionMetadata{
+				Field:              m.Field,
+				VersionRequirement: m.VersionRequirement,
+			},
+		}
+	case *manifest.Metadata:
+		i.Metadata = &spb.Inventory_JuliaManifestMetadata{
+			JuliaManifestMetadata: &spb.JuliaManifestMetadata{
+				Uuid: m.UUID,
+			},
+		}
+	case *gitmodules.Metadata:
+		i.Metadata = &spb.Inventory_GitSubmoduleMetadata{
+			GitSubmoduleMetadata: &spb.GitSubmoduleMetadata{
+				Name:   m.Name,
+				Path:   m.Path,
+				Url:    m.URL,
+				Branch: m.Branch,
+			},
+		}
 	case *osv.Metadata:
 		i.Metadata = &spb.Inventory_OsvMetadata{
 			OsvMetadata: &spb.OSVPackageMetadata{
@@ -464,6 +637,16 @@ func annotationToProto(s extractor.Annotation) spb.Inventory_AnnotationEnum {
 		e = spb.Inventory_INSIDE_OS_PACKAGE
 	case extractor.InsideCacheDir:
 		e = spb.Inventory_INSIDE_CACHE_DIR
+	case extractor.Direct:
+		e = spb.Inventory_DIRECT
+	case extractor.Transitive:
+		e = spb.Inventory_TRANSITIVE
+	case extractor.DevOnly:
+		e = spb.Inventory_DEV_ONLY
+	case extractor.OptionalDependency:
+		e = spb.Inventory_OPTIONAL_DEPENDENCY
+	case extractor.MetadataTruncated:
+		e = spb.Inventory_METADATA_TRUNCATED
 	default:
 		e = spb.Inventory_UNSPECIFIED
 	}
@@ -28,16 +28,32 @@ import (
 	"github.com/google/osv-scalibr/binary/proto"
 	"github.com/google/osv-scalibr/detector"
 	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/binary/elfdeps"
+	"github.com/google/osv-scalibr/extractor/filesystem/cicd/githubactions"
 	ctrdfs "github.com/google/osv-scalibr/extractor/filesystem/containers/containerd"
+	"github.com/google/osv-scalibr/extractor/filesystem/containers/dockerfile"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/cpp/vendoredheaders"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/depsjson"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/packageslockjson"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/runtimeconfig"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/packagejson"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/julia/manifest"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/perl/cpan"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/perl/cpanfile"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/python/condameta"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/python/requirements"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/python/wheelegg"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/r/renvlock"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/dpkg"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/rpm"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/wmiproducts"
 	"github.com/google/osv-scalibr/extractor/filesystem/sbom/cdx"
+	"github.com/google/osv-scalibr/extractor/filesystem/vcs/gitmodules"
 	ctrdruntime "github.com/google/osv-scalibr/extractor/standalone/containers/containerd"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/appx"
 	winmetadata "github.com/google/osv-scalibr/extractor/standalone/windows/common/metadata"
 	"github.com/google/osv-scalibr/extractor/standalone/windows/dismpatch"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/syspath"
 	"github.com/google/osv-scalibr/plugin"
 	"github.com/google/osv-scalibr/purl"
 	"google.golang.org/protobuf/testing/protocmp"
@@ -166,8 +182,9 @@ func TestScanResultToProto(t *testing.T) {
 			Maintainer:        "maintainer",
 			Architecture:      "amd64",
 		},
-		Locations: []string{"/file1"},
+		Locations: extractor.LocationsFromPaths("/file1"),
 		Extractor: dpkg.New(dpkg.DefaultConfig()),
+		Ecosystem: "Debian",
 	}
 	purlDPKGAnnotationInventory := &extractor.Inventory{
 		Name:    "software",
@@ -180,30 +197,195 @@ func TestScanResultToProto(t *testing.T) {
 			Maintainer:        "maintainer",
 			Architecture:      "amd64",
 		},
-		Locations:   []string{"/file1"},
+		Locations:   extractor.LocationsFromPaths("/file1"),
 		Extractor:   dpkg.New(dpkg.DefaultConfig()),
+		Ecosystem:   "Debian",
 		Annotations: []extractor.Annotation{extractor.Transitional},
 	}
 	purlPythonInventory := &extractor.Inventory{
 		Name:      "software",
 		Version:   "1.0.0",
-		Locations: []string{"/file1"},
+		Locations: extractor.LocationsFromPaths("/file1"),
 		Extractor: wheelegg.New(wheelegg.DefaultConfig()),
+		Ecosystem: "PyPI",
 		Metadata: &wheelegg.PythonPackageMetadata{
-			Author:      "author",
-			AuthorEmail: "author@corp.com",
+			Author:       "author",
+			AuthorEmail:  "author@corp.com",
+			Dependencies: []string{"bar>=1.0"},
 		},
 	}
 	pythonRequirementsInventory := &extractor.Inventory{
 		Name:      "foo",
 		Version:   "1.0",
-		Locations: []string{"/file1"},
+		Locations: extractor.LocationsFromPaths("/file1"),
 		Extractor: requirements.Extractor{},
+		Ecosystem: "PyPI",
 		Metadata: &requirements.Metadata{
 			HashCheckingModeValues: []string{"sha256:123"},
 			VersionComparator:      ">=",
 		},
 	}
+	nugetLockfileInventory := &extractor.Inventory{
+		Name:      "Newtonsoft.Json",
+		Version:   "13.0.3",
+		Locations: extractor.LocationsFromPaths("/packages.lock.json"),
+		Extractor: packageslockjson.New(packageslockjson.DefaultConfig()),
+		Ecosystem: "NuGet",
+		Metadata: &packageslockjson.Metadata{
+			Framework:      "net8.0",
+			DependencyType: "Direct",
+			ContentHash:    "HrC5BXdl00IP9zeV+0Z848QWPAoCr9P3bDEZguI+gkLcBKAOxix/tLEAAHC+UvDNPv4a2d18lOReHMOagPa+zQ==",
+			SemVer:         "13.0.3",
+			Requested:      "[13.0.3, )",
+		},
+	}
+	dockerfileInventory := &extractor.Inventory{
+		Name:      "golang",
+		Version:   "1.22",
+		Locations: extractor.LocationsFromPaths("/Dockerfile"),
+		Extractor: dockerfile.Extractor{},
+		Metadata:  &dockerfile.Metadata{Repository: "golang", Tag: "1.22", Stage: "build"},
+	}
+	githubActionInventory := &extractor.Inventory{
+		Name:      "github/codeql-action",
+		Version:   "v3",
+		Locations: extractor.LocationsFromPaths("/.github/workflows/ci.yml"),
+		Extractor: githubactions.Extractor{},
+		Ecosystem: "GitHub Actions",
+		Metadata:  &githubactions.Metadata{Path: "init"},
+	}
+	vendoredHeaderInventory := &extractor.Inventory{
+		Name:      "zlib",
+		Version:   "1.2.11",
+		Locations: extractor.LocationsFromPaths("/third_party/zlib/zlib.h"),
+		Extractor: vendoredheaders.New(vendoredheaders.DefaultConfig()),
+		Metadata: &vendoredheaders.Metadata{
+			Macro: "ZLIB_VERSION",
+			CPE:   "cpe:2.3:a:zlib:zlib:1.2.11:*:*:*:*:*:*:*",
+		},
+		Confidence: extractor.ConfidenceMedium,
+	}
+	condaMetaInventory := &extractor.Inventory{
+		Name:      "requests",
+		Version:   "2.32.3",
+		Locations: extractor.LocationsFromPaths("/opt/conda/conda-meta/requests-2.32.3-pyhd8ed1ab_0.json"),
+		Extractor: condameta.Extractor{},
+		Metadata: &condameta.Metadata{
+			Build:   "pyhd8ed1ab_0",
+			Channel: "https://conda.anaconda.org/conda-forge/noarch",
+			Subdir:  "noarch",
+			Depends: []string{"python >=3.8"},
+		},
+	}
+	runtimeConfigInventory := &extractor.Inventory{
+		Name:      "Microsoft.NETCore.App",
+		Version:   "6.0.0",
+		Locations: extractor.LocationsFromPaths("/app/MyApp.runtimeconfig.json"),
+		Extractor: runtimeconfig.Extractor{},
+		Metadata:  &runtimeconfig.Metadata{TFM: "net6.0", RollForward: "LatestPatch"},
+	}
+	appxInventory := &extractor.Inventory{
+		Name:      "Microsoft.WindowsCalculator",
+		Version:   "11.2210.0.0",
+		Locations: extractor.LocationsFromPaths("Windows/System32/config/SOFTWARE"),
+		Extractor: appx.New(appx.DefaultConfig()),
+		Metadata: &appx.Metadata{
+			PackageFullName:   "Microsoft.WindowsCalculator_11.2210.0.0_x64__8wekyb3d8bbwe",
+			PackageFamilyName: "Microsoft.WindowsCalculator_8wekyb3d8bbwe",
+			PublisherID:       "8wekyb3d8bbwe",
+			Architecture:      "x64",
+		},
+	}
+	cpanfileInventory := &extractor.Inventory{
+		Name:        "Test::More",
+		Version:     "0.98",
+		Locations:   []extractor.Location{{Path: "/app/cpanfile", Line: 12, Reason: extractor.LocationDeclared}},
+		Extractor:   cpanfile.Extractor{},
+		Annotations: []extractor.Annotation{extractor.DevOnly},
+		Metadata: &cpan.Metadata{
+			Phase:              "test",
+			Relationship:       "requires",
+			VersionRequirement: "0.98",
+		},
+	}
+	renvLockInventory := &extractor.Inventory{
+		Name:    "mime",
+		Version: "0.12.1",
+		SourceCode: &extractor.SourceCodeIdentifier{
+			Repo:   "https://github.com/yihui/mime",
+			Commit: "1763e0dcb72fb58d97bab97bb834fc71f1e012bc",
+		},
+		Locations: extractor.LocationsFromPaths("/app/renv.lock"),
+		Extractor: renvlock.Extractor{},
+		Metadata: &renvlock.Metadata{
+			Source:    renvlock.SourceGitHub,
+			RemoteRef: "main",
+			RemoteSHA: "1763e0dcb72fb58d97bab97bb834fc71f1e012bc",
+		},
+	}
+	juliaManifestInventory := &extractor.Inventory{
+		Name:      "JSON",
+		Version:   "0.21.1",
+		Ecosystem: "Julia",
+		Locations: extractor.LocationsFromPaths("/app/Manifest.toml"),
+		Extractor: manifest.Extractor{},
+		Metadata:  &manifest.Metadata{UUID: "682c06a0-de6a-54ab-a142-c8b1cf79cde6"},
+	}
+	depsJSONAssemblyInventory := &extractor.Inventory{
+		Name:    "Newtonsoft.Json",
+		Version: "13.0.1.25517",
+		Locations: []extractor.Location{
+			{Path: "/app/Newtonsoft.Json.dll", Reason: extractor.LocationInstalled},
+			{Path: "/app/MyApp.deps.json", Reason: extractor.LocationDeclared},
+		},
+		Extractor: depsjson.New(depsjson.Config{ReportAssemblies: true}),
+		Metadata: &depsjson.AssemblyMetadata{
+			Path:            "lib/netstandard2.0/Newtonsoft.Json.dll",
+			AssemblyVersion: "13.0.0.0",
+			FileVersion:     "13.0.1.25517",
+			Library:         "Newtonsoft.Json",
+			LibraryVersion:  "13.0.1",
+		},
+	}
+	wmiProductInventory := &extractor.Inventory{
+		Name:      "Java 8 Update 202",
+		Version:   "8.0.2020.8",
+		Locations: extractor.LocationsFromPaths("Windows/System32/wbem/Repository/OBJECTS.DATA"),
+		Extractor: wmiproducts.Extractor{},
+		Metadata: &wmiproducts.Metadata{
+			Class:             "Win32_Product",
+			Vendor:            "Oracle Corporation",
+			IdentifyingNumber: "{26A24AE4-039D-4CA4-87B4-2F64180202F0}",
+		},
+	}
+	sysPathInventory := &extractor.Inventory{
+		Name:      `C:\ProgramData\chocolatey\bin`,
+		Locations: extractor.LocationsFromPaths(`HKEY_LOCAL_MACHINE\SYSTEM\CurrentControlSet\Control\Session Manager\Environment`),
+		Extractor: syspath.New(syspath.DefaultConfig()),
+		Metadata: &syspath.Metadata{
+			Index:    3,
+			Expanded: `C:\ProgramData\chocolatey\bin`,
+			Issues:   []syspath.Issue{syspath.IssueNonStandard, syspath.IssueUserWritable},
+		},
+	}
+	elfDepsInventory := &extractor.Inventory{
+		Name:      "libssl.so.3",
+		Locations: []extractor.Location{{Path: "/usr/bin/curl"}},
+		Extractor: elfdeps.New(elfdeps.DefaultConfig()),
+		Metadata:  &elfdeps.Metadata{Library: "libssl", ABIVersion: "3"},
+	}
+	gitSubmoduleInventory := &extractor.Inventory{
+		Name:       "googletest",
+		Version:    "b514bdc898e2951020cbdca1304b75f5950d1f59",
+		SourceCode: &extractor.SourceCodeIdentifier{Repo: "https://github.com/google/googletest.git", Commit: "b514bdc898e2951020cbdca1304b75f5950d1f59"},
+		Locations:  extractor.LocationsFromPaths("/.gitmodules"),
+		Extractor:  gitmodules.Extractor{},
+		Metadata: &gitmodules.Metadata{
+			Name: "third_party/googletest",
+			Path: "third_party/googletest",
+			URL:  "https://github.com/google/googletest.git",
+		},
+	}
 	purlJavascriptInventory := &extractor.Inventory{
 		Name:    "software",
 		Version: "1.0.0",
@@ -220,8 +402,9 @@ func TestScanResultToProto(t *testing.T) {
 				},
 			},
 		},
-		Locations: []string{"/file1"},
+		Locations: extractor.LocationsFromPaths("/file1"),
 		Extractor: &packagejson.Extractor{},
+		Ecosystem: "npm",
 	}
 	windowsInventory := &extractor.Inventory{
 		Name:    "windows_server_2019",
@@ -304,8 +487,9 @@ func TestScanResultToProto(t *testing.T) {
 		Extractor: "python/wheelegg",
 		Metadata: &spb.Inventory_PythonMetadata{
 			PythonMetadata: &spb.PythonPackageMetadata{
-				Author:      "author",
-				AuthorEmail: "author@corp.com",
+				Author:       "author",
+				AuthorEmail:  "author@corp.com",
+				Dependencies: []string{"bar>=1.0"},
 			},
 		},
 	}
@@ -328,6 +512,308 @@ func TestScanResultToProto(t *testing.T) {
 			},
 		},
 	}
+	nugetLockfileInventoryProto := &spb.Inventory{
+		Name:    "Newtonsoft.Json",
+		Version: "13.0.3",
+		Purl: &spb.Purl{
+			Purl:    "pkg:nuget/Newtonsoft.Json@13.0.3",
+			Type:    purl.TypeNuget,
+			Name:    "Newtonsoft.Json",
+			Version: "13.0.3",
+		},
+		Ecosystem: "NuGet",
+		Locations: []string{"/packages.lock.json"},
+		Extractor: "dotnet/packageslockjson",
+		Metadata: &spb.Inventory_NugetLockfileMetadata{
+			NugetLockfileMetadata: &spb.NuGetLockfileMetadata{
+				Framework:      "net8.0",
+				DependencyType: "Direct",
+				ContentHash:    "HrC5BXdl00IP9zeV+0Z848QWPAoCr9P3bDEZguI+gkLcBKAOxix/tLEAAHC+UvDNPv4a2d18lOReHMOagPa+zQ==",
+				Semver:         "13.0.3",
+				Requested:      "[13.0.3, )",
+			},
+		},
+	}
+	dockerfileInventoryProto := &spb.Inventory{
+		Name:    "golang",
+		Version: "1.22",
+		Purl: &spb.Purl{
+			Purl:    "pkg:docker/golang@1.22",
+			Type:    purl.TypeDocker,
+			Name:    "golang",
+			Version: "1.22",
+		},
+		Locations: []string{"/Dockerfile"},
+		Extractor: "containers/dockerfile",
+		Metadata: &spb.Inventory_DockerfileBaseImageMetadata{
+			DockerfileBaseImageMetadata: &spb.DockerfileBaseImageMetadata{
+				Repository: "golang",
+				Tag:        "1.22",
+				Stage:      "build",
+			},
+		},
+	}
+	githubActionInventoryProto := &spb.Inventory{
+		Name:    "github/codeql-action",
+		Version: "v3",
+		Purl: &spb.Purl{
+			Purl:      "pkg:githubactions/github/codeql-action@v3#init",
+			Type:      purl.TypeGithubActions,
+			Namespace: "github",
+			Name:      "codeql-action",
+			Version:   "v3",
+			Subpath:   "init",
+		},
+		Ecosystem: "GitHub Actions",
+		Locations: []string{"/.github/workflows/ci.yml"},
+		Extractor: "cicd/githubactions",
+		Metadata: &spb.Inventory_GithubActionMetadata{
+			GithubActionMetadata: &spb.GitHubActionMetadata{
+				Path: "init",
+			},
+		},
+	}
+	vendoredHeaderInventoryProto := &spb.Inventory{
+		Name:    "zlib",
+		Version: "1.2.11",
+		Purl: &spb.Purl{
+			Purl:    "pkg:generic/zlib@1.2.11",
+			Type:    purl.TypeGeneric,
+			Name:    "zlib",
+			Version: "1.2.11",
+		},
+		Locations:  []string{"/third_party/zlib/zlib.h"},
+		Extractor:  "cpp/vendoredheaders",
+		Confidence: 50,
+		Metadata: &spb.Inventory_VendoredHeaderMetadata{
+			VendoredHeaderMetadata: &spb.VendoredHeaderMetadata{
+				Macro: "ZLIB_VERSION",
+				Cpe:   "cpe:2.3:a:zlib:zlib:1.2.11:*:*:*:*:*:*:*",
+			},
+		},
+	}
+	condaMetaInventoryProto := &spb.Inventory{
+		Name:    "requests",
+		Version: "2.32.3",
+		Purl: &spb.Purl{
+			Purl:    "pkg:conda/requests@2.32.3?build=pyhd8ed1ab_0&channel=conda-forge&subdir=noarch",
+			Type:    purl.TypeConda,
+			Name:    "requests",
+			Version: "2.32.3",
+			Qualifiers: []*spb.Qualifier{
+				{Key: "build", Value: "pyhd8ed1ab_0"},
+				{Key: "channel", Value: "conda-forge"},
+				{Key: "subdir", Value: "noarch"},
+			},
+		},
+		Locations: []string{"/opt/conda/conda-meta/requests-2.32.3-pyhd8ed1ab_0.json"},
+		Extractor: "python/condameta",
+		Metadata: &spb.Inventory_CondaMetaMetadata{
+			CondaMetaMetadata: &spb.CondaMetaMetadata{
+				Build:   "pyhd8ed1ab_0",
+				Channel: "https://conda.anaconda.org/conda-forge/noarch",
+				Subdir:  "noarch",
+				Depends: []string{"python >=3.8"},
+			},
+		},
+	}
+	runtimeConfigInventoryProto := &spb.Inventory{
+		Name:    "Microsoft.NETCore.App",
+		Version: "6.0.0",
+		Purl: &spb.Purl{
+			Purl:    "pkg:generic/Microsoft.NETCore.App@6.0.0",
+			Type:    purl.TypeGeneric,
+			Name:    "Microsoft.NETCore.App",
+			Version: "6.0.0",
+		},
+		Locations: []string{"/app/MyApp.runtimeconfig.json"},
+		Extractor: "dotnet/runtimeconfig",
+		Metadata: &spb.Inventory_DotnetRuntimeConfigMetadata{
+			DotnetRuntimeConfigMetadata: &spb.DotnetRuntimeConfigMetadata{
+				Tfm:         "net6.0",
+				RollForward: "LatestPatch",
+			},
+		},
+	}
+	appxInventoryProto := &spb.Inventory{
+		Name:    "Microsoft.WindowsCalculator",
+		Version: "11.2210.0.0",
+		Purl: &spb.Purl{
+			Purl:      "pkg:appx/8wekyb3d8bbwe/Microsoft.WindowsCalculator@11.2210.0.0?arch=x64",
+			Type:      purl.TypeAppx,
+			Namespace: "8wekyb3d8bbwe",
+			Name:      "Microsoft.WindowsCalculator",
+			Version:   "11.2210.0.0",
+			Qualifiers: []*spb.Qualifier{
+				{Key: "arch", Value: "x64"},
+			},
+		},
+		Locations: []string{"Windows/System32/config/SOFTWARE"},
+		Extractor: "windows/appx",
+		Metadata: &spb.Inventory_WindowsAppxMetadata{
+			WindowsAppxMetadata: &spb.WindowsAppxMetadata{
+				PackageFullName:   "Microsoft.WindowsCalculator_11.2210.0.0_x64__8wekyb3d8bbwe",
+				PackageFamilyName: "Microsoft.WindowsCalculator_8wekyb3d8bbwe",
+				PublisherId:       "8wekyb3d8bbwe",
+				Architecture:      "x64",
+			},
+		},
+	}
+	cpanfileInventoryProto := &spb.Inventory{
+		Name:    "Test::More",
+		Version: "0.98",
+		Purl: &spb.Purl{
+			Purl:    "pkg:cpan/Test-More@0.98",
+			Type:    purl.TypeCPAN,
+			Name:    "Test-More",
+			Version: "0.98",
+		},
+		Locations:   []string{"/app/cpanfile"},
+		Extractor:   "perl/cpanfile",
+		Annotations: []spb.Inventory_AnnotationEnum{spb.Inventory_DEV_ONLY},
+		Metadata: &spb.Inventory_PerlRequirementMetadata{
+			PerlRequirementMetadata: &spb.PerlRequirementMetadata{
+				Phase:              "test",
+				Relationship:       "requires",
+				VersionRequirement: "0.98",
+			},
+		},
+	}
+	renvLockInventoryProto := &spb.Inventory{
+		Name:    "mime",
+		Version: "0.12.1",
+		SourceCode: &spb.SourceCodeIdentifier{
+			Repo:   "https://github.com/yihui/mime",
+			Commit: "1763e0dcb72fb58d97bab97bb834fc71f1e012bc",
+		},
+		Purl: &spb.Purl{
+			Purl:    "pkg:cran/mime@0.12.1?vcs_url=git%2Bhttps%3A%2F%2Fgithub.com%2Fyihui%2Fmime%401763e0dcb72fb58d97bab97bb834fc71f1e012bc",
+			Type:    purl.TypeCran,
+			Name:    "mime",
+			Version: "0.12.1",
+			Qualifiers: []*spb.Qualifier{
+				{Key: "vcs_url", Value: "git+https://github.com/yihui/mime@1763e0dcb72fb58d97bab97bb834fc71f1e012bc"},
+			},
+		},
+		Locations: []string{"/app/renv.lock"},
+		Extractor: "r/renvlock",
+		Metadata: &spb.Inventory_RenvLockMetadata{
+			RenvLockMetadata: &spb.RenvLockMetadata{
+				Source:    "GitHub",
+				RemoteRef: "main",
+				RemoteSha: "1763e0dcb72fb58d97bab97bb834fc71f1e012bc",
+			},
+		},
+	}
+	juliaManifestInventoryProto := &spb.Inventory{
+		Name:      "JSON",
+		Version:   "0.21.1",
+		Ecosystem: "Julia",
+		Purl: &spb.Purl{
+			Purl:    "pkg:julia/JSON@0.21.1?uuid=682c06a0-de6a-54ab-a142-c8b1cf79cde6",
+			Type:    purl.TypeJulia,
+			Name:    "JSON",
+			Version: "0.21.1",
+			Qualifiers: []*spb.Qualifier{
+				{Key: "uuid", Value: "682c06a0-de6a-54ab-a142-c8b1cf79cde6"},
+			},
+		},
+		Locations: []string{"/app/Manifest.toml"},
+		Extractor: "julia/manifest",
+		Metadata: &spb.Inventory_JuliaManifestMetadata{
+			JuliaManifestMetadata: &spb.JuliaManifestMetadata{
+				Uuid: "682c06a0-de6a-54ab-a142-c8b1cf79cde6",
+			},
+		},
+	}
+	depsJSONAssemblyInventoryProto := &spb.Inventory{
+		Name:    "Newtonsoft.Json",
+		Version: "13.0.1.25517",
+		Purl: &spb.Purl{
+			Purl:    "pkg:generic/Newtonsoft.Json@13.0.1.25517",
+			Type:    purl.TypeGeneric,
+			Name:    "Newtonsoft.Json",
+			Version: "13.0.1.25517",
+		},
+		Locations: []string{"/app/Newtonsoft.Json.dll", "/app/MyApp.deps.json"},
+		Extractor: "dotnet/depsjson",
+		Metadata: &spb.Inventory_DotnetAssemblyMetadata{
+			DotnetAssemblyMetadata: &spb.DotnetAssemblyMetadata{
+				Path:            "lib/netstandard2.0/Newtonsoft.Json.dll",
+				AssemblyVersion: "13.0.0.0",
+				FileVersion:     "13.0.1.25517",
+				Library:         "Newtonsoft.Json",
+				LibraryVersion:  "13.0.1",
+			},
+		},
+	}
+	wmiProductInventoryProto := &spb.Inventory{
+		Name:    "Java 8 Update 202",
+		Version: "8.0.2020.8",
+		Purl: &spb.Purl{
+			Purl:      "pkg:generic/microsoft/Java%208%20Update%20202@8.0.2020.8",
+			Type:      purl.TypeGeneric,
+			Namespace: "microsoft",
+			Name:      "Java 8 Update 202",
+			Version:   "8.0.2020.8",
+		},
+		Locations: []string{"Windows/System32/wbem/Repository/OBJECTS.DATA"},
+		Extractor: "windows/wmiproducts",
+		Metadata: &spb.Inventory_WindowsWmiProductMetadata{
+			WindowsWmiProductMetadata: &spb.WindowsWmiProductMetadata{
+				Class:             "Win32_Product",
+				Vendor:            "Oracle Corporation",
+				IdentifyingNumber: "{26A24AE4-039D-4CA4-87B4-2F64180202F0}",
+			},
+		},
+	}
+	sysPathInventoryProto := &spb.Inventory{
+		Name:      `C:\ProgramData\chocolatey\bin`,
+		Locations: []string{`HKEY_LOCAL_MACHINE\SYSTEM\CurrentControlSet\Control\Session Manager\Environment`},
+		Extractor: "windows/syspath",
+		Metadata: &spb.Inventory_WindowsPathDirectoryMetadata{
+			WindowsPathDirectoryMetadata: &spb.WindowsPathDirectoryMetadata{
+				Index:    3,
+				Expanded: `C:\ProgramData\chocolatey\bin`,
+				Issues:   []string{"non-standard", "user-writable"},
+			},
+		},
+	}
+	elfDepsInventoryProto := &spb.Inventory{
+		Name:      "libssl.so.3",
+		Purl:      &spb.Purl{Purl: "pkg:generic/libssl.so.3", Type: purl.TypeGeneric, Name: "libssl.so.3"},
+		Locations: []string{"/usr/bin/curl"},
+		Extractor: "binary/elfdeps",
+		Metadata: &spb.Inventory_ElfSharedLibraryMetadata{
+			ElfSharedLibraryMetadata: &spb.ElfSharedLibraryMetadata{Library: "libssl", AbiVersion: "3"},
+		},
+	}
+	gitSubmoduleInventoryProto := &spb.Inventory{
+		Name:    "googletest",
+		Version: "b514bdc898e2951020cbdca1304b75f5950d1f59",
+		SourceCode: &spb.SourceCodeIdentifier{
+			Repo:   "https://github.com/google/googletest.git",
+			Commit: "b514bdc898e2951020cbdca1304b75f5950d1f59",
+		},
+		Purl: &spb.Purl{
+			Purl:    "pkg:generic/googletest@b514bdc898e2951020cbdca1304b75f5950d1f59?vcs_url=git%2Bhttps%3A%2F%2Fgithub.com%2Fgoogle%2Fgoogletest.git%40b514bdc898e2951020cbdca1304b75f5950d1f59",
+			Type:    purl.TypeGeneric,
+			Name:    "googletest",
+			Version: "b514bdc898e2951020cbdca1304b75f5950d1f59",
+			Qualifiers: []*spb.Qualifier{
+				{Key: "vcs_url", Value: "git+https://github.com/google/googletest.git@b514bdc898e2951020cbdca1304b75f5950d1f59"},
+			},
+		},
+		Locations: []string{"/.gitmodules"},
+		Extractor: "vcs/gitmodules",
+		Metadata: &spb.Inventory_GitSubmoduleMetadata{
+			GitSubmoduleMetadata: &spb.GitSubmoduleMetadata{
+				Name: "third_party/googletest",
+				Path: "third_party/googletest",
+				Url:  "https://github.com/google/googletest.git",
+			},
+		},
+	}
 	purlJavascriptInventoryProto := &spb.Inventory{
 		Name:    "software",
 		Version: "1.0.0",
@@ -359,13 +845,13 @@ func TestScanResultToProto(t *testing.T) {
 				Version: "1.1.1",
 			},
 		},
-		Locations: []string{"/openssl"},
+		Locations: extractor.LocationsFromPaths("/openssl"),
 		Extractor: &cdx.Extractor{},
 	}
 	cdxInventoryProto := &spb.Inventory{
 		Name:      "openssl",
 		Version:   "1.1.1",
-		Ecosystem: "generic",
+		Ecosystem: "",
 		Purl: &spb.Purl{
 			Purl:    "pkg:generic/openssl@1.1.1",
 			Type:    purl.TypeGeneric,
@@ -400,8 +886,9 @@ func TestScanResultToProto(t *testing.T) {
 			Architecture: "x86_64",
 			License:      "BSD",
 		},
-		Locations: []string{"/file1"},
+		Locations: extractor.LocationsFromPaths("/file1"),
 		Extractor: rpm.New(rpm.DefaultConfig()),
+		Ecosystem: "Red Hat",
 	}
 	purlRPMInventoryProto := &spb.Inventory{
 		Name:    "openssh-clients",
@@ -452,7 +939,7 @@ func TestScanResultToProto(t *testing.T) {
 			UpperDir:    "/var/lib/containerd/io.containerd.snapshotter.v1.overlayfs/snapshots/4/fs",
 			WorkDir:     "/var/lib/containerd/io.containerd.snapshotter.v1.overlayfs/snapshots/4/work",
 		},
-		Locations: []string{"/file4"},
+		Locations: extractor.LocationsFromPaths("/file4"),
 		Extractor: &ctrdfs.Extractor{},
 	}
 	containerdInventoryProto := &spb.Inventory{
@@ -488,7 +975,7 @@ func TestScanResultToProto(t *testing.T) {
 			PID:         8915,
 			RootFS:      "/run/containerd/io.containerd.runtime.v2.task/default/1234567890/rootfs",
 		},
-		Locations: []string{"/file7"},
+		Locations: extractor.LocationsFromPaths("/file7"),
 		Extractor: &ctrdruntime.Extractor{},
 	}
 	containerdRuntimeInventoryProto := &spb.Inventory{
@@ -564,6 +1051,21 @@ func TestScanResultToProto(t *testing.T) {
 					purlDPKGAnnotationInventory,
 					purlPythonInventory,
 					pythonRequirementsInventory,
+					nugetLockfileInventory,
+					githubActionInventory,
+					dockerfileInventory,
+					gitSubmoduleInventory,
+					vendoredHeaderInventory,
+					condaMetaInventory,
+					runtimeConfigInventory,
+					appxInventory,
+					cpanfileInventory,
+					renvLockInventory,
+					juliaManifestInventory,
+					depsJSONAssemblyInventory,
+					wmiProductInventory,
+					sysPathInventory,
+					elfDepsInventory,
 					purlJavascriptInventory,
 					cdxInventory,
 					windowsInventory,
@@ -592,6 +1094,13 @@ func TestScanResultToProto(t *testing.T) {
 						Extra: "extra details",
 					},
 				},
+				Provenance: &scalibr.Provenance{
+					ScannerVersion: "1.0.0",
+					StartTime:      startTime,
+					ScanRoots:      []string{"/"},
+					Extractors:     []string{"ext"},
+					Detectors:      []string{"det"},
+				},
 			},
 			want: &spb.ScanResult{
 				Version:   "1.0.0",
@@ -615,6 +1124,21 @@ func TestScanResultToProto(t *testing.T) {
 					purlDPKGAnnotationInventoryProto,
 					purlPythonInventoryProto,
 					pythonRequirementsInventoryProto,
+					nugetLockfileInventoryProto,
+					githubActionInventoryProto,
+					dockerfileInventoryProto,
+					gitSubmoduleInventoryProto,
+					vendoredHeaderInventoryProto,
+					condaMetaInventoryProto,
+					runtimeConfigInventoryProto,
+					appxInventoryProto,
+					cpanfileInventoryProto,
+					renvLockInventoryProto,
+					juliaManifestInventoryProto,
+					depsJSONAssemblyInventoryProto,
+					wmiProductInventoryProto,
+					sysPathInventoryProto,
+					elfDepsInventoryProto,
 					purlJavascriptInventoryProto,
 					cdxInventoryProto,
 					windowsInventoryProto,
@@ -643,6 +1167,13 @@ func TestScanResultToProto(t *testing.T) {
 						Extra: "extra details",
 					},
 				},
+				Provenance: &spb.Provenance{
+					ScannerVersion: "1.0.0",
+					StartTime:      timestamppb.New(startTime),
+					ScanRoots:      []string{"/"},
+					Extractors:     []string{"ext"},
+					Detectors:      []string{"det"},
+				},
 			},
 		},
 		{
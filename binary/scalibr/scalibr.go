@@ -19,6 +19,8 @@ package main
 import (
 	"flag"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/google/osv-scalibr/binary/cli"
 	"github.com/google/osv-scalibr/binary/scanrunner"
@@ -42,6 +44,15 @@ func parseFlags() *cli.Flags {
 	var dirsToSkip cli.StringListFlag
 	flag.Var(&dirsToSkip, "skip-dirs", "Comma-separated list of file paths to avoid traversing")
 	skipDirRegex := flag.String("skip-dir-regex", "", "If the regex matches a directory, it will be skipped. The regex is matched against the absolute file path.")
+	useIgnoreFiles := flag.Bool("use-ignore-files", false, "If set, files and directories matching the gitignore-style patterns of .scalibrignore files are skipped.")
+	oneFileSystem := flag.Bool("one-file-system", false, "If set, directories on other filesystems than the scan root, e.g. mount points of other devices, are skipped. Only supported on Unix.")
+	skipPseudoFS := flag.Bool("skip-pseudo-fs", false, "If set, the dev, proc and sys directories of the scan root are skipped so that scans of root filesystems don't read kernel interfaces.")
+	// Passed on as the scan config's MaxDepth, which counts the files in the
+	// scan root as depth 1 and uses 0 for no limit.
+	maxDepth := flag.Int("max-depth", -1, "Maximum number of directory levels below the scan root to descend into. 0 only scans the files in the scan root, -1 applies no limit.")
+	minConfidence := flag.Int("min-confidence", 0, "Minimum confidence (1-100) of the inventory to report. Inventory that heuristic extractors are less certain about is dropped. 0 reports all inventory.")
+	mergeLocations := flag.Bool("merge-locations", false, "If set, a package found in several files, e.g. the lockfiles of different projects, is reported once with all its locations instead of once per file.")
+	timeout := flag.Duration("timeout", 0, "Maximum duration of the scan, e.g. 30m. Once it's exceeded, the scan stops and the results found so far are written out. 0 applies no limit.")
 	remoteImage := flag.String("remote-image", "", "The remote image to scan. If specified, SCALIBR pulls and scans this image instead of the local filesystem.")
 	imagePlatform := flag.String("image-platform", "", "The platform of the remote image to scan. If not specified, the platform of the client is used. Format is os/arch (e.g. linux/arm64)")
 	govulncheckDBPath := flag.String("govulncheck-db", "", "Path to the offline DB for the govulncheck detectors to use. Leave empty to run the detectors in online mode.")
@@ -55,10 +66,30 @@ func parseFlags() *cli.Flags {
 	explicitExtractors := flag.Bool("explicit-extractors", false, "If set, the program will exit with an error if not all extractors required by enabled detectors are explicitly enabled.")
 	filterByCapabilities := flag.Bool("filter-by-capabilities", true, "If set, plugins whose requirements (network access, OS, etc.) aren't satisfied by the scanning environment will be silently disabled instead of throwing a validation error.")
 	windowsAllDrives := flag.Bool("windows-all-drives", false, "Scan all drives on Windows")
+	listExtractors := flag.Bool("list-extractors", false, "If set, the available extractors are listed together with the package types they report and the OS they run on, and no scan is run.")
+	reproducible := flag.Bool("reproducible", false, "If set, the outputs contain no random IDs and their timestamps are set to SOURCE_DATE_EPOCH, or the Unix epoch if it's unset, so that the same scan results always yield the same bytes.")
 
 	flag.Parse()
 	filesToExtract := flag.Args()
 
+	if *listExtractors {
+		if err := cli.ListExtractors(os.Stdout); err != nil {
+			log.Errorf("Error listing extractors: %v", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	var reproducibleTimestamp time.Time
+	if epoch := os.Getenv("SOURCE_DATE_EPOCH"); *reproducible && epoch != "" {
+		secs, err := strconv.ParseInt(epoch, 10, 64)
+		if err != nil {
+			log.Errorf("Error parsing SOURCE_DATE_EPOCH: %v", err)
+			os.Exit(1)
+		}
+		reproducibleTimestamp = time.Unix(secs, 0)
+	}
+
 	flags := &cli.Flags{
 		Root:                  *root,
 		ResultFile:            *resultFile,
@@ -68,6 +99,13 @@ func parseFlags() *cli.Flags {
 		FilesToExtract:        filesToExtract,
 		DirsToSkip:            dirsToSkip.GetSlice(),
 		SkipDirRegex:          *skipDirRegex,
+		UseIgnoreFiles:        *useIgnoreFiles,
+		OneFileSystem:         *oneFileSystem,
+		SkipPseudoFilesystems: *skipPseudoFS,
+		MaxDepth:              *maxDepth + 1,
+		MinConfidence:         *minConfidence,
+		MergeLocations:        *mergeLocations,
+		Timeout:               *timeout,
 		RemoteImage:           *remoteImage,
 		ImagePlatform:         *imagePlatform,
 		GovulncheckDBPath:     *govulncheckDBPath,
@@ -81,6 +119,8 @@ func parseFlags() *cli.Flags {
 		ExplicitExtractors:    *explicitExtractors,
 		FilterByCapabilities:  *filterByCapabilities,
 		WindowsAllDrives:      *windowsAllDrives,
+		Reproducible:          *reproducible,
+		ReproducibleTimestamp: reproducibleTimestamp,
 	}
 	if err := cli.ValidateFlags(flags); err != nil {
 		log.Errorf("Error parsing CLI args: %v", err)
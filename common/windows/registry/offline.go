@@ -15,6 +15,7 @@
 package registry
 
 import (
+	"context"
 	"errors"
 	"io"
 	"os"
@@ -27,6 +28,13 @@ var (
 	errFailedToOpenKey       = errors.New("failed to open key")
 )
 
+// Flags marking names that are stored as Latin-1 instead of UTF-16LE in the
+// hive.
+const (
+	keyCompressedName   = 0x0020
+	valueCompressedName = 0x0001
+)
+
 // OfflineRegistry wraps the regparser library to provide offline (from file) parsing of the Windows
 // registry.
 type OfflineRegistry struct {
@@ -52,11 +60,37 @@ func NewFromFile(path string) (*OfflineRegistry, error) {
 
 // OpenKey open the requested registry key.
 func (o *OfflineRegistry) OpenKey(path string) (Key, error) {
-	key := o.registry.OpenKey(path)
+	return o.OpenKeyContext(context.Background(), path)
+}
+
+// OpenKeyContext opens the requested registry key. The context is checked
+// before descending into each key of the path.
+func (o *OfflineRegistry) OpenKeyContext(ctx context.Context, path string) (Key, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	key := o.registry.OpenKey("")
 	if key == nil {
 		return nil, errFailedToOpenKey
 	}
 
+subkeyMatch:
+	for _, component := range regparser.SplitComponents(path) {
+		if component == "" {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		for _, subkey := range key.Subkeys() {
+			if subkey.Name() == component {
+				key = subkey
+				continue subkeyMatch
+			}
+		}
+		return nil, errFailedToOpenKey
+	}
+
 	return &OfflineKey{key: key}, nil
 }
 
@@ -73,7 +107,13 @@ type OfflineKey struct {
 
 // Name returns the name of the key.
 func (o *OfflineKey) Name() string {
-	return o.key.Name()
+	return DecodeName(o.NameRaw())
+}
+
+// NameRaw returns the name of the key as UTF-16LE.
+func (o *OfflineKey) NameRaw() []byte {
+	return readName(o.key.Reader, o.key.Profile.Off_CM_KEY_NODE__Name+o.key.Offset,
+		o.key.NameLength(), o.key.Flags()&keyCompressedName != 0)
 }
 
 // SubkeyNames returns the names of the subkeys of the key.
@@ -88,6 +128,15 @@ func (o *OfflineKey) SubkeyNames() ([]string, error) {
 
 // Subkeys returns the subkeys of the key.
 func (o *OfflineKey) Subkeys() ([]Key, error) {
+	return o.SubkeysContext(context.Background())
+}
+
+// SubkeysContext returns the subkeys of the key, or the context's error if it
+// is cancelled before they are read.
+func (o *OfflineKey) SubkeysContext(ctx context.Context) ([]Key, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	var subkeys []Key
 	for _, subkey := range o.key.Subkeys() {
 		subkeys = append(subkeys, &OfflineKey{subkey})
@@ -119,6 +168,15 @@ func (o *OfflineKey) ClassName() ([]byte, error) {
 
 // Values returns the different values contained in the key.
 func (o *OfflineKey) Values() ([]Value, error) {
+	return o.ValuesContext(context.Background())
+}
+
+// ValuesContext returns the different values contained in the key, or the
+// context's error if it is cancelled before they are read.
+func (o *OfflineKey) ValuesContext(ctx context.Context) ([]Value, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	var values []Value
 	for _, value := range o.key.Values() {
 		values = append(values, &OfflineValue{value})
@@ -135,7 +193,31 @@ type OfflineValue struct {
 
 // Name returns the name of the value.
 func (o *OfflineValue) Name() string {
-	return o.value.ValueName()
+	return DecodeName(o.NameRaw())
+}
+
+// NameRaw returns the name of the value as UTF-16LE.
+func (o *OfflineValue) NameRaw() []byte {
+	return readName(o.value.Reader, o.value.Profile.Off_CM_KEY_VALUE_Name+o.value.Offset,
+		o.value.NameLength(), o.value.Flags()&valueCompressedName != 0)
+}
+
+// readName reads a key or value name of length bytes from the hive and
+// returns it as UTF-16LE. Compressed names are stored as Latin-1, whose
+// characters map to the same UTF-16 code units.
+func readName(r io.ReaderAt, offset int64, length uint16, compressed bool) []byte {
+	buf := make([]byte, length)
+	if _, err := r.ReadAt(buf, offset); err != nil {
+		return nil
+	}
+	if !compressed {
+		return buf
+	}
+	raw := make([]byte, 0, 2*len(buf))
+	for _, c := range buf {
+		raw = append(raw, c, 0)
+	}
+	return raw
 }
 
 // Data returns the data contained in the value.
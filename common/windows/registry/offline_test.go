@@ -0,0 +1,97 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/osv-scalibr/common/windows/registry"
+)
+
+// emptyHive writes a hive file that only has the registry magic.
+func emptyHive(t *testing.T) string {
+	t.Helper()
+	data := make([]byte, 8192)
+	copy(data, "regf")
+	path := filepath.Join(t.TempDir(), "SOFTWARE")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatalf("os.WriteFile(%s): %v", path, err)
+	}
+	return path
+}
+
+func TestOpenKeyContext_Cancelled(t *testing.T) {
+	reg, err := registry.NewFromFile(emptyHive(t))
+	if err != nil {
+		t.Fatalf("registry.NewFromFile(): %v", err)
+	}
+	defer reg.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := reg.OpenKeyContext(ctx, `Microsoft\Windows NT\CurrentVersion`); !errors.Is(err, context.Canceled) {
+		t.Errorf("OpenKeyContext() error: got %v, want %v", err, context.Canceled)
+	}
+	if _, err := registry.OpenKeyContext(ctx, reg, `Microsoft\Windows NT\CurrentVersion`); !errors.Is(err, context.Canceled) {
+		t.Errorf("registry.OpenKeyContext() error: got %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestOpenKeyContext_MissingKey(t *testing.T) {
+	reg, err := registry.NewFromFile(emptyHive(t))
+	if err != nil {
+		t.Fatalf("registry.NewFromFile(): %v", err)
+	}
+	defer reg.Close()
+
+	if _, err := reg.OpenKeyContext(context.Background(), `Microsoft`); err == nil {
+		t.Errorf("OpenKeyContext() succeeded for a key missing from the hive, want error")
+	}
+}
+
+// plainRegistry only implements registry.Registry.
+type plainRegistry struct {
+	opened int
+}
+
+func (r *plainRegistry) OpenKey(string) (registry.Key, error) {
+	r.opened++
+	return nil, errors.New("not found")
+}
+
+func (r *plainRegistry) Close() error { return nil }
+
+func TestOpenKeyContext_PlainRegistry(t *testing.T) {
+	reg := &plainRegistry{}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := registry.OpenKeyContext(ctx, reg, "Key"); !errors.Is(err, context.Canceled) {
+		t.Errorf("registry.OpenKeyContext() error: got %v, want %v", err, context.Canceled)
+	}
+	if reg.opened != 0 {
+		t.Errorf("registry.OpenKeyContext() opened the key %d times with a cancelled context, want 0", reg.opened)
+	}
+
+	if _, err := registry.OpenKeyContext(context.Background(), reg, "Key"); err == nil {
+		t.Errorf("registry.OpenKeyContext() succeeded, want the error of OpenKey")
+	}
+	if reg.opened != 1 {
+		t.Errorf("registry.OpenKeyContext() opened the key %d times, want 1", reg.opened)
+	}
+}
@@ -17,6 +17,8 @@
 // of testing.
 package registry
 
+import "context"
+
 // Registry represents an open registry hive.
 type Registry interface {
 	// OpenKey returns a Key for the given path.
@@ -31,6 +33,10 @@ type Key interface {
 	// Name returns the name of the key.
 	Name() string
 
+	// NameRaw returns the name of the key as UTF-16LE without a terminating
+	// NUL. Unlike Name, it preserves names that aren't valid UTF-16.
+	NameRaw() []byte
+
 	// Close closes the key.
 	Close() error
 
@@ -52,6 +58,70 @@ type Value interface {
 	// Name returns the name of the value.
 	Name() string
 
+	// NameRaw returns the name of the value as UTF-16LE without a terminating
+	// NUL. Unlike Name, it preserves names that aren't valid UTF-16.
+	NameRaw() []byte
+
 	// Data returns the data of the value.
 	Data() ([]byte, error)
 }
+
+// ContextRegistry is implemented by registries that can stop opening a key
+// when the context is cancelled, e.g. for hives read from slow storage.
+type ContextRegistry interface {
+	Registry
+
+	// OpenKeyContext returns a Key for the given path, or the context's error
+	// if it is cancelled first.
+	OpenKeyContext(ctx context.Context, path string) (Key, error)
+}
+
+// ContextKey is implemented by keys that can stop enumerating their contents
+// when the context is cancelled.
+type ContextKey interface {
+	Key
+
+	// SubkeysContext returns the opened subkeys of the key, or the context's
+	// error if it is cancelled first.
+	SubkeysContext(ctx context.Context) ([]Key, error)
+
+	// ValuesContext returns the different values of the key, or the context's
+	// error if it is cancelled first.
+	ValuesContext(ctx context.Context) ([]Value, error)
+}
+
+// OpenKeyContext opens the key at path from reg. The context is passed on if reg
+// is a ContextRegistry and only checked before opening the key otherwise.
+func OpenKeyContext(ctx context.Context, reg Registry, path string) (Key, error) {
+	if r, ok := reg.(ContextRegistry); ok {
+		return r.OpenKeyContext(ctx, path)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return reg.OpenKey(path)
+}
+
+// SubkeysContext returns the subkeys of key. The context is passed on if key is
+// a ContextKey and only checked before the enumeration otherwise.
+func SubkeysContext(ctx context.Context, key Key) ([]Key, error) {
+	if k, ok := key.(ContextKey); ok {
+		return k.SubkeysContext(ctx)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return key.Subkeys()
+}
+
+// ValuesContext returns the values of key. The context is passed on if key is a
+// ContextKey and only checked before the enumeration otherwise.
+func ValuesContext(ctx context.Context, key Key) ([]Value, error) {
+	if k, ok := key.(ContextKey); ok {
+		return k.ValuesContext(ctx)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return key.Values()
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"encoding/binary"
+	"fmt"
+	"unicode/utf16"
+)
+
+// DecodeString decodes the data of a REG_SZ or REG_EXPAND_SZ value, which is a
+// NUL-terminated UTF-16LE string.
+func DecodeString(data []byte) string {
+	u := make([]uint16, 0, len(data)/2)
+	for i := 0; i+1 < len(data); i += 2 {
+		c := binary.LittleEndian.Uint16(data[i:])
+		if c == 0 {
+			break
+		}
+		u = append(u, c)
+	}
+	return string(utf16.Decode(u))
+}
+
+// EncodeName encodes a key or value name as UTF-16LE, as returned by NameRaw.
+func EncodeName(name string) []byte {
+	u := utf16.Encode([]rune(name))
+	b := make([]byte, 2*len(u))
+	for i, c := range u {
+		binary.LittleEndian.PutUint16(b[2*i:], c)
+	}
+	return b
+}
+
+// DecodeName decodes a UTF-16LE key or value name as returned by NameRaw.
+// Unpaired surrogates are replaced by U+FFFD.
+func DecodeName(raw []byte) string {
+	u := make([]uint16, 0, len(raw)/2)
+	for i := 0; i+1 < len(raw); i += 2 {
+		u = append(u, binary.LittleEndian.Uint16(raw[i:]))
+	}
+	return string(utf16.Decode(u))
+}
+
+// DecodeDWORD decodes the data of a REG_DWORD value.
+func DecodeDWORD(data []byte) (uint32, error) {
+	if len(data) < 4 {
+		return 0, fmt.Errorf("REG_DWORD value has %d bytes, want 4", len(data))
+	}
+	return binary.LittleEndian.Uint32(data), nil
+}
+
+// DecodeQWORD decodes the data of a REG_QWORD value.
+func DecodeQWORD(data []byte) (uint64, error) {
+	if len(data) < 8 {
+		return 0, fmt.Errorf("REG_QWORD value has %d bytes, want 8", len(data))
+	}
+	return binary.LittleEndian.Uint64(data), nil
+}
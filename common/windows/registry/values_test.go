@@ -0,0 +1,69 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/common/windows/registry"
+)
+
+func TestEncodeName(t *testing.T) {
+	tests := []struct {
+		name string
+		want []byte
+	}{
+		{name: "Run", want: []byte{'R', 0, 'u', 0, 'n', 0}},
+		{name: "Café", want: []byte{'C', 0, 'a', 0, 'f', 0, 0xe9, 0}},
+		{name: "日本", want: []byte{0xe5, 0x65, 0x2c, 0x67}},
+		// Characters outside the BMP are encoded as surrogate pairs.
+		{name: "😀", want: []byte{0x3d, 0xd8, 0x00, 0xde}},
+		{name: "", want: []byte{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := registry.EncodeName(tc.name)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("EncodeName(%q) (-want +got):\n%s", tc.name, diff)
+			}
+			if dec := registry.DecodeName(got); dec != tc.name {
+				t.Errorf("DecodeName(EncodeName(%q)) = %q, want the original name", tc.name, dec)
+			}
+		})
+	}
+}
+
+func TestDecodeName_UnpairedSurrogate(t *testing.T) {
+	// "a" followed by a high surrogate without its low surrogate.
+	raw := []byte{'a', 0, 0x3d, 0xd8}
+	if got, want := registry.DecodeName(raw), "a�"; got != want {
+		t.Errorf("DecodeName(%v) = %q, want %q", raw, got, want)
+	}
+}
+
+func TestDecodeQWORD(t *testing.T) {
+	got, err := registry.DecodeQWORD([]byte{0x01, 0x00, 0x02, 0x00, 0x03, 0x00, 0x04, 0x00})
+	if err != nil {
+		t.Fatalf("DecodeQWORD(): %v", err)
+	}
+	if want := uint64(0x0004000300020001); got != want {
+		t.Errorf("DecodeQWORD() = %#x, want %#x", got, want)
+	}
+	if _, err := registry.DecodeQWORD([]byte{1, 2, 3, 4}); err == nil {
+		t.Error("DecodeQWORD() of 4 bytes succeeded, want error")
+	}
+}
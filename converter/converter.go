@@ -19,11 +19,16 @@ package converter
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/CycloneDX/cyclonedx-go"
 	scalibr "github.com/google/osv-scalibr"
 	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/nugetcache"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/nupkg"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/packagejson"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/python/wheelegg"
 	cdxe "github.com/google/osv-scalibr/extractor/filesystem/sbom/cdx"
 	spdxe "github.com/google/osv-scalibr/extractor/filesystem/sbom/spdx"
 	"github.com/google/osv-scalibr/log"
@@ -43,9 +48,39 @@ const (
 // spdx_id must only contain letters, numbers, "." and "-"
 var spdxIDInvalidCharRe = regexp.MustCompile(`[^a-zA-Z0-9.-]`)
 
-// ToPURL converts a SCALIBR inventory structure into a package URL.
+// ToPURL converts a SCALIBR inventory structure into a package URL. Returns nil
+// if the extractor of the inventory fails to convert it.
 func ToPURL(i *extractor.Inventory) *purl.PackageURL {
-	return i.Extractor.ToPURL(i)
+	p, err := toPURL(i)
+	if err != nil {
+		log.Warnf("%v", err)
+	}
+	return p
+}
+
+// toPURL converts the inventory into a package URL. Extractors return nil for
+// inventory that isn't a package, which isn't an error, but their ToPURL can
+// panic on inventory they don't expect, e.g. inventory of other platforms or
+// with metadata of another type. Such panics are returned as errors so that a
+// single inventory can't abort the conversion of the whole scan result.
+func toPURL(i *extractor.Inventory) (p *purl.PackageURL, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			p = nil
+			err = fmt.Errorf("%s extractor failed to convert %s@%s into a PURL: %v", extractorName(i), i.Name, i.Version, r)
+		}
+	}()
+	if i.Extractor == nil {
+		return nil, fmt.Errorf("inventory %s@%s has no extractor to convert it into a PURL", i.Name, i.Version)
+	}
+	return i.Extractor.ToPURL(i), nil
+}
+
+func extractorName(i *extractor.Inventory) string {
+	if i.Extractor == nil {
+		return "unknown"
+	}
+	return i.Extractor.Name()
 }
 
 // SPDXConfig describes custom settings that should be applied to the generated SPDX file.
@@ -53,14 +88,75 @@ type SPDXConfig struct {
 	DocumentName      string
 	DocumentNamespace string
 	Creators          []common.Creator
+	// Reproducible makes the document depend only on the scan results: the
+	// creation and scan start times are set to Timestamp and the package IDs
+	// and default namespace are derived from the packages instead of being
+	// random.
+	Reproducible bool
+	// Timestamp is the creation time used in reproducible mode. If unset, the
+	// Unix epoch is used.
+	Timestamp time.Time
+}
+
+// newUUIDs returns a function generating the UUIDs of a document. In
+// reproducible mode they're derived from the given name and the number of
+// previous calls instead of being random, so the same scan results always yield
+// the same IDs.
+func newUUIDs(reproducible bool) func(name string) string {
+	if !reproducible {
+		return func(string) string { return uuid.New().String() }
+	}
+	n := 0
+	return func(name string) string {
+		n++
+		return uuid.NewSHA1(uuid.NameSpaceURL, []byte(fmt.Sprintf("%d/%s", n, name))).String()
+	}
+}
+
+// creationTime returns the time a document is created at, which is the
+// current time unless the document is meant to be reproducible.
+func creationTime(reproducible bool, timestamp time.Time) time.Time {
+	if !reproducible {
+		return time.Now()
+	}
+	if timestamp.IsZero() {
+		return time.Unix(0, 0)
+	}
+	return timestamp
+}
+
+// scanStartTime returns the scan start time to record in a document, which is
+// the creation time for reproducible documents.
+func scanStartTime(p *scalibr.Provenance, reproducible bool, created time.Time) time.Time {
+	if reproducible {
+		return created
+	}
+	return p.StartTime
 }
 
 // ToSPDX23 converts the SCALIBR scan results into an SPDX v2.3 document.
+// Inventory that can't be converted into a PURL is included without one, see
+// ToSPDX23WithErrors.
 func ToSPDX23(r *scalibr.ScanResult, c SPDXConfig) *v2_3.Document {
+	doc, errs := ToSPDX23WithErrors(r, c)
+	for _, err := range errs {
+		log.Warnf("%v", err)
+	}
+	return doc
+}
+
+// ToSPDX23WithErrors converts the SCALIBR scan results into an SPDX v2.3
+// document and returns the errors of the inventory that couldn't be converted
+// into a PURL. Such inventory is still included in the document but has no
+// PURL reference.
+func ToSPDX23WithErrors(r *scalibr.ScanResult, c SPDXConfig) (*v2_3.Document, []error) {
+	var errs []error
 	packages := make([]*v2_3.Package, 0, len(r.Inventories)+1)
+	newUUID := newUUIDs(c.Reproducible)
+	created := creationTime(c.Reproducible, c.Timestamp)
 
 	// Add a main package that contains all other top-level packages.
-	mainPackageID := SPDXRefPrefix + "Package-main-" + uuid.New().String()
+	mainPackageID := SPDXRefPrefix + "Package-main-" + newUUID("main")
 	packages = append(packages, &v2_3.Package{
 		PackageName:           "main",
 		PackageSPDXIdentifier: common.ElementID(mainPackageID),
@@ -76,44 +172,46 @@ func ToSPDX23(r *scalibr.ScanResult, c SPDXConfig) *v2_3.Document {
 	relationships := make([]*v2_3.Relationship, 0, 2*len(r.Inventories))
 
 	for _, i := range r.Inventories {
-		p := ToPURL(i)
-		if p == nil {
-			log.Warnf("Inventory %v has no PURL, skipping", i)
-			continue
+		p, err := toPURL(i)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		pName, pVersion, pKey := i.Name, i.Version, i.Name+"@"+i.Version
+		if p != nil {
+			pName, pVersion, pKey = p.Name, p.Version, p.String()
 		}
-		pName := p.Name
-		pVersion := p.Version
 		if pName == "" || pVersion == "" {
-			log.Warnf("Inventory %v PURL name or version empty, skipping", i)
+			log.Warnf("Inventory %v name or version empty, skipping", i)
 			continue
 		}
-		pID := SPDXRefPrefix + "Package-" + replaceSPDXIDInvalidChars(pName) + "-" + uuid.New().String()
+		pID := SPDXRefPrefix + "Package-" + replaceSPDXIDInvalidChars(pName) + "-" + newUUID(pKey)
 		pSourceInfo := fmt.Sprintf("Identified by the %s extractor", i.Extractor.Name())
 		if len(i.Locations) == 1 {
-			pSourceInfo += fmt.Sprintf(" from %s", i.Locations[0])
+			pSourceInfo += fmt.Sprintf(" from %s", i.Locations[0].Path)
 		} else if l := len(i.Locations); l > 1 {
-			pSourceInfo += fmt.Sprintf(" from %d locations, including %s and %s", l, i.Locations[0], i.Locations[1])
+			pSourceInfo += fmt.Sprintf(" from %d locations, including %s and %s", l, i.Locations[0].Path, i.Locations[1].Path)
 		}
 
-		packages = append(packages, &v2_3.Package{
-			PackageName:           pName,
-			PackageSPDXIdentifier: common.ElementID(pID),
-			PackageVersion:        pVersion,
-			PackageSupplier: &common.Supplier{
-				Supplier:     NoAssertion,
-				SupplierType: NoAssertion,
-			},
+		pkg := &v2_3.Package{
+			PackageName:               pName,
+			PackageSPDXIdentifier:     common.ElementID(pID),
+			PackageVersion:            pVersion,
+			PackageSupplier:           toSupplier(i),
 			PackageDownloadLocation:   NoAssertion,
 			IsFilesAnalyzedTagPresent: false,
 			PackageSourceInfo:         pSourceInfo,
-			PackageExternalReferences: []*v2_3.PackageExternalReference{
+			PackageLicenseDeclared:    toLicenseExpression(extractLicenses(i)),
+		}
+		if p != nil {
+			pkg.PackageExternalReferences = []*v2_3.PackageExternalReference{
 				{
 					Category: "PACKAGE-MANAGER",
 					RefType:  "purl",
 					Locator:  p.String(),
 				},
-			},
-		})
+			}
+		}
+		packages = append(packages, pkg)
 		// TODO(b/313658493): Add a DESCRIBES relationship or a DocumentDescribes field.
 		relationships = append(relationships, &v2_3.Relationship{
 			RefA:         toDocElementID(mainPackageID),
@@ -132,30 +230,86 @@ func ToSPDX23(r *scalibr.ScanResult, c SPDXConfig) *v2_3.Document {
 	}
 	namespace := c.DocumentNamespace
 	if namespace == "" {
-		namespace = "https://spdx.google/" + uuid.New().String()
+		namespace = "https://spdx.google/" + newUUID("namespace")
+	}
+	creationInfo := &v2_3.CreationInfo{
+		Created: created.UTC().Format("2006-01-02T15:04:05Z"),
 	}
-	creators := []common.Creator{
+	tool := "SCALIBR"
+	if r.Provenance != nil {
+		tool += "-" + r.Provenance.ScannerVersion
+		creationInfo.CreatorComment = provenanceComment(r.Provenance, scanStartTime(r.Provenance, c.Reproducible, created))
+	}
+	creationInfo.Creators = append([]common.Creator{
 		{
 			CreatorType: "Tool",
-			Creator:     "SCALIBR",
+			Creator:     tool,
 		},
-	}
-	creators = append(creators, c.Creators...)
+	}, c.Creators...)
 	return &v2_3.Document{
 		SPDXVersion:       "SPDX-2.3",
 		DataLicense:       "CC0-1.0",
 		SPDXIdentifier:    "DOCUMENT",
 		DocumentName:      name,
 		DocumentNamespace: namespace,
-		CreationInfo: &v2_3.CreationInfo{
-			Creators: creators,
-			Created:  time.Now().UTC().Format("2006-01-02T15:04:05Z"),
-		},
-		Packages:      packages,
-		Relationships: relationships,
+		CreationInfo:      creationInfo,
+		Packages:          packages,
+		Relationships:     relationships,
+	}, errs
+}
+
+// provenanceComment describes how the scan was run in a form suitable for the
+// SPDX creator comment.
+func provenanceComment(p *scalibr.Provenance, start time.Time) string {
+	lines := []string{
+		"Scan started: " + start.UTC().Format(time.RFC3339),
+		"Scan roots: " + strings.Join(p.ScanRoots, ", "),
+		"Extractors: " + strings.Join(p.Extractors, ", "),
+		"Detectors: " + strings.Join(p.Detectors, ", "),
+	}
+	return strings.Join(lines, "\n")
+}
+
+// toSupplier returns the SPDX supplier of the inventory, or NOASSERTION if the
+// extractor doesn't know who published it.
+func toSupplier(i *extractor.Inventory) *common.Supplier {
+	authors, supplierType := extractAuthors(i)
+	if len(authors) == 0 {
+		return &common.Supplier{
+			Supplier:     NoAssertion,
+			SupplierType: NoAssertion,
+		}
+	}
+	names := make([]string, 0, len(authors))
+	for _, a := range authors {
+		name := a.Name
+		if a.Email != "" {
+			name += " (" + a.Email + ")"
+		}
+		names = append(names, name)
+	}
+	return &common.Supplier{
+		Supplier:     strings.Join(names, ", "),
+		SupplierType: supplierType,
 	}
 }
 
+// toLicenseExpression combines the declared licenses of a package into a
+// single SPDX license expression, or "" if it doesn't declare any.
+func toLicenseExpression(licenses []string) string {
+	if len(licenses) <= 1 {
+		return strings.Join(licenses, "")
+	}
+	parts := make([]string, 0, len(licenses))
+	for _, l := range licenses {
+		if strings.Contains(l, " ") && !strings.HasPrefix(l, "(") {
+			l = "(" + l + ")"
+		}
+		parts = append(parts, l)
+	}
+	return strings.Join(parts, " AND ")
+}
+
 func replaceSPDXIDInvalidChars(id string) string {
 	return spdxIDInvalidCharRe.ReplaceAllString(id, "-")
 }
@@ -176,17 +330,60 @@ type CDXConfig struct {
 	ComponentName    string
 	ComponentVersion string
 	Authors          []string
+	// Reproducible makes the document depend only on the scan results: the
+	// metadata timestamp and scan start time are set to Timestamp and the
+	// component BOM refs are derived from the components instead of being
+	// random.
+	Reproducible bool
+	// Timestamp is the creation time used in reproducible mode. If unset, the
+	// Unix epoch is used.
+	Timestamp time.Time
+}
+
+// provenanceProperties returns CycloneDX metadata properties describing how
+// the scan was run.
+func provenanceProperties(p *scalibr.Provenance, start time.Time) *[]cyclonedx.Property {
+	props := []cyclonedx.Property{
+		{Name: "osv-scalibr:scan-start-time", Value: start.UTC().Format(time.RFC3339)},
+	}
+	for _, root := range p.ScanRoots {
+		props = append(props, cyclonedx.Property{Name: "osv-scalibr:scan-root", Value: root})
+	}
+	for _, e := range p.Extractors {
+		props = append(props, cyclonedx.Property{Name: "osv-scalibr:extractor", Value: e})
+	}
+	for _, d := range p.Detectors {
+		props = append(props, cyclonedx.Property{Name: "osv-scalibr:detector", Value: d})
+	}
+	return &props
 }
 
 // ToCDX converts the SCALIBR scan results into a CycloneDX document.
+// Inventory that can't be converted into a PURL is included without one, see
+// ToCDXWithErrors.
 func ToCDX(r *scalibr.ScanResult, c CDXConfig) *cyclonedx.BOM {
+	bom, errs := ToCDXWithErrors(r, c)
+	for _, err := range errs {
+		log.Warnf("%v", err)
+	}
+	return bom
+}
+
+// ToCDXWithErrors converts the SCALIBR scan results into a CycloneDX document
+// and returns the errors of the inventory that couldn't be converted into a
+// PURL. Such inventory is still included in the document as a component
+// without a PURL.
+func ToCDXWithErrors(r *scalibr.ScanResult, c CDXConfig) (*cyclonedx.BOM, []error) {
+	var errs []error
+	newUUID := newUUIDs(c.Reproducible)
+	created := creationTime(c.Reproducible, c.Timestamp)
 	bom := cyclonedx.NewBOM()
 	bom.Metadata = &cyclonedx.Metadata{
-		Timestamp: time.Now().UTC().Format("2006-01-02T15:04:05Z"),
+		Timestamp: created.UTC().Format("2006-01-02T15:04:05Z"),
 		Component: &cyclonedx.Component{
 			Name:    c.ComponentName,
 			Version: c.ComponentVersion,
-			BOMRef:  uuid.New().String(),
+			BOMRef:  newUUID(c.ComponentName),
 		},
 		Tools: &cyclonedx.ToolsChoice{
 			Components: &[]cyclonedx.Component{
@@ -212,26 +409,37 @@ func ToCDX(r *scalibr.ScanResult, c CDXConfig) *cyclonedx.BOM {
 		}
 		bom.Metadata.Authors = &authors
 	}
+	if r.Provenance != nil {
+		(*bom.Metadata.Tools.Components)[0].Version = r.Provenance.ScannerVersion
+		bom.Metadata.Properties = provenanceProperties(r.Provenance, scanStartTime(r.Provenance, c.Reproducible, created))
+	}
 
 	comps := make([]cyclonedx.Component, 0, len(r.Inventories))
 	for _, i := range r.Inventories {
 		pkg := cyclonedx.Component{
-			BOMRef:  uuid.New().String(),
+			BOMRef:  newUUID(i.Name + "@" + i.Version),
 			Type:    cyclonedx.ComponentTypeLibrary,
 			Name:    (*i).Name,
 			Version: (*i).Version,
 		}
-		if p := ToPURL(i); p != nil {
+		p, err := toPURL(i)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		if p != nil {
 			pkg.PackageURL = p.String()
 		}
 		if cpes := extractCPEs(i); len(cpes) > 0 {
 			pkg.CPE = cpes[0]
 		}
+		if authors, _ := extractAuthors(i); len(authors) > 0 {
+			pkg.Authors = &authors
+		}
 		if len((*i).Locations) > 0 {
 			occ := make([]cyclonedx.EvidenceOccurrence, 0, len(((*i).Locations)))
 			for _, loc := range (*i).Locations {
 				occ = append(occ, cyclonedx.EvidenceOccurrence{
-					Location: loc,
+					Location: loc.Path,
 				})
 			}
 			pkg.Evidence = &cyclonedx.Evidence{
@@ -242,7 +450,7 @@ func ToCDX(r *scalibr.ScanResult, c CDXConfig) *cyclonedx.BOM {
 	}
 	bom.Components = &comps
 
-	return bom
+	return bom, errs
 }
 
 func extractCPEs(i *extractor.Inventory) []string {
@@ -255,3 +463,45 @@ func extractCPEs(i *extractor.Inventory) []string {
 	}
 	return nil
 }
+
+// extractAuthors returns the authors of the inventory recorded by the
+// extractors that know them, along with the SPDX supplier type they map to.
+func extractAuthors(i *extractor.Inventory) ([]cyclonedx.OrganizationalContact, string) {
+	var names []string
+	switch m := i.Metadata.(type) {
+	case *packagejson.JavascriptPackageJSONMetadata:
+		if m.Author == nil || m.Author.Name == "" {
+			return nil, ""
+		}
+		return []cyclonedx.OrganizationalContact{{Name: m.Author.Name, Email: m.Author.Email}}, "Person"
+	case *nupkg.Metadata:
+		names = m.Authors
+	case *nugetcache.Metadata:
+		names = m.Authors
+	}
+	if len(names) == 0 {
+		return nil, ""
+	}
+	// NuGet authors are free-form display names, often of a team or company.
+	authors := make([]cyclonedx.OrganizationalContact, 0, len(names))
+	for _, n := range names {
+		authors = append(authors, cyclonedx.OrganizationalContact{Name: n})
+	}
+	return authors, "Organization"
+}
+
+// extractLicenses returns the declared licenses of the inventory recorded by
+// the extractors that know them.
+func extractLicenses(i *extractor.Inventory) []string {
+	switch m := i.Metadata.(type) {
+	case *packagejson.JavascriptPackageJSONMetadata:
+		return m.Licenses
+	case *wheelegg.PythonPackageMetadata:
+		return m.Licenses
+	case *nupkg.Metadata:
+		return m.Licenses
+	case *nugetcache.Metadata:
+		return m.Licenses
+	}
+	return nil
+}
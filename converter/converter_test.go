@@ -18,12 +18,15 @@ import (
 	"math/rand"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/CycloneDX/cyclonedx-go"
 	"github.com/google/go-cmp/cmp"
 	scalibr "github.com/google/osv-scalibr"
 	"github.com/google/osv-scalibr/converter"
 	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/nupkg"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/packagejson"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/python/wheelegg"
 	"github.com/google/osv-scalibr/purl"
 	"github.com/google/uuid"
@@ -329,7 +332,7 @@ func TestToSPDX23(t *testing.T) {
 			desc: "One location reported",
 			scanResult: &scalibr.ScanResult{
 				Inventories: []*extractor.Inventory{{
-					Name: "software", Version: "1.2.3", Extractor: pipEx, Locations: []string{"/file1"},
+					Name: "software", Version: "1.2.3", Extractor: pipEx, Locations: extractor.LocationsFromPaths("/file1"),
 				}},
 			},
 			want: &v2_3.Document{
@@ -404,7 +407,7 @@ func TestToSPDX23(t *testing.T) {
 			desc: "Multiple locations reported",
 			scanResult: &scalibr.ScanResult{
 				Inventories: []*extractor.Inventory{{
-					Name: "software", Version: "1.2.3", Extractor: pipEx, Locations: []string{"/file1", "/file2", "/file3"},
+					Name: "software", Version: "1.2.3", Extractor: pipEx, Locations: extractor.LocationsFromPaths("/file1", "/file2", "/file3"),
 				}},
 			},
 			want: &v2_3.Document{
@@ -490,6 +493,101 @@ func TestToSPDX23(t *testing.T) {
 	}
 }
 
+func TestToSPDX23_Supplier(t *testing.T) {
+	npmEx := packagejson.New(packagejson.DefaultConfig())
+	nugetEx := nupkg.New(nupkg.DefaultConfig())
+
+	testCases := []struct {
+		desc      string
+		inventory *extractor.Inventory
+		want      *common.Supplier
+	}{
+		{
+			desc: "npm author",
+			inventory: &extractor.Inventory{
+				Name: "software", Version: "1.2.3", Extractor: npmEx,
+				Metadata: &packagejson.JavascriptPackageJSONMetadata{
+					Author: &packagejson.Person{Name: "Jane Doe", Email: "jane@example.com"},
+				},
+			},
+			want: &common.Supplier{Supplier: "Jane Doe (jane@example.com)", SupplierType: "Person"},
+		},
+		{
+			desc: "NuGet authors",
+			inventory: &extractor.Inventory{
+				Name: "Software", Version: "1.2.3", Extractor: nugetEx,
+				Metadata: &nupkg.Metadata{Authors: []string{"Some Company", "Jane Doe"}},
+			},
+			want: &common.Supplier{Supplier: "Some Company, Jane Doe", SupplierType: "Organization"},
+		},
+		{
+			desc: "no authors",
+			inventory: &extractor.Inventory{
+				Name: "software", Version: "1.2.3", Extractor: npmEx,
+				Metadata: &packagejson.JavascriptPackageJSONMetadata{},
+			},
+			want: &common.Supplier{Supplier: converter.NoAssertion, SupplierType: converter.NoAssertion},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			doc := converter.ToSPDX23(&scalibr.ScanResult{Inventories: []*extractor.Inventory{tc.inventory}}, converter.SPDXConfig{})
+			// The first package is the main package.
+			got := doc.Packages[1].PackageSupplier
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("converter.ToSPDX23(%v): unexpected supplier diff (-want +got):\n%s", tc.inventory, diff)
+			}
+		})
+	}
+}
+
+func TestToSPDX23_LicenseDeclared(t *testing.T) {
+	npmEx := packagejson.New(packagejson.DefaultConfig())
+	pipEx := wheelegg.New(wheelegg.DefaultConfig())
+
+	testCases := []struct {
+		desc      string
+		inventory *extractor.Inventory
+		want      string
+	}{
+		{
+			desc: "single license",
+			inventory: &extractor.Inventory{
+				Name: "software", Version: "1.2.3", Extractor: npmEx,
+				Metadata: &packagejson.JavascriptPackageJSONMetadata{Licenses: []string{"MIT"}},
+			},
+			want: "MIT",
+		},
+		{
+			desc: "multiple licenses",
+			inventory: &extractor.Inventory{
+				Name: "software", Version: "1.2.3", Extractor: pipEx,
+				Metadata: &wheelegg.PythonPackageMetadata{Licenses: []string{"Apache-2.0", "MIT OR ISC"}},
+			},
+			want: "Apache-2.0 AND (MIT OR ISC)",
+		},
+		{
+			desc: "no licenses",
+			inventory: &extractor.Inventory{
+				Name: "software", Version: "1.2.3", Extractor: pipEx,
+				Metadata: &wheelegg.PythonPackageMetadata{},
+			},
+			want: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			doc := converter.ToSPDX23(&scalibr.ScanResult{Inventories: []*extractor.Inventory{tc.inventory}}, converter.SPDXConfig{})
+			// The first package is the main package.
+			if got := doc.Packages[1].PackageLicenseDeclared; got != tc.want {
+				t.Errorf("converter.ToSPDX23(%v): got declared license %q, want %q", tc.inventory, got, tc.want)
+			}
+		})
+	}
+}
+
 func ptr[T any](v T) *T {
 	return &v
 }
@@ -498,6 +596,7 @@ func TestToCDX(t *testing.T) {
 	// Make UUIDs deterministic
 	uuid.SetRand(rand.New(rand.NewSource(1)))
 	pipEx := wheelegg.New(wheelegg.DefaultConfig())
+	npmEx := packagejson.New(packagejson.DefaultConfig())
 	defaultBOM := cyclonedx.NewBOM()
 
 	testCases := []struct {
@@ -549,6 +648,45 @@ func TestToCDX(t *testing.T) {
 				}),
 			},
 		},
+		{
+			desc: "Package with author",
+			scanResult: &scalibr.ScanResult{
+				Inventories: []*extractor.Inventory{{
+					Name: "software", Version: "1.2.3", Extractor: npmEx,
+					Metadata: &packagejson.JavascriptPackageJSONMetadata{
+						Author: &packagejson.Person{Name: "Jane Doe", Email: "jane@example.com"},
+					},
+				}},
+			},
+			want: &cyclonedx.BOM{
+				Metadata: &cyclonedx.Metadata{
+					Component: &cyclonedx.Component{
+						BOMRef: "81855ad8-681d-4d86-91e9-1e00167939cb",
+					},
+					Tools: &cyclonedx.ToolsChoice{
+						Components: &[]cyclonedx.Component{
+							{
+								Type: cyclonedx.ComponentTypeApplication,
+								Name: "SCALIBR",
+								ExternalReferences: ptr([]cyclonedx.ExternalReference{
+									{URL: "https://github.com/google/osv-scalibr", Type: cyclonedx.ERTypeWebsite},
+								}),
+							},
+						},
+					},
+				},
+				Components: ptr([]cyclonedx.Component{
+					{
+						BOMRef:     "6694d2c4-22ac-4208-a007-2939487f6999",
+						Type:       "library",
+						Name:       "software",
+						Version:    "1.2.3",
+						PackageURL: "pkg:npm/software@1.2.3",
+						Authors:    ptr([]cyclonedx.OrganizationalContact{{Name: "Jane Doe", Email: "jane@example.com"}}),
+					},
+				}),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -570,6 +708,48 @@ func TestToCDX(t *testing.T) {
 	}
 }
 
+var testProvenance = &scalibr.Provenance{
+	ScannerVersion: "1.2.3",
+	StartTime:      time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC),
+	ScanRoots:      []string{"/root"},
+	Extractors:     []string{"javascript/packagejson", "python/wheelegg"},
+	Detectors:      []string{"cis/generic_linux/etcpasswdpermissions"},
+}
+
+func TestToSPDX23_Provenance(t *testing.T) {
+	doc := converter.ToSPDX23(&scalibr.ScanResult{Provenance: testProvenance}, converter.SPDXConfig{})
+
+	wantCreators := []common.Creator{{CreatorType: "Tool", Creator: "SCALIBR-1.2.3"}}
+	if diff := cmp.Diff(wantCreators, doc.CreationInfo.Creators); diff != "" {
+		t.Errorf("converter.ToSPDX23(): unexpected creators diff (-want +got):\n%s", diff)
+	}
+	wantComment := "Scan started: 2024-05-06T07:08:09Z\n" +
+		"Scan roots: /root\n" +
+		"Extractors: javascript/packagejson, python/wheelegg\n" +
+		"Detectors: cis/generic_linux/etcpasswdpermissions"
+	if got := doc.CreationInfo.CreatorComment; got != wantComment {
+		t.Errorf("converter.ToSPDX23(): got creator comment %q, want %q", got, wantComment)
+	}
+}
+
+func TestToCDX_Provenance(t *testing.T) {
+	bom := converter.ToCDX(&scalibr.ScanResult{Provenance: testProvenance}, converter.CDXConfig{})
+
+	if got := (*bom.Metadata.Tools.Components)[0].Version; got != "1.2.3" {
+		t.Errorf("converter.ToCDX(): got tool version %q, want %q", got, "1.2.3")
+	}
+	wantProps := &[]cyclonedx.Property{
+		{Name: "osv-scalibr:scan-start-time", Value: "2024-05-06T07:08:09Z"},
+		{Name: "osv-scalibr:scan-root", Value: "/root"},
+		{Name: "osv-scalibr:extractor", Value: "javascript/packagejson"},
+		{Name: "osv-scalibr:extractor", Value: "python/wheelegg"},
+		{Name: "osv-scalibr:detector", Value: "cis/generic_linux/etcpasswdpermissions"},
+	}
+	if diff := cmp.Diff(wantProps, bom.Metadata.Properties); diff != "" {
+		t.Errorf("converter.ToCDX(): unexpected properties diff (-want +got):\n%s", diff)
+	}
+}
+
 func TestToPURL(t *testing.T) {
 	pipEx := wheelegg.New(wheelegg.DefaultConfig())
 	tests := []struct {
@@ -583,7 +763,7 @@ func TestToPURL(t *testing.T) {
 			inventory: &extractor.Inventory{
 				Name:      "software",
 				Version:   "1.0.0",
-				Locations: []string{"/file1"},
+				Locations: extractor.LocationsFromPaths("/file1"),
 				Extractor: pipEx,
 			},
 			want: &purl.PackageURL{
@@ -592,6 +772,15 @@ func TestToPURL(t *testing.T) {
 				Version: "1.0.0",
 			},
 		},
+		{
+			desc: "Failing extractor",
+			inventory: &extractor.Inventory{
+				Name:      "software",
+				Version:   "1.0.0",
+				Extractor: failingExtractor{pipEx},
+			},
+			want: nil,
+		},
 	}
 
 	for _, tc := range tests {
@@ -608,3 +797,68 @@ func TestToPURL(t *testing.T) {
 		})
 	}
 }
+
+// failingExtractor fails to convert any inventory into a PURL.
+type failingExtractor struct {
+	extractor.Extractor
+}
+
+func (failingExtractor) Name() string { return "failing" }
+
+func (failingExtractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	panic("unexpected inventory")
+}
+
+func TestConversionErrors(t *testing.T) {
+	pipEx := wheelegg.New(wheelegg.DefaultConfig())
+	result := &scalibr.ScanResult{
+		Inventories: []*extractor.Inventory{
+			{Name: "good", Version: "1.0.0", Extractor: pipEx},
+			{Name: "bad", Version: "2.0.0", Extractor: failingExtractor{pipEx}},
+			{Name: "also-good", Version: "3.0.0", Extractor: pipEx},
+		},
+	}
+	wantErr := "failing extractor failed to convert bad@2.0.0 into a PURL: unexpected inventory"
+
+	t.Run("SPDX", func(t *testing.T) {
+		doc, errs := converter.ToSPDX23WithErrors(result, converter.SPDXConfig{})
+		if len(errs) != 1 || errs[0].Error() != wantErr {
+			t.Errorf("converter.ToSPDX23WithErrors(): got errors %v, want [%s]", errs, wantErr)
+		}
+		got := map[string]string{}
+		for _, p := range doc.Packages[1:] {
+			locator := ""
+			for _, ref := range p.PackageExternalReferences {
+				locator = ref.Locator
+			}
+			got[p.PackageName+"@"+p.PackageVersion] = locator
+		}
+		want := map[string]string{
+			"good@1.0.0":      "pkg:pypi/good@1.0.0",
+			"bad@2.0.0":       "",
+			"also-good@3.0.0": "pkg:pypi/also-good@3.0.0",
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("converter.ToSPDX23WithErrors(): unexpected packages diff (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("CDX", func(t *testing.T) {
+		bom, errs := converter.ToCDXWithErrors(result, converter.CDXConfig{})
+		if len(errs) != 1 || errs[0].Error() != wantErr {
+			t.Errorf("converter.ToCDXWithErrors(): got errors %v, want [%s]", errs, wantErr)
+		}
+		got := map[string]string{}
+		for _, c := range *bom.Components {
+			got[c.Name+"@"+c.Version] = c.PackageURL
+		}
+		want := map[string]string{
+			"good@1.0.0":      "pkg:pypi/good@1.0.0",
+			"bad@2.0.0":       "",
+			"also-good@3.0.0": "pkg:pypi/also-good@3.0.0",
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("converter.ToCDXWithErrors(): unexpected components diff (-want +got):\n%s", diff)
+		}
+	})
+}
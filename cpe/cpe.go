@@ -0,0 +1,115 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cpe matches inventory against CPEs in the CPE 2.3 formatted string
+// binding, e.g. cpe:2.3:o:linux:linux_kernel:6.1.0:*:*:*:*:*:*:*.
+package cpe
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+)
+
+const (
+	prefix = "cpe:2.3:"
+	// numComponents is the number of components following the prefix: part,
+	// vendor, product, version, update, edition, language, sw_edition,
+	// target_sw, target_hw and other.
+	numComponents = 11
+	// anyValue matches any value of a component.
+	anyValue = "*"
+)
+
+// FindByCPE returns the inventory with a CPE matching the query. The CPEs of
+// an inventory are provided by its extractor if it implements
+// extractor.CPEExtractor. Components of the query set to "*" match any value.
+func FindByCPE(inv []*extractor.Inventory, query string) ([]*extractor.Inventory, error) {
+	q, err := parse(query)
+	if err != nil {
+		return nil, err
+	}
+	var res []*extractor.Inventory
+	for _, i := range inv {
+		ce, ok := i.Extractor.(extractor.CPEExtractor)
+		if !ok {
+			continue
+		}
+		for _, c := range ce.ToCPEs(i) {
+			components, err := parse(c)
+			if err != nil {
+				// Skip malformed CPEs reported by the extractor.
+				continue
+			}
+			if matches(q, components) {
+				res = append(res, i)
+				break
+			}
+		}
+	}
+	return res, nil
+}
+
+// Match returns whether the CPE matches the query. Components of the query set
+// to "*" match any value.
+func Match(query, cpe string) (bool, error) {
+	q, err := parse(query)
+	if err != nil {
+		return false, err
+	}
+	c, err := parse(cpe)
+	if err != nil {
+		return false, err
+	}
+	return matches(q, c), nil
+}
+
+func matches(query, cpe []string) bool {
+	for i, q := range query {
+		if q != anyValue && !strings.EqualFold(q, cpe[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// parse splits a formatted string CPE into its components. Escaped colons
+// ("\:") don't split components.
+func parse(cpe string) ([]string, error) {
+	if !strings.HasPrefix(strings.ToLower(cpe), prefix) {
+		return nil, fmt.Errorf("%q is not a CPE 2.3 formatted string", cpe)
+	}
+	var components []string
+	var current strings.Builder
+	escaped := false
+	for _, r := range cpe[len(prefix):] {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == ':':
+			components = append(components, current.String())
+			current.Reset()
+			continue
+		}
+		current.WriteRune(r)
+	}
+	components = append(components, current.String())
+	if len(components) != numComponents {
+		return nil, fmt.Errorf("CPE %q has %d components, want %d", cpe, len(components), numComponents)
+	}
+	return components, nil
+}
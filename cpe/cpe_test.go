@@ -0,0 +1,153 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cpe_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/cpe"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/standalone/os/kernel"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/common/metadata"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/regosversion"
+	fe "github.com/google/osv-scalibr/testing/fakeextractor"
+)
+
+func TestFindByCPE(t *testing.T) {
+	linux := &extractor.Inventory{
+		Name:      "linux",
+		Version:   "6.1.0-18-amd64",
+		Extractor: kernel.Extractor{},
+		Metadata:  &kernel.Metadata{CPE: "cpe:2.3:o:linux:linux_kernel:6.1.76:*:*:*:*:*:*:*"},
+	}
+	windows := &extractor.Inventory{
+		Name:      "windows_server_2022",
+		Version:   "10.0.20348.2461",
+		Extractor: regosversion.New(regosversion.DefaultConfig()),
+		Metadata: &metadata.OSVersion{
+			Product:     "windows_server_2022",
+			FullVersion: "10.0.20348.2461",
+			CPE:         "cpe:2.3:o:microsoft:windows_server_2022:10.0.20348.2461:*:*:*:*:*:*:*",
+		},
+	}
+	noCPE := &extractor.Inventory{
+		Name:      "software",
+		Extractor: fe.New("fake", 1, nil, nil),
+	}
+	inv := []*extractor.Inventory{linux, windows, noCPE}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{
+			name:  "exact_match",
+			query: "cpe:2.3:o:linux:linux_kernel:6.1.76:*:*:*:*:*:*:*",
+			want:  []string{"linux"},
+		},
+		{
+			name:  "any_version",
+			query: "cpe:2.3:o:microsoft:windows_server_2022:*:*:*:*:*:*:*:*",
+			want:  []string{"windows_server_2022"},
+		},
+		{
+			name:  "any_vendor_and_product",
+			query: "cpe:2.3:o:*:*:*:*:*:*:*:*:*:*",
+			want:  []string{"linux", "windows_server_2022"},
+		},
+		{
+			name:  "case_insensitive",
+			query: "cpe:2.3:o:Linux:Linux_Kernel:*:*:*:*:*:*:*:*",
+			want:  []string{"linux"},
+		},
+		{
+			name:  "other_version",
+			query: "cpe:2.3:o:linux:linux_kernel:6.1.77:*:*:*:*:*:*:*",
+			want:  nil,
+		},
+		{
+			name:  "applications_only",
+			query: "cpe:2.3:a:*:*:*:*:*:*:*:*:*:*",
+			want:  nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := cpe.FindByCPE(inv, tc.query)
+			if err != nil {
+				t.Fatalf("FindByCPE(%q): %v", tc.query, err)
+			}
+			var names []string
+			for _, i := range got {
+				names = append(names, i.Name)
+			}
+			if diff := cmp.Diff(tc.want, names); diff != "" {
+				t.Errorf("FindByCPE(%q) (-want +got):\n%s", tc.query, diff)
+			}
+		})
+	}
+}
+
+func TestFindByCPE_InvalidQuery(t *testing.T) {
+	for _, query := range []string{"", "cpe:/o:linux:linux_kernel:6.1", "cpe:2.3:o:linux:linux_kernel"} {
+		if _, err := cpe.FindByCPE(nil, query); err == nil {
+			t.Errorf("FindByCPE(%q) succeeded, want error", query)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		query string
+		cpe   string
+		want  bool
+	}{
+		{
+			query: "cpe:2.3:a:vendor:product:*:*:*:*:*:*:*:*",
+			cpe:   "cpe:2.3:a:vendor:product:1.0:*:*:*:*:*:*:*",
+			want:  true,
+		},
+		{
+			// Escaped colons are part of the component.
+			query: `cpe:2.3:a:vendor:product\:name:1.0:*:*:*:*:*:*:*`,
+			cpe:   `cpe:2.3:a:vendor:product\:name:1.0:*:*:*:*:*:*:*`,
+			want:  true,
+		},
+		{
+			query: `cpe:2.3:a:vendor:product:1.0:*:*:*:*:*:*:*`,
+			cpe:   `cpe:2.3:a:vendor:product\:name:1.0:*:*:*:*:*:*:*`,
+			want:  false,
+		},
+		{
+			// Wildcards in the CPE only match wildcards in the query.
+			query: "cpe:2.3:a:vendor:product:1.0:*:*:*:*:*:*:*",
+			cpe:   "cpe:2.3:a:vendor:product:*:*:*:*:*:*:*:*",
+			want:  false,
+		},
+	}
+
+	for _, tc := range tests {
+		got, err := cpe.Match(tc.query, tc.cpe)
+		if err != nil {
+			t.Fatalf("Match(%q, %q): %v", tc.query, tc.cpe, err)
+		}
+		if got != tc.want {
+			t.Errorf("Match(%q, %q) = %v, want %v", tc.query, tc.cpe, got, tc.want)
+		}
+	}
+}
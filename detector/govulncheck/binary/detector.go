@@ -71,7 +71,7 @@ func (d Detector) Scan(ctx context.Context, scanRoot *scalibrfs.ScanRoot, ix *in
 		if i.Extractor.Name() != gobinary.Name {
 			continue
 		}
-		for _, l := range i.Locations {
+		for _, l := range i.LocationPaths() {
 			if scanned[l] {
 				continue
 			}
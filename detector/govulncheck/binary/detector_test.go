@@ -120,7 +120,7 @@ func setupInventoryIndex(names []string) *inventoryindex.InventoryIndex {
 		invs = append(invs, &extractor.Inventory{
 			Name:      n,
 			Version:   "1.2.3",
-			Locations: []string{filepath.Join("testdata", n)},
+			Locations: extractor.LocationsFromPaths(filepath.Join("testdata", n)),
 			Extractor: &gobinary.Extractor{},
 		})
 	}
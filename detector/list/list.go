@@ -25,6 +25,7 @@ import (
 	"github.com/google/osv-scalibr/detector/cis/generic_linux/etcpasswdpermissions"
 	"github.com/google/osv-scalibr/detector/cve/cve202338408"
 	"github.com/google/osv-scalibr/detector/govulncheck/binary"
+	"github.com/google/osv-scalibr/detector/osv/osvdev"
 	"github.com/google/osv-scalibr/detector/weakcredentials/etcshadow"
 	"github.com/google/osv-scalibr/detector/weakcredentials/filebrowser"
 	"github.com/google/osv-scalibr/log"
@@ -40,6 +41,9 @@ var CVE []detector.Detector = []detector.Detector{&cve202338408.Detector{}}
 // Govulncheck detectors.
 var Govulncheck []detector.Detector = []detector.Detector{&binary.Detector{}}
 
+// OSV detectors for known vulnerabilities of the extracted inventory.
+var OSV []detector.Detector = []detector.Detector{&osvdev.Detector{}}
+
 // Weakcreds detectors for weak credentials.
 var Weakcreds []detector.Detector = []detector.Detector{
 	&etcshadow.Detector{},
@@ -54,6 +58,7 @@ var All []detector.Detector = slices.Concat(
 	CIS,
 	CVE,
 	Govulncheck,
+	OSV,
 	Weakcreds,
 )
 
@@ -61,6 +66,7 @@ var detectorNames = map[string][]detector.Detector{
 	"cis":         CIS,
 	"cve":         CVE,
 	"govulncheck": Govulncheck,
+	"osv":         OSV,
 	"weakcreds":   Weakcreds,
 	"default":     Default,
 	"all":         All,
@@ -0,0 +1,101 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osvschema
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Weights of the CVSS v3 base metrics.
+// See https://www.first.org/cvss/v3.1/specification-document#7-4-Metric-Values
+var cvss3Weights = map[string]map[string]float64{
+	"AV": {"N": 0.85, "A": 0.62, "L": 0.55, "P": 0.2},
+	"AC": {"L": 0.77, "H": 0.44},
+	"UI": {"N": 0.85, "R": 0.62},
+	"C":  {"H": 0.56, "L": 0.22, "N": 0},
+	"I":  {"H": 0.56, "L": 0.22, "N": 0},
+	"A":  {"H": 0.56, "L": 0.22, "N": 0},
+}
+
+// CVSS3BaseScore computes the base score of a CVSS v3.x vector such as
+// "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H".
+func CVSS3BaseScore(vector string) (float64, error) {
+	parts := strings.Split(vector, "/")
+	if len(parts) == 0 || !strings.HasPrefix(parts[0], "CVSS:3") {
+		return 0, fmt.Errorf("not a CVSS v3 vector: %q", vector)
+	}
+	metrics := map[string]string{}
+	for _, p := range parts[1:] {
+		k, v, ok := strings.Cut(p, ":")
+		if !ok {
+			return 0, fmt.Errorf("invalid metric %q in CVSS vector %q", p, vector)
+		}
+		metrics[k] = v
+	}
+
+	changed := metrics["S"] == "C"
+	if !changed && metrics["S"] != "U" {
+		return 0, fmt.Errorf("invalid scope in CVSS vector %q", vector)
+	}
+	w := map[string]float64{}
+	for m, values := range cvss3Weights {
+		v, ok := values[metrics[m]]
+		if !ok {
+			return 0, fmt.Errorf("invalid or missing %s in CVSS vector %q", m, vector)
+		}
+		w[m] = v
+	}
+	switch {
+	case metrics["PR"] == "N":
+		w["PR"] = 0.85
+	case metrics["PR"] == "L" && changed:
+		w["PR"] = 0.68
+	case metrics["PR"] == "L":
+		w["PR"] = 0.62
+	case metrics["PR"] == "H" && changed:
+		w["PR"] = 0.5
+	case metrics["PR"] == "H":
+		w["PR"] = 0.27
+	default:
+		return 0, fmt.Errorf("invalid or missing PR in CVSS vector %q", vector)
+	}
+
+	iss := 1 - (1-w["C"])*(1-w["I"])*(1-w["A"])
+	impact := 6.42 * iss
+	if changed {
+		impact = 7.52*(iss-0.029) - 3.25*math.Pow(iss-0.02, 15)
+	}
+	if impact <= 0 {
+		return 0, nil
+	}
+	exploitability := 8.22 * w["AV"] * w["AC"] * w["PR"] * w["UI"]
+	if changed {
+		return roundUp(math.Min(1.08*(impact+exploitability), 10)), nil
+	}
+	return roundUp(math.Min(impact+exploitability, 10)), nil
+}
+
+// roundUp returns the smallest number with one decimal place that is equal to
+// or higher than x, avoiding floating point artifacts as described in the
+// CVSS v3.1 specification.
+func roundUp(x float64) float64 {
+	i := int(math.Round(x * 100000))
+	if i%10000 == 0 {
+		return float64(i) / 100000
+	}
+	return float64(i/10000+1) / 10
+}
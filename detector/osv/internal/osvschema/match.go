@@ -0,0 +1,116 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osvschema
+
+import (
+	"slices"
+
+	"github.com/google/osv-scalibr/semantic"
+)
+
+// semverEcosystem is an ecosystem whose versions are compared as SemVer. It's
+// used for SEMVER ranges independently of the package's ecosystem.
+const semverEcosystem = "npm"
+
+// AffectsVersion returns true if the version is listed in the entry's affected
+// versions or falls into one of its SEMVER or ECOSYSTEM ranges. GIT ranges are
+// ignored.
+func (a Affected) AffectsVersion(version string) (bool, error) {
+	if slices.Contains(a.Versions, version) {
+		return true, nil
+	}
+	for _, r := range a.Ranges {
+		var ecosystem string
+		switch r.Type {
+		case "SEMVER":
+			ecosystem = semverEcosystem
+		case "ECOSYSTEM":
+			ecosystem = a.Package.Ecosystem
+		default:
+			continue
+		}
+		affected, err := r.contains(ecosystem, version)
+		if err != nil {
+			return false, err
+		}
+		if affected {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// contains evaluates the range's events in version order, as described in
+// https://ossf.github.io/osv-schema/#evaluation
+func (r Range) contains(ecosystem, version string) (bool, error) {
+	events := slices.Clone(r.Events)
+	var cmpErr error
+	compare := func(a, b string) int {
+		c, err := semantic.Compare(ecosystem, a, b)
+		if err != nil && cmpErr == nil {
+			cmpErr = err
+		}
+		return c
+	}
+	slices.SortStableFunc(events, func(a, b Event) int {
+		va, vb := a.version(), b.version()
+		switch {
+		case va == vb:
+			return 0
+		case va == "0":
+			return -1
+		case vb == "0":
+			return 1
+		}
+		return compare(va, vb)
+	})
+
+	affected := false
+	for _, e := range events {
+		switch {
+		case e.Introduced == "0":
+			affected = true
+		case e.Introduced != "":
+			if compare(version, e.Introduced) >= 0 {
+				affected = true
+			}
+		case e.Fixed != "":
+			if compare(version, e.Fixed) >= 0 {
+				affected = false
+			}
+		case e.LastAffected != "":
+			if compare(version, e.LastAffected) > 0 {
+				affected = false
+			}
+		}
+	}
+	if cmpErr != nil {
+		return false, cmpErr
+	}
+	return affected, nil
+}
+
+func (e Event) version() string {
+	switch {
+	case e.Introduced != "":
+		return e.Introduced
+	case e.Fixed != "":
+		return e.Fixed
+	case e.LastAffected != "":
+		return e.LastAffected
+	default:
+		return e.Limit
+	}
+}
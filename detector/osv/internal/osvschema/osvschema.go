@@ -0,0 +1,237 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package osvschema contains the subset of the OSV schema used by the OSV
+// detectors and helpers for turning OSV vulnerabilities into findings.
+// See https://ossf.github.io/osv-schema/
+package osvschema
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/purl"
+)
+
+// Vulnerability is an OSV vulnerability entry.
+type Vulnerability struct {
+	ID               string           `json:"id"`
+	Modified         string           `json:"modified,omitempty"`
+	Aliases          []string         `json:"aliases,omitempty"`
+	Summary          string           `json:"summary,omitempty"`
+	Details          string           `json:"details,omitempty"`
+	Severity         []Severity       `json:"severity,omitempty"`
+	Affected         []Affected       `json:"affected,omitempty"`
+	DatabaseSpecific DatabaseSpecific `json:"database_specific,omitempty"`
+}
+
+// Severity is a severity score of a vulnerability, e.g. a CVSS vector.
+type Severity struct {
+	Type  string `json:"type"`
+	Score string `json:"score"`
+}
+
+// DatabaseSpecific holds the database specific fields we're interested in.
+type DatabaseSpecific struct {
+	// Severity is set by some databases (e.g. GHSA) to LOW, MODERATE, HIGH or CRITICAL.
+	Severity string `json:"severity,omitempty"`
+}
+
+// Affected describes a package affected by a vulnerability.
+type Affected struct {
+	Package  Package  `json:"package"`
+	Ranges   []Range  `json:"ranges,omitempty"`
+	Versions []string `json:"versions,omitempty"`
+}
+
+// Package identifies a package in an ecosystem.
+type Package struct {
+	Ecosystem string `json:"ecosystem"`
+	Name      string `json:"name"`
+	PURL      string `json:"purl,omitempty"`
+}
+
+// Range is a list of events describing which versions are affected.
+type Range struct {
+	// Type is one of SEMVER, ECOSYSTEM or GIT.
+	Type   string  `json:"type"`
+	Events []Event `json:"events"`
+}
+
+// Event is a version introducing or fixing a vulnerability. Exactly one of the
+// fields is set.
+type Event struct {
+	Introduced   string `json:"introduced,omitempty"`
+	Fixed        string `json:"fixed,omitempty"`
+	LastAffected string `json:"last_affected,omitempty"`
+	Limit        string `json:"limit,omitempty"`
+}
+
+// String returns a human-readable representation of the range, e.g.
+// "[introduced: 0, fixed: 1.2.3]".
+func (r Range) String() string {
+	var parts []string
+	for _, e := range r.Events {
+		switch {
+		case e.Introduced != "":
+			parts = append(parts, "introduced: "+e.Introduced)
+		case e.Fixed != "":
+			parts = append(parts, "fixed: "+e.Fixed)
+		case e.LastAffected != "":
+			parts = append(parts, "last_affected: "+e.LastAffected)
+		case e.Limit != "":
+			parts = append(parts, "limit: "+e.Limit)
+		}
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+// PackageName returns the name OSV uses for the package described by the PURL.
+func PackageName(p *purl.PackageURL) string {
+	switch p.Type {
+	case purl.TypeMaven:
+		if p.Namespace != "" {
+			return p.Namespace + ":" + p.Name
+		}
+	case purl.TypeNPM, purl.TypeGolang, purl.TypeComposer, purl.TypeSwift:
+		if p.Namespace != "" {
+			return p.Namespace + "/" + p.Name
+		}
+	case purl.TypeDebian:
+		// OSV tracks vulnerabilities of Debian packages by their source package.
+		for _, q := range p.Qualifiers {
+			if q.Key == purl.Source && q.Value != "" {
+				return q.Value
+			}
+		}
+	}
+	return p.Name
+}
+
+// pypiSeparators matches the runs of characters that PEP 503 treats as equal.
+var pypiSeparators = regexp.MustCompile(`[-_.]+`)
+
+// NormalizeName returns the form of a package name under which equivalent
+// names of the ecosystem compare equal. Names are case-insensitive and for
+// PyPI "-", "_" and "." are interchangeable, see
+// https://peps.python.org/pep-0503/#normalized-names
+func NormalizeName(ecosystem, name string) string {
+	name = strings.ToLower(name)
+	if e, _, _ := strings.Cut(ecosystem, ":"); strings.EqualFold(e, "PyPI") {
+		name = pypiSeparators.ReplaceAllString(name, "-")
+	}
+	return name
+}
+
+// AffectedFor returns the entries of the vulnerability that affect the given
+// package. Release suffixes of ecosystems, e.g. the 12 in "Debian:12", are
+// only compared if both ecosystems have one.
+func (v *Vulnerability) AffectedFor(ecosystem, name string) []Affected {
+	var res []Affected
+	for _, a := range v.Affected {
+		if !sameEcosystem(a.Package.Ecosystem, ecosystem) || NormalizeName(ecosystem, a.Package.Name) != NormalizeName(ecosystem, name) {
+			continue
+		}
+		res = append(res, a)
+	}
+	return res
+}
+
+func sameEcosystem(a, b string) bool {
+	a, aRelease, _ := strings.Cut(a, ":")
+	b, bRelease, _ := strings.Cut(b, ":")
+	if aRelease != "" && bRelease != "" && aRelease != bRelease {
+		return false
+	}
+	return strings.EqualFold(a, b)
+}
+
+// Finding converts the vulnerability into a finding for the given inventory.
+// The ranges of the affected entries are listed in the finding's extra info.
+func (v *Vulnerability) Finding(inv *extractor.Inventory, affected []Affected) *detector.Finding {
+	title := v.Summary
+	if title == "" {
+		title = v.ID
+	}
+	var ranges []string
+	for _, a := range affected {
+		for _, r := range a.Ranges {
+			ranges = append(ranges, r.String())
+		}
+	}
+	extra := ""
+	if len(ranges) > 0 {
+		extra = fmt.Sprintf("Affected ranges of %s: %s", inv.Name, strings.Join(ranges, ", "))
+	}
+	return &detector.Finding{
+		Adv: &detector.Advisory{
+			ID: &detector.AdvisoryID{
+				Publisher: "OSV",
+				Reference: v.ID,
+			},
+			Type:           detector.TypeVulnerability,
+			Title:          title,
+			Description:    v.Details,
+			Recommendation: "Upgrade the affected package to a version that is not affected by " + v.ID,
+			Sev:            v.severity(),
+		},
+		Target: &detector.TargetDetails{Inventory: inv},
+		Extra:  extra,
+	}
+}
+
+func (v *Vulnerability) severity() *detector.Severity {
+	sev := &detector.Severity{Severity: detector.SeverityUnspecified}
+	for _, s := range v.Severity {
+		if s.Type != "CVSS_V3" {
+			continue
+		}
+		score, err := CVSS3BaseScore(s.Score)
+		if err != nil {
+			continue
+		}
+		sev.CVSSV3 = &detector.CVSS{BaseScore: float32(score)}
+		sev.Severity = severityFromScore(score)
+	}
+	switch strings.ToUpper(v.DatabaseSpecific.Severity) {
+	case "LOW":
+		sev.Severity = detector.SeverityLow
+	case "MODERATE", "MEDIUM":
+		sev.Severity = detector.SeverityMedium
+	case "HIGH":
+		sev.Severity = detector.SeverityHigh
+	case "CRITICAL":
+		sev.Severity = detector.SeverityCritical
+	}
+	return sev
+}
+
+// severityFromScore uses the CVSS v3 qualitative severity rating scale.
+func severityFromScore(score float64) detector.SeverityEnum {
+	switch {
+	case score == 0:
+		return detector.SeverityMinimal
+	case score < 4:
+		return detector.SeverityLow
+	case score < 7:
+		return detector.SeverityMedium
+	case score < 9:
+		return detector.SeverityHigh
+	default:
+		return detector.SeverityCritical
+	}
+}
@@ -0,0 +1,283 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osvschema_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/detector/osv/internal/osvschema"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/purl"
+)
+
+func TestCVSS3BaseScore(t *testing.T) {
+	tests := []struct {
+		vector string
+		want   float64
+	}{
+		{vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", want: 9.8},
+		{vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H", want: 10},
+		{vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:R/S:C/C:L/I:L/A:N", want: 6.1},
+		{vector: "CVSS:3.0/AV:L/AC:H/PR:H/UI:R/S:U/C:L/I:N/A:N", want: 1.8},
+		{vector: "CVSS:3.1/AV:N/AC:H/PR:L/UI:N/S:C/C:H/I:H/A:H", want: 8.5},
+		{vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:N", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.vector, func(t *testing.T) {
+			got, err := osvschema.CVSS3BaseScore(tt.vector)
+			if err != nil {
+				t.Fatalf("CVSS3BaseScore(%q): %v", tt.vector, err)
+			}
+			if got != tt.want {
+				t.Errorf("CVSS3BaseScore(%q) = %v, want %v", tt.vector, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCVSS3BaseScoreInvalid(t *testing.T) {
+	for _, vector := range []string{
+		"",
+		"CVSS:2.0/AV:N/AC:L/Au:N/C:P/I:P/A:P",
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H",
+		"CVSS:3.1/AV:X/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+	} {
+		if _, err := osvschema.CVSS3BaseScore(vector); err == nil {
+			t.Errorf("CVSS3BaseScore(%q) returned no error", vector)
+		}
+	}
+}
+
+func TestPackageName(t *testing.T) {
+	tests := []struct {
+		purl string
+		want string
+	}{
+		{purl: "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1", want: "org.apache.logging.log4j:log4j-core"},
+		{purl: "pkg:npm/%40angular/core@12.0.0", want: "@angular/core"},
+		{purl: "pkg:golang/golang.org/x/net@0.1.0", want: "golang.org/x/net"},
+		{purl: "pkg:pypi/django@1.11.1", want: "django"},
+		{purl: "pkg:deb/debian/libssl3@3.0.11-1?source=openssl", want: "openssl"},
+		{purl: "pkg:deb/debian/curl@7.88.1-10", want: "curl"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.purl, func(t *testing.T) {
+			p, err := purl.FromString(tt.purl)
+			if err != nil {
+				t.Fatalf("purl.FromString(%q): %v", tt.purl, err)
+			}
+			if got := osvschema.PackageName(&p); got != tt.want {
+				t.Errorf("PackageName(%q) = %q, want %q", tt.purl, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		ecosystem string
+		name      string
+		want      string
+	}{
+		{ecosystem: "PyPI", name: "Zope.Interface", want: "zope-interface"},
+		{ecosystem: "PyPI", name: "zope__interface", want: "zope-interface"},
+		{ecosystem: "PyPI", name: "ruamel.yaml-clib", want: "ruamel-yaml-clib"},
+		{ecosystem: "NuGet", name: "Newtonsoft.Json", want: "newtonsoft.json"},
+		{ecosystem: "Debian:12", name: "lib_foo", want: "lib_foo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ecosystem+"/"+tt.name, func(t *testing.T) {
+			if got := osvschema.NormalizeName(tt.ecosystem, tt.name); got != tt.want {
+				t.Errorf("NormalizeName(%q, %q) = %q, want %q", tt.ecosystem, tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFinding(t *testing.T) {
+	v := &osvschema.Vulnerability{
+		ID:       "GHSA-5crp-9r3c-p9vr",
+		Summary:  "Improper Handling of Exceptional Conditions in Newtonsoft.Json",
+		Details:  "Newtonsoft.Json prior to version 13.0.1 is vulnerable to Insecure Defaults.",
+		Severity: []osvschema.Severity{{Type: "CVSS_V3", Score: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:H"}},
+		Affected: []osvschema.Affected{
+			{
+				Package: osvschema.Package{Ecosystem: "NuGet", Name: "Newtonsoft.Json"},
+				Ranges: []osvschema.Range{{
+					Type:   "ECOSYSTEM",
+					Events: []osvschema.Event{{Introduced: "0"}, {Fixed: "13.0.1"}},
+				}},
+			},
+			{
+				Package: osvschema.Package{Ecosystem: "NuGet", Name: "Other.Package"},
+			},
+		},
+		DatabaseSpecific: osvschema.DatabaseSpecific{Severity: "HIGH"},
+	}
+	inv := &extractor.Inventory{Name: "Newtonsoft.Json", Version: "12.0.3"}
+
+	affected := v.AffectedFor("NuGet", "newtonsoft.json")
+	if len(affected) != 1 {
+		t.Fatalf("AffectedFor() returned %d entries, want 1", len(affected))
+	}
+
+	want := &detector.Finding{
+		Adv: &detector.Advisory{
+			ID:             &detector.AdvisoryID{Publisher: "OSV", Reference: "GHSA-5crp-9r3c-p9vr"},
+			Type:           detector.TypeVulnerability,
+			Title:          "Improper Handling of Exceptional Conditions in Newtonsoft.Json",
+			Description:    "Newtonsoft.Json prior to version 13.0.1 is vulnerable to Insecure Defaults.",
+			Recommendation: "Upgrade the affected package to a version that is not affected by GHSA-5crp-9r3c-p9vr",
+			Sev: &detector.Severity{
+				Severity: detector.SeverityHigh,
+				CVSSV3:   &detector.CVSS{BaseScore: 7.5},
+			},
+		},
+		Target: &detector.TargetDetails{Inventory: inv},
+		Extra:  "Affected ranges of Newtonsoft.Json: [introduced: 0, fixed: 13.0.1]",
+	}
+	if diff := cmp.Diff(want, v.Finding(inv, affected)); diff != "" {
+		t.Errorf("Finding() diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestAffectsVersion(t *testing.T) {
+	introducedFixed := osvschema.Range{
+		Type:   "ECOSYSTEM",
+		Events: []osvschema.Event{{Introduced: "0"}, {Fixed: "13.0.1"}},
+	}
+	tests := []struct {
+		desc     string
+		affected osvschema.Affected
+		version  string
+		want     bool
+	}{
+		{
+			desc:     "before fix",
+			affected: osvschema.Affected{Package: osvschema.Package{Ecosystem: "NuGet"}, Ranges: []osvschema.Range{introducedFixed}},
+			version:  "12.0.3",
+			want:     true,
+		},
+		{
+			desc:     "fixed version",
+			affected: osvschema.Affected{Package: osvschema.Package{Ecosystem: "NuGet"}, Ranges: []osvschema.Range{introducedFixed}},
+			version:  "13.0.1",
+			want:     false,
+		},
+		{
+			desc:     "after fix",
+			affected: osvschema.Affected{Package: osvschema.Package{Ecosystem: "NuGet"}, Ranges: []osvschema.Range{introducedFixed}},
+			version:  "13.0.3",
+			want:     false,
+		},
+		{
+			desc: "between two affected ranges",
+			affected: osvschema.Affected{
+				Package: osvschema.Package{Ecosystem: "PyPI"},
+				Ranges: []osvschema.Range{{
+					Type: "ECOSYSTEM",
+					// Events aren't necessarily sorted.
+					Events: []osvschema.Event{{Introduced: "2.0"}, {Fixed: "2.1"}, {Introduced: "1.0"}, {Fixed: "1.5"}},
+				}},
+			},
+			version: "1.7",
+			want:    false,
+		},
+		{
+			desc: "in second affected range",
+			affected: osvschema.Affected{
+				Package: osvschema.Package{Ecosystem: "PyPI"},
+				Ranges: []osvschema.Range{{
+					Type:   "ECOSYSTEM",
+					Events: []osvschema.Event{{Introduced: "2.0"}, {Fixed: "2.1"}, {Introduced: "1.0"}, {Fixed: "1.5"}},
+				}},
+			},
+			version: "2.0.1",
+			want:    true,
+		},
+		{
+			desc: "last affected version",
+			affected: osvschema.Affected{
+				Package: osvschema.Package{Ecosystem: "PyPI"},
+				Ranges:  []osvschema.Range{{Type: "ECOSYSTEM", Events: []osvschema.Event{{Introduced: "1.0"}, {LastAffected: "1.2"}}}},
+			},
+			version: "1.2",
+			want:    true,
+		},
+		{
+			desc: "after last affected version",
+			affected: osvschema.Affected{
+				Package: osvschema.Package{Ecosystem: "PyPI"},
+				Ranges:  []osvschema.Range{{Type: "ECOSYSTEM", Events: []osvschema.Event{{Introduced: "1.0"}, {LastAffected: "1.2"}}}},
+			},
+			version: "1.2.1",
+			want:    false,
+		},
+		{
+			desc: "semver range",
+			affected: osvschema.Affected{
+				Package: osvschema.Package{Ecosystem: "Go"},
+				Ranges:  []osvschema.Range{{Type: "SEMVER", Events: []osvschema.Event{{Introduced: "0"}, {Fixed: "0.17.0"}}}},
+			},
+			version: "0.9.0",
+			want:    true,
+		},
+		{
+			desc: "explicitly listed version",
+			affected: osvschema.Affected{
+				Package:  osvschema.Package{Ecosystem: "Alpine:v3.18"},
+				Versions: []string{"1.0.0-r1"},
+			},
+			version: "1.0.0-r1",
+			want:    true,
+		},
+		{
+			desc: "git ranges are ignored",
+			affected: osvschema.Affected{
+				Package: osvschema.Package{Ecosystem: "npm"},
+				Ranges:  []osvschema.Range{{Type: "GIT", Events: []osvschema.Event{{Introduced: "0"}}}},
+			},
+			version: "1.0.0",
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := tt.affected.AffectsVersion(tt.version)
+			if err != nil {
+				t.Fatalf("AffectsVersion(%q): %v", tt.version, err)
+			}
+			if got != tt.want {
+				t.Errorf("AffectsVersion(%q) = %v, want %v", tt.version, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAffectsVersionUnsupportedEcosystem(t *testing.T) {
+	a := osvschema.Affected{
+		Package: osvschema.Package{Ecosystem: "Alpine:v3.18"},
+		Ranges:  []osvschema.Range{{Type: "ECOSYSTEM", Events: []osvschema.Event{{Introduced: "0"}, {Fixed: "1.0.0-r2"}}}},
+	}
+	if _, err := a.AffectsVersion("1.0.0-r1"); err == nil {
+		t.Error("AffectsVersion() returned no error for an ecosystem without version comparison")
+	}
+}
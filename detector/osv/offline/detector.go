@@ -0,0 +1,181 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package offline implements a detector that matches the extracted inventory
+// against local copies of the OSV database, for environments without network
+// access.
+package offline
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/detector/osv/internal/osvschema"
+	"github.com/google/osv-scalibr/extractor"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/inventoryindex"
+	"github.com/google/osv-scalibr/log"
+	"github.com/google/osv-scalibr/plugin"
+)
+
+const (
+	// Name is the unique name of this detector.
+	Name = "osv/offline"
+
+	// archiveName is the name of the per-ecosystem archives published at
+	// https://osv-vulnerabilities.storage.googleapis.com/<ecosystem>/all.zip
+	archiveName = "all.zip"
+)
+
+// Detector is a SCALIBR Detector that matches inventory against the OSV
+// advisories of a local database.
+type Detector struct {
+	// DatabasePath is the directory holding the OSV database, with one
+	// <ecosystem>/all.zip archive per ecosystem, e.g. NuGet/all.zip.
+	DatabasePath string
+}
+
+// Name of the detector.
+func (Detector) Name() string { return Name }
+
+// Version of the detector.
+func (Detector) Version() int { return 0 }
+
+// Requirements of the detector.
+func (Detector) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// RequiredExtractors returns an empty list as the detector works on the
+// inventory of all extractors.
+func (Detector) RequiredExtractors() []string { return []string{} }
+
+// Scan matches the inventory against the advisories of the local database and
+// returns a finding for each vulnerability affecting it.
+func (d Detector) Scan(ctx context.Context, scanRoot *scalibrfs.ScanRoot, ix *inventoryindex.InventoryIndex) ([]*detector.Finding, error) {
+	if d.DatabasePath == "" {
+		return nil, errors.New("no OSV database path specified")
+	}
+	// Ecosystem (without release suffix) to its advisories.
+	dbs := make(map[string]database)
+	result := []*detector.Finding{}
+	for _, i := range ix.GetAll() {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if i.Version == "" || i.Extractor == nil {
+			continue
+		}
+		p := i.Extractor.ToPURL(i)
+		if p == nil {
+			continue
+		}
+		ecosystem := i.EffectiveEcosystem()
+		if ecosystem == "" {
+			log.Debugf("osv/offline: skipping %s: no OSV ecosystem", i.Name)
+			continue
+		}
+		base, _, _ := strings.Cut(ecosystem, ":")
+		db, ok := dbs[base]
+		if !ok {
+			var err error
+			if db, err = d.loadDatabase(base); err != nil {
+				return nil, err
+			}
+			dbs[base] = db
+		}
+		result = append(result, db.match(i, ecosystem, osvschema.PackageName(p))...)
+	}
+	return result, nil
+}
+
+// database indexes the advisories of an ecosystem by normalized package name.
+type database map[string][]*osvschema.Vulnerability
+
+// loadDatabase reads all advisories from the archive of the given ecosystem.
+// Missing archives result in an empty database.
+func (d Detector) loadDatabase(ecosystem string) (database, error) {
+	path := filepath.Join(d.DatabasePath, ecosystem, archiveName)
+	r, err := zip.OpenReader(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		log.Warnf("osv/offline: no database for ecosystem %s at %s", ecosystem, path)
+		return database{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer r.Close()
+
+	db := database{}
+	for _, f := range r.File {
+		if !strings.HasSuffix(f.Name, ".json") {
+			continue
+		}
+		v, err := readVuln(f)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		// Index each vulnerability once per package it affects.
+		seen := make(map[string]bool)
+		for _, a := range v.Affected {
+			name := osvschema.NormalizeName(ecosystem, a.Package.Name)
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			db[name] = append(db[name], v)
+		}
+	}
+	return db, nil
+}
+
+func readVuln(f *zip.File) (*osvschema.Vulnerability, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", f.Name, err)
+	}
+	defer rc.Close()
+	v := &osvschema.Vulnerability{}
+	if err := json.NewDecoder(rc).Decode(v); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", f.Name, err)
+	}
+	return v, nil
+}
+
+// match returns findings for all vulnerabilities affecting the inventory.
+func (db database) match(i *extractor.Inventory, ecosystem, name string) []*detector.Finding {
+	var findings []*detector.Finding
+	for _, v := range db[osvschema.NormalizeName(ecosystem, name)] {
+		var matching []osvschema.Affected
+		for _, a := range v.AffectedFor(ecosystem, name) {
+			affected, err := a.AffectsVersion(i.Version)
+			if err != nil {
+				log.Debugf("osv/offline: can't match %s@%s against %s: %v", name, i.Version, v.ID, err)
+				continue
+			}
+			if affected {
+				matching = append(matching, a)
+			}
+		}
+		if len(matching) > 0 {
+			findings = append(findings, v.Finding(i, matching))
+		}
+	}
+	return findings
+}
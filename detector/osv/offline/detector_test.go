@@ -0,0 +1,99 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package offline_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/detector/osv/offline"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/packageslockjson"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/python/requirements"
+	"github.com/google/osv-scalibr/inventoryindex"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+func TestScan(t *testing.T) {
+	nuget := packageslockjson.New(packageslockjson.DefaultConfig())
+	pypi := requirements.New(requirements.DefaultConfig())
+	ix, err := inventoryindex.New([]*extractor.Inventory{
+		// Straddling the fixed version 13.0.1.
+		{Name: "Newtonsoft.Json", Version: "12.0.3", Extractor: nuget, Ecosystem: "NuGet"},
+		{Name: "Newtonsoft.Json", Version: "13.0.1", Extractor: nuget, Ecosystem: "NuGet"},
+		{Name: "Newtonsoft.Json", Version: "13.0.3", Extractor: nuget, Ecosystem: "NuGet"},
+		// Affected from 2.0.0 until 2.5.0.
+		{Name: "Serilog", Version: "1.5.14", Extractor: nuget, Ecosystem: "NuGet"},
+		{Name: "serilog", Version: "2.4.0", Extractor: nuget, Ecosystem: "NuGet"},
+		// Affected from 1.0 up to and including 2.2.
+		{Name: "requests", Version: "2.2", Extractor: pypi, Ecosystem: "PyPI"},
+		{Name: "requests", Version: "2.2.1", Extractor: pypi, Ecosystem: "PyPI"},
+		// Listed as zope.interface, fixed in 5.0.
+		{Name: "Zope_Interface", Version: "4.7", Extractor: pypi, Ecosystem: "PyPI"},
+		{Name: "zope-interface", Version: "5.1", Extractor: pypi, Ecosystem: "PyPI"},
+		// No database for the ecosystem.
+		{Name: "express", Version: "4.0.0", Extractor: fakeNPMExtractor{}, Ecosystem: "npm"},
+	})
+	if err != nil {
+		t.Fatalf("inventoryindex.New(): %v", err)
+	}
+
+	d := offline.Detector{DatabasePath: "testdata/osv"}
+	findings, err := d.Scan(context.Background(), nil, ix)
+	if err != nil {
+		t.Fatalf("Scan(): %v", err)
+	}
+
+	var got []string
+	for _, f := range findings {
+		i := f.Target.Inventory
+		got = append(got, f.Adv.ID.Reference+" "+i.Name+"@"+i.Version)
+	}
+	sort.Strings(got)
+	want := []string{
+		"GHSA-5crp-9r3c-p9vr Newtonsoft.Json@12.0.3",
+		"TEST-2024-0001 serilog@2.4.0",
+		"TEST-2024-0002 requests@2.2",
+		"TEST-2024-0003 Zope_Interface@4.7",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Scan() diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestScanNoDatabasePath(t *testing.T) {
+	ix, err := inventoryindex.New(nil)
+	if err != nil {
+		t.Fatalf("inventoryindex.New(): %v", err)
+	}
+	if _, err := (offline.Detector{}).Scan(context.Background(), nil, ix); err == nil {
+		t.Error("Scan() returned no error without a database path")
+	}
+}
+
+type fakeNPMExtractor struct{}
+
+func (fakeNPMExtractor) Name() string                       { return "fake/npm" }
+func (fakeNPMExtractor) Version() int                       { return 0 }
+func (fakeNPMExtractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+func (fakeNPMExtractor) Ecosystem(*extractor.Inventory) string {
+	return "npm"
+}
+func (fakeNPMExtractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{Type: purl.TypeNPM, Name: i.Name, Version: i.Version}
+}
@@ -0,0 +1,376 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package osvdev implements a detector that looks up vulnerabilities of the
+// extracted inventory on OSV.dev.
+package osvdev
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/detector/osv/internal/osvschema"
+	"github.com/google/osv-scalibr/extractor"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/inventoryindex"
+	"github.com/google/osv-scalibr/log"
+	"github.com/google/osv-scalibr/plugin"
+)
+
+const (
+	// Name is the unique name of this detector.
+	Name = "osv/osvdev"
+
+	defaultBaseURL = "https://api.osv.dev"
+	// OSV.dev accepts at most 1000 queries per batch.
+	maxBatchSize              = 1000
+	defaultMaxRetries         = 3
+	defaultMinRequestInterval = 100 * time.Millisecond
+	initialRetryDelay         = time.Second
+)
+
+// Detector is a SCALIBR Detector that queries OSV.dev for known vulnerabilities
+// of all extracted inventory.
+type Detector struct {
+	// Client is used for requests to OSV.dev. If nil, http.DefaultClient is used.
+	Client *http.Client
+	// BaseURL of the OSV API. If empty, https://api.osv.dev is used.
+	BaseURL string
+	// MinRequestInterval is the minimum time between two requests. If 0, 100ms is used.
+	MinRequestInterval time.Duration
+	// MaxRetries is how often rate limited requests and requests that failed
+	// with a server error are retried. If nil, 3 is used. 0 disables retries.
+	MaxRetries *int
+	// MaxConcurrentRequests is the maximum number of querybatch requests in
+	// flight at the same time when the inventory needs several batches. All
+	// requests still share MinRequestInterval. If 0, batches are queried one
+	// after another.
+	MaxConcurrentRequests int
+}
+
+// Name of the detector.
+func (Detector) Name() string { return Name }
+
+// Version of the detector.
+func (Detector) Version() int { return 0 }
+
+// Requirements of the detector.
+func (Detector) Requirements() *plugin.Capabilities { return &plugin.Capabilities{Network: true} }
+
+// RequiredExtractors returns an empty list as the detector works on the
+// inventory of all extractors.
+func (Detector) RequiredExtractors() []string { return []string{} }
+
+// query is a single query of a querybatch request.
+type query struct {
+	Package   osvschema.Package `json:"package"`
+	Version   string            `json:"version"`
+	PageToken string            `json:"page_token,omitempty"`
+}
+
+type batchRequest struct {
+	Queries []query `json:"queries"`
+}
+
+type batchResponse struct {
+	Results []struct {
+		Vulns []struct {
+			ID string `json:"id"`
+		} `json:"vulns"`
+		NextPageToken string `json:"next_page_token"`
+	} `json:"results"`
+}
+
+// Scan looks up the inventory on OSV.dev and returns a finding for each
+// vulnerability affecting it.
+func (d Detector) Scan(ctx context.Context, scanRoot *scalibrfs.ScanRoot, ix *inventoryindex.InventoryIndex) ([]*detector.Finding, error) {
+	var queries []query
+	var invs []*extractor.Inventory
+	for _, i := range ix.GetAll() {
+		if i.Version == "" || i.Extractor == nil {
+			continue
+		}
+		p := i.Extractor.ToPURL(i)
+		if p == nil {
+			continue
+		}
+		ecosystem := i.EffectiveEcosystem()
+		if ecosystem == "" {
+			log.Debugf("osvdev: skipping %s: no OSV ecosystem", i.Name)
+			continue
+		}
+		queries = append(queries, query{
+			Package: osvschema.Package{Ecosystem: ecosystem, Name: osvschema.PackageName(p)},
+			Version: i.Version,
+		})
+		invs = append(invs, i)
+	}
+	if len(queries) == 0 {
+		return nil, nil
+	}
+
+	c := d.newClient()
+	ids, err := c.queryBatch(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*detector.Finding{}
+	vulns := make(map[string]*osvschema.Vulnerability)
+	for qi, vulnIDs := range ids {
+		for _, id := range vulnIDs {
+			v, ok := vulns[id]
+			if !ok {
+				if v, err = c.getVuln(ctx, id); err != nil {
+					return nil, err
+				}
+				vulns[id] = v
+			}
+			q := queries[qi]
+			result = append(result, v.Finding(invs[qi], v.AffectedFor(q.Package.Ecosystem, q.Package.Name)))
+		}
+	}
+	return result, nil
+}
+
+// client sends rate limited requests to the OSV API. It's safe for
+// concurrent use.
+type client struct {
+	http                  *http.Client
+	baseURL               string
+	minRequestInterval    time.Duration
+	maxRetries            int
+	maxConcurrentRequests int
+
+	mu sync.Mutex
+	// lastRequest is the time the latest request was sent or is scheduled to
+	// be sent at.
+	lastRequest time.Time
+}
+
+func (d Detector) newClient() *client {
+	c := &client{
+		http:                  d.Client,
+		baseURL:               d.BaseURL,
+		minRequestInterval:    d.MinRequestInterval,
+		maxRetries:            defaultMaxRetries,
+		maxConcurrentRequests: max(d.MaxConcurrentRequests, 1),
+	}
+	if c.http == nil {
+		c.http = http.DefaultClient
+	}
+	if c.baseURL == "" {
+		c.baseURL = defaultBaseURL
+	}
+	if c.minRequestInterval == 0 {
+		c.minRequestInterval = defaultMinRequestInterval
+	}
+	if d.MaxRetries != nil {
+		c.maxRetries = *d.MaxRetries
+	}
+	return c
+}
+
+// queryBatch returns the IDs of the vulnerabilities affecting each query,
+// following the pagination of the results. Queries are split into batches,
+// up to maxConcurrentRequests of which are sent concurrently. If a batch
+// fails, the outstanding ones are cancelled.
+func (c *client) queryBatch(ctx context.Context, queries []query) ([][]string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Each batch only writes the results of its own queries.
+	ids := make([][]string, len(queries))
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		batchErr error
+	)
+	workers := make(chan struct{}, c.maxConcurrentRequests)
+batches:
+	for start := 0; start < len(queries); start += maxBatchSize {
+		select {
+		case workers <- struct{}{}:
+		case <-ctx.Done():
+			break batches
+		}
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			defer func() { <-workers }()
+			if err := c.queryPages(ctx, queries, ids, start, end); err != nil {
+				errOnce.Do(func() {
+					batchErr = err
+					cancel()
+				})
+			}
+		}(start, min(start+maxBatchSize, len(queries)))
+	}
+	wg.Wait()
+
+	if batchErr != nil {
+		return nil, batchErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// queryPages stores the IDs of the vulnerabilities affecting queries[start:end]
+// in ids, following the pagination of the results.
+func (c *client) queryPages(ctx context.Context, queries []query, ids [][]string, start, end int) error {
+	// Indices of the queries that still have results to fetch.
+	var pending []int
+	for i := start; i < end; i++ {
+		pending = append(pending, i)
+	}
+	pageTokens := make(map[int]string)
+	for len(pending) > 0 {
+		req := batchRequest{}
+		for _, i := range pending {
+			q := queries[i]
+			q.PageToken = pageTokens[i]
+			req.Queries = append(req.Queries, q)
+		}
+		resp := batchResponse{}
+		if err := c.do(ctx, http.MethodPost, "/v1/querybatch", req, &resp); err != nil {
+			return err
+		}
+		if len(resp.Results) != len(pending) {
+			return fmt.Errorf("OSV.dev returned %d results for %d queries", len(resp.Results), len(pending))
+		}
+		var next []int
+		for j, r := range resp.Results {
+			i := pending[j]
+			for _, v := range r.Vulns {
+				ids[i] = append(ids[i], v.ID)
+			}
+			if r.NextPageToken != "" {
+				pageTokens[i] = r.NextPageToken
+				next = append(next, i)
+			}
+		}
+		pending = next
+	}
+	return nil
+}
+
+func (c *client) getVuln(ctx context.Context, id string) (*osvschema.Vulnerability, error) {
+	v := &osvschema.Vulnerability{}
+	if err := c.do(ctx, http.MethodGet, "/v1/vulns/"+url.PathEscape(id), nil, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// do sends a request to the OSV API and decodes the JSON response into out.
+// Rate limited requests and server errors are retried with exponential backoff.
+func (c *client) do(ctx context.Context, method, path string, body any, out any) error {
+	var reqBody []byte
+	if body != nil {
+		var err error
+		if reqBody, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+
+	delay := initialRetryDelay
+	for attempt := 0; ; attempt++ {
+		if err := c.waitForRateLimit(ctx); err != nil {
+			return err
+		}
+		req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bytes.NewReader(reqBody))
+		if err != nil {
+			return err
+		}
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		resp, err := c.http.Do(req)
+		if err != nil {
+			return fmt.Errorf("%s %s: %w", method, path, err)
+		}
+
+		if retryable(resp.StatusCode) && attempt < c.maxRetries {
+			resp.Body.Close()
+			wait := delay
+			if s, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+				wait = time.Duration(s) * time.Second
+			}
+			delay *= 2
+			if err := sleep(ctx, wait); err != nil {
+				return err
+			}
+			continue
+		}
+
+		err = decodeResponse(resp, out)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("%s %s: %w", method, path, err)
+		}
+		return nil
+	}
+}
+
+// waitForRateLimit blocks until the next request may be sent. The time slot
+// is reserved before waiting so that concurrent requests are spaced out by
+// minRequestInterval too.
+func (c *client) waitForRateLimit(ctx context.Context) error {
+	c.mu.Lock()
+	slot := time.Now()
+	if next := c.lastRequest.Add(c.minRequestInterval); next.After(slot) {
+		slot = next
+	}
+	c.lastRequest = slot
+	c.mu.Unlock()
+	return sleep(ctx, time.Until(slot))
+}
+
+// retryable returns true if a request that failed with the given status is
+// likely to succeed when sent again.
+func retryable(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+func decodeResponse(resp *http.Response, out any) error {
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// sleep blocks for the given duration or until the context is cancelled.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
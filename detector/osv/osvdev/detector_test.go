@@ -0,0 +1,379 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osvdev_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/detector/osv/osvdev"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/packageslockjson"
+	"github.com/google/osv-scalibr/inventoryindex"
+)
+
+const vulnJSON = `{
+  "id": "GHSA-5crp-9r3c-p9vr",
+  "summary": "Improper Handling of Exceptional Conditions in Newtonsoft.Json",
+  "details": "Newtonsoft.Json prior to version 13.0.1 is vulnerable to Insecure Defaults.",
+  "severity": [{"type": "CVSS_V3", "score": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:H"}],
+  "affected": [{
+    "package": {"ecosystem": "NuGet", "name": "Newtonsoft.Json"},
+    "ranges": [{"type": "ECOSYSTEM", "events": [{"introduced": "0"}, {"fixed": "13.0.1"}]}]
+  }],
+  "database_specific": {"severity": "HIGH"}
+}`
+
+type batchRequest struct {
+	Queries []struct {
+		Package struct {
+			Name      string `json:"name"`
+			Ecosystem string `json:"ecosystem"`
+		} `json:"package"`
+		Version   string `json:"version"`
+		PageToken string `json:"page_token"`
+	} `json:"queries"`
+}
+
+// fakeOSV serves a single known vulnerability for Newtonsoft.Json 12.0.3. The
+// vulnerability is only returned on the second page of results, and the first
+// request is rate limited.
+type fakeOSV struct {
+	batchRequests int
+	vulnRequests  int
+}
+
+func (f *fakeOSV) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/v1/querybatch":
+		f.batchRequests++
+		if f.batchRequests == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		req := batchRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var results []map[string]any
+		for _, q := range req.Queries {
+			res := map[string]any{}
+			if q.Package.Ecosystem == "NuGet" && q.Package.Name == "Newtonsoft.Json" && q.Version == "12.0.3" {
+				if q.PageToken == "" {
+					res["next_page_token"] = "page2"
+				} else {
+					res["vulns"] = []map[string]string{{"id": "GHSA-5crp-9r3c-p9vr"}}
+				}
+			}
+			results = append(results, res)
+		}
+		json.NewEncoder(w).Encode(map[string]any{"results": results})
+	case "/v1/vulns/GHSA-5crp-9r3c-p9vr":
+		f.vulnRequests++
+		w.Write([]byte(vulnJSON))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func setupIndex(t *testing.T) (*inventoryindex.InventoryIndex, *extractor.Inventory) {
+	t.Helper()
+	ex := packageslockjson.New(packageslockjson.DefaultConfig())
+	vulnerable := &extractor.Inventory{Name: "Newtonsoft.Json", Version: "12.0.3", Extractor: ex, Ecosystem: "NuGet"}
+	ix, err := inventoryindex.New([]*extractor.Inventory{
+		vulnerable,
+		{Name: "Serilog", Version: "3.1.1", Extractor: ex, Ecosystem: "NuGet"},
+		{Name: "NoVersion", Extractor: ex, Ecosystem: "NuGet"},
+	})
+	if err != nil {
+		t.Fatalf("inventoryindex.New(): %v", err)
+	}
+	return ix, vulnerable
+}
+
+func TestScan(t *testing.T) {
+	fake := &fakeOSV{}
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+	ix, vulnerable := setupIndex(t)
+
+	d := osvdev.Detector{
+		Client:             srv.Client(),
+		BaseURL:            srv.URL,
+		MinRequestInterval: time.Millisecond,
+	}
+	got, err := d.Scan(context.Background(), nil, ix)
+	if err != nil {
+		t.Fatalf("Scan(): %v", err)
+	}
+
+	want := []*detector.Finding{{
+		Adv: &detector.Advisory{
+			ID:             &detector.AdvisoryID{Publisher: "OSV", Reference: "GHSA-5crp-9r3c-p9vr"},
+			Type:           detector.TypeVulnerability,
+			Title:          "Improper Handling of Exceptional Conditions in Newtonsoft.Json",
+			Description:    "Newtonsoft.Json prior to version 13.0.1 is vulnerable to Insecure Defaults.",
+			Recommendation: "Upgrade the affected package to a version that is not affected by GHSA-5crp-9r3c-p9vr",
+			Sev: &detector.Severity{
+				Severity: detector.SeverityHigh,
+				CVSSV3:   &detector.CVSS{BaseScore: 7.5},
+			},
+		},
+		Target: &detector.TargetDetails{Inventory: vulnerable},
+		Extra:  "Affected ranges of Newtonsoft.Json: [introduced: 0, fixed: 13.0.1]",
+	}}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(detector.TargetDetails{}, "Inventory")); diff != "" {
+		t.Errorf("Scan() diff (-want +got):\n%s", diff)
+	}
+	if len(got) == 1 && got[0].Target.Inventory != vulnerable {
+		t.Errorf("Scan() returned finding for %v, want %v", got[0].Target.Inventory, vulnerable)
+	}
+	// One rate limited request, then one request per page.
+	if fake.batchRequests != 3 {
+		t.Errorf("Scan() sent %d querybatch requests, want 3", fake.batchRequests)
+	}
+	if fake.vulnRequests != 1 {
+		t.Errorf("Scan() sent %d vuln requests, want 1", fake.vulnRequests)
+	}
+}
+
+func TestScanRetries(t *testing.T) {
+	tests := []struct {
+		desc         string
+		status       int
+		maxRetries   *int
+		wantRequests int
+	}{
+		{
+			desc:         "rate limited",
+			status:       http.StatusTooManyRequests,
+			maxRetries:   intPtr(2),
+			wantRequests: 3,
+		},
+		{
+			desc:         "service unavailable",
+			status:       http.StatusServiceUnavailable,
+			maxRetries:   intPtr(2),
+			wantRequests: 3,
+		},
+		{
+			desc:         "internal server error",
+			status:       http.StatusInternalServerError,
+			maxRetries:   intPtr(1),
+			wantRequests: 2,
+		},
+		{
+			desc:         "retries disabled",
+			status:       http.StatusTooManyRequests,
+			maxRetries:   intPtr(0),
+			wantRequests: 1,
+		},
+		{
+			desc:         "default retries",
+			status:       http.StatusBadGateway,
+			wantRequests: 4,
+		},
+		{
+			desc:         "client error isn't retried",
+			status:       http.StatusBadRequest,
+			maxRetries:   intPtr(2),
+			wantRequests: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			requests := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+			ix, _ := setupIndex(t)
+
+			d := osvdev.Detector{
+				Client:             srv.Client(),
+				BaseURL:            srv.URL,
+				MinRequestInterval: time.Millisecond,
+				MaxRetries:         tt.maxRetries,
+			}
+			if _, err := d.Scan(context.Background(), nil, ix); err == nil {
+				t.Errorf("Scan() returned no error for a server responding with %d", tt.status)
+			}
+			if requests != tt.wantRequests {
+				t.Errorf("Scan() sent %d requests, want %d", requests, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func intPtr(i int) *int { return &i }
+
+func TestScanContextCancelled(t *testing.T) {
+	fake := &fakeOSV{}
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+	ix, _ := setupIndex(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	d := osvdev.Detector{Client: srv.Client(), BaseURL: srv.URL}
+	if _, err := d.Scan(ctx, nil, ix); !errors.Is(err, context.Canceled) {
+		t.Errorf("Scan() returned error %v, want %v", err, context.Canceled)
+	}
+	if fake.batchRequests != 0 {
+		t.Errorf("Scan() sent %d requests after the context was cancelled", fake.batchRequests)
+	}
+}
+
+// concurrentOSV reports every package whose name is in vulnerable as affected
+// by GHSA-5crp-9r3c-p9vr and records how many querybatch requests are
+// handled at the same time.
+type concurrentOSV struct {
+	vulnerable map[string]bool
+	// block makes querybatch requests wait until the request is cancelled.
+	block bool
+
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+	requests    int
+}
+
+func (f *concurrentOSV) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/v1/querybatch" {
+		w.Write([]byte(vulnJSON))
+		return
+	}
+	f.mu.Lock()
+	f.requests++
+	f.inFlight++
+	f.maxInFlight = max(f.maxInFlight, f.inFlight)
+	f.mu.Unlock()
+	defer func() {
+		f.mu.Lock()
+		f.inFlight--
+		f.mu.Unlock()
+	}()
+
+	req := batchRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if f.block {
+		// The request context is only cancelled once the body was read.
+		<-r.Context().Done()
+		return
+	}
+	// Give the other batches time to be sent.
+	time.Sleep(50 * time.Millisecond)
+
+	var results []map[string]any
+	for _, q := range req.Queries {
+		res := map[string]any{}
+		if f.vulnerable[q.Package.Name] {
+			res["vulns"] = []map[string]string{{"id": "GHSA-5crp-9r3c-p9vr"}}
+		}
+		results = append(results, res)
+	}
+	json.NewEncoder(w).Encode(map[string]any{"results": results})
+}
+
+// manyPackagesIndex returns an index with enough packages for three batches.
+func manyPackagesIndex(t *testing.T) *inventoryindex.InventoryIndex {
+	t.Helper()
+	ex := packageslockjson.New(packageslockjson.DefaultConfig())
+	var inv []*extractor.Inventory
+	for i := range 2500 {
+		inv = append(inv, &extractor.Inventory{Name: fmt.Sprintf("Package%d", i), Version: "1.0.0", Extractor: ex, Ecosystem: "NuGet"})
+	}
+	ix, err := inventoryindex.New(inv)
+	if err != nil {
+		t.Fatalf("inventoryindex.New(): %v", err)
+	}
+	return ix
+}
+
+func TestScanConcurrentBatches(t *testing.T) {
+	// One vulnerable package in each batch.
+	vulnerable := map[string]bool{"Package10": true, "Package1200": true, "Package2499": true}
+	for _, maxConcurrent := range []int{0, 2, 3} {
+		t.Run(fmt.Sprintf("max_%d", maxConcurrent), func(t *testing.T) {
+			fake := &concurrentOSV{vulnerable: vulnerable}
+			srv := httptest.NewServer(fake)
+			defer srv.Close()
+
+			d := osvdev.Detector{
+				Client:                srv.Client(),
+				BaseURL:               srv.URL,
+				MinRequestInterval:    time.Millisecond,
+				MaxConcurrentRequests: maxConcurrent,
+			}
+			got, err := d.Scan(context.Background(), nil, manyPackagesIndex(t))
+			if err != nil {
+				t.Fatalf("Scan(): %v", err)
+			}
+
+			gotVulnerable := make(map[string]bool)
+			for _, f := range got {
+				gotVulnerable[f.Target.Inventory.Name] = true
+			}
+			if diff := cmp.Diff(vulnerable, gotVulnerable); diff != "" {
+				t.Errorf("Scan() found vulnerable packages diff (-want +got):\n%s", diff)
+			}
+			if fake.requests != 3 {
+				t.Errorf("Scan() sent %d querybatch requests, want 3", fake.requests)
+			}
+			if want := max(maxConcurrent, 1); fake.maxInFlight != want {
+				t.Errorf("Scan() sent up to %d querybatch requests at the same time, want %d", fake.maxInFlight, want)
+			}
+		})
+	}
+}
+
+func TestScanConcurrentBatchesCancelled(t *testing.T) {
+	fake := &concurrentOSV{block: true}
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	d := osvdev.Detector{
+		Client:                srv.Client(),
+		BaseURL:               srv.URL,
+		MinRequestInterval:    time.Millisecond,
+		MaxConcurrentRequests: 3,
+	}
+	if _, err := d.Scan(ctx, nil, manyPackagesIndex(t)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Scan() returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if fake.requests != 3 {
+		t.Errorf("Scan() sent %d querybatch requests before the context was cancelled, want 3", fake.requests)
+	}
+}
@@ -15,6 +15,7 @@
 package samreg
 
 import (
+	"errors"
 	"runtime"
 	"strings"
 	"testing"
@@ -84,6 +85,8 @@ func TestNewFromFile(t *testing.T) {
 	}
 }
 
+var errInjected = errors.New("injected")
+
 func TestUserRIDs(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -135,6 +138,17 @@ func TestUserRIDs(t *testing.T) {
 			registry: &mockregistry.MockRegistry{},
 			wantErr:  errFailedToParseUsers,
 		},
+		{
+			name: "enumerating_users_fails_returns_error",
+			registry: &mockregistry.MockRegistry{
+				Keys: map[string]registry.Key{
+					`SAM\Domains\Account\Users`: &mockregistry.MockKey{
+						KSubkeysErr: errInjected,
+					},
+				},
+			},
+			wantErr: errInjected,
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,54 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extractor
+
+import (
+	"github.com/google/osv-scalibr/plugin"
+)
+
+// PURLTyper can be implemented by extractors to declare the PURL types of the
+// inventory they create, e.g. so that tools can list the supported package
+// ecosystems without running a scan.
+type PURLTyper interface {
+	// PURLTypes returns the PURL types, e.g. "nuget", of all inventory the
+	// extractor could create.
+	PURLTypes() []string
+}
+
+// Descriptor describes an extractor without running it.
+type Descriptor struct {
+	Name    string
+	Version int
+	// PURLTypes of the inventory the extractor creates. Empty for extractors
+	// that don't declare them, e.g. because they don't create packages or
+	// because the types depend on the scanned files.
+	PURLTypes []string
+	// Requirements the scanning environment needs to meet to run the
+	// extractor, including the OS it runs on.
+	Requirements *plugin.Capabilities
+}
+
+// Describe returns the descriptor of the given extractor.
+func Describe(e Extractor) *Descriptor {
+	d := &Descriptor{
+		Name:         e.Name(),
+		Version:      e.Version(),
+		Requirements: e.Requirements(),
+	}
+	if t, ok := e.(PURLTyper); ok {
+		d.PURLTypes = t.PURLTypes()
+	}
+	return d
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extractor
+
+// RelationshipType describes how two inventories are related.
+type RelationshipType string
+
+const (
+	// RelationshipDiscrepancy links a package declared by a manifest or lockfile
+	// to an installed copy of the same package at a different version, which
+	// indicates the installation drifted from what was declared.
+	RelationshipDiscrepancy RelationshipType = "discrepancy"
+	// RelationshipDependsOn links a package to a package it depends on, as
+	// listed by the file it was extracted from.
+	RelationshipDependsOn RelationshipType = "depends_on"
+)
+
+// Relationship links an inventory to another one.
+type Relationship struct {
+	Type  RelationshipType
+	Other *Inventory
+}
+
+// LinkDiscrepancies finds packages that are declared by a manifest or lockfile
+// and installed at a different version, and links both inventories to each
+// other with a RelationshipDiscrepancy. Both inventories are kept. Packages are
+// matched by ecosystem and name; inventory without an ecosystem is ignored.
+func LinkDiscrepancies(inv []*Inventory) {
+	type key struct{ ecosystem, name string }
+	declared := make(map[key][]*Inventory)
+	for _, i := range inv {
+		if e := i.EffectiveEcosystem(); e != "" && i.hasLocationReason(LocationDeclared) {
+			k := key{e, i.Name}
+			declared[k] = append(declared[k], i)
+		}
+	}
+	for _, installed := range inv {
+		e := installed.EffectiveEcosystem()
+		if e == "" || !installed.hasLocationReason(LocationInstalled) {
+			continue
+		}
+		for _, d := range declared[key{e, installed.Name}] {
+			if d == installed || d.Version == installed.Version {
+				continue
+			}
+			d.Relationships = append(d.Relationships, Relationship{Type: RelationshipDiscrepancy, Other: installed})
+			installed.Relationships = append(installed.Relationships, Relationship{Type: RelationshipDiscrepancy, Other: d})
+		}
+	}
+}
+
+func (i *Inventory) hasLocationReason(r LocationReason) bool {
+	for _, l := range i.Locations {
+		if l.Reason == r {
+			return true
+		}
+	}
+	return false
+}
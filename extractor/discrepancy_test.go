@@ -0,0 +1,66 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extractor_test
+
+import (
+	"testing"
+
+	"github.com/google/osv-scalibr/extractor"
+)
+
+func TestLinkDiscrepancies(t *testing.T) {
+	newInv := func(name, version, ecosystem string, reason extractor.LocationReason) *extractor.Inventory {
+		return &extractor.Inventory{
+			Name:      name,
+			Version:   version,
+			Ecosystem: ecosystem,
+			Locations: []extractor.Location{{Path: "some/path", Reason: reason}},
+		}
+	}
+	declared := newInv("Newtonsoft.Json", "13.0.3", "NuGet", extractor.LocationDeclared)
+	installed := newInv("Newtonsoft.Json", "12.0.1", "NuGet", extractor.LocationInstalled)
+	declaredSame := newInv("Serilog", "3.1.1", "NuGet", extractor.LocationDeclared)
+	installedSame := newInv("Serilog", "3.1.1", "NuGet", extractor.LocationInstalled)
+	otherEcosystem := newInv("Newtonsoft.Json", "1.0.0", "npm", extractor.LocationInstalled)
+	noEcosystem := newInv("Newtonsoft.Json", "1.0.0", "", extractor.LocationInstalled)
+	inv := []*extractor.Inventory{declared, installed, declaredSame, installedSame, otherEcosystem, noEcosystem}
+
+	extractor.LinkDiscrepancies(inv)
+
+	if len(inv) != 6 {
+		t.Errorf("LinkDiscrepancies() changed the number of inventories to %d, want 6", len(inv))
+	}
+	wantLinks := map[*extractor.Inventory]*extractor.Inventory{
+		declared:  installed,
+		installed: declared,
+	}
+	for _, i := range inv {
+		other, wantLink := wantLinks[i]
+		if !wantLink {
+			if len(i.Relationships) != 0 {
+				t.Errorf("LinkDiscrepancies(): %s %s (%s) got relationships %v, want none", i.Name, i.Version, i.Ecosystem, i.Relationships)
+			}
+			continue
+		}
+		if len(i.Relationships) != 1 {
+			t.Fatalf("LinkDiscrepancies(): %s %s got %d relationships, want 1", i.Name, i.Version, len(i.Relationships))
+		}
+		r := i.Relationships[0]
+		if r.Type != extractor.RelationshipDiscrepancy || r.Other != other {
+			t.Errorf("LinkDiscrepancies(): %s %s got relationship {%s, %s %s}, want {%s, %s %s}",
+				i.Name, i.Version, r.Type, r.Other.Name, r.Other.Version, extractor.RelationshipDiscrepancy, other.Name, other.Version)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extractor
+
+import (
+	"strings"
+
+	"github.com/google/osv-scalibr/log"
+)
+
+// ecosystems are the known OSV ecosystems, keyed by their lowercase form.
+// See https://ossf.github.io/osv-schema/#defined-ecosystems
+var ecosystems = toMap([]string{
+	"AlmaLinux",
+	"Alpine",
+	"Android",
+	"Bioconductor",
+	"Bitnami",
+	"Chainguard",
+	"ConanCenter",
+	"CRAN",
+	"crates.io",
+	"Debian",
+	"GHC",
+	"GitHub Actions",
+	"Go",
+	"Hackage",
+	"Hex",
+	"Linux",
+	"Mageia",
+	"Maven",
+	"npm",
+	"NuGet",
+	"openSUSE",
+	"OSS-Fuzz",
+	"Packagist",
+	"Photon OS",
+	"Pub",
+	"PyPI",
+	"Red Hat",
+	"Rocky Linux",
+	"RubyGems",
+	"SUSE",
+	"SwiftURL",
+	"Ubuntu",
+	"Wolfi",
+})
+
+// ecosystemAliases maps the lowercase PURL types that differ from the name of
+// their OSV ecosystem, as returned e.g. by the SBOM extractors.
+var ecosystemAliases = map[string]string{
+	"cargo":    "crates.io",
+	"composer": "Packagist",
+	"conan":    "ConanCenter",
+	"gem":      "RubyGems",
+	"golang":   "Go",
+}
+
+func toMap(values []string) map[string]string {
+	m := make(map[string]string, len(values))
+	for _, v := range values {
+		m[strings.ToLower(v)] = v
+	}
+	return m
+}
+
+// NormalizeEcosystem returns the canonical spelling of an OSV ecosystem value,
+// e.g. "PyPI" for "pypi". A release suffix like the ":12" of "Debian:12" is
+// kept as is. Returns false if the ecosystem isn't known.
+func NormalizeEcosystem(ecosystem string) (string, bool) {
+	name, suffix, hasSuffix := strings.Cut(ecosystem, ":")
+	lower := strings.ToLower(name)
+	canonical, ok := ecosystems[lower]
+	if !ok {
+		if canonical, ok = ecosystemAliases[lower]; !ok {
+			return "", false
+		}
+	}
+	if hasSuffix {
+		return canonical + ":" + suffix, true
+	}
+	return canonical, true
+}
+
+// FillEcosystem sets the inventory's Ecosystem from its extractor unless the
+// extractor already set it at extraction time, and normalizes it. Unknown
+// ecosystems, e.g. the "generic" PURL type of SBOM entries, are dropped so
+// that consumers can rely on the field holding a valid OSV ecosystem.
+func FillEcosystem(i *Inventory) {
+	if i.Ecosystem == "" && i.Extractor != nil {
+		i.Ecosystem = i.Extractor.Ecosystem(i)
+	}
+	if i.Ecosystem == "" {
+		return
+	}
+	e, ok := NormalizeEcosystem(i.Ecosystem)
+	if !ok {
+		log.Debugf("Dropping unknown ecosystem %q of package %q", i.Ecosystem, i.Name)
+	}
+	i.Ecosystem = e
+}
+
+// EffectiveEcosystem returns the inventory's Ecosystem or, if it isn't set,
+// the normalized ecosystem reported by its extractor. The field is only filled
+// in by the filesystem walker and the standalone runner, so this also works for
+// inventory created elsewhere, e.g. by converters or library callers.
+func (i *Inventory) EffectiveEcosystem() string {
+	if i.Ecosystem != "" || i.Extractor == nil {
+		return i.Ecosystem
+	}
+	e, _ := NormalizeEcosystem(i.Extractor.Ecosystem(i))
+	return e
+}
@@ -0,0 +1,130 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extractor_test
+
+import (
+	"testing"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/python/requirements"
+	fe "github.com/google/osv-scalibr/testing/fakeextractor"
+)
+
+func TestNormalizeEcosystem(t *testing.T) {
+	tests := []struct {
+		ecosystem string
+		want      string
+		wantOK    bool
+	}{
+		{ecosystem: "PyPI", want: "PyPI", wantOK: true},
+		{ecosystem: "pypi", want: "PyPI", wantOK: true},
+		{ecosystem: "nuget", want: "NuGet", wantOK: true},
+		{ecosystem: "golang", want: "Go", wantOK: true},
+		{ecosystem: "Debian:12", want: "Debian:12", wantOK: true},
+		{ecosystem: "alpine:v3.20", want: "Alpine:v3.20", wantOK: true},
+		{ecosystem: "Red Hat", want: "Red Hat", wantOK: true},
+		{ecosystem: "generic", want: "", wantOK: false},
+		{ecosystem: "", want: "", wantOK: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.ecosystem, func(t *testing.T) {
+			got, ok := extractor.NormalizeEcosystem(tc.ecosystem)
+			if got != tc.want || ok != tc.wantOK {
+				t.Errorf("NormalizeEcosystem(%q) = %q, %t, want %q, %t", tc.ecosystem, got, ok, tc.want, tc.wantOK)
+			}
+		})
+	}
+}
+
+func TestFillEcosystem(t *testing.T) {
+	tests := []struct {
+		name string
+		inv  *extractor.Inventory
+		want string
+	}{
+		{
+			name: "from_extractor",
+			inv:  &extractor.Inventory{Name: "foo", Extractor: requirements.Extractor{}},
+			want: "PyPI",
+		},
+		{
+			name: "set_by_extractor",
+			inv:  &extractor.Inventory{Name: "foo", Ecosystem: "crates.io", Extractor: requirements.Extractor{}},
+			want: "crates.io",
+		},
+		{
+			name: "normalized",
+			inv:  &extractor.Inventory{Name: "foo", Ecosystem: "CRATES.IO"},
+			want: "crates.io",
+		},
+		{
+			name: "unknown_ecosystem_dropped",
+			inv:  &extractor.Inventory{Name: "foo", Extractor: fe.New("fake", 1, nil, nil)},
+			want: "",
+		},
+		{
+			name: "no_extractor",
+			inv:  &extractor.Inventory{Name: "foo"},
+			want: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			extractor.FillEcosystem(tc.inv)
+			if tc.inv.Ecosystem != tc.want {
+				t.Errorf("FillEcosystem() set Ecosystem %q, want %q", tc.inv.Ecosystem, tc.want)
+			}
+		})
+	}
+}
+
+func TestEffectiveEcosystem(t *testing.T) {
+	tests := []struct {
+		name string
+		inv  *extractor.Inventory
+		want string
+	}{
+		{
+			name: "field_set",
+			inv:  &extractor.Inventory{Name: "foo", Ecosystem: "crates.io", Extractor: requirements.Extractor{}},
+			want: "crates.io",
+		},
+		{
+			name: "from_extractor",
+			inv:  &extractor.Inventory{Name: "foo", Extractor: requirements.Extractor{}},
+			want: "PyPI",
+		},
+		{
+			name: "unknown_ecosystem",
+			inv:  &extractor.Inventory{Name: "foo", Extractor: fe.New("fake", 1, nil, nil)},
+			want: "",
+		},
+		{
+			name: "no_extractor",
+			inv:  &extractor.Inventory{Name: "foo"},
+			want: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.inv.EffectiveEcosystem(); got != tc.want {
+				t.Errorf("EffectiveEcosystem() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -16,6 +16,10 @@
 package extractor
 
 import (
+	"path"
+	"path/filepath"
+	"strings"
+
 	"github.com/google/osv-scalibr/plugin"
 	"github.com/google/osv-scalibr/purl"
 )
@@ -30,6 +34,14 @@ type Extractor interface {
 	Ecosystem(i *Inventory) string
 }
 
+// CPEExtractor is implemented by extractors that know the CPEs of the
+// inventory they create.
+type CPEExtractor interface {
+	// ToCPEs returns the CPEs in the CPE 2.3 formatted string binding of an
+	// inventory created by this extractor.
+	ToCPEs(i *Inventory) []string
+}
+
 // LINT.IfChange
 
 // SourceCodeIdentifier lists additional identifiers for source code software packages (e.g. NPM).
@@ -38,6 +50,17 @@ type SourceCodeIdentifier struct {
 	Commit string
 }
 
+// Metadata holds extractor specific information about an Inventory. Each
+// extractor defines its own concrete struct type for it, which consumers
+// type-assert to, e.g. i.Metadata.(*packageslockjson.Metadata).
+//
+// Metadata types are converted into their scan result proto message in
+// binary/proto, so new types need a matching message there to show up in the
+// scan results. They should also tag their fields with `json` names to keep
+// JSON output of the inventory, e.g. in golden test files, stable when the Go
+// fields are renamed.
+type Metadata any
+
 // Inventory is an instance of a software package or library found by the extractor.
 type Inventory struct {
 	// A human-readable name representation of the package. Note that this field
@@ -47,19 +70,168 @@ type Inventory struct {
 	// In cases when the exact name type used is important (e.g. when matching
 	// against vuln feeds) you should use the specific name field from the Metadata.
 	Name string
+	// The namespace the name is scoped to in ecosystems that have one: the
+	// scope of npm packages (e.g. "@babel"), the group ID of Maven packages
+	// (e.g. "org.slf4j") and the vendor of Composer packages (e.g. "symfony").
+	// Name keeps the full name the ecosystem uses, e.g. "@babel/core", so that
+	// it can be matched against vuln feeds, and BaseName returns it without the
+	// namespace. ToPURL implementations map the namespace to the PURL
+	// namespace. Empty for packages without a namespace.
+	Namespace string
 	// The version of this package.
 	Version string
+	// PURL qualifiers that the extractor knows at extraction time, e.g. "arch"
+	// or "checksum". ToPURL implementations built on PURLFromInventory include
+	// them in the PURL. Nil if there are none.
+	Qualifiers map[string]string
 	// Source code level package identifiers.
 	SourceCode *SourceCodeIdentifier
 
-	// Paths or source of files related to the package.
-	Locations []string
+	// Paths or source of files related to the package, along with the reason
+	// the package is attributed to them.
+	Locations []Location
 	// The Extractor that found this software instance. Set by the core library.
 	Extractor Extractor
+	// The path of the scan root the software was found under, which Locations
+	// are relative to unless absolute paths are stored. Set by the core library
+	// and empty for virtual scan roots.
+	ScanRoot string
+	// The OSV ecosystem of the package, e.g. PyPI or Debian:12. Extractors can
+	// set it at extraction time, otherwise the core library fills it in from
+	// the extractor's Ecosystem method. Empty if the package doesn't belong to
+	// a known ecosystem. See EffectiveEcosystem for inventory the core library
+	// didn't fill in.
+	Ecosystem string
 	// The additional data found in the package.
-	Metadata any
+	Metadata Metadata
 
 	Annotations []Annotation
+	// How certain the extractor is that the package is present with this name
+	// and version. Heuristic extractors, e.g. ones guessing versions from
+	// header macros, set a lower confidence. ConfidenceUnset is treated as
+	// ConfidenceMax, see EffectiveConfidence.
+	Confidence Confidence
+	// Other inventories this one is related to. Set by the core library, e.g.
+	// when linking discrepancies between declared and installed packages, and
+	// by extractors that know the dependencies between the packages they find.
+	Relationships []Relationship
+}
+
+// LocationReason describes why a package is attributed to a location.
+type LocationReason string
+
+const (
+	// LocationReasonUnknown is used if the extractor doesn't tell.
+	LocationReasonUnknown LocationReason = ""
+	// LocationDeclared is used for files declaring the package, e.g. manifests
+	// and lockfiles.
+	LocationDeclared LocationReason = "declared"
+	// LocationInstalled is used for locations the package is installed at.
+	LocationInstalled LocationReason = "installed"
+)
+
+// Location is a path related to a package, along with the reason the package
+// is attributed to it.
+type Location struct {
+	Path   string         `json:"path"`
+	Reason LocationReason `json:"reason,omitempty"`
+	// Line is the 1-based line the package is declared at in the file, or 0 if
+	// the extractor doesn't record it.
+	Line int `json:"line,omitempty"`
+}
+
+// LocationsFromPaths returns locations for the given paths without a reason.
+func LocationsFromPaths(paths ...string) []Location {
+	locs := make([]Location, 0, len(paths))
+	for _, p := range paths {
+		locs = append(locs, Location{Path: p})
+	}
+	return locs
+}
+
+// DeclaredLocations returns locations for the given paths with the
+// LocationDeclared reason, for extractors reading manifests and lockfiles.
+func DeclaredLocations(paths ...string) []Location {
+	return locationsWithReason(LocationDeclared, paths)
+}
+
+// InstalledLocations returns locations for the given paths with the
+// LocationInstalled reason, for extractors reading the files of installed
+// packages or the databases of package managers.
+func InstalledLocations(paths ...string) []Location {
+	return locationsWithReason(LocationInstalled, paths)
+}
+
+func locationsWithReason(r LocationReason, paths []string) []Location {
+	locs := make([]Location, 0, len(paths))
+	for _, p := range paths {
+		locs = append(locs, Location{Path: p, Reason: r})
+	}
+	return locs
+}
+
+// LocationPaths returns the paths of the inventory's Locations.
+func (i *Inventory) LocationPaths() []string {
+	paths := make([]string, 0, len(i.Locations))
+	for _, l := range i.Locations {
+		paths = append(paths, l.Path)
+	}
+	return paths
+}
+
+// ManifestSubpath returns the directory of the file declaring the inventory,
+// relative to the scan root, for use as a PURL subpath. This keeps PURLs of
+// the same package declared by different projects of a monorepo apart. It
+// returns "" if the manifest is at the scan root or the inventory has no
+// declared location. Absolute locations, e.g. from scans that store absolute
+// paths, are made relative to the inventory's ScanRoot first.
+func (i *Inventory) ManifestSubpath() string {
+	for _, l := range i.Locations {
+		if l.Reason != LocationDeclared {
+			continue
+		}
+		p := l.Path
+		if i.ScanRoot != "" && filepath.IsAbs(p) {
+			if rel, err := filepath.Rel(i.ScanRoot, p); err == nil && !strings.HasPrefix(rel, "..") {
+				p = filepath.ToSlash(rel)
+			}
+		}
+		dir := path.Dir(p)
+		if dir == "." || dir == "/" {
+			return ""
+		}
+		return strings.TrimPrefix(dir, "/")
+	}
+	return ""
+}
+
+// Confidence is how certain an extractor is about a package it found, from 1
+// to 100.
+type Confidence int
+
+const (
+	// ConfidenceUnset is used by extractors that don't report a confidence
+	// since they read packages from authoritative sources, e.g. lockfiles or
+	// package databases.
+	ConfidenceUnset Confidence = 0
+	// ConfidenceLow is used for packages that are likely misidentified.
+	ConfidenceLow Confidence = 25
+	// ConfidenceMedium is used for packages identified by heuristics that can
+	// be fooled, e.g. version strings in source files.
+	ConfidenceMedium Confidence = 50
+	// ConfidenceHigh is used for packages whose identification rarely fails.
+	ConfidenceHigh Confidence = 75
+	// ConfidenceMax is used for packages found in authoritative sources.
+	ConfidenceMax Confidence = 100
+)
+
+// EffectiveConfidence returns the inventory's Confidence, or ConfidenceMax if
+// the extractor didn't set one.
+func (i *Inventory) EffectiveConfidence() Confidence {
+	if i.Confidence == ConfidenceUnset {
+		return ConfidenceMax
+	}
+	return i.Confidence
 }
 
 // Annotation are additional information about the inventory.
@@ -77,12 +249,18 @@ const (
 	// InsideCacheDir is set for packages that are found inside a cache directory.
 	// TODO(b/364539671): Annotation for packages inside cache directories.
 	InsideCacheDir
+	// Direct is set for packages that the scanned project depends on directly.
+	Direct
+	// Transitive is set for packages that are only pulled in by other dependencies.
+	Transitive
+	// DevOnly is set for packages that are only needed during development, e.g. for tests.
+	DevOnly
+	// OptionalDependency is set for packages that are not required for the
+	// project to work.
+	OptionalDependency
+	// MetadataTruncated is set for packages whose metadata exceeded the size
+	// limit of the scan and was shortened or dropped.
+	MetadataTruncated
 )
 
-// Ecosystem returns the Ecosystem of the inventory. For software packages this corresponds
-// to an OSV ecosystem value, e.g. PyPI.
-func (i *Inventory) Ecosystem() string {
-	return i.Extractor.Ecosystem(i)
-}
-
 // LINT.ThenChange(/binary/proto/scan_result.proto)
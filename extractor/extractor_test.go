@@ -0,0 +1,144 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extractor_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor"
+)
+
+func TestLocationsFromPaths(t *testing.T) {
+	got := extractor.LocationsFromPaths("a", "b")
+	want := []extractor.Location{{Path: "a"}, {Path: "b"}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LocationsFromPaths(a, b) (-want +got):\n%s", diff)
+	}
+}
+
+func TestLocationsWithReason(t *testing.T) {
+	got := extractor.DeclaredLocations("a", "b")
+	want := []extractor.Location{{Path: "a", Reason: extractor.LocationDeclared}, {Path: "b", Reason: extractor.LocationDeclared}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("DeclaredLocations(a, b) (-want +got):\n%s", diff)
+	}
+	got = extractor.InstalledLocations("a")
+	want = []extractor.Location{{Path: "a", Reason: extractor.LocationInstalled}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("InstalledLocations(a) (-want +got):\n%s", diff)
+	}
+}
+
+func TestLocationPaths(t *testing.T) {
+	tests := []struct {
+		name string
+		inv  *extractor.Inventory
+		want []string
+	}{
+		{
+			name: "locations_with_reasons",
+			inv: &extractor.Inventory{
+				Locations: []extractor.Location{
+					{Path: "project/packages.lock.json", Reason: extractor.LocationDeclared},
+					{Path: "root/.nuget/packages/foo/1.0.0", Reason: extractor.LocationInstalled},
+				},
+			},
+			want: []string{"project/packages.lock.json", "root/.nuget/packages/foo/1.0.0"},
+		},
+		{
+			name: "locations_without_reasons",
+			inv:  &extractor.Inventory{Locations: extractor.LocationsFromPaths("a", "b")},
+			want: []string{"a", "b"},
+		},
+		{
+			name: "no_locations",
+			inv:  &extractor.Inventory{},
+			want: []string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, tc.inv.LocationPaths()); diff != "" {
+				t.Errorf("LocationPaths() (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestManifestSubpath(t *testing.T) {
+	tests := []struct {
+		name string
+		inv  *extractor.Inventory
+		want string
+	}{
+		{
+			name: "nested_manifest",
+			inv: &extractor.Inventory{Locations: []extractor.Location{
+				{Path: "src/app/packages.lock.json", Reason: extractor.LocationDeclared},
+			}},
+			want: "src/app",
+		},
+		{
+			name: "manifest_at_root",
+			inv: &extractor.Inventory{Locations: []extractor.Location{
+				{Path: "packages.lock.json", Reason: extractor.LocationDeclared},
+			}},
+			want: "",
+		},
+		{
+			name: "installed_location_ignored",
+			inv: &extractor.Inventory{Locations: []extractor.Location{
+				{Path: "root/.nuget/packages/foo/1.0.0", Reason: extractor.LocationInstalled},
+				{Path: "project/packages.lock.json", Reason: extractor.LocationDeclared},
+			}},
+			want: "project",
+		},
+		{
+			name: "absolute_location",
+			inv: &extractor.Inventory{
+				ScanRoot: "/scan/root",
+				Locations: []extractor.Location{
+					{Path: "/scan/root/src/app/packages.lock.json", Reason: extractor.LocationDeclared},
+				},
+			},
+			want: "src/app",
+		},
+		{
+			name: "absolute_location_at_root",
+			inv: &extractor.Inventory{
+				ScanRoot: "/scan/root",
+				Locations: []extractor.Location{
+					{Path: "/scan/root/packages.lock.json", Reason: extractor.LocationDeclared},
+				},
+			},
+			want: "",
+		},
+		{
+			name: "no_declared_location",
+			inv:  &extractor.Inventory{Locations: extractor.LocationsFromPaths("project/packages.lock.json")},
+			want: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.inv.ManifestSubpath(); got != tc.want {
+				t.Errorf("ManifestSubpath() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
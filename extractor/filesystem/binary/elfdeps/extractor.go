@@ -0,0 +1,242 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package elfdeps extracts the shared libraries that ELF executables and
+// libraries are linked against from their DT_NEEDED entries.
+package elfdeps
+
+import (
+	"bytes"
+	"context"
+	"debug/elf"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/log"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+// Name is the unique name of this extractor.
+const Name = "binary/elfdeps"
+
+// sharedLibRe matches the file names of shared libraries, e.g. libssl.so.3.
+var sharedLibRe = regexp.MustCompile(`\.so(\.|$)`)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the extractor.
+func DefaultConfig() Config {
+	return Config{}
+}
+
+// Metadata holds additional information about a linked shared library.
+type Metadata struct {
+	// Library is the name of the library without the ".so" suffix and ABI
+	// version, e.g. "libssl" for libssl.so.3.
+	Library string `json:"library"`
+	// ABIVersion is the version following ".so" in the soname, e.g. "3" for
+	// libssl.so.3. It's the version of the library's interface rather than of
+	// the library itself. Empty if the soname has none.
+	ABIVersion string `json:"abiVersion,omitempty"`
+}
+
+// Extractor extracts the shared libraries ELF binaries are linked against.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns an ELF shared library extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileRequired returns true for executables and shared libraries. Whether they
+// are ELF files is checked by SniffHeader.
+func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
+	if !e.fileMatches(path, fileinfo) {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// FileSizeLimitExceeded returns true if the file would be required if it
+// didn't exceed the maximum file size.
+func (e Extractor) FileSizeLimitExceeded(path string, fileinfo fs.FileInfo) bool {
+	return e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes && e.fileMatches(path, fileinfo)
+}
+
+// fileMatches returns true for regular files that are marked executable or
+// named like shared libraries, which often aren't executable.
+func (e Extractor) fileMatches(path string, fileinfo fs.FileInfo) bool {
+	if !fileinfo.Mode().IsRegular() {
+		return false
+	}
+	return fileinfo.Mode()&0111 != 0 || sharedLibRe.MatchString(filepath.Base(path))
+}
+
+// SniffHeader returns true for files starting with the ELF magic bytes.
+func (e Extractor) SniffHeader(path string, header []byte) bool {
+	return bytes.HasPrefix(header, []byte(elf.ELFMAG))
+}
+
+// Extract returns the shared libraries in the DT_NEEDED entries of the ELF
+// file's dynamic section, one inventory per soname. Statically linked binaries
+// have none. Files that can't be parsed as ELF are skipped without an error.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	ra, ok := input.Reader.(io.ReaderAt)
+	if !ok {
+		b, err := io.ReadAll(input.Reader)
+		if err != nil {
+			e.reportFileExtracted(input.Path, input.Info, err)
+			return nil, fmt.Errorf("could not read %s: %w", input.Path, err)
+		}
+		ra = bytes.NewReader(b)
+	}
+	f, err := elf.NewFile(ra)
+	if err != nil {
+		log.Debugf("error parsing ELF file %s: %v", input.Path, err)
+		e.reportFileExtracted(input.Path, input.Info, nil)
+		return []*extractor.Inventory{}, nil
+	}
+	defer f.Close()
+
+	libs, err := f.ImportedLibraries()
+	if err != nil {
+		log.Debugf("error reading the dynamic section of %s: %v", input.Path, err)
+		e.reportFileExtracted(input.Path, input.Info, nil)
+		return []*extractor.Inventory{}, nil
+	}
+
+	res := []*extractor.Inventory{}
+	seen := make(map[string]bool)
+	for _, soname := range libs {
+		if soname == "" || seen[soname] {
+			continue
+		}
+		seen[soname] = true
+		if input.MaxInventoryPerFile > 0 && len(res) >= input.MaxInventoryPerFile {
+			break
+		}
+		library, abiVersion := splitSONAME(soname)
+		res = append(res, &extractor.Inventory{
+			Name:      soname,
+			Locations: extractor.LocationsFromPaths(input.Path),
+			Metadata: &Metadata{
+				Library:    library,
+				ABIVersion: abiVersion,
+			},
+		})
+	}
+	e.reportFileExtracted(input.Path, input.Info, nil)
+	return res, nil
+}
+
+// splitSONAME splits a soname like libssl.so.3 into the library name and the
+// ABI version. Sonames without ".so" are returned as the library name.
+func splitSONAME(soname string) (library, abiVersion string) {
+	i := strings.LastIndex(soname, ".so")
+	if i <= 0 {
+		return soname, ""
+	}
+	rest := soname[i+len(".so"):]
+	if rest != "" && !strings.HasPrefix(rest, ".") {
+		return soname, ""
+	}
+	return soname[:i], strings.TrimPrefix(rest, ".")
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Extractor:     e.Name(),
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a generic PURL
+// named after the soname.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return extractor.PURLFromInventory(purl.TypeGeneric, i)
+}
+
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeGeneric} }
+
+// Ecosystem returns no ecosystem since sonames don't identify the package
+// that provides the library.
+func (e Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var (
+	_ filesystem.Extractor            = Extractor{}
+	_ filesystem.HeaderSniffer        = Extractor{}
+	_ filesystem.SizeLimitedExtractor = Extractor{}
+)
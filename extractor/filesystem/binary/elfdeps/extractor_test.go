@@ -0,0 +1,189 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elfdeps_test
+
+import (
+	"context"
+	"io/fs"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/binary/elfdeps"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		mode             fs.FileMode
+		size             int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "executable",
+			path:             "usr/bin/curl",
+			mode:             0755,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "shared library",
+			path:             "usr/lib/x86_64-linux-gnu/libssl.so.3",
+			mode:             0644,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "unversioned shared library",
+			path:             "opt/app/lib/libplugin.so",
+			mode:             0644,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name: "not executable",
+			path: "usr/share/doc/README",
+			mode: 0644,
+		},
+		{
+			name: "name containing .so",
+			path: "home/user/notes.solution",
+			mode: 0644,
+		},
+		{
+			name: "symlink",
+			path: "usr/lib/libssl.so",
+			mode: fs.ModeSymlink | 0777,
+		},
+		{
+			name:             "executable above size limit",
+			path:             "usr/bin/curl",
+			mode:             0755,
+			size:             1000,
+			maxFileSizeBytes: 100,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := elfdeps.New(elfdeps.Config{Stats: collector, MaxFileSizeBytes: tt.maxFileSizeBytes})
+			info := fakefs.FakeFileInfo{FileName: tt.path, FileMode: tt.mode, FileSize: tt.size}
+			if got := e.FileRequired(tt.path, info); got != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, got, tt.wantRequired)
+			}
+			if got := collector.FileRequiredResult(tt.path); got != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want %v", tt.path, got, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestSniffHeader(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   bool
+	}{
+		{name: "ELF", header: "\x7fELF\x02\x01\x01", want: true},
+		{name: "shell script", header: "#!/bin/sh\n", want: false},
+		{name: "empty", header: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (elfdeps.Extractor{}).SniffHeader("bin/app", []byte(tt.header)); got != tt.want {
+				t.Errorf("SniffHeader(%q) = %v, want %v", tt.header, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name:        "executable",
+			InputConfig: extracttest.ScanInputMockConfig{Path: "testdata/app"},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "libssl.so.3",
+					Locations: []extractor.Location{{Path: "testdata/app"}},
+					Metadata:  &elfdeps.Metadata{Library: "libssl", ABIVersion: "3"},
+				},
+				{
+					Name:      "libcrypto.so.3",
+					Locations: []extractor.Location{{Path: "testdata/app"}},
+					Metadata:  &elfdeps.Metadata{Library: "libcrypto", ABIVersion: "3"},
+				},
+				{
+					Name:      "libz.so.1",
+					Locations: []extractor.Location{{Path: "testdata/app"}},
+					Metadata:  &elfdeps.Metadata{Library: "libz", ABIVersion: "1"},
+				},
+			},
+		},
+		{
+			Name:        "shared library",
+			InputConfig: extracttest.ScanInputMockConfig{Path: "testdata/libfoo.so.1.2.3"},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "libz.so.1",
+				Locations: []extractor.Location{{Path: "testdata/libfoo.so.1.2.3"}},
+				Metadata:  &elfdeps.Metadata{Library: "libz", ABIVersion: "1"},
+			}},
+		},
+		{
+			Name:          "not an ELF file",
+			InputConfig:   extracttest.ScanInputMockConfig{Path: "testdata/script.so"},
+			WantInventory: []*extractor.Inventory{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := elfdeps.New(elfdeps.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+			if !cmp.Equal(err, tt.WantErr, cmpopts.EquateErrors()) {
+				t.Fatalf("%s.Extract(%q) error diff: got %v, want %v", extr.Name(), tt.InputConfig.Path, err, tt.WantErr)
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	i := &extractor.Inventory{
+		Name:     "libssl.so.3",
+		Metadata: &elfdeps.Metadata{Library: "libssl", ABIVersion: "3"},
+	}
+	want := &purl.PackageURL{Type: purl.TypeGeneric, Name: "libssl.so.3"}
+	if diff := cmp.Diff(want, (elfdeps.Extractor{}).ToPURL(i)); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
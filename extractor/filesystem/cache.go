@@ -0,0 +1,105 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filesystem
+
+import (
+	"io/fs"
+	"slices"
+	"time"
+
+	"github.com/google/osv-scalibr/extractor"
+)
+
+// Cache remembers the inventory extracted from each file so that later runs
+// can skip parsing files that haven't changed since. A file counts as
+// unchanged if its size and modification time are the same, so changes that
+// preserve both go unnoticed. A Cache can be reused across consecutive runs
+// but not shared by concurrent ones.
+type Cache struct {
+	entries map[cacheKey]*cacheEntry
+	// hits is the number of extractions answered from the cache.
+	hits int
+}
+
+type cacheKey struct {
+	extractor string
+	scanRoot  string
+	path      string
+}
+
+type cacheEntry struct {
+	size      int64
+	modTime   time.Time
+	inventory []*extractor.Inventory
+	// used is true if the entry was read or written during the current run.
+	used bool
+}
+
+// NewCache returns an empty cache.
+func NewCache() *Cache {
+	return &Cache{entries: make(map[cacheKey]*cacheEntry)}
+}
+
+// Hits returns the number of times a file's inventory was served from the
+// cache instead of being extracted.
+func (c *Cache) Hits() int {
+	return c.hits
+}
+
+// get returns copies of the inventory cached for the file, if it hasn't
+// changed since.
+func (c *Cache) get(ex, scanRoot, path string, info fs.FileInfo) ([]*extractor.Inventory, bool) {
+	e, ok := c.entries[cacheKey{ex, scanRoot, path}]
+	if !ok || e.size != info.Size() || !e.modTime.Equal(info.ModTime()) {
+		return nil, false
+	}
+	e.used = true
+	c.hits++
+	return cloneInventory(e.inventory), true
+}
+
+// put caches copies of the inventory extracted from the file.
+func (c *Cache) put(ex, scanRoot, path string, info fs.FileInfo, inventory []*extractor.Inventory) {
+	c.entries[cacheKey{ex, scanRoot, path}] = &cacheEntry{
+		size:      info.Size(),
+		modTime:   info.ModTime(),
+		inventory: cloneInventory(inventory),
+		used:      true,
+	}
+}
+
+// endRun drops the entries of files that weren't extracted during the run,
+// e.g. because they were deleted.
+func (c *Cache) endRun() {
+	for k, e := range c.entries {
+		if !e.used {
+			delete(c.entries, k)
+			continue
+		}
+		e.used = false
+	}
+}
+
+// cloneInventory copies the inventory so that the walk can fill in the scan
+// root and locations without affecting the cached entries. Metadata is shared.
+func cloneInventory(inventory []*extractor.Inventory) []*extractor.Inventory {
+	res := make([]*extractor.Inventory, 0, len(inventory))
+	for _, i := range inventory {
+		c := *i
+		c.Locations = slices.Clone(i.Locations)
+		res = append(res, &c)
+	}
+	return res
+}
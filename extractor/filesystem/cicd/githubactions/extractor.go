@@ -0,0 +1,195 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package githubactions extracts the third-party actions used by GitHub
+// Actions workflows and composite actions.
+package githubactions
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"gopkg.in/yaml.v3"
+)
+
+// Name is the unique name of this extractor.
+const Name = "cicd/githubactions"
+
+// commitSHARe matches refs that pin an action to a full commit SHA.
+var commitSHARe = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// Metadata holds additional information about a referenced action.
+type Metadata struct {
+	// Path is the directory of the action or the reusable workflow file inside
+	// the repository, if it isn't at the repository root.
+	Path string `json:"path,omitempty"`
+}
+
+type step struct {
+	Uses string `yaml:"uses"`
+}
+
+type job struct {
+	// Uses references a reusable workflow.
+	Uses  string `yaml:"uses"`
+	Steps []step `yaml:"steps"`
+}
+
+// definition holds the parts of workflow and composite action files that
+// reference other actions. Workflows have jobs, composite actions have steps
+// below runs.
+type definition struct {
+	Jobs map[string]job `yaml:"jobs"`
+	Runs struct {
+		Steps []step `yaml:"steps"`
+	} `yaml:"runs"`
+}
+
+// Extractor extracts the actions referenced by GitHub Actions workflows and
+// composite actions.
+type Extractor struct{}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileHints returns the file extensions the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{Extensions: []string{".yml", ".yaml"}}
+}
+
+// FileRequired returns true for workflow files in .github/workflows and for
+// action.yml files, which define composite actions.
+func (e Extractor) FileRequired(p string, _ fs.FileInfo) bool {
+	p = filepath.ToSlash(p)
+	base := path.Base(p)
+	if base == "action.yml" || base == "action.yaml" {
+		return true
+	}
+	ext := path.Ext(base)
+	if ext != ".yml" && ext != ".yaml" {
+		return false
+	}
+	dir := path.Dir(p)
+	return dir == ".github/workflows" || strings.HasSuffix(dir, "/.github/workflows")
+}
+
+// Extract returns the actions and reusable workflows referenced by the file.
+// Local actions and Docker images are skipped.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	var def definition
+	if err := yaml.NewDecoder(input.Reader).Decode(&def); err != nil {
+		return nil, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+
+	var uses []string
+	for _, j := range def.Jobs {
+		uses = append(uses, j.Uses)
+		for _, s := range j.Steps {
+			uses = append(uses, s.Uses)
+		}
+	}
+	for _, s := range def.Runs.Steps {
+		uses = append(uses, s.Uses)
+	}
+
+	seen := make(map[string]bool)
+	result := []*extractor.Inventory{}
+	for _, u := range uses {
+		if seen[u] {
+			continue
+		}
+		seen[u] = true
+		name, actionPath, ref, ok := parseUses(u)
+		if !ok {
+			continue
+		}
+		inv := &extractor.Inventory{
+			Name:      name,
+			Version:   ref,
+			Locations: []extractor.Location{{Path: input.Path, Reason: extractor.LocationDeclared}},
+			Metadata:  &Metadata{Path: actionPath},
+		}
+		if commitSHARe.MatchString(ref) {
+			inv.SourceCode = &extractor.SourceCodeIdentifier{Commit: ref}
+		}
+		result = append(result, inv)
+	}
+
+	// Jobs are stored in a map, sort for a stable output.
+	slices.SortFunc(result, func(a, b *extractor.Inventory) int {
+		return cmp.Or(
+			cmp.Compare(a.Name, b.Name),
+			cmp.Compare(a.Metadata.(*Metadata).Path, b.Metadata.(*Metadata).Path),
+			cmp.Compare(a.Version, b.Version),
+		)
+	})
+	return result, nil
+}
+
+// parseUses splits a reference of the form owner/repo[/path]@ref. It returns
+// false for local actions, Docker images, expressions and malformed values.
+func parseUses(uses string) (name, actionPath, ref string, ok bool) {
+	if uses == "" || strings.HasPrefix(uses, "./") || strings.HasPrefix(uses, "docker://") || strings.Contains(uses, "${{") {
+		return "", "", "", false
+	}
+	repo, ref, found := strings.Cut(uses, "@")
+	if !found || ref == "" {
+		return "", "", "", false
+	}
+	parts := strings.SplitN(repo, "/", 3)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", false
+	}
+	if len(parts) == 3 {
+		actionPath = parts[2]
+	}
+	return parts[0] + "/" + parts[1], actionPath, ref, true
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	owner, repo, _ := strings.Cut(i.Name, "/")
+	return &purl.PackageURL{
+		Type:      purl.TypeGithubActions,
+		Namespace: owner,
+		Name:      repo,
+		Version:   i.Version,
+		Subpath:   i.Metadata.(*Metadata).Path,
+	}
+}
+
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeGithubActions} }
+
+// Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "GitHub Actions" }
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,156 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubactions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/cicd/githubactions"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/testing/extracttest"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: ".github/workflows/ci.yml", want: true},
+		{path: "repo/.github/workflows/release.yaml", want: true},
+		{path: "action.yml", want: true},
+		{path: "tools/setup/action.yaml", want: true},
+		{path: ".github/workflows/README.md", want: false},
+		{path: ".github/workflows/nested/ci.yml", want: false},
+		{path: "config/ci.yml", want: false},
+		{path: "not.github/workflows/ci.yml", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := (githubactions.Extractor{}).FileRequired(tt.path, nil); got != tt.want {
+				t.Errorf("FileRequired(%s) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	declared := func(path string) []extractor.Location {
+		return []extractor.Location{{Path: path, Reason: extractor.LocationDeclared}}
+	}
+	tests := []extracttest.TestTableEntry{
+		{
+			Name:        "workflow with SHA and tag pinned actions",
+			InputConfig: extracttest.ScanInputMockConfig{Path: "testdata/.github/workflows/ci.yml"},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:       "actions/checkout",
+					Version:    "11bd71901bbe5b1630ceea73d27597364c9af683",
+					SourceCode: &extractor.SourceCodeIdentifier{Commit: "11bd71901bbe5b1630ceea73d27597364c9af683"},
+					Locations:  declared("testdata/.github/workflows/ci.yml"),
+					Metadata:   &githubactions.Metadata{},
+				},
+				{
+					Name:      "actions/setup-go",
+					Version:   "v5",
+					Locations: declared("testdata/.github/workflows/ci.yml"),
+					Metadata:  &githubactions.Metadata{},
+				},
+				{
+					Name:      "golangci/golangci-lint-action",
+					Version:   "v6.1.1",
+					Locations: declared("testdata/.github/workflows/ci.yml"),
+					Metadata:  &githubactions.Metadata{},
+				},
+				{
+					Name:      "octo-org/shared-workflows",
+					Version:   "v2",
+					Locations: declared("testdata/.github/workflows/ci.yml"),
+					Metadata:  &githubactions.Metadata{Path: ".github/workflows/release.yml"},
+				},
+			},
+		},
+		{
+			Name:        "composite action",
+			InputConfig: extracttest.ScanInputMockConfig{Path: "testdata/composite/action.yml"},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:       "actions/cache",
+					Version:    "1bd1e32a3bdc45362d1e726936510720a7c30a57",
+					SourceCode: &extractor.SourceCodeIdentifier{Commit: "1bd1e32a3bdc45362d1e726936510720a7c30a57"},
+					Locations:  declared("testdata/composite/action.yml"),
+					Metadata:   &githubactions.Metadata{},
+				},
+				{
+					Name:      "github/codeql-action",
+					Version:   "v3",
+					Locations: declared("testdata/composite/action.yml"),
+					Metadata:  &githubactions.Metadata{Path: "init"},
+				},
+			},
+		},
+		{
+			Name:          "no actions",
+			InputConfig:   extracttest.ScanInputMockConfig{Path: "testdata/.github/workflows/empty.yml"},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name:        "invalid yaml",
+			InputConfig: extracttest.ScanInputMockConfig{Path: "testdata/invalid.yml"},
+			WantErr:     extracttest.ContainsErrStr{Str: "could not extract from"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := githubactions.Extractor{}
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	i := &extractor.Inventory{
+		Name:     "github/codeql-action",
+		Version:  "v3",
+		Metadata: &githubactions.Metadata{Path: "init"},
+	}
+	want := &purl.PackageURL{
+		Type:      purl.TypeGithubActions,
+		Namespace: "github",
+		Name:      "codeql-action",
+		Version:   "v3",
+		Subpath:   "init",
+	}
+	if diff := cmp.Diff(want, (githubactions.Extractor{}).ToPURL(i)); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
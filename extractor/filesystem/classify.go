@@ -0,0 +1,98 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filesystem
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+)
+
+// magicMatcher returns true if a file header has the magic bytes of a format.
+type magicMatcher func(header []byte) bool
+
+func hasPrefix(magics ...string) magicMatcher {
+	return func(header []byte) bool {
+		for _, m := range magics {
+			if bytes.HasPrefix(header, []byte(m)) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// riff matches RIFF containers of the given form type, e.g. "WEBP".
+func riff(form string) magicMatcher {
+	return func(header []byte) bool {
+		return len(header) >= 12 && string(header[:4]) == "RIFF" && string(header[8:12]) == form
+	}
+}
+
+// isoBMFF matches ISO base media files, e.g. MP4 and QuickTime videos.
+func isoBMFF(header []byte) bool {
+	return len(header) >= 8 && string(header[4:8]) == "ftyp"
+}
+
+var (
+	matchJPEG = hasPrefix("\xff\xd8\xff")
+	matchTIFF = hasPrefix("II*\x00", "MM\x00*")
+	matchEBML = hasPrefix("\x1a\x45\xdf\xa3")
+)
+
+// mediaTypes maps the extensions of image, video and audio files to the magic
+// bytes the files are expected to start with. No extractor looks at media, so
+// files that match both are skipped without asking the extractors.
+var mediaTypes = map[string]magicMatcher{
+	// Images.
+	".png":  hasPrefix("\x89PNG\r\n\x1a\n"),
+	".jpg":  matchJPEG,
+	".jpeg": matchJPEG,
+	".gif":  hasPrefix("GIF87a", "GIF89a"),
+	".webp": riff("WEBP"),
+	".bmp":  hasPrefix("BM"),
+	".ico":  hasPrefix("\x00\x00\x01\x00"),
+	".tif":  matchTIFF,
+	".tiff": matchTIFF,
+	// Videos.
+	".mp4":  isoBMFF,
+	".m4v":  isoBMFF,
+	".mov":  isoBMFF,
+	".mkv":  matchEBML,
+	".webm": matchEBML,
+	".avi":  riff("AVI "),
+	// Audio.
+	".m4a":  isoBMFF,
+	".mp3":  hasPrefix("ID3", "\xff\xfb", "\xff\xf3", "\xff\xf2"),
+	".wav":  riff("WAVE"),
+	".flac": hasPrefix("fLaC"),
+	".ogg":  hasPrefix("OggS"),
+}
+
+// isMediaFile returns true if the file is an image, video or audio file. The
+// extension is checked first and the header only read to confirm it, so files
+// with other extensions are never opened.
+func (wc *walkContext) isMediaFile(path string) bool {
+	match, ok := mediaTypes[strings.ToLower(filepath.Ext(path))]
+	if !ok {
+		return false
+	}
+	header, err := wc.fileHeader(path)
+	if err != nil {
+		// Let the extractors deal with files that can't be read.
+		return false
+	}
+	return match(header)
+}
@@ -155,7 +155,7 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 		pkg := &extractor.Inventory{
 			Name:      ctr.ImageName,
 			Version:   ctr.ImageDigest,
-			Locations: []string{input.Path},
+			Locations: extractor.LocationsFromPaths(input.Path),
 			Metadata:  &ctr,
 		}
 		inventory = append(inventory, pkg)
@@ -121,7 +121,7 @@ func TestExtract(t *testing.T) {
 						UpperDir:    "/tmp/TestExtractmetadb_valid_linux1567346986/001/var/lib/containerd/io.containerd.snapshotter.v1.overlayfs/snapshots/16/fs",
 						WorkDir:     "/tmp/TestExtractmetadb_valid_linux1567346986/001/var/lib/containerd/io.containerd.snapshotter.v1.overlayfs/snapshots/16/work",
 					},
-					Locations: []string{"var/lib/containerd/io.containerd.metadata.v1.bolt/meta.db"},
+					Locations: extractor.LocationsFromPaths("var/lib/containerd/io.containerd.metadata.v1.bolt/meta.db"),
 				},
 			},
 		},
@@ -189,7 +189,7 @@ func TestExtract(t *testing.T) {
 						ID:          "test_pod",
 						PID:         5628,
 					},
-					Locations: []string{"ProgramData/containerd/root/io.containerd.metadata.v1.bolt/meta.db"},
+					Locations: extractor.LocationsFromPaths("ProgramData/containerd/root/io.containerd.metadata.v1.bolt/meta.db"),
 				},
 			},
 		},
@@ -0,0 +1,235 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dockerfile extracts the base images of Dockerfiles.
+package dockerfile
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/log"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+// Name is the unique name of this extractor.
+const Name = "containers/dockerfile"
+
+// varRe matches $NAME, ${NAME} and ${NAME:-default} references.
+var varRe = regexp.MustCompile(`\$(?:([A-Za-z_][A-Za-z0-9_]*)|\{([A-Za-z_][A-Za-z0-9_]*)(?::-([^}]*))?\})`)
+
+// Metadata holds additional information about a base image.
+type Metadata struct {
+	// Repository is the image repository, including the registry if given,
+	// e.g. "gcr.io/distroless/static".
+	Repository string `json:"repository"`
+	// Tag is the image tag, if given.
+	Tag string `json:"tag,omitempty"`
+	// Digest is the image digest, e.g. "sha256:...", if given.
+	Digest string `json:"digest,omitempty"`
+	// Stage is the name of the build stage using the image, if given with
+	// "FROM ... AS name".
+	Stage string `json:"stage,omitempty"`
+}
+
+// Extractor extracts the base images of Dockerfiles.
+type Extractor struct{}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileHints returns the file names and extensions the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{BaseNames: []string{"Dockerfile"}, Extensions: []string{".dockerfile"}}
+}
+
+// FileRequired returns true for files named Dockerfile or *.dockerfile.
+func (e Extractor) FileRequired(path string, _ fs.FileInfo) bool {
+	base := strings.ToLower(filepath.Base(path))
+	return base == "dockerfile" || strings.HasSuffix(base, ".dockerfile")
+}
+
+// Extract returns the base images of the build stages in the Dockerfile.
+// Variables in FROM instructions are resolved with the defaults of the ARG
+// instructions preceding the first FROM. Stages built on previous stages,
+// "scratch" and images with unresolved variables are skipped.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	instructions, err := readInstructions(input)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+
+	args := make(map[string]string)
+	stages := make(map[string]bool)
+	seenFrom := false
+	result := []*extractor.Inventory{}
+	for _, ins := range instructions {
+		fields := strings.Fields(ins)
+		keyword := strings.ToUpper(fields[0])
+		fields = fields[1:]
+		if keyword == "ARG" && !seenFrom {
+			// Only ARGs declared before the first FROM are in scope of FROM lines.
+			for _, f := range fields {
+				name, value, _ := strings.Cut(f, "=")
+				args[name] = strings.Trim(value, `"'`)
+			}
+		}
+		if keyword != "FROM" {
+			continue
+		}
+		seenFrom = true
+
+		// Skip flags like --platform.
+		for len(fields) > 0 && strings.HasPrefix(fields[0], "--") {
+			fields = fields[1:]
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		stage := ""
+		if len(fields) >= 3 && strings.EqualFold(fields[1], "AS") {
+			stage = fields[2]
+		}
+		image, ok := expand(fields[0], args)
+		switch {
+		case !ok:
+			log.Debugf("%s: skipping FROM %s with unresolved variables", input.Path, fields[0])
+		case strings.EqualFold(image, "scratch"), stages[strings.ToLower(image)]:
+			// Not a base image.
+		default:
+			result = append(result, toInventory(image, stage, input.Path))
+		}
+		if stage != "" {
+			stages[strings.ToLower(stage)] = true
+		}
+	}
+	return result, nil
+}
+
+// readInstructions returns the instructions of the Dockerfile with comments
+// and empty lines removed and continuation lines joined.
+func readInstructions(input *filesystem.ScanInput) ([]string, error) {
+	var instructions []string
+	var current strings.Builder
+	s := bufio.NewScanner(input.Reader)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if cont, ok := strings.CutSuffix(line, `\`); ok {
+			current.WriteString(cont)
+			current.WriteString(" ")
+			continue
+		}
+		current.WriteString(line)
+		instructions = append(instructions, current.String())
+		current.Reset()
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if current.Len() > 0 {
+		instructions = append(instructions, current.String())
+	}
+	return instructions, nil
+}
+
+// expand replaces variable references with the given values. It returns false
+// if a variable has neither a value nor a default.
+func expand(s string, args map[string]string) (string, bool) {
+	ok := true
+	expanded := varRe.ReplaceAllStringFunc(s, func(ref string) string {
+		m := varRe.FindStringSubmatch(ref)
+		name := m[1] + m[2]
+		if v := args[name]; v != "" {
+			return v
+		}
+		if m[3] != "" {
+			return m[3]
+		}
+		ok = false
+		return ""
+	})
+	return expanded, ok
+}
+
+// toInventory parses an image reference of the form
+// [registry/]repository[:tag][@digest].
+func toInventory(image, stage, path string) *extractor.Inventory {
+	m := &Metadata{Stage: stage}
+	repo, digest, _ := strings.Cut(image, "@")
+	m.Digest = digest
+	// A colon after the last slash separates the tag, others belong to a
+	// registry port.
+	if i := strings.LastIndex(repo, ":"); i > strings.LastIndex(repo, "/") {
+		repo, m.Tag = repo[:i], repo[i+1:]
+	}
+	m.Repository = repo
+
+	version := m.Tag
+	if version == "" {
+		version = m.Digest
+	}
+	return &extractor.Inventory{
+		Name:      repo,
+		Version:   version,
+		Locations: []extractor.Location{{Path: path, Reason: extractor.LocationDeclared}},
+		Metadata:  m,
+	}
+}
+
+// ToPURL converts an inventory created by this extractor into a docker PURL.
+// The version is the digest if known and the tag otherwise; if both are known
+// the tag is added as a qualifier.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	m := i.Metadata.(*Metadata)
+	p := &purl.PackageURL{
+		Type:    purl.TypeDocker,
+		Name:    m.Repository,
+		Version: m.Tag,
+	}
+	if i := strings.LastIndex(m.Repository, "/"); i >= 0 {
+		p.Namespace, p.Name = m.Repository[:i], m.Repository[i+1:]
+	}
+	if m.Digest != "" {
+		p.Version = m.Digest
+		if m.Tag != "" {
+			p.Qualifiers = purl.QualifiersFromMap(map[string]string{"tag": m.Tag})
+		}
+	}
+	return p
+}
+
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeDocker} }
+
+// Ecosystem returns no ecosystem since container images have none in OSV.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
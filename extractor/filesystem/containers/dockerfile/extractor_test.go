@@ -0,0 +1,148 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerfile_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/containers/dockerfile"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/testing/extracttest"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "Dockerfile", want: true},
+		{path: "app/Dockerfile", want: true},
+		{path: "app/dockerfile", want: true},
+		{path: "build/release.dockerfile", want: true},
+		{path: "Dockerfile.txt", want: false},
+		{path: "docs/Dockerfile/README.md", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := (dockerfile.Extractor{}).FileRequired(tt.path, nil); got != tt.want {
+				t.Errorf("FileRequired(%s) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	declared := func(path string) []extractor.Location {
+		return []extractor.Location{{Path: path, Reason: extractor.LocationDeclared}}
+	}
+	tests := []extracttest.TestTableEntry{
+		{
+			Name:        "multi-stage build",
+			InputConfig: extracttest.ScanInputMockConfig{Path: "testdata/multistage/Dockerfile"},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "golang",
+					Version:   "1.22-bookworm",
+					Locations: declared("testdata/multistage/Dockerfile"),
+					Metadata:  &dockerfile.Metadata{Repository: "golang", Tag: "1.22-bookworm", Stage: "build"},
+				},
+				{
+					Name:      "gcr.io/distroless/static-debian12",
+					Version:   "sha256:8dd8d3ca2cf283383304fd45a5c9c74d5f2cd9da8d3b077d720e264880077c65",
+					Locations: declared("testdata/multistage/Dockerfile"),
+					Metadata: &dockerfile.Metadata{
+						Repository: "gcr.io/distroless/static-debian12",
+						Digest:     "sha256:8dd8d3ca2cf283383304fd45a5c9c74d5f2cd9da8d3b077d720e264880077c65",
+					},
+				},
+			},
+		},
+		{
+			Name:        "ARG parameterized FROM",
+			InputConfig: extracttest.ScanInputMockConfig{Path: "testdata/args.dockerfile"},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "registry.example.com:5000/python",
+					Version:   "3.12-slim",
+					Locations: declared("testdata/args.dockerfile"),
+					Metadata:  &dockerfile.Metadata{Repository: "registry.example.com:5000/python", Tag: "3.12-slim", Stage: "base"},
+				},
+				{
+					Name:      "registry.example.com:5000/tools/builder",
+					Version:   "2.1",
+					Locations: declared("testdata/args.dockerfile"),
+					Metadata:  &dockerfile.Metadata{Repository: "registry.example.com:5000/tools/builder", Tag: "2.1"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := dockerfile.Extractor{}
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	tests := []struct {
+		name string
+		m    *dockerfile.Metadata
+		want *purl.PackageURL
+	}{
+		{
+			name: "tag",
+			m:    &dockerfile.Metadata{Repository: "golang", Tag: "1.22"},
+			want: &purl.PackageURL{Type: purl.TypeDocker, Name: "golang", Version: "1.22"},
+		},
+		{
+			name: "tag and digest",
+			m:    &dockerfile.Metadata{Repository: "gcr.io/distroless/static", Tag: "nonroot", Digest: "sha256:abc"},
+			want: &purl.PackageURL{
+				Type:       purl.TypeDocker,
+				Namespace:  "gcr.io/distroless",
+				Name:       "static",
+				Version:    "sha256:abc",
+				Qualifiers: purl.QualifiersFromMap(map[string]string{"tag": "nonroot"}),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &extractor.Inventory{Name: tt.m.Repository, Metadata: tt.m}
+			if diff := cmp.Diff(tt.want, (dockerfile.Extractor{}).ToPURL(i)); diff != "" {
+				t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unix
+
+package filesystem_test
+
+import (
+	"context"
+	"io/fs"
+	"sort"
+	"syscall"
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/stats"
+	fe "github.com/google/osv-scalibr/testing/fakeextractor"
+)
+
+func TestRun_OneFileSystem(t *testing.T) {
+	// A snapshot on device 1 with another filesystem mounted on mnt/data.
+	fsys := pathsMapFS{mapfs: fstest.MapFS{
+		".":                  {Mode: fs.ModeDir, Sys: &syscall.Stat_t{Dev: 1}},
+		"etc":                {Mode: fs.ModeDir, Sys: &syscall.Stat_t{Dev: 1}},
+		"etc/root.txt":       {Data: []byte("root")},
+		"mnt":                {Mode: fs.ModeDir, Sys: &syscall.Stat_t{Dev: 1}},
+		"mnt/data":           {Mode: fs.ModeDir, Sys: &syscall.Stat_t{Dev: 2}},
+		"mnt/data/mount.txt": {Data: []byte("mount")},
+		"mnt/data/sub":       {Mode: fs.ModeDir, Sys: &syscall.Stat_t{Dev: 2}},
+		"mnt/data/sub/x.txt": {Data: []byte("x")},
+	}}
+	ex := fe.New("ex", 1, []string{"etc/root.txt", "mnt/data/mount.txt", "mnt/data/sub/x.txt"}, map[string]fe.NamesErr{
+		"etc/root.txt":       {Names: []string{"root"}},
+		"mnt/data/mount.txt": {Names: []string{"mount"}},
+		"mnt/data/sub/x.txt": {Names: []string{"x"}},
+	})
+
+	for _, tc := range []struct {
+		desc          string
+		oneFileSystem bool
+		want          []string
+	}{
+		{
+			desc: "crosses mount points by default",
+			want: []string{"mount", "root", "x"},
+		},
+		{
+			desc:          "stays on the scan root's device",
+			oneFileSystem: true,
+			want:          []string{"root"},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			config := &filesystem.Config{
+				Extractors:    []filesystem.Extractor{ex},
+				ScanRoots:     []*scalibrfs.ScanRoot{{FS: fsys, Path: "."}},
+				OneFileSystem: tc.oneFileSystem,
+				Stats:         stats.NoopCollector{},
+			}
+			inv, _, err := filesystem.Run(context.Background(), config)
+			if err != nil {
+				t.Fatalf("filesystem.Run(): %v", err)
+			}
+			got := []string{}
+			for _, i := range inv {
+				got = append(got, i.Name)
+			}
+			sort.Strings(got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("filesystem.Run() inventory names (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
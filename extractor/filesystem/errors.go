@@ -16,6 +16,8 @@ package filesystem
 
 import (
 	"errors"
+	"fmt"
+	"io"
 
 	"github.com/google/osv-scalibr/stats"
 )
@@ -24,8 +26,42 @@ var (
 	// ErrExtractorMemoryLimitExceeded is returned when an extractor skips a file
 	// due to the extraction process exceeding a configured memory limit.
 	ErrExtractorMemoryLimitExceeded = errors.New("extraction failed due to extractor exceeding the configured memory limit")
+	// ErrSizeLimitExceeded is returned by readers from LimitReader once more
+	// than the configured maximum number of bytes is read, e.g. because the file
+	// grew after FileRequired checked its size.
+	ErrSizeLimitExceeded = errors.New("extraction failed due to the file exceeding the configured maximum size")
+	// ErrArchiveLimitExceeded is matched by the ArchiveLimitError returned by
+	// extractors that stop walking an archive because of its nesting depth or
+	// number of entries.
+	ErrArchiveLimitExceeded = errors.New("extraction stopped due to the archive exceeding the configured nesting or entry limits")
 )
 
+// ArchiveLimitError is returned by extractors that walk archives, along with
+// the inventory found so far, once an archive is nested too deeply or holds too
+// many entries in total. Untrusted archives can otherwise exhaust resources,
+// e.g. zip files nested in zip files without end.
+type ArchiveLimitError struct {
+	// Path of the archive or entry at which the limit was exceeded.
+	Path string
+	// MaxDepth is set if the nesting depth limit was exceeded.
+	MaxDepth int
+	// MaxEntries is set if the limit for the total number of entries was
+	// exceeded.
+	MaxEntries int
+}
+
+func (e *ArchiveLimitError) Error() string {
+	if e.MaxDepth > 0 {
+		return fmt.Sprintf("not opening %s: exceeds the maximum archive nesting depth %d", e.Path, e.MaxDepth)
+	}
+	return fmt.Sprintf("stopped at %s: exceeds the maximum of %d archive entries", e.Path, e.MaxEntries)
+}
+
+// Is makes ArchiveLimitErrors match ErrArchiveLimitExceeded.
+func (e *ArchiveLimitError) Is(target error) bool {
+	return target == ErrArchiveLimitExceeded
+}
+
 // ExtractorErrorToFileExtractedResult converts an error returned by an extractor
 // to a FileExtractedResult for stats collection. Converting the error to a
 // result minimizes the memory used for reporting stats.
@@ -34,6 +70,38 @@ func ExtractorErrorToFileExtractedResult(err error) stats.FileExtractedResult {
 		return stats.FileExtractedResultSuccess
 	} else if errors.Is(err, ErrExtractorMemoryLimitExceeded) {
 		return stats.FileExtractedResultErrorMemoryLimitExceeded
+	} else if errors.Is(err, ErrSizeLimitExceeded) {
+		return stats.FileExtractedResultErrorSizeLimitExceeded
 	}
 	return stats.FileExtractedResultErrorUnknown
 }
+
+// LimitReader returns a reader that reads from r but fails with
+// ErrSizeLimitExceeded once it returned maxBytes bytes and more data follows.
+// Unlike io.LimitReader, which reports io.EOF at the limit, this keeps
+// extractors from silently parsing a truncated file. If maxBytes is 0 or less,
+// r is returned as is.
+func LimitReader(r io.Reader, maxBytes int64) io.Reader {
+	if maxBytes <= 0 {
+		return r
+	}
+	// Allow one byte past the limit to tell a file that ends at the limit from a
+	// file that exceeds it.
+	return &limitedReader{r: &io.LimitedReader{R: r, N: maxBytes + 1}}
+}
+
+type limitedReader struct {
+	r *io.LimitedReader
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.r.N <= 0 {
+		return 0, ErrSizeLimitExceeded
+	}
+	n, err := l.r.Read(p)
+	if l.r.N <= 0 {
+		// The byte past the limit was read.
+		return n - 1, ErrSizeLimitExceeded
+	}
+	return n, err
+}
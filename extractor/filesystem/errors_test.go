@@ -0,0 +1,121 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filesystem_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/stats"
+)
+
+func TestLimitReader(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		maxBytes int64
+		want     string
+		wantErr  error
+	}{
+		{
+			name:     "below_limit",
+			content:  "abc",
+			maxBytes: 5,
+			want:     "abc",
+		},
+		{
+			name:     "at_limit",
+			content:  "abcde",
+			maxBytes: 5,
+			want:     "abcde",
+		},
+		{
+			name:     "above_limit",
+			content:  "abcdef",
+			maxBytes: 5,
+			want:     "abcde",
+			wantErr:  filesystem.ErrSizeLimitExceeded,
+		},
+		{
+			name:     "no_limit",
+			content:  "abcdef",
+			maxBytes: 0,
+			want:     "abcdef",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Reading one byte at a time checks that the limit holds across reads.
+			for _, r := range []io.Reader{strings.NewReader(tc.content), iotest.OneByteReader(strings.NewReader(tc.content))} {
+				got, err := io.ReadAll(filesystem.LimitReader(r, tc.maxBytes))
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("io.ReadAll(LimitReader(%q, %d)) error: got %v, want %v", tc.content, tc.maxBytes, err, tc.wantErr)
+				}
+				if string(got) != tc.want {
+					t.Errorf("io.ReadAll(LimitReader(%q, %d)) = %q, want %q", tc.content, tc.maxBytes, got, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestExtractorErrorToFileExtractedResult(t *testing.T) {
+	tests := []struct {
+		err  error
+		want stats.FileExtractedResult
+	}{
+		{err: nil, want: stats.FileExtractedResultSuccess},
+		{err: filesystem.ErrExtractorMemoryLimitExceeded, want: stats.FileExtractedResultErrorMemoryLimitExceeded},
+		{err: filesystem.ErrSizeLimitExceeded, want: stats.FileExtractedResultErrorSizeLimitExceeded},
+		{err: errors.New("other"), want: stats.FileExtractedResultErrorUnknown},
+	}
+
+	for _, tc := range tests {
+		if got := filesystem.ExtractorErrorToFileExtractedResult(tc.err); got != tc.want {
+			t.Errorf("ExtractorErrorToFileExtractedResult(%v) = %v, want %v", tc.err, got, tc.want)
+		}
+	}
+}
+
+func TestArchiveLimitError(t *testing.T) {
+	tests := []struct {
+		err  *filesystem.ArchiveLimitError
+		want string
+	}{
+		{
+			err:  &filesystem.ArchiveLimitError{Path: "a.jar/b.jar", MaxDepth: 1},
+			want: "not opening a.jar/b.jar: exceeds the maximum archive nesting depth 1",
+		},
+		{
+			err:  &filesystem.ArchiveLimitError{Path: "a.jar/pom.properties", MaxEntries: 10},
+			want: "stopped at a.jar/pom.properties: exceeds the maximum of 10 archive entries",
+		},
+	}
+
+	for _, tc := range tests {
+		if got := tc.err.Error(); got != tc.want {
+			t.Errorf("Error() = %q, want %q", got, tc.want)
+		}
+		if wrapped := fmt.Errorf("extractor: %w", tc.err); !errors.Is(wrapped, filesystem.ErrArchiveLimitExceeded) {
+			t.Errorf("errors.Is(%v, ErrArchiveLimitExceeded) = false, want true", wrapped)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filesystem
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing/fstest"
+	"time"
+
+	"github.com/google/osv-scalibr/extractor"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/stats"
+)
+
+// StdinPath is the path ExtractReader presents the contents it reads as if the
+// caller doesn't pass one.
+const StdinPath = "stdin"
+
+// ErrNoExtractorMatched is returned by ExtractFile if none of the extractors
+// requires the file.
+var ErrNoExtractorMatched = errors.New("no extractor matched the file")
+
+// ExtractFile runs the extractors that require the file at path on it,
+// without walking the rest of the filesystem. This is meant for tools like
+// editor integrations that want to analyze a single manifest, e.g. right after
+// it was saved. The locations of the returned inventory are absolute.
+//
+// ErrNoExtractorMatched is returned if no extractor requires the file. If some
+// of the matching extractors fail, the inventory of the others is returned
+// along with their errors.
+func ExtractFile(ctx context.Context, path string, extractors []Extractor) ([]*extractor.Inventory, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(absPath)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("%s is a directory", path)
+	}
+
+	// Use the filesystem root as the scan root so that extractors matching on
+	// parent directories (e.g. node_modules) see the full path.
+	root := filepath.VolumeName(absPath) + string(filepath.Separator)
+	config := &Config{
+		Extractors:        extractors,
+		FilesToExtract:    []string{absPath},
+		Stats:             stats.NoopCollector{},
+		StoreAbsolutePath: true,
+	}
+	wc, err := InitWalkContext(ctx, config, []*scalibrfs.ScanRoot{scalibrfs.RealFSScanRoot(root)})
+	if err != nil {
+		return nil, err
+	}
+	if err := wc.UpdateScanRoot(root, scalibrfs.DirFS(root)); err != nil {
+		return nil, err
+	}
+	if err := walkIndividualFiles(wc.fs, wc.filesToExtract, wc.handleFile); err != nil {
+		return nil, err
+	}
+
+	if wc.filesRequired == 0 {
+		return nil, fmt.Errorf("%s: %w", path, ErrNoExtractorMatched)
+	}
+	var errs []error
+	for _, ex := range extractors {
+		if err, ok := wc.errors[ex.Name()]; ok {
+			errs = append(errs, fmt.Errorf("%s: %w", ex.Name(), err))
+		}
+	}
+	return wc.inventory, errors.Join(errs...)
+}
+
+// ExtractReader runs the extractor on the contents of r, e.g. a manifest piped
+// to stdin, without them being stored on disk. The contents are presented to
+// the extractor as a file at the given relative path, which defaults to
+// StdinPath and can be used to pass the file name a format is recognized by,
+// e.g. "packages.lock.json". The path is also used for the locations of the
+// returned inventory.
+//
+// Unlike ExtractFile, the extractor runs even if it doesn't require the path
+// since the caller picked it explicitly. If the extraction fails, the inventory
+// found until then is returned along with the error.
+func ExtractReader(ctx context.Context, r io.Reader, path string, ex Extractor) ([]*extractor.Inventory, error) {
+	if path == "" {
+		path = StdinPath
+	}
+	path = filepath.ToSlash(path)
+	if !fs.ValidPath(path) || path == "." {
+		return nil, fmt.Errorf("invalid path %q: must be a relative file path", path)
+	}
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+	fsys := fstest.MapFS{path: &fstest.MapFile{Data: content, Mode: 0444, ModTime: time.Now()}}
+	info, err := fs.Stat(fsys, path)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &Config{
+		Extractors: []Extractor{ex},
+		Stats:      stats.NoopCollector{},
+	}
+	wc, err := InitWalkContext(ctx, config, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := wc.UpdateScanRoot("", fsys); err != nil {
+		return nil, err
+	}
+
+	input := &ScanInput{
+		FS:     fsys,
+		Path:   path,
+		Info:   info,
+		Reader: bytes.NewReader(content),
+	}
+	results, err := ex.Extract(ctx, input)
+	wc.reportWarnings(ex, path, input.Warnings())
+	wc.storeInventory(ex, path, results)
+	if err != nil {
+		return wc.inventory, fmt.Errorf("%s: %s: %w", ex.Name(), path, err)
+	}
+	return wc.inventory, nil
+}
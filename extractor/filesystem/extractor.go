@@ -17,6 +17,7 @@ package filesystem
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
@@ -28,6 +29,8 @@ import (
 
 	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem/internal"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/ignore"
+	"github.com/google/osv-scalibr/extractor/internal/redact"
 	scalibrfs "github.com/google/osv-scalibr/fs"
 	"github.com/google/osv-scalibr/log"
 	"github.com/google/osv-scalibr/plugin"
@@ -50,9 +53,44 @@ type Extractor interface {
 	// library for that.
 	FileRequired(path string, fileinfo fs.FileInfo) bool
 	// Extract extracts inventory data relevant for the extractor from a given file.
+	// Zero-byte files aren't malformed but declare nothing: extractors should
+	// return empty inventory for them rather than an error, and report
+	// stats.FileExtractedResultEmpty if they collect stats.
 	Extract(ctx context.Context, input *ScanInput) ([]*extractor.Inventory, error)
 }
 
+// HeaderSize is the maximum number of bytes from the start of a file that are
+// passed to HeaderSniffer.SniffHeader.
+const HeaderSize = 4096
+
+// HeaderSniffer can be implemented by extractors that need to look at the start
+// of a file to decide whether it's relevant, e.g. to check for magic bytes.
+// The header is read once per file and shared by all sniffing extractors.
+type HeaderSniffer interface {
+	// SniffHeader is called after FileRequired returned true and should return
+	// true if the file is still relevant given its header. The header holds up
+	// to HeaderSize bytes and is only valid for the duration of the call.
+	SniffHeader(path string, header []byte) bool
+}
+
+// SizeLimitedExtractor can be implemented by extractors that skip files above
+// a maximum size. It lets the walker tell files that were skipped because of
+// their size apart from files that were never relevant.
+type SizeLimitedExtractor interface {
+	// FileSizeLimitExceeded returns true if the file would be required if it
+	// didn't exceed the maximum file size.
+	FileSizeLimitExceeded(path string, fileinfo fs.FileInfo) bool
+}
+
+// FileHinter can be implemented by extractors that only require files with
+// certain names or extensions. If Config.UseFileHints is set, the walker only
+// calls FileRequired of such extractors for files matching their hints.
+type FileHinter interface {
+	// FileHints returns the names and extensions of all files the extractor
+	// could require. FileRequired must return false for any other file.
+	FileHints() FileHints
+}
+
 // ScanInput describes one file to extract from.
 type ScanInput struct {
 	// FS for file access. This is rooted at Root.
@@ -65,6 +103,29 @@ type ScanInput struct {
 	// A reader for accessing contents of the file.
 	// Note that the file is closed by the core library, not the plugin.
 	Reader io.Reader
+	// The maximum number of inventory the extractor should return for the file.
+	// Extractors should stop reading the file once they reach it so that files
+	// declaring an excessive number of packages can't exhaust memory. The core
+	// library drops any inventory beyond the limit. If 0, no limit is applied.
+	MaxInventoryPerFile int
+	// The limits for following the files the file includes. Extractors that
+	// resolve includes should track them with NewIncludes.
+	IncludeLimits IncludeLimits
+
+	// Warnings recorded with Warnf.
+	warnings []string
+}
+
+// Warnf records a recoverable anomaly found while extracting the file, e.g. a
+// malformed entry that was skipped. Warnings don't fail the extraction. The
+// core library logs them and reports them per file to the stats collector.
+func (i *ScanInput) Warnf(format string, args ...any) {
+	i.warnings = append(i.warnings, fmt.Sprintf(format, args...))
+}
+
+// Warnings returns the warnings recorded with Warnf.
+func (i *ScanInput) Warnings() []string {
+	return i.warnings
 }
 
 // Config stores the config settings for an extraction run.
@@ -83,21 +144,61 @@ type Config struct {
 	DirsToSkip []string
 	// Optional: If the regex matches a directory, it will be skipped.
 	SkipDirRegex *regexp.Regexp
+	// Optional: If true, files and directories matching the gitignore-style
+	// patterns of .scalibrignore files are skipped. An ignore file applies to
+	// the directory it's in and everything below it. Ignore files are only read
+	// during the filesystem walk, not for FilesToExtract.
+	UseIgnoreFiles bool
 	// Optional: stats allows to enter a metric hook. If left nil, no metrics will be recorded.
 	Stats stats.Collector
 	// Optional: Whether to read symlinks.
 	ReadSymlinks bool
 	// Optional: Limit for visited inodes. If 0, no limit is applied.
 	MaxInodes int
+	// Optional: Limit for how deep the walk descends below the scan root. Files
+	// directly in the scan root are at depth 1, files in its subdirectories at
+	// depth 2 and so on. Directories holding files beyond the limit are not
+	// walked. If 0, no limit is applied.
+	MaxDepth int
+	// Optional: Limit for the inventory extracted from a single file. If 0, no
+	// limit is applied.
+	MaxInventoryPerFile int
+	// Optional: Limit for the size of each inventory's metadata in its JSON
+	// encoding. Larger metadata is shortened or dropped and the inventory is
+	// annotated with extractor.MetadataTruncated. If 0, no limit is applied.
+	MaxMetadataBytes int
+	// Optional: Limits for following the files referenced by extracted files,
+	// e.g. `-r` lines of requirements.txt files. If unset, no limits are applied.
+	IncludeLimits IncludeLimits
 	// Optional: By default, inventories stores a path relative to the scan root. If StoreAbsolutePath
 	// is set, the absolute path is stored instead.
 	StoreAbsolutePath bool
 	// Optional: If true, print a detailed analysis of the duration of each extractor.
 	PrintDurationAnalysis bool
+	// Optional: Cache of the inventory extracted by previous runs. Files that
+	// haven't changed since are not extracted again.
+	Cache *Cache
+	// Optional: If true, extractors implementing FileHinter are only asked
+	// about files matching their hints instead of about every file.
+	UseFileHints bool
+	// Optional: If true, image, video and audio files are also passed to the
+	// extractors. By default they're skipped since no extractor handles them.
+	DisableMediaFileSkipping bool
+	// Optional: If true, the walk doesn't descend into directories on another
+	// device than the scan root, e.g. other filesystems mounted below it. Only
+	// supported on Unix and for scan roots on the local filesystem.
+	OneFileSystem bool
+	// Optional: If true, the directories the kernel's pseudo-filesystems are
+	// usually mounted on (/dev, /proc and /sys) are skipped if they're directly
+	// in the scan root, so that a scan of a root filesystem doesn't read kernel
+	// interfaces.
+	SkipPseudoFilesystems bool
 }
 
 // Run runs the specified extractors and returns their extraction results,
 // as well as info about whether the plugin runs completed successfully.
+// If ctx is cancelled during the walk, the inventory found up to that point is
+// returned along with the context's error.
 func Run(ctx context.Context, config *Config) ([]*extractor.Inventory, []*plugin.Status, error) {
 	if len(config.Extractors) == 0 {
 		return []*extractor.Inventory{}, []*plugin.Status{}, nil
@@ -113,17 +214,29 @@ func Run(ctx context.Context, config *Config) ([]*extractor.Inventory, []*plugin
 		return nil, nil, err
 	}
 
-	var inventory []*extractor.Inventory
-	var status []*plugin.Status
-
+	// The walk context accumulates the inventory and errors of all scan roots.
 	for _, root := range scanRoots {
-		inv, st, err := runOnScanRoot(ctx, config, root, wc)
-		if err != nil {
+		if _, _, err := runOnScanRoot(ctx, config, root, wc); err != nil {
+			if ctx.Err() != nil && errors.Is(err, ctx.Err()) {
+				inventory := append([]*extractor.Inventory(nil), wc.inventory...)
+				return inventory, errToExtractorStatus(config.Extractors, wc.foundInv, wc.errors), err
+			}
 			return nil, nil, err
 		}
+	}
+
+	inventory := append([]*extractor.Inventory(nil), wc.inventory...)
+	status := errToExtractorStatus(config.Extractors, wc.foundInv, wc.errors)
 
-		inventory = append(inventory, inv...)
-		status = append(status, st...)
+	wc.stats.ScanFinished(&stats.ScanFinishedStats{
+		FilesRequired:     wc.filesRequired,
+		FilesSizeExceeded: wc.filesSizeExceeded,
+		FilesNotRequired:  wc.filesNotRequired,
+		DirsDepthExceeded: wc.dirsDepthExceeded,
+		FilesSkippedMedia: wc.filesSkippedMedia,
+	})
+	if config.Cache != nil {
+		config.Cache.endRun()
 	}
 
 	return inventory, status, nil
@@ -158,6 +271,11 @@ func InitWalkContext(ctx context.Context, config *Config, absScanRoots []*scalib
 		return nil, err
 	}
 
+	var index *extractorIndex
+	if config.UseFileHints {
+		index = newExtractorIndex(config.Extractors)
+	}
+
 	return &walkContext{
 		ctx:               ctx,
 		stats:             config.Stats,
@@ -165,10 +283,20 @@ func InitWalkContext(ctx context.Context, config *Config, absScanRoots []*scalib
 		filesToExtract:    filesToExtract,
 		dirsToSkip:        pathStringListToMap(dirsToSkip),
 		skipDirRegex:      config.SkipDirRegex,
+		useIgnoreFiles:    config.UseIgnoreFiles,
 		readSymlinks:      config.ReadSymlinks,
 		maxInodes:         config.MaxInodes,
+		maxDepth:          config.MaxDepth,
 		inodesVisited:     0,
+		maxInvPerFile:     config.MaxInventoryPerFile,
+		maxMetadataBytes:  config.MaxMetadataBytes,
+		includeLimits:     config.IncludeLimits,
 		storeAbsolutePath: config.StoreAbsolutePath,
+		cache:             config.Cache,
+		index:             index,
+		skipMedia:         !config.DisableMediaFileSkipping,
+		oneFileSystem:     config.OneFileSystem,
+		skipPseudoFS:      config.SkipPseudoFilesystems,
 
 		lastStatus: time.Now(),
 
@@ -233,9 +361,37 @@ type walkContext struct {
 	filesToExtract    []string
 	dirsToSkip        map[string]bool // Anything under these paths should be skipped.
 	skipDirRegex      *regexp.Regexp
+	useIgnoreFiles    bool
+	ignored           *ignore.Matcher // Patterns of the ignore files of the current scan root.
 	maxInodes         int
 	inodesVisited     int
+	maxDepth          int
+	maxInvPerFile     int
+	maxMetadataBytes  int
+	includeLimits     IncludeLimits
 	storeAbsolutePath bool
+	cache             *Cache
+	// Extractors that could require a file, by file name. Nil if all
+	// extractors are asked about every file.
+	index *extractorIndex
+	// Whether to skip media files without asking the extractors.
+	skipMedia bool
+	// Whether to stay on the device of the scan root.
+	oneFileSystem bool
+	// Device of the current scan root, if known.
+	rootDevice      uint64
+	rootDeviceKnown bool
+	// Whether to skip the mount points of pseudo-filesystems.
+	skipPseudoFS bool
+
+	// Number of files that were or weren't required by any extractor.
+	filesRequired     int
+	filesSizeExceeded int
+	filesNotRequired  int
+	// Number of directories skipped because of maxDepth.
+	dirsDepthExceeded int
+	// Number of media files skipped before asking the extractors.
+	filesSkippedMedia int
 
 	// Inventories found.
 	inventory []*extractor.Inventory
@@ -246,6 +402,13 @@ type walkContext struct {
 	// Whether to read symlinks.
 	readSymlinks bool
 
+	// Header of the file currently being handled, shared by HeaderSniffers.
+	// The buffer is reused across files.
+	headerBuf  []byte
+	header     []byte
+	headerErr  error
+	headerRead bool
+
 	// Data for status printing.
 	lastStatus   time.Time
 	lastInodes   int
@@ -310,9 +473,22 @@ func (wc *walkContext) handleFile(path string, d fs.DirEntry, fserr error) error
 		return nil
 	}
 	if d.Type().IsDir() {
-		if wc.shouldSkipDir(path) { // Skip everything inside this dir.
+		if wc.shouldSkipDir(path) || wc.isIgnored(path, true) { // Skip everything inside this dir.
 			return fs.SkipDir
 		}
+		if wc.isPseudoFS(path) || wc.crossesDevice(path, d) {
+			log.Debugf("Skipping %s: not part of the scanned filesystem", path)
+			return fs.SkipDir
+		}
+		if wc.depthExceeded(path) {
+			log.Debugf("Skipping %s: files inside exceed the maximum depth %d", path, wc.maxDepth)
+			wc.dirsDepthExceeded++
+			return fs.SkipDir
+		}
+		wc.readIgnoreFile(path)
+		return nil
+	}
+	if wc.isIgnored(path, false) {
 		return nil
 	}
 
@@ -337,12 +513,48 @@ func (wc *walkContext) handleFile(path string, d fs.DirEntry, fserr error) error
 		return nil
 	}
 
-	for _, ex := range wc.extractors {
-		wc.runExtractor(ex, path, fileinfo)
+	wc.headerRead = false
+	candidates := wc.candidates(path)
+	if wc.skipMedia && len(candidates) > 0 && wc.isMediaFile(path) {
+		wc.filesSkippedMedia++
+		return nil
+	}
+	required := false
+	for _, ex := range candidates {
+		if wc.runExtractor(ex, path, fileinfo) {
+			required = true
+		}
+	}
+	switch {
+	case required:
+		wc.filesRequired++
+	case wc.sizeLimitExceeded(path, fileinfo):
+		wc.filesSizeExceeded++
+	default:
+		wc.filesNotRequired++
 	}
 	return nil
 }
 
+// sizeLimitExceeded returns true if any extractor skipped the file only
+// because of its size.
+func (wc *walkContext) sizeLimitExceeded(path string, fileinfo fs.FileInfo) bool {
+	for _, ex := range wc.candidates(path) {
+		if sl, ok := ex.(SizeLimitedExtractor); ok && sl.FileSizeLimitExceeded(path, fileinfo) {
+			return true
+		}
+	}
+	return false
+}
+
+// candidates returns the extractors that could require the file.
+func (wc *walkContext) candidates(path string) []Extractor {
+	if wc.index == nil {
+		return wc.extractors
+	}
+	return wc.index.candidates(path)
+}
+
 func (wc *walkContext) shouldSkipDir(path string) bool {
 	if _, ok := wc.dirsToSkip[path]; ok {
 		return true
@@ -353,13 +565,105 @@ func (wc *walkContext) shouldSkipDir(path string) bool {
 	return false
 }
 
-func (wc *walkContext) runExtractor(ex Extractor, path string, fileinfo fs.FileInfo) {
+// pseudoFSDirs are the directories in a root filesystem that the kernel's
+// pseudo-filesystems are mounted on.
+var pseudoFSDirs = map[string]bool{"dev": true, "proc": true, "sys": true}
+
+// isPseudoFS returns true if dir is the mount point of a pseudo-filesystem in
+// the scan root.
+func (wc *walkContext) isPseudoFS(dir string) bool {
+	return wc.skipPseudoFS && pseudoFSDirs[dir]
+}
+
+// crossesDevice returns true if the directory is on another device than the
+// scan root. The scan root's device is taken from the first directory walked,
+// which is the scan root itself.
+func (wc *walkContext) crossesDevice(dir string, d fs.DirEntry) bool {
+	if !wc.oneFileSystem {
+		return false
+	}
+	info, err := d.Info()
+	if err != nil {
+		return false
+	}
+	dev, ok := deviceID(info)
+	if !ok {
+		return false
+	}
+	if dir == "." {
+		wc.rootDevice, wc.rootDeviceKnown = dev, true
+		return false
+	}
+	return wc.rootDeviceKnown && dev != wc.rootDevice
+}
+
+// depthExceeded returns true if the files inside the directory are deeper
+// than maxDepth.
+func (wc *walkContext) depthExceeded(dir string) bool {
+	if wc.maxDepth <= 0 || dir == "." {
+		return false
+	}
+	return strings.Count(dir, "/")+1 >= wc.maxDepth
+}
+
+// isIgnored returns true if the path is matched by the patterns of an ignore
+// file in one of its parent directories.
+func (wc *walkContext) isIgnored(path string, isDir bool) bool {
+	return wc.useIgnoreFiles && wc.ignored != nil && wc.ignored.Match(path, isDir)
+}
+
+// readIgnoreFile adds the patterns of the ignore file in dir, if there is one.
+func (wc *walkContext) readIgnoreFile(dir string) {
+	if !wc.useIgnoreFiles {
+		return
+	}
+	if wc.ignored == nil {
+		wc.ignored = ignore.NewMatcher()
+	}
+	path := filepath.ToSlash(filepath.Join(dir, ignore.FileName))
+	f, err := wc.fs.Open(path)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			log.Warnf("Open(%s): %v", path, err)
+		}
+		return
+	}
+	defer f.Close()
+	patterns, err := ignore.Parse(f)
+	if err != nil {
+		log.Warnf("failed to read ignore file %s: %v", path, err)
+		return
+	}
+	wc.ignored.Add(dir, patterns)
+}
+
+// runExtractor runs the extractor on the file if it's required and returns
+// whether it was.
+func (wc *walkContext) runExtractor(ex Extractor, path string, fileinfo fs.FileInfo) bool {
 	startRequired := time.Now()
 	required := ex.FileRequired(path, fileinfo)
+	var headerErr error
+	if s, ok := ex.(HeaderSniffer); ok && required {
+		var header []byte
+		if header, headerErr = wc.fileHeader(path); headerErr == nil {
+			required = s.SniffHeader(path, header)
+		}
+	}
 	wc.requiredDuration += time.Since(startRequired)
 	wc.requiredDurationPerExtractor[ex.Name()] += time.Since(startRequired)
+	if headerErr != nil {
+		addErrToMap(wc.errors, ex.Name(), fmt.Errorf("read header of %s: %v", path, headerErr))
+		return true
+	}
 	if !required {
-		return
+		return false
+	}
+
+	if wc.cache != nil {
+		if cached, ok := wc.cache.get(ex.Name(), wc.scanRoot, path, fileinfo); ok {
+			wc.storeInventory(ex, path, cached)
+			return true
+		}
 	}
 
 	openStart := time.Now()
@@ -367,14 +671,14 @@ func (wc *walkContext) runExtractor(ex Extractor, path string, fileinfo fs.FileI
 	rc, err := wc.fs.Open(path)
 	if err != nil {
 		addErrToMap(wc.errors, ex.Name(), fmt.Errorf("Open(%s): %v", path, err))
-		return
+		return true
 	}
 	defer rc.Close()
 
 	info, err := rc.Stat()
 	if err != nil {
 		addErrToMap(wc.errors, ex.Name(), fmt.Errorf("stat(%s): %v", path, err))
-		return
+		return true
 	}
 
 	wc.openDuration += time.Since(openStart)
@@ -382,32 +686,95 @@ func (wc *walkContext) runExtractor(ex Extractor, path string, fileinfo fs.FileI
 	wc.extractCalls++
 
 	start := time.Now()
-	results, err := ex.Extract(wc.ctx, &ScanInput{
-		FS:     wc.fs,
-		Path:   path,
-		Root:   wc.scanRoot,
-		Info:   info,
-		Reader: rc,
-	})
+	input := &ScanInput{
+		FS:                  wc.fs,
+		Path:                path,
+		Root:                wc.scanRoot,
+		Info:                info,
+		Reader:              rc,
+		MaxInventoryPerFile: wc.maxInvPerFile,
+		IncludeLimits:       wc.includeLimits,
+	}
+	results, err := ex.Extract(wc.ctx, input)
 	wc.extractDuration += time.Since(start)
 	wc.stats.AfterExtractorRun(ex.Name(), time.Since(start), err)
+	wc.reportWarnings(ex, path, input.Warnings())
 
 	start = time.Now()
 	if err != nil {
 		addErrToMap(wc.errors, ex.Name(), fmt.Errorf("%s: %w", path, err))
+	} else if wc.cache != nil {
+		wc.cache.put(ex.Name(), wc.scanRoot, path, info, results)
+	}
+	wc.storeInventory(ex, path, results)
+	wc.storageDuration += time.Since(start)
+	return true
+}
+
+// reportWarnings logs the warnings the extractor recorded for the file and
+// passes them on to the stats collector.
+func (wc *walkContext) reportWarnings(ex Extractor, path string, warnings []string) {
+	if len(warnings) == 0 {
+		return
+	}
+	for _, w := range warnings {
+		log.Warnf("%s: %s: %s", ex.Name(), path, w)
+	}
+	wc.stats.AfterFileWarnings(ex.Name(), &stats.FileWarningsStats{Path: path, Warnings: warnings})
+}
+
+// storeInventory adds the inventory the extractor found in the file to the
+// results of the walk.
+func (wc *walkContext) storeInventory(ex Extractor, path string, results []*extractor.Inventory) {
+	if wc.maxInvPerFile > 0 && len(results) > wc.maxInvPerFile {
+		log.Warnf("%s returned %d inventories for %s, dropping all but the first %d", ex.Name(), len(results), path, wc.maxInvPerFile)
+		results = results[:wc.maxInvPerFile]
 	}
 
 	if len(results) > 0 {
 		wc.foundInv[ex.Name()] = true
 		for _, r := range results {
 			r.Extractor = ex
+			r.ScanRoot = wc.scanRoot
+			redact.Inventory(r)
+			if limitMetadata(r, wc.maxMetadataBytes) {
+				log.Warnf("%s: metadata of %s in %s exceeded %d bytes and was truncated", ex.Name(), r.Name, path, wc.maxMetadataBytes)
+			}
+			extractor.FillEcosystem(r)
 			if wc.storeAbsolutePath {
 				r.Locations = expandAbsolutePath(wc.scanRoot, r.Locations)
 			}
 			wc.inventory = append(wc.inventory, r)
 		}
 	}
-	wc.storageDuration += time.Since(start)
+}
+
+// fileHeader returns up to HeaderSize bytes from the start of the file. The file
+// is only read for the first sniffing extractor that asks for it.
+func (wc *walkContext) fileHeader(path string) ([]byte, error) {
+	if wc.headerRead {
+		return wc.header, wc.headerErr
+	}
+	wc.headerRead = true
+	wc.header, wc.headerErr = wc.readHeader(path)
+	return wc.header, wc.headerErr
+}
+
+func (wc *walkContext) readHeader(path string) ([]byte, error) {
+	f, err := wc.fs.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if wc.headerBuf == nil {
+		wc.headerBuf = make([]byte, HeaderSize)
+	}
+	n, err := io.ReadFull(f, wc.headerBuf)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, err
+	}
+	return wc.headerBuf[:n], nil
 }
 
 // UpdateScanRoot updates the scan root and the filesystem to use for the filesystem walk.
@@ -415,13 +782,16 @@ func (wc *walkContext) runExtractor(ex Extractor, path string, fileinfo fs.FileI
 func (wc *walkContext) UpdateScanRoot(absRoot string, fs scalibrfs.FS) error {
 	wc.scanRoot = absRoot
 	wc.fs = fs
+	wc.ignored = nil
+	wc.rootDeviceKnown = false
 	return nil
 }
 
-func expandAbsolutePath(scanRoot string, paths []string) []string {
-	var locations []string
-	for _, l := range paths {
-		locations = append(locations, filepath.Join(scanRoot, l))
+func expandAbsolutePath(scanRoot string, locs []extractor.Location) []extractor.Location {
+	var locations []extractor.Location
+	for _, l := range locs {
+		l.Path = filepath.Join(scanRoot, l.Path)
+		locations = append(locations, l)
 	}
 	return locations
 }
@@ -16,6 +16,7 @@ package filesystem_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -25,7 +26,9 @@ import (
 	"path/filepath"
 	"regexp"
 	"runtime"
+	"slices"
 	"sort"
+	"strings"
 	"testing"
 	"testing/fstest"
 	"time"
@@ -34,10 +37,15 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/packageslockjson"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/java/pomxml"
+	el "github.com/google/osv-scalibr/extractor/filesystem/list"
 	scalibrfs "github.com/google/osv-scalibr/fs"
 	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
 	"github.com/google/osv-scalibr/stats"
 	fe "github.com/google/osv-scalibr/testing/fakeextractor"
+	"github.com/google/osv-scalibr/testing/testcollector"
 )
 
 // pathsMapFS provides a hooked version of MapFS that forces slashes. Because depending on the
@@ -184,6 +192,7 @@ func TestRunFS(t *testing.T) {
 		skipDirRegex   string
 		storeAbsPath   bool
 		maxInodes      int
+		maxInvPerFile  int
 		wantErr        error
 		wantInv        []*extractor.Inventory
 		wantStatus     []*plugin.Status
@@ -195,12 +204,12 @@ func TestRunFS(t *testing.T) {
 			wantInv: []*extractor.Inventory{
 				{
 					Name:      name1,
-					Locations: []string{path1},
+					Locations: extractor.LocationsFromPaths(path1),
 					Extractor: fakeEx1,
 				},
 				{
 					Name:      name2,
-					Locations: []string{path2},
+					Locations: extractor.LocationsFromPaths(path2),
 					Extractor: fakeEx2,
 				},
 			},
@@ -218,7 +227,7 @@ func TestRunFS(t *testing.T) {
 			wantInv: []*extractor.Inventory{
 				{
 					Name:      name2,
-					Locations: []string{path2},
+					Locations: extractor.LocationsFromPaths(path2),
 					Extractor: fakeEx2,
 				},
 			},
@@ -235,7 +244,7 @@ func TestRunFS(t *testing.T) {
 			wantInv: []*extractor.Inventory{
 				{
 					Name:      name2,
-					Locations: []string{path2},
+					Locations: extractor.LocationsFromPaths(path2),
 					Extractor: fakeEx2,
 				},
 			},
@@ -252,7 +261,7 @@ func TestRunFS(t *testing.T) {
 			wantInv: []*extractor.Inventory{
 				{
 					Name:      name2,
-					Locations: []string{path2},
+					Locations: extractor.LocationsFromPaths(path2),
 					Extractor: fakeEx2,
 				},
 			},
@@ -269,7 +278,7 @@ func TestRunFS(t *testing.T) {
 			wantInv: []*extractor.Inventory{
 				{
 					Name:      name1,
-					Locations: []string{path1},
+					Locations: extractor.LocationsFromPaths(path1),
 					Extractor: fakeEx1,
 				},
 			},
@@ -286,12 +295,12 @@ func TestRunFS(t *testing.T) {
 			wantInv: []*extractor.Inventory{
 				{
 					Name:      name1,
-					Locations: []string{path1},
+					Locations: extractor.LocationsFromPaths(path1),
 					Extractor: fakeEx1,
 				},
 				{
 					Name:      name2,
-					Locations: []string{path2},
+					Locations: extractor.LocationsFromPaths(path2),
 					Extractor: fakeEx2,
 				},
 			},
@@ -307,12 +316,12 @@ func TestRunFS(t *testing.T) {
 			wantInv: []*extractor.Inventory{
 				{
 					Name:      name1,
-					Locations: []string{path1},
+					Locations: extractor.LocationsFromPaths(path1),
 					Extractor: fakeEx1,
 				},
 				{
 					Name:      name1,
-					Locations: []string{path2},
+					Locations: extractor.LocationsFromPaths(path2),
 					Extractor: fakeEx2WithInv1,
 				},
 			},
@@ -330,7 +339,7 @@ func TestRunFS(t *testing.T) {
 			wantInv: []*extractor.Inventory{
 				{
 					Name:      name2,
-					Locations: []string{path2},
+					Locations: extractor.LocationsFromPaths(path2),
 					Extractor: fakeEx2,
 				},
 			},
@@ -347,7 +356,7 @@ func TestRunFS(t *testing.T) {
 			wantInv: []*extractor.Inventory{
 				{
 					Name:      name2,
-					Locations: []string{path2},
+					Locations: extractor.LocationsFromPaths(path2),
 					Extractor: fakeEx2,
 				},
 			},
@@ -375,7 +384,7 @@ func TestRunFS(t *testing.T) {
 			wantInv: []*extractor.Inventory{
 				{
 					Name:      name1,
-					Locations: []string{path1},
+					Locations: extractor.LocationsFromPaths(path1),
 					Extractor: fakeExWithPartialResult,
 				},
 			},
@@ -435,12 +444,12 @@ func TestRunFS(t *testing.T) {
 			wantInv: []*extractor.Inventory{
 				{
 					Name:      name1,
-					Locations: []string{path1},
+					Locations: extractor.LocationsFromPaths(path1),
 					Extractor: fakeEx1,
 				},
 				{
 					Name:      name2,
-					Locations: []string{path2},
+					Locations: extractor.LocationsFromPaths(path2),
 					Extractor: fakeEx2,
 				},
 			},
@@ -456,12 +465,12 @@ func TestRunFS(t *testing.T) {
 			wantInv: []*extractor.Inventory{
 				{
 					Name:      name1,
-					Locations: []string{filepath.Join(cwd, path1)},
+					Locations: extractor.LocationsFromPaths(filepath.Join(cwd, path1)),
 					Extractor: fakeEx1,
 				},
 				{
 					Name:      name2,
-					Locations: []string{filepath.Join(cwd, path2)},
+					Locations: extractor.LocationsFromPaths(filepath.Join(cwd, path2)),
 					Extractor: fakeEx2,
 				},
 			},
@@ -472,6 +481,24 @@ func TestRunFS(t *testing.T) {
 			},
 			wantInodeCount: 6,
 		},
+		{
+			desc: "Inventory per file limited",
+			ex: []filesystem.Extractor{
+				fe.New("ex1", 1, []string{path1}, map[string]fe.NamesErr{path1: {Names: []string{name1, name2}, Err: nil}}),
+			},
+			maxInvPerFile: 1,
+			wantInv: []*extractor.Inventory{
+				{
+					Name:      name1,
+					Locations: extractor.LocationsFromPaths(path1),
+					Extractor: fe.New("ex1", 1, []string{path1}, map[string]fe.NamesErr{path1: {Names: []string{name1, name2}, Err: nil}}),
+				},
+			},
+			wantStatus: []*plugin.Status{
+				{Name: "ex1", Version: 1, Status: success},
+			},
+			wantInodeCount: 6,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -482,11 +509,12 @@ func TestRunFS(t *testing.T) {
 				skipDirRegex = regexp.MustCompile(tc.skipDirRegex)
 			}
 			config := &filesystem.Config{
-				Extractors:     tc.ex,
-				FilesToExtract: tc.filesToExtract,
-				DirsToSkip:     tc.dirsToSkip,
-				SkipDirRegex:   skipDirRegex,
-				MaxInodes:      tc.maxInodes,
+				Extractors:          tc.ex,
+				FilesToExtract:      tc.filesToExtract,
+				DirsToSkip:          tc.dirsToSkip,
+				SkipDirRegex:        skipDirRegex,
+				MaxInodes:           tc.maxInodes,
+				MaxInventoryPerFile: tc.maxInvPerFile,
 				ScanRoots: []*scalibrfs.ScanRoot{{
 					FS: fsys, Path: ".",
 				}},
@@ -515,7 +543,11 @@ func TestRunFS(t *testing.T) {
 
 			// The order of the locations doesn't matter.
 			for _, i := range gotInv {
-				sort.Strings(i.Locations)
+				sort.Slice(i.Locations, func(a, b int) bool { return i.Locations[a].Path < i.Locations[b].Path })
+				if i.ScanRoot != cwd {
+					t.Errorf("extractor.Run(%v): inventory %q attributed to scan root %q, want %q", tc.ex, i.Name, i.ScanRoot, cwd)
+				}
+				i.ScanRoot = ""
 			}
 
 			if diff := cmp.Diff(tc.wantInv, gotInv, cmpopts.SortSlices(invLess), fe.AllowUnexported, cmpopts.EquateErrors()); diff != "" {
@@ -645,3 +677,705 @@ func TestRunFS_ReadError(t *testing.T) {
 		t.Errorf("extractor.Run(%v): unexpected status (-want +got):\n%s", ex, diff)
 	}
 }
+
+// sizeLimitedExtractor wraps a fake extractor and skips required files above
+// maxFileSizeBytes, reporting the results like the real extractors do.
+type sizeLimitedExtractor struct {
+	filesystem.Extractor
+	maxFileSizeBytes int64
+	stats            stats.Collector
+}
+
+func (e sizeLimitedExtractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
+	if !e.Extractor.FileRequired(path, fileinfo) {
+		return false
+	}
+	result := stats.FileRequiredResultOK
+	if fileinfo.Size() > e.maxFileSizeBytes {
+		result = stats.FileRequiredResultSizeLimitExceeded
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{Path: path, Result: result})
+	return result == stats.FileRequiredResultOK
+}
+
+func (e sizeLimitedExtractor) FileSizeLimitExceeded(path string, fileinfo fs.FileInfo) bool {
+	return e.Extractor.FileRequired(path, fileinfo) && fileinfo.Size() > e.maxFileSizeBytes
+}
+
+func TestRun_SkipsMediaFiles(t *testing.T) {
+	pngData := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	tests := []struct {
+		desc        string
+		disable     bool
+		wantFiles   []string
+		wantSkipped int
+	}{
+		{
+			desc:        "media_files_skipped",
+			wantFiles:   []string{"package-lock.json", "not-really.png"},
+			wantSkipped: 1,
+		},
+		{
+			desc:      "skipping_disabled",
+			disable:   true,
+			wantFiles: []string{"image.png", "package-lock.json", "not-really.png"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			fsys := pathsMapFS{mapfs: fstest.MapFS{
+				"image.png":         {Data: pngData},
+				"not-really.png":    {Data: []byte("lockfile content")},
+				"package-lock.json": {Data: []byte("{}")},
+			}}
+			collector := testcollector.New()
+			ex := fe.New("ex", 1, []string{"image.png", "not-really.png", "package-lock.json"}, map[string]fe.NamesErr{
+				"image.png":         {Names: []string{"image"}},
+				"not-really.png":    {Names: []string{"not-really"}},
+				"package-lock.json": {Names: []string{"lockfile"}},
+			})
+			config := &filesystem.Config{
+				Extractors:               []filesystem.Extractor{ex},
+				ScanRoots:                []*scalibrfs.ScanRoot{{FS: fsys, Path: "."}},
+				Stats:                    collector,
+				DisableMediaFileSkipping: tc.disable,
+			}
+			inv, _, err := filesystem.Run(context.Background(), config)
+			if err != nil {
+				t.Fatalf("filesystem.Run(): %v", err)
+			}
+
+			var gotFiles []string
+			for _, i := range inv {
+				gotFiles = append(gotFiles, i.Locations[0].Path)
+			}
+			if diff := cmp.Diff(tc.wantFiles, gotFiles, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("filesystem.Run() extracted files diff (-want +got):\n%s", diff)
+			}
+			if got := collector.ScanFinishedStats().FilesSkippedMedia; got != tc.wantSkipped {
+				t.Errorf("filesystem.Run() FilesSkippedMedia = %d, want %d", got, tc.wantSkipped)
+			}
+		})
+	}
+}
+
+// warningExtractor records a warning for every file it extracts.
+type warningExtractor struct {
+	filesystem.Extractor
+}
+
+func (e warningExtractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	input.Warnf("skipped entry %d", 1)
+	return e.Extractor.Extract(ctx, input)
+}
+
+func TestRun_ReportsWarnings(t *testing.T) {
+	fsys := pathsMapFS{mapfs: fstest.MapFS{
+		"warn.txt":  {Data: []byte("warn")},
+		"other.txt": {Data: []byte("other")},
+	}}
+	collector := testcollector.New()
+	ex := warningExtractor{fe.New("ex", 1, []string{"warn.txt"}, map[string]fe.NamesErr{
+		"warn.txt": {Names: []string{"warn"}},
+	})}
+	config := &filesystem.Config{
+		Extractors: []filesystem.Extractor{ex},
+		ScanRoots:  []*scalibrfs.ScanRoot{{FS: fsys, Path: "."}},
+		Stats:      collector,
+	}
+	inv, _, err := filesystem.Run(context.Background(), config)
+	if err != nil {
+		t.Fatalf("filesystem.Run(): %v", err)
+	}
+	if len(inv) != 1 {
+		t.Errorf("filesystem.Run() returned %d inventories, want 1", len(inv))
+	}
+
+	if diff := cmp.Diff([]string{"skipped entry 1"}, collector.FileWarnings("warn.txt")); diff != "" {
+		t.Errorf("filesystem.Run() warnings for warn.txt (-want +got):\n%s", diff)
+	}
+	if got := collector.FileWarnings("other.txt"); len(got) != 0 {
+		t.Errorf("filesystem.Run() warnings for other.txt: %q, want none", got)
+	}
+}
+
+// bulkyMetadata is metadata with a variable amount of data.
+type bulkyMetadata struct {
+	Hash    string            `json:"hash"`
+	Depends []string          `json:"depends"`
+	Extra   map[string]string `json:"extra,omitempty"`
+}
+
+// bulkyExtractor returns a copy of its metadata for each inventory.
+type bulkyExtractor struct {
+	filesystem.Extractor
+	metadata map[string]bulkyMetadata
+}
+
+func (e bulkyExtractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	invs, err := e.Extractor.Extract(ctx, input)
+	for _, i := range invs {
+		if m, ok := e.metadata[i.Name]; ok {
+			i.Metadata = &m
+		}
+	}
+	return invs, err
+}
+
+func TestRun_MaxMetadataBytes(t *testing.T) {
+	fsys := pathsMapFS{mapfs: fstest.MapFS{"deps.txt": {Data: []byte("deps")}}}
+	var depends []string
+	extra := map[string]string{}
+	for i := range 100 {
+		depends = append(depends, fmt.Sprintf("dependency-%03d", i))
+		extra[fmt.Sprintf("key-%03d", i)] = "value"
+	}
+	ex := bulkyExtractor{
+		Extractor: fe.New("ex", 1, []string{"deps.txt"}, map[string]fe.NamesErr{
+			"deps.txt": {Names: []string{"small", "large", "unshrinkable"}},
+		}),
+		metadata: map[string]bulkyMetadata{
+			"small":        {Hash: "abc", Depends: []string{"a"}},
+			"large":        {Hash: strings.Repeat("ab", 100), Depends: depends},
+			"unshrinkable": {Extra: extra},
+		},
+	}
+	const maxBytes = 200
+	config := &filesystem.Config{
+		Extractors:       []filesystem.Extractor{ex},
+		ScanRoots:        []*scalibrfs.ScanRoot{{FS: fsys, Path: "."}},
+		MaxMetadataBytes: maxBytes,
+		Stats:            stats.NoopCollector{},
+	}
+	invs, _, err := filesystem.Run(context.Background(), config)
+	if err != nil {
+		t.Fatalf("filesystem.Run(): %v", err)
+	}
+
+	got := map[string]*extractor.Inventory{}
+	for _, i := range invs {
+		got[i.Name] = i
+	}
+	if len(got) != 3 {
+		t.Fatalf("filesystem.Run() returned %d inventories, want 3", len(got))
+	}
+
+	small := got["small"]
+	if diff := cmp.Diff(&bulkyMetadata{Hash: "abc", Depends: []string{"a"}}, small.Metadata); diff != "" {
+		t.Errorf("metadata of small inventory changed (-want +got):\n%s", diff)
+	}
+	if len(small.Annotations) != 0 {
+		t.Errorf("small inventory annotations = %v, want none", small.Annotations)
+	}
+
+	large := got["large"]
+	m, ok := large.Metadata.(*bulkyMetadata)
+	if !ok {
+		t.Fatalf("large inventory metadata = %T, want *bulkyMetadata", large.Metadata)
+	}
+	if b, _ := json.Marshal(m); len(b) > maxBytes {
+		t.Errorf("large inventory metadata is %d bytes, want at most %d", len(b), maxBytes)
+	}
+	if len(m.Depends) == 0 || !strings.HasPrefix(m.Hash, "abab") {
+		t.Errorf("large inventory metadata = %+v, want shortened rather than emptied", m)
+	}
+	if !slices.Equal(m.Depends, depends[:len(m.Depends)]) {
+		t.Errorf("large inventory dependencies = %v, want a prefix of the original", m.Depends)
+	}
+	if diff := cmp.Diff([]extractor.Annotation{extractor.MetadataTruncated}, large.Annotations); diff != "" {
+		t.Errorf("large inventory annotations (-want +got):\n%s", diff)
+	}
+
+	// Metadata that's still too large once its strings and lists are empty is
+	// dropped.
+	unshrinkable := got["unshrinkable"]
+	if unshrinkable.Metadata != nil {
+		t.Errorf("unshrinkable inventory metadata = %+v, want nil", unshrinkable.Metadata)
+	}
+	if diff := cmp.Diff([]extractor.Annotation{extractor.MetadataTruncated}, unshrinkable.Annotations); diff != "" {
+		t.Errorf("unshrinkable inventory annotations (-want +got):\n%s", diff)
+	}
+}
+
+func TestRun_ScanFinished(t *testing.T) {
+	fsys := pathsMapFS{mapfs: fstest.MapFS{
+		"small.txt":  {Data: []byte("small")},
+		"large1.txt": {Data: []byte("large file")},
+		"large2.txt": {Data: []byte("large file")},
+		"other1.txt": {Data: []byte("other")},
+		"other2.txt": {Data: []byte("other")},
+		"other3.txt": {Data: []byte("other")},
+	}}
+	collector := testcollector.New()
+	ex := sizeLimitedExtractor{
+		Extractor: fe.New("ex", 1, []string{"small.txt", "large1.txt", "large2.txt"}, map[string]fe.NamesErr{
+			"small.txt": {Names: []string{"small"}},
+		}),
+		maxFileSizeBytes: 5,
+		stats:            collector,
+	}
+	config := &filesystem.Config{
+		Extractors: []filesystem.Extractor{ex},
+		ScanRoots:  []*scalibrfs.ScanRoot{{FS: fsys, Path: "."}},
+		Stats:      collector,
+	}
+	if _, _, err := filesystem.Run(context.Background(), config); err != nil {
+		t.Fatalf("filesystem.Run(): %v", err)
+	}
+
+	got := collector.ScanFinishedStats()
+	want := &stats.ScanFinishedStats{FilesRequired: 1, FilesSizeExceeded: 2, FilesNotRequired: 3}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("filesystem.Run() scan finished stats diff (-want +got):\n%s", diff)
+	}
+
+	// The rollup matches the per-file events reported by the extractor.
+	if ok := collector.FileRequiredResultCount(stats.FileRequiredResultOK); got.FilesRequired != ok {
+		t.Errorf("FilesRequired = %d, want %d from per-file events", got.FilesRequired, ok)
+	}
+	if exceeded := collector.FileRequiredResultCount(stats.FileRequiredResultSizeLimitExceeded); got.FilesSizeExceeded != exceeded {
+		t.Errorf("FilesSizeExceeded = %d, want %d from per-file events", got.FilesSizeExceeded, exceeded)
+	}
+}
+
+func TestRun_MultipleRoots(t *testing.T) {
+	lockfile := `{"version": 1, "dependencies": {"net8.0": {%q: {"type": "Direct", "resolved": "1.0.0"}}}}`
+	roots := map[string]string{
+		"Vol1.Dep": t.TempDir(),
+		"Vol2.Dep": t.TempDir(),
+	}
+	var paths []string
+	for dep, dir := range roots {
+		content := fmt.Sprintf(lockfile, dep)
+		if err := os.WriteFile(filepath.Join(dir, "packages.lock.json"), []byte(content), 0644); err != nil {
+			t.Fatalf("os.WriteFile(%s): %v", dir, err)
+		}
+		paths = append(paths, dir)
+	}
+
+	for _, storeAbsPath := range []bool{false, true} {
+		t.Run(fmt.Sprintf("store_absolute_path_%t", storeAbsPath), func(t *testing.T) {
+			config := &filesystem.Config{
+				Extractors:        []filesystem.Extractor{packageslockjson.New(packageslockjson.DefaultConfig())},
+				ScanRoots:         scalibrfs.RealFSScanRoots(paths...),
+				StoreAbsolutePath: storeAbsPath,
+				Stats:             stats.NoopCollector{},
+			}
+			inv, _, err := filesystem.Run(context.Background(), config)
+			if err != nil {
+				t.Fatalf("filesystem.Run(): %v", err)
+			}
+			if len(inv) != len(roots) {
+				t.Fatalf("filesystem.Run() returned %d packages, want %d", len(inv), len(roots))
+			}
+			for _, i := range inv {
+				wantRoot := roots[i.Name]
+				if i.ScanRoot != wantRoot {
+					t.Errorf("filesystem.Run(): %s attributed to scan root %q, want %q", i.Name, i.ScanRoot, wantRoot)
+				}
+				wantLocation := "packages.lock.json"
+				if storeAbsPath {
+					wantLocation = filepath.Join(wantRoot, wantLocation)
+				}
+				want := []extractor.Location{{Path: wantLocation, Reason: extractor.LocationDeclared}}
+				if diff := cmp.Diff(want, i.Locations); diff != "" {
+					t.Errorf("filesystem.Run(): %s locations (-want +got):\n%s", i.Name, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestExtractFile(t *testing.T) {
+	extractors := []filesystem.Extractor{packageslockjson.New(packageslockjson.DefaultConfig())}
+	lockfile, err := filepath.Abs("language/dotnet/packageslockjson/testdata/valid/packages.lock.json")
+	if err != nil {
+		t.Fatalf("filepath.Abs(): %v", err)
+	}
+
+	inv, err := filesystem.ExtractFile(context.Background(), lockfile, extractors)
+	if err != nil {
+		t.Fatalf("filesystem.ExtractFile(%s): %v", lockfile, err)
+	}
+	if len(inv) == 0 {
+		t.Fatalf("filesystem.ExtractFile(%s) returned no inventory", lockfile)
+	}
+	for _, i := range inv {
+		want := []extractor.Location{{Path: lockfile, Reason: extractor.LocationDeclared}}
+		if diff := cmp.Diff(want, i.Locations); diff != "" {
+			t.Errorf("filesystem.ExtractFile(%s): %s locations (-want +got):\n%s", lockfile, i.Name, diff)
+		}
+		if i.Extractor != extractors[0] {
+			t.Errorf("filesystem.ExtractFile(%s): %s extracted by %v, want %v", lockfile, i.Name, i.Extractor, extractors[0])
+		}
+	}
+
+	unmatched := filepath.Join(t.TempDir(), "notes.txt")
+	if err := os.WriteFile(unmatched, []byte("nothing to see"), 0644); err != nil {
+		t.Fatalf("os.WriteFile(%s): %v", unmatched, err)
+	}
+	if _, err := filesystem.ExtractFile(context.Background(), unmatched, extractors); !errors.Is(err, filesystem.ErrNoExtractorMatched) {
+		t.Errorf("filesystem.ExtractFile(%s) error: got %v, want %v", unmatched, err, filesystem.ErrNoExtractorMatched)
+	}
+}
+
+func TestExtractReader(t *testing.T) {
+	ex, err := el.ExtractorFromName(packageslockjson.Name)
+	if err != nil {
+		t.Fatalf("el.ExtractorFromName(%s): %v", packageslockjson.Name, err)
+	}
+	testCases := []struct {
+		desc     string
+		path     string
+		wantPath string
+	}{
+		{desc: "default path", wantPath: filesystem.StdinPath},
+		{desc: "format hint", path: "packages.lock.json", wantPath: "packages.lock.json"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			// Simulates piping the lockfile to stdin.
+			stdin, err := os.Open("language/dotnet/packageslockjson/testdata/valid/packages.lock.json")
+			if err != nil {
+				t.Fatalf("os.Open(): %v", err)
+			}
+			defer stdin.Close()
+
+			inv, err := filesystem.ExtractReader(context.Background(), stdin, tc.path, ex)
+			if err != nil {
+				t.Fatalf("filesystem.ExtractReader(%q): %v", tc.path, err)
+			}
+			if len(inv) == 0 {
+				t.Fatalf("filesystem.ExtractReader(%q) returned no inventory", tc.path)
+			}
+			for _, i := range inv {
+				want := []extractor.Location{{Path: tc.wantPath, Reason: extractor.LocationDeclared}}
+				if diff := cmp.Diff(want, i.Locations); diff != "" {
+					t.Errorf("filesystem.ExtractReader(%q): %s locations (-want +got):\n%s", tc.path, i.Name, diff)
+				}
+				if i.Extractor != ex {
+					t.Errorf("filesystem.ExtractReader(%q): %s extracted by %v, want %v", tc.path, i.Name, i.Extractor, ex)
+				}
+			}
+		})
+	}
+}
+
+func TestExtractReader_Errors(t *testing.T) {
+	ex := packageslockjson.New(packageslockjson.DefaultConfig())
+	if _, err := filesystem.ExtractReader(context.Background(), strings.NewReader("{"), "", ex); err == nil {
+		t.Error("filesystem.ExtractReader() succeeded for invalid JSON, want error")
+	}
+	if _, err := filesystem.ExtractReader(context.Background(), strings.NewReader("{}"), "/abs/packages.lock.json", ex); err == nil {
+		t.Error("filesystem.ExtractReader() succeeded for an absolute path, want error")
+	}
+}
+
+func TestExtractFile_KeepsLines(t *testing.T) {
+	content, err := os.ReadFile("language/java/pomxml/testdata/one-package.xml")
+	if err != nil {
+		t.Fatalf("os.ReadFile(): %v", err)
+	}
+	pom := filepath.Join(t.TempDir(), "pom.xml")
+	if err := os.WriteFile(pom, content, 0644); err != nil {
+		t.Fatalf("os.WriteFile(%s): %v", pom, err)
+	}
+
+	inv, err := filesystem.ExtractFile(context.Background(), pom, []filesystem.Extractor{pomxml.Extractor{}})
+	if err != nil {
+		t.Fatalf("filesystem.ExtractFile(%s): %v", pom, err)
+	}
+	if len(inv) != 1 {
+		t.Fatalf("filesystem.ExtractFile(%s) returned %d packages, want 1", pom, len(inv))
+	}
+	want := []extractor.Location{{Path: pom, Reason: extractor.LocationDeclared, Line: 7}}
+	if diff := cmp.Diff(want, inv[0].Locations); diff != "" {
+		t.Errorf("filesystem.ExtractFile(%s) locations (-want +got):\n%s", pom, diff)
+	}
+}
+
+// countingFS counts how often each file is opened.
+type countingFS struct {
+	pathsMapFS
+	opens map[string]int
+}
+
+func (fsys *countingFS) Open(name string) (fs.File, error) {
+	fsys.opens[filepath.ToSlash(name)]++
+	return fsys.pathsMapFS.Open(name)
+}
+
+// sniffingExtractor requires all files that start with its magic bytes.
+type sniffingExtractor struct {
+	name  string
+	magic string
+}
+
+func (e sniffingExtractor) Name() string                        { return e.name }
+func (sniffingExtractor) Version() int                          { return 1 }
+func (sniffingExtractor) Requirements() *plugin.Capabilities    { return &plugin.Capabilities{} }
+func (sniffingExtractor) FileRequired(string, fs.FileInfo) bool { return true }
+func (sniffingExtractor) ToPURL(*extractor.Inventory) *purl.PackageURL {
+	return nil
+}
+func (sniffingExtractor) Ecosystem(*extractor.Inventory) string { return "" }
+func (e sniffingExtractor) SniffHeader(path string, header []byte) bool {
+	return strings.HasPrefix(string(header), e.magic)
+}
+func (e sniffingExtractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	return []*extractor.Inventory{{Name: e.name, Locations: extractor.LocationsFromPaths(input.Path)}}, nil
+}
+
+func TestRunFS_HeaderSniffing(t *testing.T) {
+	elf := sniffingExtractor{name: "elf", magic: "\x7fELF"}
+	zip := sniffingExtractor{name: "zip", magic: "PK\x03\x04"}
+	testCases := []struct {
+		desc      string
+		content   string
+		wantOpens int
+		wantInv   []string
+	}{
+		{
+			desc:      "no extractor matches the header",
+			content:   "#!/bin/sh",
+			wantOpens: 1,
+		},
+		{
+			desc:      "one extractor matches the header",
+			content:   "PK\x03\x04rest of the archive",
+			wantOpens: 2, // One header read and one for Extract.
+			wantInv:   []string{"zip"},
+		},
+		{
+			desc:      "file shorter than the magic bytes",
+			content:   "P",
+			wantOpens: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			fsys := &countingFS{
+				pathsMapFS: pathsMapFS{mapfs: fstest.MapFS{
+					"file": {Data: []byte(tc.content), Mode: fs.ModePerm},
+				}},
+				opens: map[string]int{},
+			}
+			config := &filesystem.Config{
+				Extractors: []filesystem.Extractor{elf, zip},
+				ScanRoots:  []*scalibrfs.ScanRoot{{FS: fsys, Path: "."}},
+				Stats:      stats.NoopCollector{},
+			}
+			wc, err := filesystem.InitWalkContext(context.Background(), config, config.ScanRoots)
+			if err != nil {
+				t.Fatalf("filesystem.InitWalkContext(): %v", err)
+			}
+			if err = wc.UpdateScanRoot(".", fsys); err != nil {
+				t.Fatalf("wc.UpdateScanRoot(): %v", err)
+			}
+			gotInv, _, err := filesystem.RunFS(context.Background(), config, wc)
+			if err != nil {
+				t.Fatalf("filesystem.RunFS(): %v", err)
+			}
+
+			if got := fsys.opens["file"]; got != tc.wantOpens {
+				t.Errorf("filesystem.RunFS() opened the file %d times, want %d", got, tc.wantOpens)
+			}
+			var gotNames []string
+			for _, i := range gotInv {
+				gotNames = append(gotNames, i.Name)
+			}
+			if diff := cmp.Diff(tc.wantInv, gotNames); diff != "" {
+				t.Errorf("filesystem.RunFS() unexpected inventory (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRunFS_IgnoreFiles(t *testing.T) {
+	fsys := pathsMapFS{mapfs: fstest.MapFS{
+		".scalibrignore":           {Data: []byte("# Logs\n*.log\n!keep.log\nbuild/\n/vendor\n")},
+		"a.txt":                    {Data: []byte("a")},
+		"debug.log":                {Data: []byte("log")},
+		"keep.log":                 {Data: []byte("log")},
+		"build/out.txt":            {Data: []byte("out")},
+		"vendor/lib.txt":           {Data: []byte("lib")},
+		"sub/.scalibrignore":       {Data: []byte("*.txt\n!important.txt\n")},
+		"sub/z.txt":                {Data: []byte("z")},
+		"sub/important.txt":        {Data: []byte("important")},
+		"sub/vendor/lib.txt":       {Data: []byte("lib")},
+		"sub/vendor/lib.json":      {Data: []byte("lib")},
+		"sub/deep/.scalibrignore":  {Data: []byte("!trace.log\n")},
+		"sub/deep/trace.log":       {Data: []byte("log")},
+		"sub/deep/other.log":       {Data: []byte("log")},
+		"other/build/keep/out.txt": {Data: []byte("out")},
+	}}
+
+	testCases := []struct {
+		desc           string
+		useIgnoreFiles bool
+		want           []string
+	}{
+		{
+			desc:           "ignore files used",
+			useIgnoreFiles: true,
+			want: []string{
+				".scalibrignore",
+				"a.txt",
+				"keep.log",
+				"sub/.scalibrignore",
+				"sub/deep/.scalibrignore",
+				"sub/deep/trace.log",
+				"sub/important.txt",
+				"sub/vendor/lib.json",
+			},
+		},
+		{
+			desc:           "ignore files not used",
+			useIgnoreFiles: false,
+			want: []string{
+				".scalibrignore",
+				"a.txt",
+				"build/out.txt",
+				"debug.log",
+				"keep.log",
+				"other/build/keep/out.txt",
+				"sub/.scalibrignore",
+				"sub/deep/.scalibrignore",
+				"sub/deep/other.log",
+				"sub/deep/trace.log",
+				"sub/important.txt",
+				"sub/vendor/lib.json",
+				"sub/vendor/lib.txt",
+				"sub/z.txt",
+				"vendor/lib.txt",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			config := &filesystem.Config{
+				Extractors:     []filesystem.Extractor{sniffingExtractor{name: "all"}},
+				ScanRoots:      []*scalibrfs.ScanRoot{{FS: fsys, Path: "."}},
+				UseIgnoreFiles: tc.useIgnoreFiles,
+				Stats:          stats.NoopCollector{},
+			}
+			inv, _, err := filesystem.Run(context.Background(), config)
+			if err != nil {
+				t.Fatalf("filesystem.Run(): %v", err)
+			}
+			var got []string
+			for _, i := range inv {
+				got = append(got, i.LocationPaths()...)
+			}
+			sort.Strings(got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("filesystem.Run() extracted files diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRun_MaxDepth(t *testing.T) {
+	fsys := pathsMapFS{mapfs: fstest.MapFS{
+		"root.txt":                 {Data: []byte("1")},
+		"a/one.txt":                {Data: []byte("2")},
+		"a/b/two.txt":              {Data: []byte("3")},
+		"a/b/c/three.txt":          {Data: []byte("4")},
+		"a/b/c/d/four.txt":         {Data: []byte("5")},
+		"x/b/two.txt":              {Data: []byte("3")},
+		"node_modules/m/n/two.txt": {Data: []byte("4")},
+	}}
+
+	testCases := []struct {
+		desc          string
+		maxDepth      int
+		want          []string
+		wantDirsDepth int
+	}{
+		{
+			desc:     "no limit",
+			maxDepth: 0,
+			want: []string{
+				"a/b/c/d/four.txt", "a/b/c/three.txt", "a/b/two.txt", "a/one.txt",
+				"node_modules/m/n/two.txt", "root.txt", "x/b/two.txt",
+			},
+		},
+		{
+			desc:          "root only",
+			maxDepth:      1,
+			want:          []string{"root.txt"},
+			wantDirsDepth: 3,
+		},
+		{
+			desc:          "three levels",
+			maxDepth:      3,
+			want:          []string{"a/b/two.txt", "a/one.txt", "root.txt", "x/b/two.txt"},
+			wantDirsDepth: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			collector := testcollector.New()
+			config := &filesystem.Config{
+				Extractors: []filesystem.Extractor{sniffingExtractor{name: "all"}},
+				ScanRoots:  []*scalibrfs.ScanRoot{{FS: fsys, Path: "."}},
+				MaxDepth:   tc.maxDepth,
+				Stats:      collector,
+			}
+			inv, _, err := filesystem.Run(context.Background(), config)
+			if err != nil {
+				t.Fatalf("filesystem.Run(): %v", err)
+			}
+			var got []string
+			for _, i := range inv {
+				got = append(got, i.LocationPaths()...)
+			}
+			sort.Strings(got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("filesystem.Run() extracted files diff (-want +got):\n%s", diff)
+			}
+			if got := collector.ScanFinishedStats().DirsDepthExceeded; got != tc.wantDirsDepth {
+				t.Errorf("filesystem.Run() reported %d directories exceeding the depth, want %d", got, tc.wantDirsDepth)
+			}
+		})
+	}
+}
+
+func TestRun_SkipPseudoFilesystems(t *testing.T) {
+	fsys := pathsMapFS{mapfs: fstest.MapFS{
+		"proc/1/status":     {Data: []byte("status")},
+		"sys/kernel/notes":  {Data: []byte("notes")},
+		"dev/null":          {Data: []byte("")},
+		"usr/sys/notes":     {Data: []byte("notes")},
+		"usr/lib/proc.conf": {Data: []byte("conf")},
+	}}
+	paths := []string{"proc/1/status", "sys/kernel/notes", "dev/null", "usr/sys/notes", "usr/lib/proc.conf"}
+	namesErr := map[string]fe.NamesErr{}
+	for _, p := range paths {
+		namesErr[p] = fe.NamesErr{Names: []string{p}}
+	}
+	config := &filesystem.Config{
+		Extractors:            []filesystem.Extractor{fe.New("ex", 1, paths, namesErr)},
+		ScanRoots:             []*scalibrfs.ScanRoot{{FS: fsys, Path: "."}},
+		SkipPseudoFilesystems: true,
+		Stats:                 stats.NoopCollector{},
+	}
+	inv, _, err := filesystem.Run(context.Background(), config)
+	if err != nil {
+		t.Fatalf("filesystem.Run(): %v", err)
+	}
+	got := []string{}
+	for _, i := range inv {
+		got = append(got, i.Name)
+	}
+	sort.Strings(got)
+	// Only the pseudo-filesystems directly in the scan root are skipped.
+	want := []string{"usr/lib/proc.conf", "usr/sys/notes"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("filesystem.Run() inventory names (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filesystem
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/log"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/stats"
+)
+
+// tarMemoryLimit is the size up to which ExtractTar buffers the tar entries
+// extractors read in memory. Larger entries are spooled to a temporary file
+// so that memory use stays bounded regardless of the entry sizes.
+var tarMemoryLimit int64 = 4 << 20
+
+// tarGzipMagic are the first bytes of gzip-compressed tar archives.
+var tarGzipMagic = []byte{0x1f, 0x8b}
+
+// ExtractTar runs the extractors on the regular files of the tar archive read
+// from r, e.g. the output of `docker save`, without unpacking it to disk.
+// gzip-compressed archives are detected by their magic bytes. The archive is
+// read as a stream: each entry is presented to the extractors that require it
+// as a file at its path inside the archive, which is also used for the
+// locations of the returned inventory. An entry's content is only read if an
+// extractor opens it and is released before moving on to the next entry.
+//
+// Archives nested directly in the tar, e.g. the layers of `docker save`
+// output, are descended into, and their entries are presented at their paths
+// inside the nested archive. Layers are read one after the other without
+// applying whiteouts, so files deleted or replaced by a later layer are still
+// extracted. Archives nested any deeper are treated as regular files.
+//
+// Directories, links, devices and fifos are skipped. Since only one entry is
+// available at a time, extractors that look at other files next to the one
+// they extract, e.g. to resolve includes, find none. Of the walk options of
+// the config, DirsToSkip, SkipDirRegex, MaxInodes and MaxDepth apply to the
+// paths inside the archive; ScanRoots and FilesToExtract are ignored.
+func ExtractTar(ctx context.Context, r io.Reader, config *Config) ([]*extractor.Inventory, []*plugin.Status, error) {
+	if config.Stats == nil {
+		cfg := *config
+		cfg.Stats = stats.NoopCollector{}
+		config = &cfg
+	}
+	wc, err := InitWalkContext(ctx, config, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := wc.walkTar(r, false); err != nil {
+		if ctx.Err() != nil {
+			return wc.inventory, errToExtractorStatus(config.Extractors, wc.foundInv, wc.errors), err
+		}
+		return nil, nil, err
+	}
+
+	wc.stats.ScanFinished(&stats.ScanFinishedStats{
+		FilesRequired:     wc.filesRequired,
+		FilesSizeExceeded: wc.filesSizeExceeded,
+		FilesNotRequired:  wc.filesNotRequired,
+		DirsDepthExceeded: wc.dirsDepthExceeded,
+		FilesSkippedMedia: wc.filesSkippedMedia,
+	})
+	return wc.inventory, errToExtractorStatus(config.Extractors, wc.foundInv, wc.errors), nil
+}
+
+// walkTar runs the extractors on the entries of the possibly gzip-compressed
+// tar archive read from r. Unless the archive is itself nested, archives found
+// in it are walked as well.
+func (wc *walkContext) walkTar(r io.Reader, nested bool) error {
+	br := bufio.NewReader(r)
+	var archive io.Reader = br
+	if magic, err := br.Peek(len(tarGzipMagic)); err == nil && bytes.Equal(magic, tarGzipMagic) {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return fmt.Errorf("read tar: %w", err)
+		}
+		defer gz.Close()
+		archive = gz
+	}
+
+	tr := tar.NewReader(archive)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read tar: %w", err)
+		}
+		var entry io.Reader = tr
+		if !nested && hdr.Typeflag == tar.TypeReg {
+			er := bufio.NewReaderSize(tr, tarBlockSize)
+			if isNestedTar(hdr.Name, er) {
+				if err := wc.walkTar(er, true); err != nil {
+					if wc.ctx.Err() != nil {
+						return err
+					}
+					log.Warnf("Skipping the rest of nested archive %s: %v", hdr.Name, err)
+				}
+				continue
+			}
+			entry = er
+		}
+		if err := wc.handleTarEntry(hdr, entry); err != nil {
+			return err
+		}
+	}
+}
+
+// tarBlockSize is the size of a tar header block.
+const tarBlockSize = 512
+
+// isNestedTar returns true if the tar entry read by r is itself a tar archive:
+// either an uncompressed one, recognized by the ustar magic of its first
+// header, or a gzip-compressed one named like a tar or stored as an OCI blob,
+// as the layers of `docker save` output are.
+func isNestedTar(name string, r *bufio.Reader) bool {
+	// Peek returns as much as is available for entries smaller than a block.
+	b, _ := r.Peek(tarBlockSize)
+	if len(b) >= 262 && string(b[257:262]) == "ustar" {
+		return true
+	}
+	if !bytes.HasPrefix(b, tarGzipMagic) {
+		return false
+	}
+	name = strings.ToLower(strings.TrimLeft(name, "/"))
+	return strings.HasSuffix(name, ".tar.gz") || strings.HasSuffix(name, ".tgz") || strings.HasPrefix(name, "blobs/")
+}
+
+// handleTarEntry runs the extractors on a regular file of a tar archive.
+func (wc *walkContext) handleTarEntry(hdr *tar.Header, r io.Reader) error {
+	if hdr.Typeflag != tar.TypeReg {
+		return nil
+	}
+	p := path.Clean(strings.TrimLeft(hdr.Name, "/"))
+	if !fs.ValidPath(p) || p == "." {
+		log.Warnf("Skipping tar entry with invalid path %q", hdr.Name)
+		return nil
+	}
+	for dir := path.Dir(p); dir != "."; dir = path.Dir(dir) {
+		if wc.shouldSkipDir(dir) {
+			return nil
+		}
+	}
+	if dir := path.Dir(p); wc.depthExceeded(dir) {
+		return nil
+	}
+
+	entry := &tarEntryFS{path: p, info: hdr.FileInfo(), r: r}
+	defer entry.release()
+	if err := wc.UpdateScanRoot("", entry); err != nil {
+		return err
+	}
+	return wc.handleFile(p, fs.FileInfoToDirEntry(entry.info), nil)
+}
+
+// tarEntryFS presents a single regular file of a tar archive as a filesystem.
+// The content is read from the archive when the file is first opened and kept
+// for further opens until release is called.
+type tarEntryFS struct {
+	path string
+	info fs.FileInfo
+	r    io.Reader
+
+	content io.ReaderAt
+	tmp     *os.File
+	err     error
+}
+
+func (e *tarEntryFS) Open(name string) (fs.File, error) {
+	if name != e.path {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	if err := e.load(); err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	}
+	return &tarEntryFile{SectionReader: io.NewSectionReader(e.content, 0, e.info.Size()), info: e.info}, nil
+}
+
+func (e *tarEntryFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+}
+
+func (e *tarEntryFS) Stat(name string) (fs.FileInfo, error) {
+	if name != e.path {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrNotExist}
+	}
+	return e.info, nil
+}
+
+// load reads the entry's content from the archive, into memory if it's small
+// enough and into a temporary file otherwise.
+func (e *tarEntryFS) load() error {
+	if e.content != nil || e.err != nil {
+		return e.err
+	}
+	size := e.info.Size()
+	if size <= tarMemoryLimit {
+		buf := make([]byte, size)
+		if _, err := io.ReadFull(e.r, buf); err != nil {
+			e.err = err
+			return err
+		}
+		e.content = bytes.NewReader(buf)
+		return nil
+	}
+	f, err := os.CreateTemp("", "scalibr-tar-entry-*")
+	if err != nil {
+		e.err = err
+		return err
+	}
+	e.tmp = f
+	if _, err := io.Copy(f, e.r); err != nil {
+		e.err = err
+		return err
+	}
+	e.content = f
+	return nil
+}
+
+// release drops the entry's content.
+func (e *tarEntryFS) release() {
+	e.content = nil
+	if e.tmp != nil {
+		e.tmp.Close()
+		os.Remove(e.tmp.Name())
+		e.tmp = nil
+	}
+}
+
+// tarEntryFile is an opened tarEntryFS file.
+type tarEntryFile struct {
+	*io.SectionReader
+	info fs.FileInfo
+}
+
+func (f *tarEntryFile) Stat() (fs.FileInfo, error) { return f.info, nil }
+func (f *tarEntryFile) Close() error               { return nil }
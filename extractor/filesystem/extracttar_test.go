@@ -0,0 +1,193 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filesystem_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/rust/cargolock"
+	"github.com/google/osv-scalibr/plugin"
+	fe "github.com/google/osv-scalibr/testing/fakeextractor"
+)
+
+const cargoLock = `version = 3
+
+[[package]]
+name = "serde"
+version = "1.0.197"
+`
+
+// tarEntry is an entry of a test tar archive.
+type tarEntry struct {
+	hdr     *tar.Header
+	content string
+}
+
+func writeTar(t *testing.T, entries []tarEntry) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, e := range entries {
+		e.hdr.Size = int64(len(e.content))
+		if err := tw.WriteHeader(e.hdr); err != nil {
+			t.Fatalf("tar.WriteHeader(%s): %v", e.hdr.Name, err)
+		}
+		if _, err := tw.Write([]byte(e.content)); err != nil {
+			t.Fatalf("tar.Write(%s): %v", e.hdr.Name, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("tar.Close(): %v", err)
+	}
+	return buf.Bytes()
+}
+
+func gzipped(t *testing.T, b []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	if _, err := gw.Write(b); err != nil {
+		t.Fatalf("gzip.Write(): %v", err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatalf("gzip.Close(): %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestExtractTar(t *testing.T) {
+	archive := writeTar(t, []tarEntry{
+		{hdr: &tar.Header{Name: "app/", Typeflag: tar.TypeDir, Mode: 0755}},
+		{hdr: &tar.Header{Name: "app/Cargo.lock", Typeflag: tar.TypeReg, Mode: 0644}, content: cargoLock},
+		{hdr: &tar.Header{Name: "app/README.md", Typeflag: tar.TypeReg, Mode: 0644}, content: "# app"},
+		{hdr: &tar.Header{Name: "app/link/Cargo.lock", Typeflag: tar.TypeSymlink, Linkname: "../Cargo.lock"}},
+		{hdr: &tar.Header{Name: "app/hard/Cargo.lock", Typeflag: tar.TypeLink, Linkname: "app/Cargo.lock"}},
+		{hdr: &tar.Header{Name: "dev/null", Typeflag: tar.TypeChar, Devmajor: 1, Devminor: 3}},
+		{hdr: &tar.Header{Name: "tmp/fifo", Typeflag: tar.TypeFifo}},
+	})
+	cargo := cargolock.Extractor{}
+	// Fails for any file other than the lockfile, so that passing the other
+	// entries shows up in the statuses.
+	fake := fe.New("fake", 1,
+		[]string{"app", "app/Cargo.lock", "app/link/Cargo.lock", "app/hard/Cargo.lock", "dev/null", "tmp/fifo"},
+		map[string]fe.NamesErr{"app/Cargo.lock": {Names: []string{"fake"}}},
+	)
+
+	testCases := []struct {
+		desc    string
+		archive []byte
+	}{
+		{desc: "tar", archive: archive},
+		{desc: "gzipped tar", archive: gzipped(t, archive)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			config := &filesystem.Config{Extractors: []filesystem.Extractor{cargo, fake}}
+			inv, status, err := filesystem.ExtractTar(context.Background(), bytes.NewReader(tc.archive), config)
+			if err != nil {
+				t.Fatalf("filesystem.ExtractTar(): %v", err)
+			}
+
+			want := []*extractor.Inventory{
+				{Name: "serde", Version: "1.0.197", Locations: extractor.DeclaredLocations("app/Cargo.lock"), Extractor: cargo, Ecosystem: "crates.io"},
+				{Name: "fake", Locations: extractor.LocationsFromPaths("app/Cargo.lock"), Extractor: fake},
+			}
+			if diff := cmp.Diff(want, inv, fe.AllowUnexported); diff != "" {
+				t.Errorf("filesystem.ExtractTar() inventory (-want +got):\n%s", diff)
+			}
+			for _, s := range status {
+				if s.Status.Status != plugin.ScanStatusSucceeded {
+					t.Errorf("filesystem.ExtractTar(): %s status %v, want success", s.Name, s.Status)
+				}
+			}
+		})
+	}
+}
+
+func TestExtractTar_DockerSave(t *testing.T) {
+	// Legacy `docker save` output stores each layer as <id>/layer.tar, newer
+	// versions as OCI blobs which may be gzip-compressed.
+	legacyLayer := writeTar(t, []tarEntry{
+		{hdr: &tar.Header{Name: "app/", Typeflag: tar.TypeDir, Mode: 0755}},
+		{hdr: &tar.Header{Name: "app/Cargo.lock", Typeflag: tar.TypeReg, Mode: 0644}, content: cargoLock},
+	})
+	nestedArchive := writeTar(t, []tarEntry{
+		{hdr: &tar.Header{Name: "Cargo.lock", Typeflag: tar.TypeReg, Mode: 0644}, content: cargoLock},
+	})
+	blobLayer := writeTar(t, []tarEntry{
+		{hdr: &tar.Header{Name: "srv/Cargo.lock", Typeflag: tar.TypeReg, Mode: 0644}, content: strings.ReplaceAll(cargoLock, "serde", "tokio")},
+		// Archives inside of layers aren't descended into.
+		{hdr: &tar.Header{Name: "srv/backup.tar", Typeflag: tar.TypeReg, Mode: 0644}, content: string(nestedArchive)},
+	})
+	archive := writeTar(t, []tarEntry{
+		{hdr: &tar.Header{Name: "manifest.json", Typeflag: tar.TypeReg, Mode: 0644}, content: `[{"Layers":["1a2b/layer.tar","blobs/sha256/3c4d"]}]`},
+		{hdr: &tar.Header{Name: "1a2b/VERSION", Typeflag: tar.TypeReg, Mode: 0644}, content: "1.0"},
+		{hdr: &tar.Header{Name: "1a2b/layer.tar", Typeflag: tar.TypeReg, Mode: 0644}, content: string(legacyLayer)},
+		{hdr: &tar.Header{Name: "blobs/sha256/3c4d", Typeflag: tar.TypeReg, Mode: 0644}, content: string(gzipped(t, blobLayer))},
+		{hdr: &tar.Header{Name: "blobs/sha256/5e6f", Typeflag: tar.TypeReg, Mode: 0644}, content: `{"architecture":"amd64"}`},
+	})
+	cargo := cargolock.Extractor{}
+	fake := fe.New("fake", 1, []string{"srv/backup.tar"}, map[string]fe.NamesErr{"srv/backup.tar": {Names: []string{"backup"}}})
+	config := &filesystem.Config{Extractors: []filesystem.Extractor{cargo, fake}}
+
+	inv, _, err := filesystem.ExtractTar(context.Background(), bytes.NewReader(archive), config)
+	if err != nil {
+		t.Fatalf("filesystem.ExtractTar(): %v", err)
+	}
+	want := []*extractor.Inventory{
+		{Name: "serde", Version: "1.0.197", Locations: extractor.DeclaredLocations("app/Cargo.lock"), Extractor: cargo, Ecosystem: "crates.io"},
+		{Name: "tokio", Version: "1.0.197", Locations: extractor.DeclaredLocations("srv/Cargo.lock"), Extractor: cargo, Ecosystem: "crates.io"},
+		{Name: "backup", Locations: extractor.LocationsFromPaths("srv/backup.tar"), Extractor: fake},
+	}
+	if diff := cmp.Diff(want, inv, fe.AllowUnexported); diff != "" {
+		t.Errorf("filesystem.ExtractTar() inventory (-want +got):\n%s", diff)
+	}
+}
+
+func TestExtractTar_LargeEntry(t *testing.T) {
+	// Entries too large to buffer in memory are spooled to a temporary file.
+	padding := strings.Repeat("# padding padding padding padding padding padding\n", 100000)
+	archive := writeTar(t, []tarEntry{
+		{hdr: &tar.Header{Name: "Cargo.lock", Typeflag: tar.TypeReg, Mode: 0644}, content: padding + cargoLock},
+	})
+	config := &filesystem.Config{Extractors: []filesystem.Extractor{cargolock.Extractor{}}}
+
+	inv, _, err := filesystem.ExtractTar(context.Background(), bytes.NewReader(archive), config)
+	if err != nil {
+		t.Fatalf("filesystem.ExtractTar(): %v", err)
+	}
+	var names []string
+	for _, i := range inv {
+		names = append(names, i.Name)
+	}
+	if diff := cmp.Diff([]string{"serde"}, names); diff != "" {
+		t.Errorf("filesystem.ExtractTar() inventory (-want +got):\n%s", diff)
+	}
+}
+
+func TestExtractTar_InvalidArchive(t *testing.T) {
+	config := &filesystem.Config{Extractors: []filesystem.Extractor{cargolock.Extractor{}}}
+	if _, _, err := filesystem.ExtractTar(context.Background(), strings.NewReader("not a tar archive"), config); err == nil {
+		t.Error("filesystem.ExtractTar() succeeded for an invalid archive, want error")
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filesystem
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// FileHints lists the files an extractor could require. Matching is
+// case-insensitive.
+type FileHints struct {
+	// BaseNames are complete file names, e.g. "package.json".
+	BaseNames []string
+	// Extensions are file name suffixes starting with a dot, e.g. ".jar" or
+	// ".nupkg.sha512".
+	Extensions []string
+}
+
+// extractorIndex maps file names and extensions to the extractors that could
+// require the files, so that the walker doesn't have to ask every extractor
+// about every file. It isn't safe for concurrent use.
+type extractorIndex struct {
+	extractors  []Extractor
+	byBaseName  map[string][]int
+	byExtension map[string][]int
+	// unhinted are the extractors without hints, which are asked about every
+	// file.
+	unhinted []int
+
+	// Buffers reused across lookups.
+	idx []int
+	res []Extractor
+}
+
+func newExtractorIndex(extractors []Extractor) *extractorIndex {
+	ix := &extractorIndex{
+		extractors:  extractors,
+		byBaseName:  make(map[string][]int),
+		byExtension: make(map[string][]int),
+	}
+	for i, ex := range extractors {
+		h, ok := ex.(FileHinter)
+		if !ok {
+			ix.unhinted = append(ix.unhinted, i)
+			continue
+		}
+		hints := h.FileHints()
+		for _, n := range hints.BaseNames {
+			n = strings.ToLower(n)
+			ix.byBaseName[n] = append(ix.byBaseName[n], i)
+		}
+		for _, e := range hints.Extensions {
+			e = strings.ToLower(e)
+			ix.byExtension[e] = append(ix.byExtension[e], i)
+		}
+	}
+	return ix
+}
+
+// candidates returns the extractors that could require the file in the order
+// they were configured, so that inventory is reported in the same order as
+// without the index. The returned slice is only valid until the next call.
+func (ix *extractorIndex) candidates(path string) []Extractor {
+	base := strings.ToLower(filepath.Base(path))
+	ix.idx = append(ix.idx[:0], ix.unhinted...)
+	ix.idx = append(ix.idx, ix.byBaseName[base]...)
+	// Every suffix starting with a dot is a possible extension, e.g. both
+	// ".nupkg.sha512" and ".sha512".
+	for i := 0; i < len(base); i++ {
+		if base[i] == '.' {
+			ix.idx = append(ix.idx, ix.byExtension[base[i:]]...)
+		}
+	}
+	slices.Sort(ix.idx)
+	ix.idx = slices.Compact(ix.idx)
+
+	ix.res = ix.res[:0]
+	for _, i := range ix.idx {
+		ix.res = append(ix.res, ix.extractors[i])
+	}
+	return ix.res
+}
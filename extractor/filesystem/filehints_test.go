@@ -0,0 +1,130 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filesystem_test
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	el "github.com/google/osv-scalibr/extractor/filesystem/list"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/stats"
+)
+
+// countingExtractor counts the FileRequired calls of the wrapped extractor.
+type countingExtractor struct {
+	filesystem.Extractor
+	calls *int
+}
+
+func (e countingExtractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
+	*e.calls++
+	return e.Extractor.FileRequired(path, fileinfo)
+}
+
+// countingHinter is a countingExtractor that passes on the file hints of the
+// wrapped extractor.
+type countingHinter struct {
+	countingExtractor
+}
+
+func (e countingHinter) FileHints() filesystem.FileHints {
+	return e.Extractor.(filesystem.FileHinter).FileHints()
+}
+
+// withCallCounter wraps the extractors to count their FileRequired calls.
+func withCallCounter(extractors []filesystem.Extractor, calls *int) []filesystem.Extractor {
+	var res []filesystem.Extractor
+	for _, ex := range extractors {
+		c := countingExtractor{Extractor: ex, calls: calls}
+		if _, ok := ex.(filesystem.FileHinter); ok {
+			res = append(res, countingHinter{c})
+			continue
+		}
+		res = append(res, c)
+	}
+	return res
+}
+
+func TestRun_FileHintsMatchExhaustive(t *testing.T) {
+	// The testdata of the language extractors holds files for all of them.
+	root := "language"
+	run := func(useFileHints bool) ([]*extractor.Inventory, []*plugin.Status, int) {
+		t.Helper()
+		calls := 0
+		config := &filesystem.Config{
+			Extractors:   withCallCounter(el.All, &calls),
+			ScanRoots:    scalibrfs.RealFSScanRoots(root),
+			UseFileHints: useFileHints,
+			Stats:        stats.NoopCollector{},
+		}
+		inv, status, err := filesystem.Run(context.Background(), config)
+		if err != nil {
+			t.Fatalf("filesystem.Run(UseFileHints: %t): %v", useFileHints, err)
+		}
+		return inv, status, calls
+	}
+
+	wantInv, wantStatus, exhaustiveCalls := run(false)
+	gotInv, gotStatus, hintedCalls := run(true)
+
+	if len(wantInv) == 0 {
+		t.Fatalf("filesystem.Run(%s) found no inventory", root)
+	}
+	// Each run wraps the extractors anew, so only their names are compared.
+	sameName := cmp.Comparer(func(a, b extractor.Extractor) bool { return a.Name() == b.Name() })
+	if diff := cmp.Diff(wantInv, gotInv, sameName); diff != "" {
+		t.Errorf("filesystem.Run() with file hints returned different inventory (-exhaustive +hints):\n%s", diff)
+	}
+	if diff := cmp.Diff(wantStatus, gotStatus); diff != "" {
+		t.Errorf("filesystem.Run() with file hints returned different status (-exhaustive +hints):\n%s", diff)
+	}
+	if hintedCalls >= exhaustiveCalls {
+		t.Errorf("filesystem.Run() with file hints made %d FileRequired calls, want fewer than the %d without", hintedCalls, exhaustiveCalls)
+	}
+}
+
+func BenchmarkRun_FileHints(b *testing.B) {
+	mapFS := fstest.MapFS{}
+	for i := range 1000 {
+		mapFS[fmt.Sprintf("src/dir%d/file%d.go", i%50, i)] = &fstest.MapFile{Mode: fs.ModePerm}
+	}
+	mapFS["src/package.json"] = &fstest.MapFile{Data: []byte(`{"name": "foo", "version": "1.0.0"}`), Mode: fs.ModePerm}
+
+	for _, useFileHints := range []bool{false, true} {
+		b.Run(fmt.Sprintf("UseFileHints=%t", useFileHints), func(b *testing.B) {
+			calls := 0
+			config := &filesystem.Config{
+				Extractors:   withCallCounter(el.All, &calls),
+				ScanRoots:    []*scalibrfs.ScanRoot{{FS: pathsMapFS{mapfs: mapFS}, Path: "."}},
+				UseFileHints: useFileHints,
+				Stats:        stats.NoopCollector{},
+			}
+			for range b.N {
+				if _, _, err := filesystem.Run(context.Background(), config); err != nil {
+					b.Fatalf("filesystem.Run(): %v", err)
+				}
+			}
+			b.ReportMetric(float64(calls)/float64(b.N), "FileRequired-calls/op")
+		})
+	}
+}
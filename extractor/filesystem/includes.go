@@ -0,0 +1,100 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filesystem
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+)
+
+// ErrIncludeLimitExceeded is matched by the IncludeLimitError returned by
+// extractors that stop following includes because of the IncludeLimits.
+var ErrIncludeLimitExceeded = errors.New("extraction stopped following includes due to the configured include limits")
+
+// IncludeLimits bounds how far extractors follow the other files referenced by
+// a file they extract, e.g. the `-r` lines of requirements.txt files.
+type IncludeLimits struct {
+	// Limit for the number of files followed from a single extracted file. If
+	// 0, no limit is applied.
+	MaxFiles int
+	// Limit for the include depth. Files referenced by the extracted file are at
+	// depth 1, files referenced by those at depth 2 and so on. If 0, no limit is
+	// applied.
+	MaxDepth int
+}
+
+// IncludeLimitError is returned by extractors along with the inventory found
+// so far if an include wasn't followed because of the IncludeLimits.
+type IncludeLimitError struct {
+	// Path of the included file that wasn't followed.
+	Path string
+	// Depth the file was included at.
+	Depth int
+	// The limits that were exceeded.
+	Limits IncludeLimits
+}
+
+func (e *IncludeLimitError) Error() string {
+	if e.Limits.MaxDepth > 0 && e.Depth > e.Limits.MaxDepth {
+		return fmt.Sprintf("not following include %s: depth %d exceeds the maximum include depth %d", e.Path, e.Depth, e.Limits.MaxDepth)
+	}
+	return fmt.Sprintf("not following include %s: exceeds the maximum of %d included files", e.Path, e.Limits.MaxFiles)
+}
+
+// Is makes IncludeLimitErrors match ErrIncludeLimitExceeded.
+func (e *IncludeLimitError) Is(target error) bool {
+	return target == ErrIncludeLimitExceeded
+}
+
+// Includes keeps track of the files an extractor followed from the includes of
+// a single extracted file, so that all extractors resolving includes share the
+// same cycle detection and limits.
+type Includes struct {
+	limits   IncludeLimits
+	followed map[string]bool
+}
+
+// NewIncludes returns an include tracker for the extracted file at path.
+func NewIncludes(path string, limits IncludeLimits) *Includes {
+	return &Includes{
+		limits:   limits,
+		followed: map[string]bool{cleanIncludePath(path): true},
+	}
+}
+
+// Follow returns whether the extractor should follow the include of the file
+// at path at the given depth and records it as followed if so. Files that were
+// already followed, e.g. because the includes form a cycle, are not followed
+// again. An IncludeLimitError is returned if following the file would exceed
+// the limits.
+func (in *Includes) Follow(path string, depth int) (bool, error) {
+	path = cleanIncludePath(path)
+	if in.followed[path] {
+		return false, nil
+	}
+	// The extracted file itself is in followed too.
+	if (in.limits.MaxDepth > 0 && depth > in.limits.MaxDepth) ||
+		(in.limits.MaxFiles > 0 && len(in.followed) > in.limits.MaxFiles) {
+		return false, &IncludeLimitError{Path: path, Depth: depth, Limits: in.limits}
+	}
+	in.followed[path] = true
+	return true, nil
+}
+
+func cleanIncludePath(p string) string {
+	return path.Clean(filepath.ToSlash(p))
+}
@@ -0,0 +1,83 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filesystem_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/osv-scalibr/extractor/filesystem"
+)
+
+func TestIncludesFollow(t *testing.T) {
+	type follow struct {
+		path       string
+		depth      int
+		want       bool
+		wantErrStr string
+	}
+	tests := []struct {
+		name    string
+		limits  filesystem.IncludeLimits
+		follows []follow
+	}{
+		{
+			name: "cycle_back_to_extracted_file",
+			follows: []follow{
+				{path: "dir/other.txt", depth: 1, want: true},
+				{path: "dir/../requirements.txt", depth: 2, want: false},
+				{path: "dir/other.txt", depth: 3, want: false},
+			},
+		},
+		{
+			name:   "max_files",
+			limits: filesystem.IncludeLimits{MaxFiles: 1},
+			follows: []follow{
+				{path: "a.txt", depth: 1, want: true},
+				{path: "a.txt", depth: 2, want: false},
+				{path: "b.txt", depth: 1, wantErrStr: "not following include b.txt: exceeds the maximum of 1 included files"},
+			},
+		},
+		{
+			name:   "max_depth",
+			limits: filesystem.IncludeLimits{MaxDepth: 1},
+			follows: []follow{
+				{path: "a.txt", depth: 1, want: true},
+				{path: "b.txt", depth: 2, wantErrStr: "not following include b.txt: depth 2 exceeds the maximum include depth 1"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := filesystem.NewIncludes("requirements.txt", tt.limits)
+			for _, f := range tt.follows {
+				got, err := in.Follow(f.path, f.depth)
+				if f.wantErrStr != "" {
+					if err == nil || err.Error() != f.wantErrStr || !errors.Is(err, filesystem.ErrIncludeLimitExceeded) {
+						t.Errorf("Follow(%q, %d) returned error %v, want %q matching ErrIncludeLimitExceeded", f.path, f.depth, err, f.wantErrStr)
+					}
+					continue
+				}
+				if err != nil {
+					t.Errorf("Follow(%q, %d) returned unexpected error: %v", f.path, f.depth, err)
+				}
+				if got != f.want {
+					t.Errorf("Follow(%q, %d) = %v, want %v", f.path, f.depth, got, f.want)
+				}
+			}
+		})
+	}
+}
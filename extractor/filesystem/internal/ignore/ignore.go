@@ -0,0 +1,186 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ignore matches paths against the gitignore-style patterns of
+// .scalibrignore files.
+//
+// An ignore file applies to the directory it's in and everything below it.
+// Patterns are matched in order and the last matching pattern decides whether
+// a path is ignored, with patterns of deeper ignore files coming later. As with
+// .gitignore, a path can't be re-included by a negated pattern if one of its
+// parent directories is ignored, since the walk never descends into it.
+package ignore
+
+import (
+	"bufio"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// FileName is the name of ignore files.
+const FileName = ".scalibrignore"
+
+// Pattern is a single pattern of an ignore file.
+type Pattern struct {
+	re      *regexp.Regexp
+	negate  bool
+	dirOnly bool
+}
+
+// Parse returns the patterns of the ignore file read from r. Blank lines and
+// comments starting with '#' are skipped.
+func Parse(r io.Reader) ([]Pattern, error) {
+	var patterns []Pattern
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		if p, ok := parseLine(s.Text()); ok {
+			patterns = append(patterns, p)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return patterns, nil
+}
+
+func parseLine(line string) (Pattern, bool) {
+	line = strings.TrimRight(strings.TrimSuffix(line, "\r"), " ")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return Pattern{}, false
+	}
+
+	var p Pattern
+	if strings.HasPrefix(line, "!") {
+		p.negate = true
+		line = line[1:]
+	} else if strings.HasPrefix(line, `\!`) || strings.HasPrefix(line, `\#`) {
+		line = line[1:]
+	}
+	if strings.HasSuffix(line, "/") {
+		p.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	if line == "" {
+		return Pattern{}, false
+	}
+
+	// Patterns with a slash other than at the end are relative to the directory
+	// of the ignore file, others match at any depth.
+	anchored := strings.Contains(line, "/")
+	line = strings.TrimPrefix(line, "/")
+
+	var re strings.Builder
+	re.WriteString("^")
+	if !anchored {
+		re.WriteString("(?:.*/)?")
+	}
+	re.WriteString(globToRegexp(line))
+	re.WriteString("$")
+	compiled, err := regexp.Compile(re.String())
+	if err != nil {
+		// Invalid patterns, e.g. with an unterminated character class, are
+		// ignored like git does.
+		return Pattern{}, false
+	}
+	p.re = compiled
+	return p, true
+}
+
+// globToRegexp translates a gitignore glob into a regular expression.
+func globToRegexp(glob string) string {
+	var re strings.Builder
+	for i := 0; i < len(glob); i++ {
+		c := glob[i]
+		switch {
+		case strings.HasPrefix(glob[i:], "**/") && (i == 0 || glob[i-1] == '/'):
+			// Zero or more directories.
+			re.WriteString("(?:.*/)?")
+			i += 2
+		case glob[i:] == "**" && i > 0 && glob[i-1] == '/':
+			// Everything inside the directory.
+			re.WriteString(".*")
+			i++
+		case c == '*':
+			re.WriteString("[^/]*")
+		case c == '?':
+			re.WriteString("[^/]")
+		case c == '[':
+			end := strings.IndexByte(glob[i+1:], ']')
+			if end < 0 {
+				re.WriteString(`\[`)
+				continue
+			}
+			class := glob[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			re.WriteString("[" + class + "]")
+			i += end + 1
+		case c == '\\' && i+1 < len(glob):
+			i++
+			re.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		default:
+			re.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return re.String()
+}
+
+// Matcher holds the patterns of the ignore files found during a walk.
+type Matcher struct {
+	patterns map[string][]Pattern
+}
+
+// NewMatcher returns a matcher without any patterns.
+func NewMatcher() *Matcher {
+	return &Matcher{patterns: make(map[string][]Pattern)}
+}
+
+// Add adds the patterns of the ignore file in dir, a slash-separated path
+// relative to the walk root or "." for the root itself.
+func (m *Matcher) Add(dir string, patterns []Pattern) {
+	if len(patterns) > 0 {
+		m.patterns[dir] = append(m.patterns[dir], patterns...)
+	}
+}
+
+// Match returns true if the slash-separated path, relative to the walk root,
+// is ignored by the patterns of the ignore files in its parent directories.
+func (m *Matcher) Match(p string, isDir bool) bool {
+	if len(m.patterns) == 0 || p == "." {
+		return false
+	}
+
+	ignored := false
+	dir := "."
+	rel := p
+	for {
+		for _, pat := range m.patterns[dir] {
+			if pat.dirOnly && !isDir {
+				continue
+			}
+			if pat.re.MatchString(rel) {
+				ignored = !pat.negate
+			}
+		}
+		first, rest, found := strings.Cut(rel, "/")
+		if !found {
+			return ignored
+		}
+		dir = path.Join(dir, first)
+		rel = rest
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ignore_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/ignore"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		desc    string
+		files   map[string]string
+		path    string
+		isDir   bool
+		ignored bool
+	}{
+		{
+			desc:    "basename at any depth",
+			files:   map[string]string{".": "*.log"},
+			path:    "a/b/debug.log",
+			ignored: true,
+		},
+		{
+			desc:    "comments and blank lines",
+			files:   map[string]string{".": "# *.log\n\n"},
+			path:    "debug.log",
+			ignored: false,
+		},
+		{
+			desc:    "escaped hash",
+			files:   map[string]string{".": `\#notes`},
+			path:    "#notes",
+			ignored: true,
+		},
+		{
+			desc:    "anchored pattern matches at its directory",
+			files:   map[string]string{".": "/vendor"},
+			path:    "vendor",
+			isDir:   true,
+			ignored: true,
+		},
+		{
+			desc:    "anchored pattern doesn't match deeper",
+			files:   map[string]string{".": "/vendor"},
+			path:    "sub/vendor",
+			isDir:   true,
+			ignored: false,
+		},
+		{
+			desc:    "pattern with a slash is anchored",
+			files:   map[string]string{".": "docs/*.md"},
+			path:    "sub/docs/a.md",
+			ignored: false,
+		},
+		{
+			desc:    "directory pattern doesn't match files",
+			files:   map[string]string{".": "build/"},
+			path:    "build",
+			ignored: false,
+		},
+		{
+			desc:    "directory pattern matches directories",
+			files:   map[string]string{".": "build/"},
+			path:    "a/build",
+			isDir:   true,
+			ignored: true,
+		},
+		{
+			desc:    "leading double star",
+			files:   map[string]string{".": "**/testdata/*.json"},
+			path:    "a/b/testdata/x.json",
+			ignored: true,
+		},
+		{
+			desc:    "middle double star matches no directory",
+			files:   map[string]string{".": "a/**/b"},
+			path:    "a/b",
+			ignored: true,
+		},
+		{
+			desc:    "trailing double star",
+			files:   map[string]string{".": "a/**"},
+			path:    "a/x/y",
+			ignored: true,
+		},
+		{
+			desc:    "single star doesn't cross directories",
+			files:   map[string]string{".": "a/*"},
+			path:    "a/x/y",
+			ignored: false,
+		},
+		{
+			desc:    "character class",
+			files:   map[string]string{".": "file[0-9].txt"},
+			path:    "file7.txt",
+			ignored: true,
+		},
+		{
+			desc:    "negated character class",
+			files:   map[string]string{".": "file[!0-9].txt"},
+			path:    "file7.txt",
+			ignored: false,
+		},
+		{
+			desc:    "negation re-includes",
+			files:   map[string]string{".": "*.log\n!keep.log"},
+			path:    "keep.log",
+			ignored: false,
+		},
+		{
+			desc:    "last match wins",
+			files:   map[string]string{".": "!keep.log\n*.log"},
+			path:    "keep.log",
+			ignored: true,
+		},
+		{
+			desc:    "deeper ignore file overrides",
+			files:   map[string]string{".": "*.log", "sub": "!*.log"},
+			path:    "sub/debug.log",
+			ignored: false,
+		},
+		{
+			desc:    "deeper ignore file relative to its directory",
+			files:   map[string]string{"sub": "/debug.log"},
+			path:    "sub/debug.log",
+			ignored: true,
+		},
+		{
+			desc:    "deeper ignore file doesn't apply to siblings",
+			files:   map[string]string{"sub": "*.log"},
+			path:    "other/debug.log",
+			ignored: false,
+		},
+		{
+			desc:    "root is never ignored",
+			files:   map[string]string{".": "*"},
+			path:    ".",
+			isDir:   true,
+			ignored: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := ignore.NewMatcher()
+			// Add the ignore files in walk order.
+			for _, dir := range []string{".", "sub"} {
+				content, ok := tt.files[dir]
+				if !ok {
+					continue
+				}
+				patterns, err := ignore.Parse(strings.NewReader(content))
+				if err != nil {
+					t.Fatalf("Parse(%q): %v", content, err)
+				}
+				m.Add(dir, patterns)
+			}
+
+			if got := m.Match(tt.path, tt.isDir); got != tt.ignored {
+				t.Errorf("Match(%q, %t) = %t, want %t", tt.path, tt.isDir, got, tt.ignored)
+			}
+		})
+	}
+}
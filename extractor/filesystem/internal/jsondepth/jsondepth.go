@@ -0,0 +1,81 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jsondepth limits the nesting depth of JSON input before it reaches
+// the decoder, protecting JSON-based extractors from pathologically nested files.
+package jsondepth
+
+import (
+	"errors"
+	"io"
+)
+
+// ErrMaxDepthExceeded is returned when the JSON input is nested deeper than allowed.
+var ErrMaxDepthExceeded = errors.New("JSON nesting depth limit exceeded")
+
+// Reader wraps an io.Reader of JSON input and fails with ErrMaxDepthExceeded
+// once objects or arrays are nested deeper than the configured maximum.
+// Syntax errors are left to the JSON decoder.
+type Reader struct {
+	r        io.Reader
+	maxDepth int
+	depth    int
+	inString bool
+	escaped  bool
+	err      error
+}
+
+// NewReader returns a Reader enforcing maxDepth on r. If maxDepth is 0 or
+// less, r is returned as is.
+func NewReader(r io.Reader, maxDepth int) io.Reader {
+	if maxDepth <= 0 {
+		return r
+	}
+	return &Reader{r: r, maxDepth: maxDepth}
+}
+
+// Read implements io.Reader.
+func (d *Reader) Read(p []byte) (int, error) {
+	if d.err != nil {
+		return 0, d.err
+	}
+	n, err := d.r.Read(p)
+	for i, c := range p[:n] {
+		if d.inString {
+			switch {
+			case d.escaped:
+				d.escaped = false
+			case c == '\\':
+				d.escaped = true
+			case c == '"':
+				d.inString = false
+			}
+			continue
+		}
+		switch c {
+		case '"':
+			d.inString = true
+		case '{', '[':
+			d.depth++
+			if d.depth > d.maxDepth {
+				// Only pass on the input up to the offending bracket.
+				d.err = ErrMaxDepthExceeded
+				return i, d.err
+			}
+		case '}', ']':
+			d.depth--
+		}
+	}
+	return n, err
+}
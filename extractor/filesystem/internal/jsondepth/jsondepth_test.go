@@ -0,0 +1,71 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsondepth_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/jsondepth"
+)
+
+func TestReader(t *testing.T) {
+	tests := []struct {
+		desc     string
+		input    string
+		maxDepth int
+		wantErr  error
+	}{
+		{
+			desc:     "within limit",
+			input:    `{"a": [{"b": 1}]}`,
+			maxDepth: 3,
+		},
+		{
+			desc:     "beyond limit",
+			input:    `{"a": [{"b": [1]}]}`,
+			maxDepth: 3,
+			wantErr:  jsondepth.ErrMaxDepthExceeded,
+		},
+		{
+			desc:     "brackets inside strings are ignored",
+			input:    `{"a": "[[[{{{\"[[["}`,
+			maxDepth: 1,
+		},
+		{
+			desc:     "deeply nested arrays",
+			input:    strings.Repeat("[", 100000) + strings.Repeat("]", 100000),
+			maxDepth: 100,
+			wantErr:  jsondepth.ErrMaxDepthExceeded,
+		},
+		{
+			desc:     "no limit",
+			input:    strings.Repeat("[", 1000) + strings.Repeat("]", 1000),
+			maxDepth: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			var v any
+			err := json.NewDecoder(jsondepth.NewReader(strings.NewReader(tc.input), tc.maxDepth)).Decode(&v)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("Decode() returned error %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+}
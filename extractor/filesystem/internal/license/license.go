@@ -0,0 +1,190 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package license normalizes the licenses declared in package manifests to
+// SPDX license expressions.
+package license
+
+import (
+	"slices"
+	"strings"
+)
+
+// ids are the SPDX license identifiers commonly found in package manifests,
+// keyed by their lowercase form.
+var ids = toMap([]string{
+	"0BSD",
+	"AGPL-3.0-only",
+	"AGPL-3.0-or-later",
+	"Apache-1.1",
+	"Apache-2.0",
+	"Artistic-2.0",
+	"BSD-2-Clause",
+	"BSD-3-Clause",
+	"BSL-1.0",
+	"CC-BY-4.0",
+	"CC0-1.0",
+	"EPL-1.0",
+	"EPL-2.0",
+	"GPL-2.0-only",
+	"GPL-2.0-or-later",
+	"GPL-3.0-only",
+	"GPL-3.0-or-later",
+	"ISC",
+	"LGPL-2.1-only",
+	"LGPL-2.1-or-later",
+	"LGPL-3.0-only",
+	"LGPL-3.0-or-later",
+	"MIT",
+	"MIT-0",
+	"MPL-2.0",
+	"PSF-2.0",
+	"Python-2.0",
+	"Unlicense",
+	"WTFPL",
+	"Zlib",
+})
+
+// aliases maps the lowercase names that manifests and Python trove classifiers
+// commonly use instead of SPDX identifiers, including deprecated identifiers.
+var aliases = map[string]string{
+	"agpl-3.0":                    "AGPL-3.0-only",
+	"apache 2":                    "Apache-2.0",
+	"apache 2.0":                  "Apache-2.0",
+	"apache license 2.0":          "Apache-2.0",
+	"apache license, version 2.0": "Apache-2.0",
+	"apache license version 2.0":  "Apache-2.0",
+	"apache software license":     "Apache-2.0",
+	"apache-2":                    "Apache-2.0",
+	"apache2":                     "Apache-2.0",
+	"bsd 2-clause":                "BSD-2-Clause",
+	"bsd 3-clause":                "BSD-3-Clause",
+	"cc0 1.0 universal (cc0 1.0) public domain dedication":    "CC0-1.0",
+	"eclipse public license 2.0 (epl-2.0)":                    "EPL-2.0",
+	"gnu affero general public license v3":                    "AGPL-3.0-only",
+	"gnu general public license v2 (gplv2)":                   "GPL-2.0-only",
+	"gnu general public license v2 or later (gplv2+)":         "GPL-2.0-or-later",
+	"gnu general public license v3 (gplv3)":                   "GPL-3.0-only",
+	"gnu general public license v3 or later (gplv3+)":         "GPL-3.0-or-later",
+	"gnu lesser general public license v2 or later (lgplv2+)": "LGPL-2.1-or-later",
+	"gnu lesser general public license v3 (lgplv3)":           "LGPL-3.0-only",
+	"gnu lesser general public license v3 or later (lgplv3+)": "LGPL-3.0-or-later",
+	"gpl-2.0":                              "GPL-2.0-only",
+	"gpl-2.0+":                             "GPL-2.0-or-later",
+	"gpl-3.0":                              "GPL-3.0-only",
+	"gpl-3.0+":                             "GPL-3.0-or-later",
+	"gplv2":                                "GPL-2.0-only",
+	"gplv2+":                               "GPL-2.0-or-later",
+	"gplv3":                                "GPL-3.0-only",
+	"gplv3+":                               "GPL-3.0-or-later",
+	"isc license":                          "ISC",
+	"isc license (iscl)":                   "ISC",
+	"lgpl-2.1":                             "LGPL-2.1-only",
+	"lgpl-2.1+":                            "LGPL-2.1-or-later",
+	"lgpl-3.0":                             "LGPL-3.0-only",
+	"lgpl-3.0+":                            "LGPL-3.0-or-later",
+	"mit license":                          "MIT",
+	"modified bsd license":                 "BSD-3-Clause",
+	"mozilla public license 2.0 (mpl 2.0)": "MPL-2.0",
+	"mpl 2.0":                              "MPL-2.0",
+	"new bsd license":                      "BSD-3-Clause",
+	"python software foundation license":   "PSF-2.0",
+	"simplified bsd license":               "BSD-2-Clause",
+	"the unlicense (unlicense)":            "Unlicense",
+	"zlib/libpng license":                  "Zlib",
+}
+
+// operators of SPDX license expressions.
+var operators = []string{"AND", "OR", "WITH"}
+
+func toMap(ids []string) map[string]string {
+	m := make(map[string]string, len(ids))
+	for _, id := range ids {
+		m[strings.ToLower(id)] = id
+	}
+	return m
+}
+
+// Normalize returns the SPDX license expression for a declared license. Known
+// names and identifiers are mapped to their canonical SPDX spelling and
+// operators of compound expressions are uppercased. Unknown licenses are
+// returned as declared. Returns "" if no license is declared, including the
+// "UNKNOWN" placeholder some Python tooling writes.
+func Normalize(declared string) string {
+	declared = strings.Join(strings.Fields(declared), " ")
+	if declared == "" || strings.EqualFold(declared, "UNKNOWN") {
+		return ""
+	}
+	if id, ok := lookup(declared); ok {
+		return id
+	}
+
+	// Normalize each part of a compound expression like "(mit or apache-2.0)".
+	tokens := strings.Fields(strings.NewReplacer("(", " ( ", ")", " ) ").Replace(declared))
+	if len(tokens) == 1 {
+		return declared
+	}
+	var b strings.Builder
+	for i, t := range tokens {
+		if i > 0 && t != ")" && tokens[i-1] != "(" {
+			b.WriteString(" ")
+		}
+		switch upper := strings.ToUpper(t); {
+		case slices.Contains(operators, upper):
+			b.WriteString(upper)
+		default:
+			if id, ok := lookup(t); ok {
+				t = id
+			}
+			b.WriteString(t)
+		}
+	}
+	return b.String()
+}
+
+// FromClassifier returns the SPDX license expression for a Python trove
+// classifier like "License :: OSI Approved :: MIT License", or "" if the
+// classifier doesn't name a license.
+func FromClassifier(classifier string) string {
+	parts := strings.Split(classifier, "::")
+	if len(parts) < 2 || strings.TrimSpace(parts[0]) != "License" {
+		return ""
+	}
+	name := strings.TrimSpace(parts[len(parts)-1])
+	if len(parts) == 2 && name == "OSI Approved" {
+		return ""
+	}
+	return Normalize(name)
+}
+
+// Dedupe returns the non-empty licenses in their original order without
+// duplicates.
+func Dedupe(licenses []string) []string {
+	var res []string
+	for _, l := range licenses {
+		if l != "" && !slices.Contains(res, l) {
+			res = append(res, l)
+		}
+	}
+	return res
+}
+
+func lookup(name string) (string, bool) {
+	lower := strings.ToLower(name)
+	if id, ok := ids[lower]; ok {
+		return id, true
+	}
+	id, ok := aliases[lower]
+	return id, ok
+}
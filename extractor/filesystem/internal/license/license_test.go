@@ -0,0 +1,73 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package license_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/license"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		declared string
+		want     string
+	}{
+		{declared: "MIT", want: "MIT"},
+		{declared: " mit ", want: "MIT"},
+		{declared: "apache-2.0", want: "Apache-2.0"},
+		{declared: "Apache License, Version 2.0", want: "Apache-2.0"},
+		{declared: "GPL-2.0+", want: "GPL-2.0-or-later"},
+		{declared: "(mit or apache-2.0)", want: "(MIT OR Apache-2.0)"},
+		{declared: "GPL-2.0-only WITH Classpath-exception-2.0", want: "GPL-2.0-only WITH Classpath-exception-2.0"},
+		{declared: "Some Custom License", want: "Some Custom License"},
+		{declared: "UNKNOWN", want: ""},
+		{declared: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := license.Normalize(tt.declared); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.declared, got, tt.want)
+		}
+	}
+}
+
+func TestFromClassifier(t *testing.T) {
+	tests := []struct {
+		classifier string
+		want       string
+	}{
+		{classifier: "License :: OSI Approved :: MIT License", want: "MIT"},
+		{classifier: "License :: OSI Approved :: Apache Software License", want: "Apache-2.0"},
+		{classifier: "License :: OSI Approved :: BSD License", want: "BSD License"},
+		{classifier: "License :: OSI Approved", want: ""},
+		{classifier: "Programming Language :: Python :: 3", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := license.FromClassifier(tt.classifier); got != tt.want {
+			t.Errorf("FromClassifier(%q) = %q, want %q", tt.classifier, got, tt.want)
+		}
+	}
+}
+
+func TestDedupe(t *testing.T) {
+	got := license.Dedupe([]string{"MIT", "", "Apache-2.0", "MIT"})
+	want := []string{"MIT", "Apache-2.0"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Dedupe() returned unexpected licenses (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package textual tells text files apart from binary ones, so that extractors
+// for text formats can skip binary files that happen to have a matching name.
+package textual
+
+import (
+	"bytes"
+	"unicode/utf8"
+)
+
+// LooksTextual returns true if head, the start of a file, looks like UTF-8
+// text. Input containing NUL bytes, which includes UTF-16 encoded text, is
+// considered binary. A rune cut off at the end of head is ignored.
+func LooksTextual(head []byte) bool {
+	if bytes.IndexByte(head, 0) >= 0 {
+		return false
+	}
+	return utf8.Valid(trimPartialRune(head))
+}
+
+// trimPartialRune removes an incomplete rune from the end of b.
+func trimPartialRune(b []byte) []byte {
+	for i := len(b) - 1; i >= 0 && i >= len(b)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(b[i]) {
+			if !utf8.FullRune(b[i:]) {
+				return b[:i]
+			}
+			break
+		}
+	}
+	return b
+}
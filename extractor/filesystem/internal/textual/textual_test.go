@@ -0,0 +1,46 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package textual_test
+
+import (
+	"testing"
+
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/textual"
+)
+
+func TestLooksTextual(t *testing.T) {
+	tests := []struct {
+		name string
+		head []byte
+		want bool
+	}{
+		{name: "empty", head: nil, want: true},
+		{name: "json", head: []byte(`{"version": 1, "dependencies": {}}`), want: true},
+		{name: "utf8", head: []byte("名前 = \"ü\"\n"), want: true},
+		{name: "rune_cut_off_at_end", head: []byte("name: 名")[:8], want: true},
+		{name: "nul_byte", head: []byte("{\x00}"), want: false},
+		{name: "utf16", head: []byte{0xff, 0xfe, '{', 0, '}', 0}, want: false},
+		{name: "invalid_utf8", head: []byte{'{', 0xc3, 0x28, '}'}, want: false},
+		{name: "elf_binary", head: []byte("\x7fELF\x02\x01\x01\x00\x00\x00"), want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := textual.LooksTextual(tc.head); got != tc.want {
+				t.Errorf("LooksTextual(%q) = %v, want %v", tc.head, got, tc.want)
+			}
+		})
+	}
+}
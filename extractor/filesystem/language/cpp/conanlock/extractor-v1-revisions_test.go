@@ -46,7 +46,7 @@ func TestExtractor_Extract_v1_revisions(t *testing.T) {
 				{
 					Name:      "zlib",
 					Version:   "1.2.11",
-					Locations: []string{"testdata/one-package.v1.revisions.json"},
+					Locations: extractor.DeclaredLocations("testdata/one-package.v1.revisions.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -62,7 +62,7 @@ func TestExtractor_Extract_v1_revisions(t *testing.T) {
 				{
 					Name:      "zlib",
 					Version:   "1.2.11",
-					Locations: []string{"testdata/no-name.v1.revisions.json"},
+					Locations: extractor.DeclaredLocations("testdata/no-name.v1.revisions.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -78,7 +78,7 @@ func TestExtractor_Extract_v1_revisions(t *testing.T) {
 				{
 					Name:      "zlib",
 					Version:   "1.2.11",
-					Locations: []string{"testdata/two-packages.v1.revisions.json"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.v1.revisions.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -86,7 +86,7 @@ func TestExtractor_Extract_v1_revisions(t *testing.T) {
 				{
 					Name:      "bzip2",
 					Version:   "1.0.8",
-					Locations: []string{"testdata/two-packages.v1.revisions.json"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.v1.revisions.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -102,7 +102,7 @@ func TestExtractor_Extract_v1_revisions(t *testing.T) {
 				{
 					Name:      "zlib",
 					Version:   "1.2.13",
-					Locations: []string{"testdata/nested-dependencies.v1.revisions.json"},
+					Locations: extractor.DeclaredLocations("testdata/nested-dependencies.v1.revisions.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -110,7 +110,7 @@ func TestExtractor_Extract_v1_revisions(t *testing.T) {
 				{
 					Name:      "bzip2",
 					Version:   "1.0.8",
-					Locations: []string{"testdata/nested-dependencies.v1.revisions.json"},
+					Locations: extractor.DeclaredLocations("testdata/nested-dependencies.v1.revisions.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -118,7 +118,7 @@ func TestExtractor_Extract_v1_revisions(t *testing.T) {
 				{
 					Name:      "freetype",
 					Version:   "2.12.1",
-					Locations: []string{"testdata/nested-dependencies.v1.revisions.json"},
+					Locations: extractor.DeclaredLocations("testdata/nested-dependencies.v1.revisions.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -126,7 +126,7 @@ func TestExtractor_Extract_v1_revisions(t *testing.T) {
 				{
 					Name:      "libpng",
 					Version:   "1.6.39",
-					Locations: []string{"testdata/nested-dependencies.v1.revisions.json"},
+					Locations: extractor.DeclaredLocations("testdata/nested-dependencies.v1.revisions.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -134,7 +134,7 @@ func TestExtractor_Extract_v1_revisions(t *testing.T) {
 				{
 					Name:      "brotli",
 					Version:   "1.0.9",
-					Locations: []string{"testdata/nested-dependencies.v1.revisions.json"},
+					Locations: extractor.DeclaredLocations("testdata/nested-dependencies.v1.revisions.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -150,7 +150,7 @@ func TestExtractor_Extract_v1_revisions(t *testing.T) {
 				{
 					Name:      "ninja",
 					Version:   "1.11.1",
-					Locations: []string{"testdata/one-package-dev.v1.revisions.json"},
+					Locations: extractor.DeclaredLocations("testdata/one-package-dev.v1.revisions.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
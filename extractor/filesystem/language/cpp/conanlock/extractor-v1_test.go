@@ -54,7 +54,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "zlib",
 					Version:   "1.2.11",
-					Locations: []string{"testdata/one-package.v1.json"},
+					Locations: extractor.DeclaredLocations("testdata/one-package.v1.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -70,7 +70,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "zlib",
 					Version:   "1.2.11",
-					Locations: []string{"testdata/no-name.v1.json"},
+					Locations: extractor.DeclaredLocations("testdata/no-name.v1.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -86,7 +86,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "zlib",
 					Version:   "1.2.11",
-					Locations: []string{"testdata/two-packages.v1.json"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.v1.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -94,7 +94,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "bzip2",
 					Version:   "1.0.8",
-					Locations: []string{"testdata/two-packages.v1.json"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.v1.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -110,7 +110,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "zlib",
 					Version:   "1.2.13",
-					Locations: []string{"testdata/nested-dependencies.v1.json"},
+					Locations: extractor.DeclaredLocations("testdata/nested-dependencies.v1.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -118,7 +118,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "bzip2",
 					Version:   "1.0.8",
-					Locations: []string{"testdata/nested-dependencies.v1.json"},
+					Locations: extractor.DeclaredLocations("testdata/nested-dependencies.v1.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -126,7 +126,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "freetype",
 					Version:   "2.12.1",
-					Locations: []string{"testdata/nested-dependencies.v1.json"},
+					Locations: extractor.DeclaredLocations("testdata/nested-dependencies.v1.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -134,7 +134,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "libpng",
 					Version:   "1.6.39",
-					Locations: []string{"testdata/nested-dependencies.v1.json"},
+					Locations: extractor.DeclaredLocations("testdata/nested-dependencies.v1.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -142,7 +142,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "brotli",
 					Version:   "1.0.9",
-					Locations: []string{"testdata/nested-dependencies.v1.json"},
+					Locations: extractor.DeclaredLocations("testdata/nested-dependencies.v1.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -158,7 +158,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "ninja",
 					Version:   "1.11.1",
-					Locations: []string{"testdata/one-package-dev.v1.json"},
+					Locations: extractor.DeclaredLocations("testdata/one-package-dev.v1.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -174,7 +174,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "zlib",
 					Version:   "1.2.11",
-					Locations: []string{"testdata/old-format-0.0.json"},
+					Locations: extractor.DeclaredLocations("testdata/old-format-0.0.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -190,7 +190,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "zlib",
 					Version:   "1.2.11",
-					Locations: []string{"testdata/old-format-0.1.json"},
+					Locations: extractor.DeclaredLocations("testdata/old-format-0.1.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -206,7 +206,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "zlib",
 					Version:   "1.2.11",
-					Locations: []string{"testdata/old-format-0.2.json"},
+					Locations: extractor.DeclaredLocations("testdata/old-format-0.2.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -222,7 +222,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "zlib",
 					Version:   "1.2.11",
-					Locations: []string{"testdata/old-format-0.3.json"},
+					Locations: extractor.DeclaredLocations("testdata/old-format-0.3.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
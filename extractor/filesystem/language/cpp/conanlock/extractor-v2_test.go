@@ -45,7 +45,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "zlib",
 					Version:   "1.2.11",
-					Locations: []string{"testdata/one-package.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/one-package.v2.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{"requires"},
 					},
@@ -61,7 +61,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "zlib",
 					Version:   "1.2.11",
-					Locations: []string{"testdata/no-name.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/no-name.v2.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{"requires"},
 					},
@@ -77,7 +77,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "zlib",
 					Version:   "1.2.11",
-					Locations: []string{"testdata/two-packages.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.v2.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{"requires"},
 					},
@@ -85,7 +85,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "bzip2",
 					Version:   "1.0.8",
-					Locations: []string{"testdata/two-packages.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.v2.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{"requires"},
 					},
@@ -101,7 +101,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "zlib",
 					Version:   "1.2.13",
-					Locations: []string{"testdata/nested-dependencies.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/nested-dependencies.v2.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{"requires"},
 					},
@@ -109,7 +109,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "bzip2",
 					Version:   "1.0.8",
-					Locations: []string{"testdata/nested-dependencies.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/nested-dependencies.v2.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{"requires"},
 					},
@@ -117,7 +117,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "freetype",
 					Version:   "2.12.1",
-					Locations: []string{"testdata/nested-dependencies.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/nested-dependencies.v2.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{"requires"},
 					},
@@ -125,7 +125,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "libpng",
 					Version:   "1.6.39",
-					Locations: []string{"testdata/nested-dependencies.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/nested-dependencies.v2.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{"requires"},
 					},
@@ -133,7 +133,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "brotli",
 					Version:   "1.0.9",
-					Locations: []string{"testdata/nested-dependencies.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/nested-dependencies.v2.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{"requires"},
 					},
@@ -149,7 +149,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "ninja",
 					Version:   "1.11.1",
-					Locations: []string{"testdata/one-package-dev.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/one-package-dev.v2.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{"build-requires"},
 					},
@@ -204,6 +204,11 @@ func (e Extractor) Requirements() *plugin.Capabilities {
 	return &plugin.Capabilities{}
 }
 
+// FileHints returns the file names the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{BaseNames: []string{"conan.lock"}}
+}
+
 // FileRequired returns true if the specified file matches Conan lockfile patterns.
 func (e Extractor) FileRequired(path string, fileInfo fs.FileInfo) bool {
 	return filepath.Base(path) == "conan.lock"
@@ -221,7 +226,7 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 	inv := parseConanLock(*parsedLockfile)
 
 	for i := range inv {
-		inv[i].Locations = []string{input.Path}
+		inv[i].Locations = extractor.DeclaredLocations(input.Path)
 	}
 
 	return inv, nil
@@ -236,6 +241,9 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	}
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeConan} }
+
 // Ecosystem returns the OSV ecosystem ('ConanCenter') of the software extracted by this extractor.
 func (e Extractor) Ecosystem(i *extractor.Inventory) string {
 	return "ConanCenter"
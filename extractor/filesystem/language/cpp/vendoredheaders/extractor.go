@@ -0,0 +1,201 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vendoredheaders extracts C/C++ libraries vendored into source trees
+// from the version macros their headers define.
+package vendoredheaders
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"slices"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+// Name is the unique name of this extractor.
+const Name = "cpp/vendoredheaders"
+
+var (
+	// defineRe matches object-like macro definitions, e.g.
+	// `#define ZLIB_VERSION "1.2.11"`.
+	defineRe = regexp.MustCompile(`^\s*#\s*define\s+(\w+)\s+(.+)$`)
+	// versionRe matches the version in a macro value, e.g. 1.1.1w in
+	// "OpenSSL 1.1.1w  11 Sep 2023".
+	versionRe = regexp.MustCompile(`\d+(?:\.\d+)+[a-z]?`)
+)
+
+// Library describes how to find the version of a vendored library.
+type Library struct {
+	// Name of the library, used as the inventory name.
+	Name string
+	// FileName is the base name of the header defining the version macro.
+	FileName string
+	// Macro is the name of the macro whose value holds the version.
+	Macro string
+	// CPE is the vendor and product of the library's CPE separated by a colon,
+	// e.g. "zlib:zlib". Empty if the library has no CPE.
+	CPE string
+}
+
+// builtinLibraries are the libraries the extractor knows about by default.
+// Libraries with several entries are reported for the first matching one.
+var builtinLibraries = []Library{
+	{Name: "zlib", FileName: "zlib.h", Macro: "ZLIB_VERSION", CPE: "zlib:zlib"},
+	{Name: "openssl", FileName: "opensslv.h", Macro: "OPENSSL_VERSION_STR", CPE: "openssl:openssl"},
+	{Name: "openssl", FileName: "opensslv.h", Macro: "OPENSSL_VERSION_TEXT", CPE: "openssl:openssl"},
+	{Name: "libpng", FileName: "png.h", Macro: "PNG_LIBPNG_VER_STRING", CPE: "libpng:libpng"},
+	{Name: "sqlite", FileName: "sqlite3.h", Macro: "SQLITE_VERSION", CPE: "sqlite:sqlite"},
+	{Name: "curl", FileName: "curlver.h", Macro: "LIBCURL_VERSION", CPE: "haxx:libcurl"},
+	{Name: "libxml2", FileName: "xmlversion.h", Macro: "LIBXML_DOTTED_VERSION", CPE: "xmlsoft:libxml2"},
+	{Name: "libjpeg-turbo", FileName: "jconfig.h", Macro: "LIBJPEG_TURBO_VERSION", CPE: "libjpeg-turbo:libjpeg-turbo"},
+	{Name: "mbedtls", FileName: "build_info.h", Macro: "MBEDTLS_VERSION_STRING", CPE: "arm:mbed_tls"},
+	{Name: "mbedtls", FileName: "version.h", Macro: "MBEDTLS_VERSION_STRING", CPE: "arm:mbed_tls"},
+}
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Libraries to look for in addition to the built-in ones.
+	Libraries []Library
+}
+
+// DefaultConfig returns the default configuration for the extractor.
+func DefaultConfig() Config {
+	return Config{}
+}
+
+// Metadata holds additional information about a vendored library.
+type Metadata struct {
+	// Macro is the name of the macro the version was read from.
+	Macro string `json:"macro"`
+	// CPE of the library in the CPE 2.3 formatted string binding, if known.
+	CPE string `json:"cpe,omitempty"`
+}
+
+// Extractor extracts vendored C/C++ libraries from their headers.
+type Extractor struct {
+	// libraries by header file name.
+	libraries map[string][]Library
+}
+
+// New returns a vendored C/C++ library extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	libraries := make(map[string][]Library)
+	for _, l := range slices.Concat(builtinLibraries, cfg.Libraries) {
+		libraries[l.FileName] = append(libraries[l.FileName], l)
+	}
+	return &Extractor{libraries: libraries}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileHints returns the header file names the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	names := make([]string, 0, len(e.libraries))
+	for n := range e.libraries {
+		names = append(names, n)
+	}
+	slices.Sort(names)
+	return filesystem.FileHints{BaseNames: names}
+}
+
+// FileRequired returns true for headers of known libraries.
+func (e Extractor) FileRequired(path string, _ fs.FileInfo) bool {
+	_, ok := e.libraries[filepath.Base(path)]
+	return ok
+}
+
+// Extract returns the libraries whose version macros the header defines. They
+// are reported with ConfidenceMedium.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	libraries := e.libraries[filepath.Base(input.Path)]
+	macros := make(map[string]string)
+	s := bufio.NewScanner(input.Reader)
+	for s.Scan() {
+		if m := defineRe.FindStringSubmatch(s.Text()); m != nil {
+			macros[m[1]] = m[2]
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+
+	result := []*extractor.Inventory{}
+	found := make(map[string]bool)
+	for _, l := range libraries {
+		if found[l.Name] {
+			continue
+		}
+		version := versionRe.FindString(macros[l.Macro])
+		if version == "" {
+			continue
+		}
+		found[l.Name] = true
+		m := &Metadata{Macro: l.Macro}
+		if l.CPE != "" {
+			m.CPE = fmt.Sprintf("cpe:2.3:a:%s:%s:*:*:*:*:*:*:*", l.CPE, version)
+		}
+		result = append(result, &extractor.Inventory{
+			Name:      l.Name,
+			Version:   version,
+			Locations: extractor.LocationsFromPaths(input.Path),
+			Metadata:  m,
+			// Headers can be stale copies or belong to a different library
+			// with the same file name.
+			Confidence: extractor.ConfidenceMedium,
+		})
+	}
+	return result, nil
+}
+
+// ToPURL converts an inventory created by this extractor into a generic PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return extractor.PURLFromInventory(purl.TypeGeneric, i)
+}
+
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeGeneric} }
+
+// ToCPEs returns the CPE of the library, if known.
+func (e Extractor) ToCPEs(i *extractor.Inventory) []string {
+	if m, ok := i.Metadata.(*Metadata); ok && m.CPE != "" {
+		return []string{m.CPE}
+	}
+	return nil
+}
+
+// Ecosystem returns no ecosystem since OSV doesn't track vendored C/C++
+// libraries by version.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
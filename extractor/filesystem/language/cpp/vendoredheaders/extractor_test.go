@@ -0,0 +1,140 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vendoredheaders_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/cpp/vendoredheaders"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/testing/extracttest"
+)
+
+var fooLibrary = vendoredheaders.Library{Name: "foo", FileName: "foo_version.h", Macro: "FOO_VERSION"}
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "third_party/zlib/zlib.h", want: true},
+		{path: "include/openssl/opensslv.h", want: true},
+		{path: "sqlite3.h", want: true},
+		{path: "custom/foo_version.h", want: true},
+		{path: "zlib.c", want: false},
+		{path: "myzlib.h", want: false},
+	}
+	e := vendoredheaders.New(vendoredheaders.Config{Libraries: []vendoredheaders.Library{fooLibrary}})
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := e.FileRequired(tt.path, nil); got != tt.want {
+				t.Errorf("FileRequired(%s) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+	if vendoredheaders.New(vendoredheaders.DefaultConfig()).FileRequired("custom/foo_version.h", nil) {
+		t.Errorf("FileRequired(custom/foo_version.h) = true without configured library, want false")
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name:        "vendored zlib",
+			InputConfig: extracttest.ScanInputMockConfig{Path: "testdata/third_party/zlib/zlib.h"},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "zlib",
+				Version:   "1.2.11",
+				Locations: extractor.LocationsFromPaths("testdata/third_party/zlib/zlib.h"),
+				Metadata: &vendoredheaders.Metadata{
+					Macro: "ZLIB_VERSION",
+					CPE:   "cpe:2.3:a:zlib:zlib:1.2.11:*:*:*:*:*:*:*",
+				},
+				Confidence: extractor.ConfidenceMedium,
+			}},
+		},
+		{
+			Name:        "library with several version macros is reported once",
+			InputConfig: extracttest.ScanInputMockConfig{Path: "testdata/openssl/opensslv.h"},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "openssl",
+				Version:   "3.0.13",
+				Locations: extractor.LocationsFromPaths("testdata/openssl/opensslv.h"),
+				Metadata: &vendoredheaders.Metadata{
+					Macro: "OPENSSL_VERSION_STR",
+					CPE:   "cpe:2.3:a:openssl:openssl:3.0.13:*:*:*:*:*:*:*",
+				},
+				Confidence: extractor.ConfidenceMedium,
+			}},
+		},
+		{
+			Name:        "configured library without CPE",
+			InputConfig: extracttest.ScanInputMockConfig{Path: "testdata/custom/foo_version.h"},
+			WantInventory: []*extractor.Inventory{{
+				Name:       "foo",
+				Version:    "2.4.1",
+				Locations:  extractor.LocationsFromPaths("testdata/custom/foo_version.h"),
+				Metadata:   &vendoredheaders.Metadata{Macro: "FOO_VERSION"},
+				Confidence: extractor.ConfidenceMedium,
+			}},
+		},
+		{
+			Name:          "no version macro",
+			InputConfig:   extracttest.ScanInputMockConfig{Path: "testdata/nomacro/zlib.h"},
+			WantInventory: []*extractor.Inventory{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := vendoredheaders.New(vendoredheaders.Config{Libraries: []vendoredheaders.Library{fooLibrary}})
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+			if err != nil {
+				t.Fatalf("%s.Extract(%q) unexpected error: %v", extr.Name(), tt.InputConfig.Path, err)
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	i := &extractor.Inventory{Name: "zlib", Version: "1.2.11", Metadata: &vendoredheaders.Metadata{Macro: "ZLIB_VERSION"}}
+	want := &purl.PackageURL{Type: purl.TypeGeneric, Name: "zlib", Version: "1.2.11"}
+	if diff := cmp.Diff(want, vendoredheaders.New(vendoredheaders.DefaultConfig()).ToPURL(i)); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
+
+func TestToCPEs(t *testing.T) {
+	e := vendoredheaders.New(vendoredheaders.DefaultConfig())
+	withCPE := &extractor.Inventory{Metadata: &vendoredheaders.Metadata{CPE: "cpe:2.3:a:zlib:zlib:1.2.11:*:*:*:*:*:*:*"}}
+	if diff := cmp.Diff([]string{"cpe:2.3:a:zlib:zlib:1.2.11:*:*:*:*:*:*:*"}, e.ToCPEs(withCPE)); diff != "" {
+		t.Errorf("ToCPEs(%v) (-want +got):\n%s", withCPE, diff)
+	}
+	withoutCPE := &extractor.Inventory{Metadata: &vendoredheaders.Metadata{}}
+	if got := e.ToCPEs(withoutCPE); got != nil {
+		t.Errorf("ToCPEs(%v) = %v, want nil", withoutCPE, got)
+	}
+}
@@ -0,0 +1,3 @@
+#pragma once
+
+#define FOO_VERSION "2.4.1"
@@ -0,0 +1,7 @@
+/* A header that happens to be called zlib.h without defining a version. */
+#ifndef ZLIB_H
+#define ZLIB_H
+
+int compress(char *dest, const char *source);
+
+#endif
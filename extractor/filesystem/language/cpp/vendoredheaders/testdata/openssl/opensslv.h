@@ -0,0 +1,16 @@
+#ifndef OPENSSL_OPENSSLV_H
+# define OPENSSL_OPENSSLV_H
+# pragma once
+
+# define OPENSSL_VERSION_MAJOR  3
+# define OPENSSL_VERSION_MINOR  0
+# define OPENSSL_VERSION_PATCH  13
+
+# define OPENSSL_VERSION_STR "3.0.13"
+# define OPENSSL_FULL_VERSION_STR "3.0.13"
+
+# define OPENSSL_RELEASE_DATE "30 Jan 2024"
+
+# define OPENSSL_VERSION_TEXT "OpenSSL 3.0.13 30 Jan 2024"
+
+#endif /* OPENSSL_OPENSSLV_H */
@@ -77,6 +77,11 @@ func (e Extractor) Requirements() *plugin.Capabilities {
 	return &plugin.Capabilities{}
 }
 
+// FileHints returns the file names the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{BaseNames: []string{"pubspec.lock"}}
+}
+
 // FileRequired returns true if the specified file is a pubspec.lock
 func (e Extractor) FileRequired(path string, fileInfo fs.FileInfo) bool {
 	return filepath.Base(path) == "pubspec.lock"
@@ -95,7 +100,7 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 		pkgDetails := &extractor.Inventory{
 			Name:      name,
 			Version:   pkg.Version,
-			Locations: []string{input.Path},
+			Locations: extractor.DeclaredLocations(input.Path),
 			SourceCode: &extractor.SourceCodeIdentifier{
 				Commit: pkg.Description.Ref,
 			},
@@ -122,6 +127,9 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	}
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypePub} }
+
 // Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
 func (e Extractor) Ecosystem(i *extractor.Inventory) string { return "Pub" }
 
@@ -100,7 +100,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "back_button_interceptor",
 					Version:   "6.0.1",
-					Locations: []string{"testdata/one-package.lock"},
+					Locations: extractor.DeclaredLocations("testdata/one-package.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -117,7 +117,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "build_runner",
 					Version:   "2.2.1",
-					Locations: []string{"testdata/one-package-dev.lock"},
+					Locations: extractor.DeclaredLocations("testdata/one-package-dev.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -136,7 +136,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "shelf",
 					Version:   "1.3.2",
-					Locations: []string{"testdata/two-packages.lock"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -145,7 +145,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "shelf_web_socket",
 					Version:   "1.0.2",
-					Locations: []string{"testdata/two-packages.lock"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -162,7 +162,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "back_button_interceptor",
 					Version:   "6.0.1",
-					Locations: []string{"testdata/mixed-packages.lock"},
+					Locations: extractor.DeclaredLocations("testdata/mixed-packages.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -171,7 +171,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "build_runner",
 					Version:   "2.2.1",
-					Locations: []string{"testdata/mixed-packages.lock"},
+					Locations: extractor.DeclaredLocations("testdata/mixed-packages.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -182,7 +182,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "shelf",
 					Version:   "1.3.2",
-					Locations: []string{"testdata/mixed-packages.lock"},
+					Locations: extractor.DeclaredLocations("testdata/mixed-packages.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -191,7 +191,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "shelf_web_socket",
 					Version:   "1.0.2",
-					Locations: []string{"testdata/mixed-packages.lock"},
+					Locations: extractor.DeclaredLocations("testdata/mixed-packages.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -208,7 +208,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "flutter_rust_bridge",
 					Version:   "1.32.0",
-					Locations: []string{"testdata/source-git.lock"},
+					Locations: extractor.DeclaredLocations("testdata/source-git.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "e5adce55eea0b74d3680e66a2c5252edf17b07e1",
 					},
@@ -217,7 +217,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "screen_retriever",
 					Version:   "0.1.2",
-					Locations: []string{"testdata/source-git.lock"},
+					Locations: extractor.DeclaredLocations("testdata/source-git.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "406b9b038b2c1d779f1e7bf609c8c248be247372",
 					},
@@ -226,7 +226,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "tray_manager",
 					Version:   "0.1.8",
-					Locations: []string{"testdata/source-git.lock"},
+					Locations: extractor.DeclaredLocations("testdata/source-git.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "3aa37c86e47ea748e7b5507cbe59f2c54ebdb23a",
 					},
@@ -235,7 +235,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "window_manager",
 					Version:   "0.2.7",
-					Locations: []string{"testdata/source-git.lock"},
+					Locations: extractor.DeclaredLocations("testdata/source-git.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "88487257cbafc501599ab4f82ec343b46acec020",
 					},
@@ -244,7 +244,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "toggle_switch",
 					Version:   "1.4.0",
-					Locations: []string{"testdata/source-git.lock"},
+					Locations: extractor.DeclaredLocations("testdata/source-git.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -261,7 +261,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "flutter_web_plugins",
 					Version:   "0.0.0",
-					Locations: []string{"testdata/source-sdk.lock"},
+					Locations: extractor.DeclaredLocations("testdata/source-sdk.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -278,7 +278,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "maa_core",
 					Version:   "0.0.1",
-					Locations: []string{"testdata/source-path.lock"},
+					Locations: extractor.DeclaredLocations("testdata/source-path.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -0,0 +1,273 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package depsjson extracts the NuGet packages and runtime assemblies of
+// published .NET apps from their <app>.deps.json files.
+package depsjson
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+// Name is the unique name of this extractor.
+const Name = "dotnet/depsjson"
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// ReportAssemblies makes the extractor also report the assembly files the
+	// app ships, as listed by the runtime entries of the runtime target, in
+	// addition to the NuGet packages.
+	ReportAssemblies bool
+}
+
+// DefaultConfig returns the default configuration for the extractor.
+func DefaultConfig() Config {
+	return Config{}
+}
+
+// AssemblyMetadata holds additional information about an assembly file
+// shipped with an app. Package inventory has no metadata.
+type AssemblyMetadata struct {
+	// Path of the assembly inside the library, e.g.
+	// "lib/net6.0/Newtonsoft.Json.dll".
+	Path string `json:"path"`
+	// AssemblyVersion is the version the runtime binds the assembly by, e.g.
+	// "13.0.0.0".
+	AssemblyVersion string `json:"assemblyVersion,omitempty"`
+	// FileVersion is the version of the assembly file, e.g. "13.0.1.25517".
+	FileVersion string `json:"fileVersion,omitempty"`
+	// Library and LibraryVersion identify the NuGet package or project that
+	// provides the assembly.
+	Library        string `json:"library"`
+	LibraryVersion string `json:"libraryVersion"`
+}
+
+type depsJSON struct {
+	RuntimeTarget struct {
+		Name string `json:"name"`
+	} `json:"runtimeTarget"`
+	Targets   map[string]map[string]targetLibrary `json:"targets"`
+	Libraries map[string]library                  `json:"libraries"`
+}
+
+type targetLibrary struct {
+	Dependencies map[string]string          `json:"dependencies"`
+	Runtime      map[string]runtimeAssembly `json:"runtime"`
+}
+
+type runtimeAssembly struct {
+	AssemblyVersion string `json:"assemblyVersion"`
+	FileVersion     string `json:"fileVersion"`
+}
+
+type library struct {
+	Type string `json:"type"`
+}
+
+// Extractor extracts the NuGet packages and, optionally, the runtime
+// assemblies of published .NET apps.
+type Extractor struct {
+	reportAssemblies bool
+}
+
+// New returns a deps.json extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{reportAssemblies: cfg.ReportAssemblies}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileHints returns the file extensions the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{Extensions: []string{".json"}}
+}
+
+// FileRequired returns true for <app>.deps.json files.
+func (e Extractor) FileRequired(path string, _ fs.FileInfo) bool {
+	return strings.HasSuffix(strings.ToLower(filepath.Base(path)), ".deps.json")
+}
+
+// Extract returns the NuGet packages the app depends on, sorted by name, each
+// linked to the packages it depends on with a RelationshipDependsOn. If
+// assemblies are reported too, they follow the packages, sorted by library
+// and path. Their locations are the assembly files next to the deps.json
+// file, where publishing puts them, followed by the deps.json file itself.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	var deps depsJSON
+	if err := json.NewDecoder(input.Reader).Decode(&deps); err != nil {
+		return nil, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+
+	keys := make([]string, 0, len(deps.Libraries))
+	for k := range deps.Libraries {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	res := []*extractor.Inventory{}
+	packages := make(map[string]*extractor.Inventory)
+	for _, k := range keys {
+		name, version, ok := strings.Cut(k, "/")
+		if !ok || deps.Libraries[k].Type != "package" {
+			continue
+		}
+		i := &extractor.Inventory{
+			Name:      name,
+			Version:   version,
+			Locations: []extractor.Location{{Path: input.Path, Reason: extractor.LocationDeclared}},
+		}
+		packages[k] = i
+		res = append(res, i)
+	}
+	target := runtimeTarget(&deps)
+	linkDependencies(&deps, target, packages)
+	if !e.reportAssemblies {
+		return res, nil
+	}
+
+	dir := path.Dir(filepath.ToSlash(input.Path))
+	for _, k := range keys {
+		name, version, ok := strings.Cut(k, "/")
+		if !ok {
+			continue
+		}
+		runtime := target[k].Runtime
+		paths := make([]string, 0, len(runtime))
+		for p := range runtime {
+			paths = append(paths, p)
+		}
+		slices.Sort(paths)
+		for _, p := range paths {
+			a := runtime[p]
+			file := path.Base(p)
+			res = append(res, &extractor.Inventory{
+				Name:    strings.TrimSuffix(file, path.Ext(file)),
+				Version: cmp.Or(a.FileVersion, a.AssemblyVersion),
+				Locations: []extractor.Location{
+					{Path: path.Join(dir, file), Reason: extractor.LocationInstalled},
+					{Path: input.Path, Reason: extractor.LocationDeclared},
+				},
+				Metadata: &AssemblyMetadata{
+					Path:            p,
+					AssemblyVersion: a.AssemblyVersion,
+					FileVersion:     a.FileVersion,
+					Library:         name,
+					LibraryVersion:  version,
+				},
+			})
+		}
+	}
+	return res, nil
+}
+
+// runtimeTarget returns the libraries of the target the app runs on. Older
+// files have no runtimeTarget entry but only list that one target.
+func runtimeTarget(deps *depsJSON) map[string]targetLibrary {
+	if deps.RuntimeTarget.Name != "" {
+		return deps.Targets[deps.RuntimeTarget.Name]
+	}
+	if len(deps.Targets) == 1 {
+		for _, t := range deps.Targets {
+			return t
+		}
+	}
+	return nil
+}
+
+// linkDependencies links each package to the packages it depends on. The
+// dependencies are taken from the runtime target, which, unlike the target
+// that's only used for compiling, reflects the packages resolved for the
+// platform the app runs on. Libraries the runtime target doesn't list fall
+// back to the first other target that does.
+func linkDependencies(deps *depsJSON, runtime map[string]targetLibrary, packages map[string]*extractor.Inventory) {
+	targets := make([]string, 0, len(deps.Targets))
+	for t := range deps.Targets {
+		targets = append(targets, t)
+	}
+	slices.Sort(targets)
+
+	for k, i := range packages {
+		lib, ok := runtime[k]
+		for _, t := range targets {
+			if ok {
+				break
+			}
+			lib, ok = deps.Targets[t][k]
+		}
+		names := make([]string, 0, len(lib.Dependencies))
+		for n := range lib.Dependencies {
+			names = append(names, n)
+		}
+		slices.Sort(names)
+		for _, n := range names {
+			// Dependencies on projects or on packages the app doesn't ship
+			// aren't inventory.
+			if dep, ok := packages[n+"/"+lib.Dependencies[n]]; ok {
+				i.Relationships = append(i.Relationships, extractor.Relationship{Type: extractor.RelationshipDependsOn, Other: dep})
+			}
+		}
+	}
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL: a NuGet
+// PURL for packages and a generic one for assemblies.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	if _, ok := i.Metadata.(*AssemblyMetadata); ok {
+		return extractor.PURLFromInventory(purl.TypeGeneric, i)
+	}
+	return &purl.PackageURL{
+		Type:    purl.TypeNuget,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeNuget, purl.TypeGeneric} }
+
+// Ecosystem returns the OSV ecosystem ('NuGet') of packages. Assemblies have
+// none since their versions differ from the ones of the packages.
+func (e Extractor) Ecosystem(i *extractor.Inventory) string {
+	if _, ok := i.Metadata.(*AssemblyMetadata); ok {
+		return ""
+	}
+	return "NuGet"
+}
+
+var _ filesystem.Extractor = Extractor{}
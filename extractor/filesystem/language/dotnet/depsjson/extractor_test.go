@@ -0,0 +1,237 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package depsjson_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/depsjson"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/testing/extracttest"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "app/MyApp.deps.json", want: true},
+		{path: "MyApp.Deps.json", want: true},
+		{path: "app/MyApp.runtimeconfig.json", want: false},
+		{path: "app/deps.json.bak", want: false},
+		{path: "app/packages.lock.json", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := depsjson.New(depsjson.DefaultConfig()).FileRequired(tt.path, nil); got != tt.want {
+				t.Errorf("FileRequired(%s) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	myApp := "testdata/MyApp.deps.json"
+	legacy := "testdata/Legacy.deps.json"
+	declared := func(path string) []extractor.Location {
+		return []extractor.Location{{Path: path, Reason: extractor.LocationDeclared}}
+	}
+	assembly := func(path, file string) []extractor.Location {
+		return []extractor.Location{
+			{Path: "testdata/" + file, Reason: extractor.LocationInstalled},
+			{Path: path, Reason: extractor.LocationDeclared},
+		}
+	}
+	myAppPackages := []*extractor.Inventory{
+		{Name: "Newtonsoft.Json", Version: "13.0.1", Locations: declared(myApp)},
+		{Name: "Serilog", Version: "2.12.0", Locations: declared(myApp)},
+	}
+
+	tests := []struct {
+		extracttest.TestTableEntry
+		cfg depsjson.Config
+	}{
+		{
+			TestTableEntry: extracttest.TestTableEntry{
+				Name:          "packages only",
+				InputConfig:   extracttest.ScanInputMockConfig{Path: myApp},
+				WantInventory: myAppPackages,
+			},
+			cfg: depsjson.DefaultConfig(),
+		},
+		{
+			TestTableEntry: extracttest.TestTableEntry{
+				Name:        "packages and assemblies",
+				InputConfig: extracttest.ScanInputMockConfig{Path: myApp},
+				WantInventory: append(append([]*extractor.Inventory{}, myAppPackages...),
+					&extractor.Inventory{
+						Name:      "MyApp",
+						Locations: assembly(myApp, "MyApp.dll"),
+						Metadata: &depsjson.AssemblyMetadata{
+							Path:           "MyApp.dll",
+							Library:        "MyApp",
+							LibraryVersion: "1.0.0",
+						},
+					},
+					&extractor.Inventory{
+						Name:      "Newtonsoft.Json",
+						Version:   "13.0.1.25517",
+						Locations: assembly(myApp, "Newtonsoft.Json.dll"),
+						Metadata: &depsjson.AssemblyMetadata{
+							Path:            "lib/netstandard2.0/Newtonsoft.Json.dll",
+							AssemblyVersion: "13.0.0.0",
+							FileVersion:     "13.0.1.25517",
+							Library:         "Newtonsoft.Json",
+							LibraryVersion:  "13.0.1",
+						},
+					},
+					&extractor.Inventory{
+						Name:      "Serilog.Extensions",
+						Version:   "2.12.0.0",
+						Locations: assembly(myApp, "Serilog.Extensions.dll"),
+						Metadata: &depsjson.AssemblyMetadata{
+							Path:            "lib/net5.0/Serilog.Extensions.dll",
+							AssemblyVersion: "2.0.0.0",
+							FileVersion:     "2.12.0.0",
+							Library:         "Serilog",
+							LibraryVersion:  "2.12.0",
+						},
+					},
+					&extractor.Inventory{
+						Name:      "Serilog",
+						Version:   "2.0.0.0",
+						Locations: assembly(myApp, "Serilog.dll"),
+						Metadata: &depsjson.AssemblyMetadata{
+							Path:            "lib/net5.0/Serilog.dll",
+							AssemblyVersion: "2.0.0.0",
+							Library:         "Serilog",
+							LibraryVersion:  "2.12.0",
+						},
+					},
+				),
+			},
+			cfg: depsjson.Config{ReportAssemblies: true},
+		},
+		{
+			TestTableEntry: extracttest.TestTableEntry{
+				Name:        "no runtime target",
+				InputConfig: extracttest.ScanInputMockConfig{Path: legacy},
+				WantInventory: []*extractor.Inventory{
+					{Name: "Dapper", Version: "1.50.5", Locations: declared(legacy)},
+					{
+						Name:      "Dapper",
+						Version:   "1.50.5.0",
+						Locations: assembly(legacy, "Dapper.dll"),
+						Metadata: &depsjson.AssemblyMetadata{
+							Path:            "lib/netstandard2.0/Dapper.dll",
+							AssemblyVersion: "1.50.5.0",
+							FileVersion:     "1.50.5.0",
+							Library:         "Dapper",
+							LibraryVersion:  "1.50.5",
+						},
+					},
+					{
+						Name:      "Legacy",
+						Locations: assembly(legacy, "Legacy.dll"),
+						Metadata: &depsjson.AssemblyMetadata{
+							Path:           "Legacy.dll",
+							Library:        "Legacy",
+							LibraryVersion: "1.0.0",
+						},
+					},
+				},
+			},
+			cfg: depsjson.Config{ReportAssemblies: true},
+		},
+		{
+			TestTableEntry: extracttest.TestTableEntry{
+				Name:        "invalid json",
+				InputConfig: extracttest.ScanInputMockConfig{Path: "testdata/Invalid.deps.json"},
+				WantErr:     extracttest.ContainsErrStr{Str: "could not extract from"},
+			},
+			cfg: depsjson.DefaultConfig(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := depsjson.New(tt.cfg)
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+			if !cmp.Equal(err, tt.WantErr, cmpopts.EquateErrors()) {
+				t.Fatalf("%s.Extract(%q) error diff: got %v, want %v", extr.Name(), tt.InputConfig.Path, err, tt.WantErr)
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestExtractDependencies(t *testing.T) {
+	// The runtime target lists System.Text.Json 8.0.4 as the dependency of the
+	// logging abstractions while the compile target still lists 8.0.0.
+	// System.Text.Json itself is only listed by the compile target.
+	path := "testdata/Transitive.deps.json"
+	scanInput := extracttest.GenerateScanInputMock(t, extracttest.ScanInputMockConfig{Path: path})
+	defer extracttest.CloseTestScanInput(t, scanInput)
+
+	extr := depsjson.New(depsjson.DefaultConfig())
+	got, err := extr.Extract(context.Background(), &scanInput)
+	if err != nil {
+		t.Fatalf("%s.Extract(%q): %v", extr.Name(), path, err)
+	}
+
+	gotDeps := make(map[string][]string)
+	for _, i := range got {
+		deps := []string{}
+		for _, r := range i.Relationships {
+			if r.Type != extractor.RelationshipDependsOn {
+				t.Errorf("%s.Extract(%q): %s has relationship of type %q, want %q", extr.Name(), path, i.Name, r.Type, extractor.RelationshipDependsOn)
+			}
+			deps = append(deps, r.Other.Name+"@"+r.Other.Version)
+		}
+		gotDeps[i.Name+"@"+i.Version] = deps
+	}
+	wantDeps := map[string][]string{
+		"Microsoft.Extensions.Logging@8.0.0":              {"Microsoft.Extensions.Logging.Abstractions@8.0.0"},
+		"Microsoft.Extensions.Logging.Abstractions@8.0.0": {"System.Text.Json@8.0.4"},
+		"System.Text.Json@8.0.4":                          {"System.Text.Encodings.Web@8.0.0"},
+		"System.Text.Encodings.Web@8.0.0":                 {},
+	}
+	if diff := cmp.Diff(wantDeps, gotDeps); diff != "" {
+		t.Errorf("%s.Extract(%q) dependencies (-want +got):\n%s", extr.Name(), path, diff)
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := depsjson.New(depsjson.DefaultConfig())
+	pkg := &extractor.Inventory{Name: "Newtonsoft.Json", Version: "13.0.1"}
+	if diff := cmp.Diff(&purl.PackageURL{Type: purl.TypeNuget, Name: "Newtonsoft.Json", Version: "13.0.1"}, e.ToPURL(pkg)); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", pkg, diff)
+	}
+	asm := &extractor.Inventory{Name: "Newtonsoft.Json", Version: "13.0.1.25517", Metadata: &depsjson.AssemblyMetadata{}}
+	if diff := cmp.Diff(&purl.PackageURL{Type: purl.TypeGeneric, Name: "Newtonsoft.Json", Version: "13.0.1.25517"}, e.ToPURL(asm)); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", asm, diff)
+	}
+}
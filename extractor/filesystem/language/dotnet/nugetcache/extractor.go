@@ -0,0 +1,296 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package nugetcache extracts packages restored into the NuGet global packages
+// cache (~/.nuget/packages).
+package nugetcache
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/nupkg"
+	"github.com/google/osv-scalibr/log"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "dotnet/nugetcache"
+
+	// NuGet writes this file once a package is fully extracted into the cache.
+	hashFileSuffix = ".nupkg.sha512"
+	// Written alongside the hash file by NuGet 5 and later.
+	metadataFileName = ".nupkg.metadata"
+	// The package manifest NuGet extracts next to the hash file.
+	nuspecSuffix = ".nuspec"
+
+	// defaultMaxFileSizeBytes is the default maximum size of the hash file the
+	// extractor will read. A base64 encoded SHA-512 hash is 88 bytes.
+	defaultMaxFileSizeBytes = 1 * units.KiB
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of the hash file this extractor will
+	// read. If `FileRequired` gets a bigger file, it will return false.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+}
+
+// Extractor extracts packages from the NuGet global packages cache, which is
+// laid out as .nuget/packages/<id>/<version>/<id>.<version>.nupkg.sha512
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns a NuGet cache extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// nupkgMetadata is the content of a .nupkg.metadata file.
+type nupkgMetadata struct {
+	ContentHash string `json:"contentHash"`
+}
+
+// nuspec is the part of the package manifest the extractor needs.
+type nuspec struct {
+	Metadata struct {
+		ID      string        `xml:"id"`
+		Authors string        `xml:"authors"`
+		License nupkg.License `xml:"license"`
+	} `xml:"metadata"`
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileHints returns the file extensions the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{Extensions: []string{hashFileSuffix}}
+}
+
+// FileRequired returns true if the specified file is the hash file of a
+// package in the NuGet global packages cache.
+func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
+	if !e.fileMatches(path, fileinfo) {
+		return false
+	}
+
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// FileSizeLimitExceeded returns true if the file would be required if it
+// didn't exceed the maximum file size.
+func (e Extractor) FileSizeLimitExceeded(path string, fileinfo fs.FileInfo) bool {
+	return e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes && e.fileMatches(path, fileinfo)
+}
+
+// fileMatches returns true if the file is one the extractor is interested in,
+// regardless of its size.
+func (e Extractor) fileMatches(path string, fileinfo fs.FileInfo) bool {
+	_, _, ok := parseCachePath(path)
+	return ok
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Extractor:     e.Name(),
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// parseCachePath returns the package ID and version directory names if path
+// points to the hash file of a cached package.
+func parseCachePath(path string) (id, version string, ok bool) {
+	parts := strings.Split(filepath.ToSlash(path), "/")
+	if len(parts) < 5 {
+		return "", "", false
+	}
+	parts = parts[len(parts)-5:]
+	if parts[0] != ".nuget" || parts[1] != "packages" {
+		return "", "", false
+	}
+	id, version = parts[2], parts[3]
+	if !strings.EqualFold(parts[4], id+"."+version+hashFileSuffix) {
+		return "", "", false
+	}
+	return id, version, true
+}
+
+// Extract returns the cached package whose hash file is passed through the scan input.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inventory, err := e.extractFromInput(input)
+	if e.stats != nil {
+		var fileSizeBytes int64
+		if input.Info != nil {
+			fileSizeBytes = input.Info.Size()
+		}
+		e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+			Path:          input.Path,
+			Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+			FileSizeBytes: fileSizeBytes,
+		})
+	}
+	return inventory, err
+}
+
+func (e Extractor) extractFromInput(input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	id, version, ok := parseCachePath(input.Path)
+	if !ok {
+		return nil, fmt.Errorf("%s is not in the NuGet cache layout", input.Path)
+	}
+
+	hash, err := io.ReadAll(input.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s: %w", input.Path, err)
+	}
+	m := &Metadata{ContentHash: strings.TrimSpace(string(hash))}
+
+	dir := path.Dir(filepath.ToSlash(input.Path))
+	metadataPath := path.Join(dir, metadataFileName)
+	recorded, err := recordedContentHash(input.FS, metadataPath)
+	if err != nil {
+		return nil, err
+	}
+	if recorded != "" && recorded != m.ContentHash {
+		// Still report the package: a tampered cache entry is worth surfacing.
+		log.Warnf("content hash in %s doesn't match the cached package", metadataPath)
+		m.ContentHashMismatch = true
+	}
+
+	spec := readNuspec(input.FS, path.Join(dir, id+nuspecSuffix))
+	if spec != nil {
+		m.Authors = nupkg.SplitAuthors(spec.Metadata.Authors)
+		m.Licenses = spec.Metadata.License.Licenses()
+	}
+
+	return []*extractor.Inventory{{
+		Name:      packageID(spec, id),
+		Version:   version,
+		Ecosystem: "NuGet",
+		Metadata:  m,
+		Locations: extractor.InstalledLocations(input.Path),
+	}}, nil
+}
+
+// recordedContentHash returns the package hash recorded in the .nupkg.metadata
+// file, or "" if there is none.
+func recordedContentHash(fsys fs.FS, metadataPath string) (string, error) {
+	content, err := fs.ReadFile(fsys, metadataPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("could not read %s: %w", metadataPath, err)
+	}
+	var m nupkgMetadata
+	if err := json.Unmarshal(content, &m); err != nil {
+		return "", fmt.Errorf("could not parse %s: %w", metadataPath, err)
+	}
+	return m.ContentHash, nil
+}
+
+// readNuspec returns the package's .nuspec, or nil if it's missing or
+// unreadable.
+func readNuspec(fsys fs.FS, nuspecPath string) *nuspec {
+	f, err := fsys.Open(nuspecPath)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	var spec nuspec
+	if err := xml.NewDecoder(f).Decode(&spec); err != nil {
+		log.Debugf("could not parse %s: %v", nuspecPath, err)
+		return nil
+	}
+	return &spec
+}
+
+// packageID returns the package ID as spelled in the package's .nuspec. The
+// cache directories are lowercased, so dirID is only used if the .nuspec is
+// missing or unreadable.
+func packageID(spec *nuspec, dirID string) string {
+	if spec == nil || !strings.EqualFold(spec.Metadata.ID, dirID) {
+		return dirID
+	}
+	return spec.Metadata.ID
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:    purl.TypeNuget,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeNuget} }
+
+// Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "NuGet" }
+
+var _ filesystem.Extractor = Extractor{}
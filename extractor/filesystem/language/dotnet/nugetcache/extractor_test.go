@@ -0,0 +1,226 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nugetcache_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/nugetcache"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		want             bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "hash file in user cache",
+			path:             "home/user/.nuget/packages/newtonsoft.json/13.0.3/newtonsoft.json.13.0.3.nupkg.sha512",
+			want:             true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "hash file in relative cache",
+			path:             ".nuget/packages/serilog/3.1.1/serilog.3.1.1.nupkg.sha512",
+			want:             true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "hash file with mixed case",
+			path:             "home/user/.nuget/packages/newtonsoft.json/13.0.3/Newtonsoft.Json.13.0.3.nupkg.sha512",
+			want:             true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name: "metadata file",
+			path: "home/user/.nuget/packages/newtonsoft.json/13.0.3/.nupkg.metadata",
+			want: false,
+		},
+		{
+			name: "nuspec file",
+			path: "home/user/.nuget/packages/newtonsoft.json/13.0.3/newtonsoft.json.nuspec",
+			want: false,
+		},
+		{
+			name: "hash file not matching directory names",
+			path: "home/user/.nuget/packages/newtonsoft.json/13.0.3/serilog.3.1.1.nupkg.sha512",
+			want: false,
+		},
+		{
+			name: "hash file outside of the cache",
+			path: "home/user/packages/newtonsoft.json/13.0.3/newtonsoft.json.13.0.3.nupkg.sha512",
+			want: false,
+		},
+		{
+			name: "hash file nested too deep",
+			path: "home/user/.nuget/packages/newtonsoft.json/13.0.3/lib/newtonsoft.json.13.0.3.nupkg.sha512",
+			want: false,
+		},
+		{
+			name:             "hash file not required if file size > max file size",
+			path:             "home/user/.nuget/packages/newtonsoft.json/13.0.3/newtonsoft.json.13.0.3.nupkg.sha512",
+			fileSizeBytes:    10 * units.KiB,
+			maxFileSizeBytes: 1 * units.KiB,
+			want:             false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := nugetcache.New(nugetcache.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			// Set a default file size if not specified.
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 88
+			}
+
+			got := e.FileRequired(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			})
+			if got != tt.want {
+				t.Errorf("FileRequired(%s, FileInfo) got = %v, want %v", tt.path, got, tt.want)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "matching content hash",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         ".nuget/packages/newtonsoft.json/13.0.3/newtonsoft.json.13.0.3.nupkg.sha512",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "Newtonsoft.Json",
+					Version:   "13.0.3",
+					Ecosystem: "NuGet",
+					Metadata: &nugetcache.Metadata{
+						ContentHash: "HrC5BXdl00IP9zeV+0Z848QWPAoCr9P3bDEZguI+gkLcBKAOxix/tLEAAHC+UvDNPv4a2d18lOReHMOagPa+zQ==",
+						Authors:     []string{"James Newton-King"},
+						Licenses:    []string{"MIT"},
+					},
+					Locations: extractor.InstalledLocations(".nuget/packages/newtonsoft.json/13.0.3/newtonsoft.json.13.0.3.nupkg.sha512"),
+				},
+			},
+		},
+		{
+			Name: "no metadata file",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         ".nuget/packages/serilog/3.1.1/serilog.3.1.1.nupkg.sha512",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "serilog",
+					Version:   "3.1.1",
+					Ecosystem: "NuGet",
+					Metadata: &nugetcache.Metadata{
+						ContentHash: "gLt2NnjVzmfG6hC7E4TnXAPhKX2GRXITS9ttbDe+Vn4EaVr3TnfVt8S8Mqn0pQnqBMGPIEIbpaKeNnn5DtjbeWQ==",
+					},
+					Locations: extractor.InstalledLocations(".nuget/packages/serilog/3.1.1/serilog.3.1.1.nupkg.sha512"),
+				},
+			},
+		},
+		{
+			Name: "mismatching content hash",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         ".nuget/packages/tampered.package/1.0.0/tampered.package.1.0.0.nupkg.sha512",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "tampered.package",
+					Version:   "1.0.0",
+					Ecosystem: "NuGet",
+					Metadata: &nugetcache.Metadata{
+						ContentHash:         "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA==",
+						ContentHashMismatch: true,
+					},
+					Locations: extractor.InstalledLocations(".nuget/packages/tampered.package/1.0.0/tampered.package.1.0.0.nupkg.sha512"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := nugetcache.New(nugetcache.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := nugetcache.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "newtonsoft.json",
+		Version:   "13.0.3",
+		Locations: extractor.InstalledLocations("location"),
+	}
+	want := &purl.PackageURL{
+		Type:    purl.TypeNuget,
+		Name:    "newtonsoft.json",
+		Version: "13.0.3",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nugetcache
+
+// Metadata holds parsing information for a package in the NuGet cache.
+type Metadata struct {
+	// ContentHash is the base64 encoded SHA-512 hash of the cached package.
+	ContentHash string `json:"contentHash,omitempty"`
+	// ContentHashMismatch is true if the hash recorded in .nupkg.metadata
+	// differs from ContentHash, which indicates the cache was tampered with.
+	ContentHashMismatch bool `json:"contentHashMismatch,omitempty"`
+	// Authors are the package authors listed in the package's .nuspec, if the
+	// cache holds one.
+	Authors []string `json:"authors,omitempty"`
+	// Licenses are the licenses declared in the package's .nuspec as SPDX
+	// license expressions.
+	Licenses []string `json:"licenses,omitempty"`
+}
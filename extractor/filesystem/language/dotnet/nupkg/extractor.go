@@ -0,0 +1,306 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package nupkg extracts the .nuspec manifest of NuGet package archives (.nupkg).
+package nupkg
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/license"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "dotnet/nupkg"
+
+	// defaultMaxFileSizeBytes is the maximum file size an extractor will unmarshal.
+	// If Extract gets a bigger file, it will return an error.
+	defaultMaxFileSizeBytes = 100 * units.MiB
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum file size this extractor will unmarshal. If
+	// `FileRequired` gets a bigger file, it will return false,
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+}
+
+// Extractor extracts NuGet packages from .nupkg archives.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns a .nupkg extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// nuspec is the package manifest stored at the root of a .nupkg archive.
+type nuspec struct {
+	Metadata struct {
+		ID           string  `xml:"id"`
+		Version      string  `xml:"version"`
+		Authors      string  `xml:"authors"`
+		License      License `xml:"license"`
+		Dependencies struct {
+			// Dependencies outside of a group apply to all target frameworks.
+			Dependencies []nuspecDependency `xml:"dependency"`
+			Groups       []struct {
+				TargetFramework string             `xml:"targetFramework,attr"`
+				Dependencies    []nuspecDependency `xml:"dependency"`
+			} `xml:"group"`
+		} `xml:"dependencies"`
+	} `xml:"metadata"`
+}
+
+type nuspecDependency struct {
+	ID      string `xml:"id,attr"`
+	Version string `xml:"version,attr"`
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileHints returns the file extensions the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{Extensions: []string{".nupkg"}}
+}
+
+// FileRequired returns true if the specified file is a .nupkg archive.
+func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
+	if !e.fileMatches(path, fileinfo) {
+		return false
+	}
+
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// FileSizeLimitExceeded returns true if the file would be required if it
+// didn't exceed the maximum file size.
+func (e Extractor) FileSizeLimitExceeded(path string, fileinfo fs.FileInfo) bool {
+	return e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes && e.fileMatches(path, fileinfo)
+}
+
+// fileMatches returns true if the file is one the extractor is interested in,
+// regardless of its size.
+func (e Extractor) fileMatches(path string, fileinfo fs.FileInfo) bool {
+	return strings.EqualFold(filepath.Ext(path), ".nupkg")
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Extractor:     e.Name(),
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// Extract returns the package described by the .nuspec inside the .nupkg archive.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inventory, err := e.extractFromInput(input)
+	if e.stats != nil {
+		var fileSizeBytes int64
+		if input.Info != nil {
+			fileSizeBytes = input.Info.Size()
+		}
+		e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+			Path:          input.Path,
+			Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+			FileSizeBytes: fileSizeBytes,
+		})
+	}
+	return inventory, err
+}
+
+func (e Extractor) extractFromInput(input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	r, ok := input.Reader.(io.ReaderAt)
+	var size int64
+	if input.Info != nil {
+		size = input.Info.Size()
+	}
+	if !ok || input.Info == nil {
+		b, err := io.ReadAll(input.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", input.Path, err)
+		}
+		r = bytes.NewReader(b)
+		size = int64(len(b))
+	}
+
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("invalid archive %s: %w", input.Path, err)
+	}
+
+	spec, err := readNuspec(zr)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", input.Path, err)
+	}
+	if spec.Metadata.ID == "" || spec.Metadata.Version == "" {
+		return nil, fmt.Errorf("%s: .nuspec is missing the package id or version", input.Path)
+	}
+
+	m := &Metadata{
+		Authors:  SplitAuthors(spec.Metadata.Authors),
+		Licenses: spec.Metadata.License.Licenses(),
+	}
+	deps := spec.Metadata.Dependencies
+	if len(deps.Dependencies) > 0 {
+		m.DependencyGroups = append(m.DependencyGroups, DependencyGroup{
+			Dependencies: toDependencies(deps.Dependencies),
+		})
+	}
+	for _, g := range deps.Groups {
+		m.DependencyGroups = append(m.DependencyGroups, DependencyGroup{
+			TargetFramework: g.TargetFramework,
+			Dependencies:    toDependencies(g.Dependencies),
+		})
+	}
+
+	return []*extractor.Inventory{{
+		Name:      spec.Metadata.ID,
+		Version:   spec.Metadata.Version,
+		Ecosystem: "NuGet",
+		Metadata:  m,
+		Locations: extractor.InstalledLocations(input.Path),
+	}}, nil
+}
+
+// readNuspec parses the .nuspec file at the root of the archive.
+func readNuspec(zr *zip.Reader) (*nuspec, error) {
+	for _, f := range zr.File {
+		if strings.Contains(f.Name, "/") || !strings.EqualFold(filepath.Ext(f.Name), ".nuspec") {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s: %w", f.Name, err)
+		}
+		defer rc.Close()
+
+		spec := &nuspec{}
+		if err := xml.NewDecoder(rc).Decode(spec); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", f.Name, err)
+		}
+		return spec, nil
+	}
+	return nil, errors.New("no .nuspec found in archive")
+}
+
+// License is the <license> element of a .nuspec.
+type License struct {
+	// Type is "expression" for SPDX license expressions and "file" for a
+	// license file inside the package.
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// Licenses returns the normalized license expression, or nil if the package
+// doesn't declare one. License files aren't read.
+func (l License) Licenses() []string {
+	if l.Type != "expression" {
+		return nil
+	}
+	if expr := license.Normalize(l.Value); expr != "" {
+		return []string{expr}
+	}
+	return nil
+}
+
+// SplitAuthors splits the comma-separated authors of a .nuspec.
+func SplitAuthors(authors string) []string {
+	var res []string
+	for _, a := range strings.Split(authors, ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			res = append(res, a)
+		}
+	}
+	return res
+}
+
+func toDependencies(deps []nuspecDependency) []Dependency {
+	var res []Dependency
+	for _, d := range deps {
+		res = append(res, Dependency{ID: d.ID, Version: d.Version})
+	}
+	return res
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:    purl.TypeNuget,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeNuget} }
+
+// Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "NuGet" }
+
+var _ filesystem.Extractor = Extractor{}
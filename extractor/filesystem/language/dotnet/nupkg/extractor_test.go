@@ -0,0 +1,236 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nupkg_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/nupkg"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "nupkg file",
+			path:             "artifacts/serilog.3.1.1.nupkg",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "upper case extension",
+			path:             "artifacts/Serilog.3.1.1.NUPKG",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "nuspec file",
+			path:         "artifacts/Serilog.nuspec",
+			wantRequired: false,
+		},
+		{
+			name:         "nupkg hash file",
+			path:         "artifacts/serilog.3.1.1.nupkg.sha512",
+			wantRequired: false,
+		},
+		{
+			name:             "nupkg file exceeding max file size",
+			path:             "artifacts/serilog.3.1.1.nupkg",
+			fileSizeBytes:    1000 * units.KiB,
+			maxFileSizeBytes: 100 * units.KiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := nupkg.New(nupkg.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: test.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := test.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 10 * units.KiB
+			}
+
+			isRequired := e.FileRequired(test.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(test.path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			})
+			if isRequired != test.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", test.path, isRequired, test.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(test.path)
+			if gotResultMetric != test.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", test.path, gotResultMetric, test.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "dependencies grouped by target framework",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/serilog.3.1.1.nupkg",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "Serilog",
+					Version:   "3.1.1",
+					Ecosystem: "NuGet",
+					Metadata: &nupkg.Metadata{
+						Authors:  []string{"Serilog Contributors"},
+						Licenses: []string{"Apache-2.0"},
+						DependencyGroups: []nupkg.DependencyGroup{
+							{
+								TargetFramework: ".NETFramework4.6.2",
+								Dependencies: []nupkg.Dependency{
+									{ID: "System.Diagnostics.DiagnosticSource", Version: "7.0.2"},
+									{ID: "System.ValueTuple", Version: "4.5.0"},
+								},
+							},
+							{
+								TargetFramework: "net6.0",
+							},
+							{
+								TargetFramework: ".NETStandard2.0",
+								Dependencies: []nupkg.Dependency{
+									{ID: "System.Diagnostics.DiagnosticSource", Version: "[7.0.2, )"},
+								},
+							},
+						},
+					},
+					Locations: extractor.InstalledLocations("testdata/serilog.3.1.1.nupkg"),
+				},
+			},
+		},
+		{
+			Name: "ungrouped dependencies",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/legacy.package.1.0.0.nupkg",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "Legacy.Package",
+					Version:   "1.0.0",
+					Ecosystem: "NuGet",
+					Metadata: &nupkg.Metadata{
+						Authors: []string{"someone"},
+						DependencyGroups: []nupkg.DependencyGroup{
+							{
+								Dependencies: []nupkg.Dependency{
+									{ID: "Newtonsoft.Json", Version: "6.0.1"},
+								},
+							},
+						},
+					},
+					Locations: extractor.InstalledLocations("testdata/legacy.package.1.0.0.nupkg"),
+				},
+			},
+		},
+		{
+			Name: "no dependencies",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/no.deps.2.0.0-beta.1.nupkg",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "No.Deps",
+					Version:   "2.0.0-beta.1",
+					Ecosystem: "NuGet",
+					Metadata:  &nupkg.Metadata{Authors: []string{"someone"}},
+					Locations: extractor.InstalledLocations("testdata/no.deps.2.0.0-beta.1.nupkg"),
+				},
+			},
+		},
+		{
+			Name: "archive without nuspec",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/no-nuspec.nupkg",
+			},
+			WantErr: extracttest.ContainsErrStr{Str: "no .nuspec found"},
+		},
+		{
+			Name: "not a zip file",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/invalid.nupkg",
+			},
+			WantErr: extracttest.ContainsErrStr{Str: "invalid archive"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := nupkg.New(nupkg.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := nupkg.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "Serilog",
+		Version:   "3.1.1",
+		Locations: extractor.InstalledLocations("location"),
+	}
+	want := &purl.PackageURL{
+		Type:    purl.TypeNuget,
+		Name:    "Serilog",
+		Version: "3.1.1",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
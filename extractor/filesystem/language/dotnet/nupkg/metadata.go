@@ -0,0 +1,40 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nupkg
+
+// Metadata holds parsing information for a NuGet package.
+type Metadata struct {
+	// Authors are the package authors listed in the .nuspec.
+	Authors []string
+	// Licenses are the declared licenses as SPDX license expressions.
+	Licenses []string
+	// DependencyGroups lists the dependencies declared in the .nuspec, grouped
+	// by target framework.
+	DependencyGroups []DependencyGroup
+}
+
+// DependencyGroup holds the dependencies of a package for one target framework.
+type DependencyGroup struct {
+	// TargetFramework is empty if the dependencies apply to all frameworks.
+	TargetFramework string
+	Dependencies    []Dependency
+}
+
+// Dependency is a dependency edge to another NuGet package.
+type Dependency struct {
+	ID string
+	// Version is a NuGet version range, e.g. "[1.0.0, 2.0.0)".
+	Version string
+}
@@ -16,15 +16,24 @@
 package packageslockjson
 
 import (
+	"bufio"
+	"cmp"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/jsondepth"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/textual"
+	"github.com/google/osv-scalibr/log"
 	"github.com/google/osv-scalibr/plugin"
 	"github.com/google/osv-scalibr/purl"
 	"github.com/google/osv-scalibr/stats"
@@ -33,6 +42,20 @@ import (
 const (
 	// Name is the unique name of this extractor.
 	Name = "dotnet/packageslockjson"
+
+	// A valid packages.lock.json is nested 5 levels deep, anything much deeper
+	// is malicious.
+	defaultMaxJSONDepth = 64
+
+	// projectType is the type of entries referencing another project.
+	projectType = "Project"
+)
+
+var (
+	// errNotText is returned by extractFromInput for binary files.
+	errNotText = errors.New("file is not text")
+	// errEmpty is returned by extractFromInput for zero-byte files.
+	errEmpty = errors.New("file is empty")
 )
 
 // Config is the configuration for the Extractor.
@@ -42,20 +65,60 @@ type Config struct {
 	// MaxFileSizeBytes is the maximum file size this extractor will unmarshal. If
 	// `FileRequired` gets a bigger file, it will return false,
 	MaxFileSizeBytes int64
+	// MaxJSONDepth is the maximum nesting depth of objects and arrays this
+	// extractor accepts. Deeper input is rejected with
+	// jsondepth.ErrMaxDepthExceeded. If 0, no limit is applied.
+	MaxJSONDepth int
+	// IncludeProjectReferences includes entries of type "Project", which
+	// reference other projects of the same solution rather than NuGet packages.
+	// They are reported without a version and with Metadata.ProjectReference set.
+	IncludeProjectReferences bool
+	// NormalizeVersions records a SemVer compatible form of each package's
+	// version in Metadata.SemVer, for matching against advisories whose ranges
+	// use SemVer. The original version is kept in Inventory.Version.
+	NormalizeVersions bool
 }
 
 // DefaultConfig returns the default configuration for the extractor.
 func DefaultConfig() Config {
 	return Config{
-		Stats:            nil,
-		MaxFileSizeBytes: 0,
+		Stats:                    nil,
+		MaxFileSizeBytes:         0,
+		MaxJSONDepth:             defaultMaxJSONDepth,
+		IncludeProjectReferences: false,
+		NormalizeVersions:        false,
 	}
 }
 
+// Metadata holds additional information about a packages.lock.json entry.
+type Metadata struct {
+	// Framework is the target framework moniker the package was resolved for,
+	// e.g. "net8.0".
+	Framework string `json:"framework"`
+	// DependencyType is the type of the entry, e.g. "Direct", "Transitive",
+	// "CentralTransitive" or "Project".
+	DependencyType string `json:"dependencyType"`
+	// ContentHash is the base64 encoded SHA-512 hash of the package.
+	ContentHash string `json:"contentHash,omitempty"`
+	// Requested is the version range the project requested, e.g. "[1.0.0, )".
+	// A floating range permits newer versions than the resolved one, which is
+	// recorded in Inventory.Version. Empty for transitive entries.
+	Requested string `json:"requested,omitempty"`
+	// ProjectReference is true if the entry references another project rather
+	// than a NuGet package.
+	ProjectReference bool `json:"projectReference,omitempty"`
+	// SemVer is the SemVer compatible form of the version, if the extractor
+	// was configured to normalize versions and such a form exists.
+	SemVer string `json:"semver,omitempty"`
+}
+
 // Extractor extracts packages from inside a packages.lock.json.
 type Extractor struct {
-	stats            stats.Collector
-	maxFileSizeBytes int64
+	stats              stats.Collector
+	maxFileSizeBytes   int64
+	maxJSONDepth       int
+	includeProjectRefs bool
+	normalizeVersions  bool
 }
 
 // New returns a requirements.txt extractor.
@@ -66,8 +129,11 @@ type Extractor struct {
 // ```
 func New(cfg Config) *Extractor {
 	return &Extractor{
-		stats:            cfg.Stats,
-		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+		stats:              cfg.Stats,
+		maxFileSizeBytes:   cfg.MaxFileSizeBytes,
+		maxJSONDepth:       cfg.MaxJSONDepth,
+		includeProjectRefs: cfg.IncludeProjectReferences,
+		normalizeVersions:  cfg.NormalizeVersions,
 	}
 }
 
@@ -82,8 +148,14 @@ type PackagesLockJSON struct {
 // PackageInfo represents a single package's info, including its resolved
 // version, and its dependencies
 type PackageInfo struct {
+	// Type is e.g. "Direct", "Transitive", "CentralTransitive" or "Project".
+	Type string `json:"type"`
+	// Requested is the version range requested by the project, if any.
+	Requested string `json:"requested"`
 	// Resolved is the resolved version for this dependency.
-	Resolved     string            `json:"resolved"`
+	Resolved string `json:"resolved"`
+	// ContentHash is the base64 encoded SHA-512 hash of the package.
+	ContentHash  string            `json:"contentHash"`
 	Dependencies map[string]string `json:"dependencies"`
 }
 
@@ -96,9 +168,14 @@ func (e Extractor) Version() int { return 0 }
 // Requirements of the extractor.
 func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
 
+// FileHints returns the file names the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{BaseNames: []string{"packages.lock.json"}}
+}
+
 // FileRequired returns true if the specified file is marked executable.
 func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
-	if filepath.Base(path) != "packages.lock.json" {
+	if !e.fileMatches(path, fileinfo) {
 		return false
 	}
 
@@ -111,11 +188,24 @@ func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
 	return true
 }
 
+// FileSizeLimitExceeded returns true if the file would be required if it
+// didn't exceed the maximum file size.
+func (e Extractor) FileSizeLimitExceeded(path string, fileinfo fs.FileInfo) bool {
+	return e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes && e.fileMatches(path, fileinfo)
+}
+
+// fileMatches returns true if the file is one the extractor is interested in,
+// regardless of its size.
+func (e Extractor) fileMatches(path string, fileinfo fs.FileInfo) bool {
+	return filepath.Base(path) == "packages.lock.json"
+}
+
 func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
 	if e.stats == nil {
 		return
 	}
 	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Extractor:     e.Name(),
 		Path:          path,
 		Result:        result,
 		FileSizeBytes: fileSizeBytes,
@@ -123,42 +213,249 @@ func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result s
 }
 
 // Extract returns a list of dependencies in a packages.lock.json file.
+// The returned inventory is sorted by name, then version, so that the output is
+// the same across runs.
 func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
-	inventory, err := e.extractFromInput(ctx, input)
+	inventory, truncated, err := e.extractFromInput(ctx, input)
+	notText := errors.Is(err, errNotText)
+	empty := errors.Is(err, errEmpty)
+	if notText || empty {
+		// Binary files that happen to be named like a lockfile and empty files
+		// aren't an error.
+		err = nil
+	}
 	if e.stats != nil {
 		var fileSizeBytes int64
 		if input.Info != nil {
 			fileSizeBytes = input.Info.Size()
 		}
+		result := filesystem.ExtractorErrorToFileExtractedResult(err)
+		if truncated {
+			result = stats.FileExtractedResultTruncated
+		}
+		if notText {
+			result = stats.FileExtractedResultNotText
+		}
+		if empty {
+			result = stats.FileExtractedResultEmpty
+		}
 		e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
 			Path:          input.Path,
-			Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+			Result:        result,
 			FileSizeBytes: fileSizeBytes,
 		})
 	}
 	return inventory, err
 }
 
-func (e Extractor) extractFromInput(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
-	p, err := Parse(input.Reader)
-	if err != nil {
-		return nil, err
+// extractFromInput returns the packages in the file, and whether the list was
+// truncated because of the input's MaxInventoryPerFile limit.
+//
+// The file is decoded one package at a time so that a file declaring an
+// excessive number of packages is never fully held in memory. Empty files are
+// reported with errEmpty and binary files are rejected with errNotText before
+// decoding, and files that turn out bigger than MaxFileSizeBytes while reading
+// fail with filesystem.ErrSizeLimitExceeded.
+// Entries that aren't valid package info objects are skipped with a warning.
+func (e Extractor) extractFromInput(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, bool, error) {
+	r := bufio.NewReaderSize(filesystem.LimitReader(input.Reader, e.maxFileSizeBytes), filesystem.HeaderSize)
+	head, err := r.Peek(filesystem.HeaderSize)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, false, fmt.Errorf("failed to read packages.lock.json file: %w", err)
+	}
+	if len(head) == 0 {
+		return []*extractor.Inventory{}, false, errEmpty
 	}
+	if !textual.LooksTextual(head) {
+		return nil, false, errNotText
+	}
+
+	dec := json.NewDecoder(jsondepth.NewReader(r, e.maxJSONDepth))
 	var res []*extractor.Inventory
-	for _, pkgs := range p.Dependencies {
-		for pkgName, info := range pkgs {
-			inv := &extractor.Inventory{
-				Name:    pkgName,
-				Version: info.Resolved,
-				Locations: []string{
-					input.Path,
-				},
-			}
-			res = append(res, inv)
+	truncated := false
+	err = decodeObject(dec, func(key string) (bool, error) {
+		if key != "dependencies" {
+			return true, skipValue(dec)
 		}
+		err := decodeObject(dec, func(framework string) (bool, error) {
+			err := decodeObject(dec, func(pkgName string) (bool, error) {
+				var raw json.RawMessage
+				if err := dec.Decode(&raw); err != nil {
+					return false, err
+				}
+				var info PackageInfo
+				if err := json.Unmarshal(raw, &info); err != nil {
+					input.Warnf("skipping malformed entry %q for %s: %v", pkgName, framework, err)
+					return true, nil
+				}
+				if info.Type == projectType && !e.includeProjectRefs {
+					return true, nil
+				}
+				if input.MaxInventoryPerFile > 0 && len(res) >= input.MaxInventoryPerFile {
+					log.Warnf("%s: more than %d packages found, truncating", input.Path, input.MaxInventoryPerFile)
+					truncated = true
+					return false, nil
+				}
+				res = append(res, e.toInventory(input.Path, framework, pkgName, info))
+				return true, nil
+			})
+			// Read truncated only after decoding since the callback sets it.
+			return !truncated, err
+		})
+		return !truncated, err
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to decode packages.lock.json file: %w", err)
 	}
 
-	return res, nil
+	slices.SortStableFunc(res, func(a, b *extractor.Inventory) int {
+		if c := cmp.Compare(a.Name, b.Name); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Version, b.Version)
+	})
+
+	return res, truncated, nil
+}
+
+func (e Extractor) toInventory(path, framework, pkgName string, info PackageInfo) *extractor.Inventory {
+	inv := &extractor.Inventory{
+		Name:      pkgName,
+		Version:   info.Resolved,
+		Ecosystem: "NuGet",
+		Locations: []extractor.Location{
+			{Path: path, Reason: extractor.LocationDeclared},
+		},
+		Metadata: &Metadata{
+			Framework:      framework,
+			DependencyType: info.Type,
+			ContentHash:    info.ContentHash,
+		},
+	}
+	a := annotation(info.Type)
+	if a != extractor.Unknown {
+		inv.Annotations = []extractor.Annotation{a}
+	}
+	if a != extractor.Transitive {
+		// Only the project's own requests are kept, transitive ranges are up to
+		// the packages depending on them.
+		inv.Metadata.(*Metadata).Requested = info.Requested
+	}
+	if info.Type == projectType {
+		// Project references have no resolved version.
+		inv.Version = ""
+		inv.Metadata.(*Metadata).ProjectReference = true
+	} else if e.normalizeVersions {
+		inv.Metadata.(*Metadata).SemVer = toSemVer(info.Resolved)
+	}
+	return inv
+}
+
+// toSemVer converts a NuGet version into SemVer 2.0 form: build metadata is
+// dropped, missing minor and patch numbers are filled in with zeros, leading
+// zeros are stripped and a fourth number is dropped if it's zero. It returns ""
+// for versions with a non-zero fourth number or that aren't valid NuGet
+// versions, as they can't be expressed in SemVer without changing how they
+// compare.
+func toSemVer(v string) string {
+	v, _, _ = strings.Cut(v, "+")
+	release, prerelease, hasPrerelease := strings.Cut(v, "-")
+	parts := strings.Split(release, ".")
+	if len(parts) > 4 {
+		return ""
+	}
+	nums := make([]string, 0, len(parts))
+	for _, p := range parts {
+		n, err := strconv.ParseUint(p, 10, 64)
+		if err != nil {
+			return ""
+		}
+		nums = append(nums, strconv.FormatUint(n, 10))
+	}
+	if len(nums) == 4 {
+		if nums[3] != "0" {
+			return ""
+		}
+		nums = nums[:3]
+	}
+	for len(nums) < 3 {
+		nums = append(nums, "0")
+	}
+	semver := strings.Join(nums, ".")
+	if hasPrerelease {
+		if prerelease == "" {
+			return ""
+		}
+		semver += "-" + prerelease
+	}
+	return semver
+}
+
+// decodeObject reads a JSON object from dec and calls f for each of its keys.
+// f is expected to consume the value of the key and returns whether decoding
+// should continue. A null value is treated as an empty object.
+func decodeObject(dec *json.Decoder, f func(key string) (bool, error)) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if d, ok := tok.(json.Delim); !ok || d != '{' {
+		return fmt.Errorf("expected JSON object, got %v", tok)
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("expected JSON object key, got %v", tok)
+		}
+		more, err := f(key)
+		if err != nil {
+			return err
+		}
+		if !more {
+			return nil
+		}
+	}
+	_, err = dec.Token()
+	return err
+}
+
+// skipValue consumes the next JSON value from dec without keeping it.
+func skipValue(dec *json.Decoder) error {
+	depth := 0
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch tok {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+// annotation maps the type of a dependency to an inventory annotation.
+func annotation(depType string) extractor.Annotation {
+	switch depType {
+	case "Direct":
+		return extractor.Direct
+	case "Transitive", "CentralTransitive":
+		return extractor.Transitive
+	default:
+		return extractor.Unknown
+	}
 }
 
 // Parse returns a struct representing the structure of a .NET project's
@@ -173,14 +470,17 @@ func Parse(r io.Reader) (PackagesLockJSON, error) {
 	return p, nil
 }
 
-// ToPURL converts an inventory created by this extractor into a PURL.
+// ToPURL converts an inventory created by this extractor into a PURL with the
+// inventory's Qualifiers.
 func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
-	return &purl.PackageURL{
-		Type:    purl.TypeNuget,
-		Name:    i.Name,
-		Version: i.Version,
-	}
+	p := extractor.PURLFromInventory(purl.TypeNuget, i)
+	// Tell apart the same package locked by different projects.
+	p.Subpath = i.ManifestSubpath()
+	return p
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeNuget} }
+
 // Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
 func (Extractor) Ecosystem(i *extractor.Inventory) string { return "NuGet" }
@@ -16,21 +16,28 @@ package packageslockjson_test
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/jsondepth"
 	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/packageslockjson"
 	scalibrfs "github.com/google/osv-scalibr/fs"
 	"github.com/google/osv-scalibr/purl"
 	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extractortest"
 	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/golden"
 	"github.com/google/osv-scalibr/testing/testcollector"
 )
 
@@ -123,61 +130,148 @@ func TestFileRequired(t *testing.T) {
 			if gotResultMetric != test.wantResultMetric {
 				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", test.path, gotResultMetric, test.wantResultMetric)
 			}
+
+			if test.wantResultMetric != "" {
+				if gotExtractor := collector.FileRequiredExtractor(test.path); gotExtractor != packageslockjson.Name {
+					t.Errorf("FileRequired(%s) recorded extractor %q, want %q", test.path, gotExtractor, packageslockjson.Name)
+				}
+			}
 		})
 	}
 }
 
 func TestExtractor(t *testing.T) {
 	tests := []struct {
-		name             string
-		path             string
-		wantInventory    []*extractor.Inventory
-		wantErr          error
-		wantResultMetric stats.FileExtractedResult
+		name                     string
+		path                     string
+		includeProjectReferences bool
+		wantInventory            []*extractor.Inventory
+		golden                   string
+		wantErr                  error
+		wantResultMetric         stats.FileExtractedResult
 	}{
 		{
-			name: "valid packages.lock.json",
-			path: "testdata/valid/packages.lock.json",
+			name:             "valid packages.lock.json",
+			path:             "testdata/valid/packages.lock.json",
+			golden:           "testdata/valid/inventory.golden.json",
+			wantResultMetric: stats.FileExtractedResultSuccess,
+		},
+		{
+			name: "project references excluded by default",
+			path: "testdata/projectref/packages.lock.json",
 			wantInventory: []*extractor.Inventory{
 				{
-					Name:      "Core.Dep",
-					Version:   "1.24.0",
-					Locations: []string{"testdata/valid/packages.lock.json"},
+					Name:        "Newtonsoft.Json",
+					Version:     "13.0.3",
+					Ecosystem:   "NuGet",
+					Annotations: []extractor.Annotation{extractor.Direct},
+					Locations:   []extractor.Location{{Path: "testdata/projectref/packages.lock.json", Reason: extractor.LocationDeclared}},
+					Metadata: &packageslockjson.Metadata{
+						Framework:      "net8.0",
+						DependencyType: "Direct",
+						ContentHash:    "HrC5BXdl00IP9zeV+0Z848QWPAoCr9P3bDEZguI+gkLcBKAOxix/tLEAAHC+UvDNPv4a2d18lOReHMOagPa+zQ==",
+						Requested:      "[13.0.3, )",
+					},
 				},
 				{
-					Name:      "Some.Dep.One",
-					Version:   "1.1.1",
-					Locations: []string{"testdata/valid/packages.lock.json"},
+					Name:        "Serilog",
+					Version:     "3.1.1",
+					Ecosystem:   "NuGet",
+					Annotations: []extractor.Annotation{extractor.Transitive},
+					Locations:   []extractor.Location{{Path: "testdata/projectref/packages.lock.json", Reason: extractor.LocationDeclared}},
+					Metadata: &packageslockjson.Metadata{
+						Framework:      "net8.0",
+						DependencyType: "Transitive",
+						ContentHash:    "P6G4/4Kt9bT635bhuwdXlJ2SCqqn2nhh4gqFqQueCOr9bK/e7W9ll/IoX1Ter948cV2Z/5+5v8pAfJYUISY03A==",
+					},
 				},
+			},
+			wantResultMetric: stats.FileExtractedResultSuccess,
+		},
+		{
+			name:                     "project references included",
+			path:                     "testdata/projectref/packages.lock.json",
+			includeProjectReferences: true,
+			wantInventory: []*extractor.Inventory{
 				{
-					Name:      "Some.Dep.Two",
-					Version:   "4.6.0",
-					Locations: []string{"testdata/valid/packages.lock.json"},
+					Name:        "Newtonsoft.Json",
+					Version:     "13.0.3",
+					Ecosystem:   "NuGet",
+					Annotations: []extractor.Annotation{extractor.Direct},
+					Locations:   []extractor.Location{{Path: "testdata/projectref/packages.lock.json", Reason: extractor.LocationDeclared}},
+					Metadata: &packageslockjson.Metadata{
+						Framework:      "net8.0",
+						DependencyType: "Direct",
+						ContentHash:    "HrC5BXdl00IP9zeV+0Z848QWPAoCr9P3bDEZguI+gkLcBKAOxix/tLEAAHC+UvDNPv4a2d18lOReHMOagPa+zQ==",
+						Requested:      "[13.0.3, )",
+					},
 				},
 				{
-					Name:      "Some.Dep.Three",
-					Version:   "1.0.2",
-					Locations: []string{"testdata/valid/packages.lock.json"},
+					Name:        "Serilog",
+					Version:     "3.1.1",
+					Ecosystem:   "NuGet",
+					Annotations: []extractor.Annotation{extractor.Transitive},
+					Locations:   []extractor.Location{{Path: "testdata/projectref/packages.lock.json", Reason: extractor.LocationDeclared}},
+					Metadata: &packageslockjson.Metadata{
+						Framework:      "net8.0",
+						DependencyType: "Transitive",
+						ContentHash:    "P6G4/4Kt9bT635bhuwdXlJ2SCqqn2nhh4gqFqQueCOr9bK/e7W9ll/IoX1Ter948cV2Z/5+5v8pAfJYUISY03A==",
+					},
 				},
 				{
-					Name:      "Some.Dep.Four",
-					Version:   "4.5.0",
-					Locations: []string{"testdata/valid/packages.lock.json"},
+					Name:      "mycompany.logging",
+					Ecosystem: "NuGet",
+					Locations: []extractor.Location{{Path: "testdata/projectref/packages.lock.json", Reason: extractor.LocationDeclared}},
+					Metadata: &packageslockjson.Metadata{
+						Framework:        "net8.0",
+						DependencyType:   "Project",
+						ProjectReference: true,
+					},
 				},
+			},
+			wantResultMetric: stats.FileExtractedResultSuccess,
+		},
+		{
+			name: "floating requested range",
+			path: "testdata/floating/packages.lock.json",
+			wantInventory: []*extractor.Inventory{
 				{
-					Name:      "Some.Longer.Name.Dep",
-					Version:   "4.7.2",
-					Locations: []string{"testdata/valid/packages.lock.json"},
+					Name:        "Newtonsoft.Json",
+					Version:     "13.0.3",
+					Ecosystem:   "NuGet",
+					Annotations: []extractor.Annotation{extractor.Direct},
+					Locations:   []extractor.Location{{Path: "testdata/floating/packages.lock.json", Reason: extractor.LocationDeclared}},
+					Metadata: &packageslockjson.Metadata{
+						Framework:      "net8.0",
+						DependencyType: "Direct",
+						ContentHash:    "HrC5BXdl00IP9zeV+0Z848QWPAoCr9P3bDEZguI+gkLcBKAOxix/tLEAAHC+UvDNPv4a2d18lOReHMOagPa+zQ==",
+						Requested:      "[12.0.1, )",
+					},
 				},
 				{
-					Name:      "Some.Dep.Five",
-					Version:   "4.7.2",
-					Locations: []string{"testdata/valid/packages.lock.json"},
+					Name:        "Serilog",
+					Version:     "3.1.1",
+					Ecosystem:   "NuGet",
+					Annotations: []extractor.Annotation{extractor.Direct},
+					Locations:   []extractor.Location{{Path: "testdata/floating/packages.lock.json", Reason: extractor.LocationDeclared}},
+					Metadata: &packageslockjson.Metadata{
+						Framework:      "net8.0",
+						DependencyType: "Direct",
+						ContentHash:    "P6G4/4Kt9bT635bhuwdXlJ2SCqqn2nhh4gqFqQueCOr9bK/e7W9ll/IoX1Ter948cV2Z/5+5v8pAfJYUISY03A==",
+						Requested:      "[3.1.1]",
+					},
 				},
 				{
-					Name:      "Another.Longer.Name.Dep",
-					Version:   "4.5.4",
-					Locations: []string{"testdata/valid/packages.lock.json"},
+					Name:        "System.Text.Json",
+					Version:     "8.0.4",
+					Ecosystem:   "NuGet",
+					Annotations: []extractor.Annotation{extractor.Transitive},
+					Locations:   []extractor.Location{{Path: "testdata/floating/packages.lock.json", Reason: extractor.LocationDeclared}},
+					Metadata: &packageslockjson.Metadata{
+						Framework:      "net8.0",
+						DependencyType: "Transitive",
+						ContentHash:    "bAkhgDJ88XTsqczoxEMliSrpijKZHhbJQldhAmObj/RbrN3sU5dcokuXmWJWsdQAhiMJ9bTayWsL1C9fbbCRhw==",
+					},
 				},
 			},
 			wantResultMetric: stats.FileExtractedResultSuccess,
@@ -188,12 +282,26 @@ func TestExtractor(t *testing.T) {
 			wantErr:          cmpopts.AnyError,
 			wantResultMetric: stats.FileExtractedResultErrorUnknown,
 		},
+		{
+			name:             "binary file named like a lockfile",
+			path:             "testdata/binary/packages.lock.json",
+			wantResultMetric: stats.FileExtractedResultNotText,
+		},
+		{
+			name:             "empty file",
+			path:             "testdata/empty/packages.lock.json",
+			wantInventory:    []*extractor.Inventory{},
+			wantResultMetric: stats.FileExtractedResultEmpty,
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			collector := testcollector.New()
-			var e filesystem.Extractor = packageslockjson.New(packageslockjson.Config{Stats: collector})
+			var e filesystem.Extractor = packageslockjson.New(packageslockjson.Config{
+				Stats:                    collector,
+				IncludeProjectReferences: test.includeProjectReferences,
+			})
 
 			r, err := os.Open(test.path)
 			defer func() {
@@ -221,8 +329,9 @@ func TestExtractor(t *testing.T) {
 				t.Fatalf("Extract(%+v) error: got %v, want %v\n", test.name, err, test.wantErr)
 			}
 
-			sort := func(a, b *extractor.Inventory) bool { return a.Name < b.Name }
-			if diff := cmp.Diff(test.wantInventory, got, cmpopts.SortSlices(sort)); diff != "" {
+			if test.golden != "" {
+				golden.CompareInventory(t, test.golden, got)
+			} else if diff := cmp.Diff(test.wantInventory, got); diff != "" {
 				t.Errorf("Extract(%s) (-want +got):\n%s", test.path, diff)
 			}
 
@@ -239,12 +348,284 @@ func TestExtractor(t *testing.T) {
 	}
 }
 
+func TestExtractorNormalizeVersions(t *testing.T) {
+	path := "testdata/versions/packages.lock.json"
+	want := map[string]string{
+		"Four.Part.Zero":       "1.2.3",
+		"Four.Part.NonZero":    "",
+		"Prerelease":           "2.0.0-beta.1",
+		"Four.Part.Prerelease": "3.1.0-rc1",
+		"Two.Part":             "4.1.0",
+	}
+	wantVersions := map[string]string{
+		"Four.Part.Zero":       "1.2.3.0",
+		"Four.Part.NonZero":    "1.2.3.4",
+		"Prerelease":           "2.0.0-beta.1",
+		"Four.Part.Prerelease": "3.1.0.0-rc1+build.5",
+		"Two.Part":             "4.01",
+	}
+
+	for _, normalize := range []bool{false, true} {
+		t.Run(fmt.Sprintf("normalize_%t", normalize), func(t *testing.T) {
+			r, err := os.Open(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Close()
+
+			e := packageslockjson.New(packageslockjson.Config{NormalizeVersions: normalize})
+			inv, err := e.Extract(context.Background(), &filesystem.ScanInput{FS: scalibrfs.DirFS("."), Path: path, Reader: r})
+			if err != nil {
+				t.Fatalf("Extract(%s): %v", path, err)
+			}
+
+			got := map[string]string{}
+			gotVersions := map[string]string{}
+			for _, i := range inv {
+				got[i.Name] = i.Metadata.(*packageslockjson.Metadata).SemVer
+				gotVersions[i.Name] = i.Version
+			}
+			wantSemVer := want
+			if !normalize {
+				wantSemVer = map[string]string{}
+				for name := range want {
+					wantSemVer[name] = ""
+				}
+			}
+			if diff := cmp.Diff(wantSemVer, got); diff != "" {
+				t.Errorf("Extract(%s) SemVer versions (-want +got):\n%s", path, diff)
+			}
+			if diff := cmp.Diff(wantVersions, gotVersions); diff != "" {
+				t.Errorf("Extract(%s) original versions (-want +got):\n%s", path, diff)
+			}
+		})
+	}
+}
+
+func TestExtractorTruncated(t *testing.T) {
+	tests := []struct {
+		name             string
+		packages         int
+		projectRefs      int
+		wantCount        int
+		wantResultMetric stats.FileExtractedResult
+	}{
+		{
+			name:             "more packages than allowed",
+			packages:         100,
+			wantCount:        10,
+			wantResultMetric: stats.FileExtractedResultTruncated,
+		},
+		{
+			name:             "only skipped project references beyond the limit",
+			packages:         10,
+			projectRefs:      5,
+			wantCount:        10,
+			wantResultMetric: stats.FileExtractedResultSuccess,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Synthesize a lockfile declaring the given number of entries.
+			var entries []string
+			for i := 0; i < test.packages; i++ {
+				entries = append(entries, fmt.Sprintf(`"Fake.Dep.%d": {"type": "Direct", "resolved": "1.0.%d"}`, i, i))
+			}
+			for i := 0; i < test.projectRefs; i++ {
+				entries = append(entries, fmt.Sprintf(`"fake.project.%d": {"type": "Project"}`, i))
+			}
+			content := `{"version": 1, "dependencies": {"net8.0": {` + strings.Join(entries, ",") + "}}}"
+
+			path := filepath.Join(t.TempDir(), "packages.lock.json")
+			if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+				t.Fatalf("os.WriteFile(%s): %v", path, err)
+			}
+			r, err := os.Open(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Close()
+			info, err := r.Stat()
+			if err != nil {
+				t.Fatalf("Failed to stat test file: %v", err)
+			}
+
+			collector := testcollector.New()
+			var e filesystem.Extractor = packageslockjson.New(packageslockjson.Config{Stats: collector})
+			input := &filesystem.ScanInput{
+				FS:                  scalibrfs.DirFS(filepath.Dir(path)),
+				Path:                path,
+				Reader:              r,
+				Info:                info,
+				MaxInventoryPerFile: 10,
+			}
+			got, err := e.Extract(context.Background(), input)
+			if err != nil {
+				t.Fatalf("Extract(%s): %v", path, err)
+			}
+			if len(got) != test.wantCount {
+				t.Errorf("Extract(%s) returned %d packages, want %d", path, len(got), test.wantCount)
+			}
+			if gotResultMetric := collector.FileExtractedResult(path); gotResultMetric != test.wantResultMetric {
+				t.Errorf("Extract(%s) recorded result metric %v, want result metric %v", path, gotResultMetric, test.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtractorSizeLimitExceededWhileReading(t *testing.T) {
+	// The file passed FileRequired at its stated size but grew before Extract
+	// read it.
+	content := `{"version": 1, "dependencies": {"net8.0": {"Fake.Dep": {"type": "Direct", "resolved": "1.0.0"}}}}`
+	const maxFileSizeBytes = 32
+
+	collector := testcollector.New()
+	var e filesystem.Extractor = packageslockjson.New(packageslockjson.Config{
+		Stats:            collector,
+		MaxFileSizeBytes: maxFileSizeBytes,
+	})
+	input := &filesystem.ScanInput{
+		Path:   "packages.lock.json",
+		Reader: strings.NewReader(content),
+		Info: fakefs.FakeFileInfo{
+			FileName: "packages.lock.json",
+			FileSize: maxFileSizeBytes,
+		},
+	}
+	got, err := e.Extract(context.Background(), input)
+	if !errors.Is(err, filesystem.ErrSizeLimitExceeded) {
+		t.Errorf("Extract() error: got %v, want %v", err, filesystem.ErrSizeLimitExceeded)
+	}
+	if len(got) != 0 {
+		t.Errorf("Extract() returned %v, want no packages", got)
+	}
+	if gotResultMetric := collector.FileExtractedResult(input.Path); gotResultMetric != stats.FileExtractedResultErrorSizeLimitExceeded {
+		t.Errorf("Extract() recorded result metric %v, want result metric %v", gotResultMetric, stats.FileExtractedResultErrorSizeLimitExceeded)
+	}
+}
+
+func TestExtractorMaxJSONDepth(t *testing.T) {
+	// Nest far beyond the default depth limit.
+	content := `{"version": 1, "dependencies": ` + strings.Repeat(`{"a": `, 10000) + "{}" + strings.Repeat("}", 10000) + "}"
+
+	path := filepath.Join(t.TempDir(), "packages.lock.json")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("os.WriteFile(%s): %v", path, err)
+	}
+	r, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	info, err := r.Stat()
+	if err != nil {
+		t.Fatalf("Failed to stat test file: %v", err)
+	}
+
+	var e filesystem.Extractor = packageslockjson.New(packageslockjson.DefaultConfig())
+	input := &filesystem.ScanInput{
+		FS:     scalibrfs.DirFS(filepath.Dir(path)),
+		Path:   path,
+		Reader: r,
+		Info:   info,
+	}
+	got, err := e.Extract(context.Background(), input)
+	if !errors.Is(err, jsondepth.ErrMaxDepthExceeded) {
+		t.Errorf("Extract(%s) returned error %v, want %v", path, err, jsondepth.ErrMaxDepthExceeded)
+	}
+	if len(got) != 0 {
+		t.Errorf("Extract(%s) returned %d packages, want 0", path, len(got))
+	}
+}
+
+func TestExtractorSkipsMalformedEntries(t *testing.T) {
+	path := "testdata/malformed/packages.lock.json"
+	r, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	var e filesystem.Extractor = packageslockjson.New(packageslockjson.DefaultConfig())
+	input := &filesystem.ScanInput{FS: scalibrfs.DirFS("."), Path: path, Reader: r}
+	inv, err := e.Extract(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Extract(%s): %v", path, err)
+	}
+
+	var gotNames []string
+	for _, i := range inv {
+		gotNames = append(gotNames, i.Name)
+	}
+	if diff := cmp.Diff([]string{"Newtonsoft.Json", "Serilog"}, gotNames); diff != "" {
+		t.Errorf("Extract(%s) package names (-want +got):\n%s", path, diff)
+	}
+
+	warnings := input.Warnings()
+	if len(warnings) != 2 {
+		t.Fatalf("Extract(%s) recorded warnings %q, want 2", path, warnings)
+	}
+	for i, name := range []string{"Broken.Version", "Not.An.Object"} {
+		if !strings.Contains(warnings[i], name) {
+			t.Errorf("Extract(%s) warning %q doesn't mention %q", path, warnings[i], name)
+		}
+	}
+}
+
+func TestMetadataJSON(t *testing.T) {
+	path := "testdata/projectref/packages.lock.json"
+	r, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	var e filesystem.Extractor = packageslockjson.New(packageslockjson.Config{IncludeProjectReferences: true})
+	input := &filesystem.ScanInput{FS: scalibrfs.DirFS("."), Path: path, Reader: r}
+	inv, err := e.Extract(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Extract(%s): %v", path, err)
+	}
+
+	want := []string{
+		`{"framework":"net8.0","dependencyType":"Direct","contentHash":"HrC5BXdl00IP9zeV+0Z848QWPAoCr9P3bDEZguI+gkLcBKAOxix/tLEAAHC+UvDNPv4a2d18lOReHMOagPa+zQ==","requested":"[13.0.3, )"}`,
+		`{"framework":"net8.0","dependencyType":"Transitive","contentHash":"P6G4/4Kt9bT635bhuwdXlJ2SCqqn2nhh4gqFqQueCOr9bK/e7W9ll/IoX1Ter948cV2Z/5+5v8pAfJYUISY03A=="}`,
+		`{"framework":"net8.0","dependencyType":"Project","projectReference":true}`,
+	}
+	if len(inv) != len(want) {
+		t.Fatalf("Extract(%s) returned %d packages, want %d", path, len(inv), len(want))
+	}
+	for i, w := range want {
+		m, ok := inv[i].Metadata.(*packageslockjson.Metadata)
+		if !ok {
+			t.Fatalf("Extract(%s)[%d].Metadata has type %T, want *packageslockjson.Metadata", path, i, inv[i].Metadata)
+		}
+		got, err := json.Marshal(m)
+		if err != nil {
+			t.Fatalf("json.Marshal(%+v): %v", m, err)
+		}
+		if string(got) != w {
+			t.Errorf("json.Marshal(%+v) = %s, want %s", m, got, w)
+		}
+
+		var roundTrip packageslockjson.Metadata
+		if err := json.Unmarshal(got, &roundTrip); err != nil {
+			t.Fatalf("json.Unmarshal(%s): %v", got, err)
+		}
+		if diff := cmp.Diff(m, &roundTrip); diff != "" {
+			t.Errorf("json round trip of %s (-want +got):\n%s", got, diff)
+		}
+	}
+}
+
 func TestToPURL(t *testing.T) {
 	e := packageslockjson.Extractor{}
 	i := &extractor.Inventory{
-		Name:      "Name",
-		Version:   "1.2.3",
-		Locations: []string{"location"},
+		Name:        "Name",
+		Version:     "1.2.3",
+		Annotations: []extractor.Annotation{extractor.Transitive},
+		Locations:   extractor.LocationsFromPaths("location"),
 	}
 	want := &purl.PackageURL{
 		Type:    purl.TypeNuget,
@@ -256,3 +637,40 @@ func TestToPURL(t *testing.T) {
 		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
 	}
 }
+
+func TestToPURL_Subpath(t *testing.T) {
+	e := packageslockjson.Extractor{}
+	inv := func(path string) *extractor.Inventory {
+		return &extractor.Inventory{
+			Name:      "Newtonsoft.Json",
+			Version:   "13.0.3",
+			Locations: []extractor.Location{{Path: path, Reason: extractor.LocationDeclared}},
+		}
+	}
+	api := e.ToPURL(inv("services/api/packages.lock.json"))
+	worker := e.ToPURL(inv("services/worker/packages.lock.json"))
+
+	if api.Subpath != "services/api" {
+		t.Errorf("ToPURL(services/api).Subpath = %q, want %q", api.Subpath, "services/api")
+	}
+	if api.String() == worker.String() {
+		t.Errorf("ToPURL() of two projects = %q for both, want distinct PURLs", api)
+	}
+}
+
+func TestToPURL_Qualifiers(t *testing.T) {
+	i := &extractor.Inventory{
+		Name:       "Newtonsoft.Json",
+		Version:    "13.0.3",
+		Qualifiers: map[string]string{"repository_url": "https://nuget.example.com/v3/index.json", "checksum": "sha512:0a1b"},
+		Locations:  []extractor.Location{{Path: "services/api/packages.lock.json", Reason: extractor.LocationDeclared}},
+	}
+	want := "pkg:nuget/Newtonsoft.Json@13.0.3?checksum=sha512%3A0a1b&repository_url=https%3A%2F%2Fnuget.example.com%2Fv3%2Findex.json#services/api"
+	if got := (packageslockjson.Extractor{}).ToPURL(i).String(); got != want {
+		t.Errorf("ToPURL(%v) = %q, want %q", i, got, want)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	extractortest.Validate(t, packageslockjson.New(packageslockjson.DefaultConfig()), "testdata")
+}
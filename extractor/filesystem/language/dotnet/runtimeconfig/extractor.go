@@ -0,0 +1,143 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package runtimeconfig extracts the .NET runtimes targeted by published apps
+// from their <app>.runtimeconfig.json files.
+package runtimeconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+// Name is the unique name of this extractor.
+const Name = "dotnet/runtimeconfig"
+
+// Metadata holds additional information about a targeted .NET runtime.
+type Metadata struct {
+	// TFM is the target framework moniker of the app, e.g. "net6.0".
+	TFM string `json:"tfm,omitempty"`
+	// RollForward is the policy for picking a newer runtime than the targeted
+	// one, e.g. "LatestPatch". Empty if the app uses the default policy.
+	RollForward string `json:"rollForward,omitempty"`
+}
+
+type runtimeConfig struct {
+	RuntimeOptions struct {
+		TFM         string      `json:"tfm"`
+		RollForward string      `json:"rollForward"`
+		Framework   *framework  `json:"framework"`
+		Frameworks  []framework `json:"frameworks"`
+	} `json:"runtimeOptions"`
+}
+
+type framework struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// Extractor extracts the .NET runtimes targeted by published apps.
+type Extractor struct{}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileHints returns the file extensions the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{Extensions: []string{".json"}}
+}
+
+// FileRequired returns true for <app>.runtimeconfig.json files.
+func (e Extractor) FileRequired(path string, _ fs.FileInfo) bool {
+	return strings.HasSuffix(strings.ToLower(filepath.Base(path)), ".runtimeconfig.json")
+}
+
+// Extract returns the runtimes the app targets, either a single framework or
+// several ones, e.g. for ASP.NET Core apps. The versions are the lowest ones
+// the app runs on; the runtime picked at startup depends on RollForward.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	var cfg runtimeConfig
+	if err := json.NewDecoder(input.Reader).Decode(&cfg); err != nil {
+		return nil, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+	opts := cfg.RuntimeOptions
+	frameworks := opts.Frameworks
+	if opts.Framework != nil {
+		frameworks = append([]framework{*opts.Framework}, frameworks...)
+	}
+
+	res := []*extractor.Inventory{}
+	for _, f := range frameworks {
+		if f.Name == "" || f.Version == "" {
+			continue
+		}
+		res = append(res, &extractor.Inventory{
+			Name:      f.Name,
+			Version:   f.Version,
+			Locations: extractor.LocationsFromPaths(input.Path),
+			Metadata: &Metadata{
+				TFM:         opts.TFM,
+				RollForward: opts.RollForward,
+			},
+		})
+	}
+	return res, nil
+}
+
+// ToPURL converts an inventory created by this extractor into a generic PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return extractor.PURLFromInventory(purl.TypeGeneric, i)
+}
+
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeGeneric} }
+
+// ToCPEs returns the CPE of the runtime for the frameworks NVD tracks.
+func (e Extractor) ToCPEs(i *extractor.Inventory) []string {
+	var product string
+	switch i.Name {
+	case "Microsoft.NETCore.App":
+		// .NET Core was renamed to .NET with version 5.
+		product = ".net"
+		if major, err := strconv.Atoi(strings.SplitN(i.Version, ".", 2)[0]); err == nil && major < 5 {
+			product = ".net_core"
+		}
+	case "Microsoft.AspNetCore.App":
+		product = "asp.net_core"
+	default:
+		return nil
+	}
+	return []string{fmt.Sprintf("cpe:2.3:a:microsoft:%s:%s:*:*:*:*:*:*:*", product, i.Version)}
+}
+
+// Ecosystem returns no ecosystem since OSV doesn't track .NET runtimes.
+func (e Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,138 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtimeconfig_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/runtimeconfig"
+	"github.com/google/osv-scalibr/testing/extracttest"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "app/MyApp.runtimeconfig.json", want: true},
+		{path: "MyApp.RuntimeConfig.json", want: true},
+		{path: "app/MyApp.runtimeconfig.dev.json", want: false},
+		{path: "app/runtimeconfig.template.json", want: false},
+		{path: "app/MyApp.deps.json", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := (runtimeconfig.Extractor{}).FileRequired(tt.path, nil); got != tt.want {
+				t.Errorf("FileRequired(%s) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name:        "single framework",
+			InputConfig: extracttest.ScanInputMockConfig{Path: "testdata/framework.runtimeconfig.json"},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "Microsoft.NETCore.App",
+				Version:   "6.0.0",
+				Locations: []extractor.Location{{Path: "testdata/framework.runtimeconfig.json"}},
+				Metadata:  &runtimeconfig.Metadata{TFM: "net6.0", RollForward: "LatestPatch"},
+			}},
+		},
+		{
+			Name:        "multiple frameworks",
+			InputConfig: extracttest.ScanInputMockConfig{Path: "testdata/frameworks.runtimeconfig.json"},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "Microsoft.NETCore.App",
+					Version:   "8.0.2",
+					Locations: []extractor.Location{{Path: "testdata/frameworks.runtimeconfig.json"}},
+					Metadata:  &runtimeconfig.Metadata{TFM: "net8.0"},
+				},
+				{
+					Name:      "Microsoft.AspNetCore.App",
+					Version:   "8.0.2",
+					Locations: []extractor.Location{{Path: "testdata/frameworks.runtimeconfig.json"}},
+					Metadata:  &runtimeconfig.Metadata{TFM: "net8.0"},
+				},
+			},
+		},
+		{
+			Name:        ".NET Core app",
+			InputConfig: extracttest.ScanInputMockConfig{Path: "testdata/netcoreapp.runtimeconfig.json"},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "Microsoft.NETCore.App",
+				Version:   "3.1.0",
+				Locations: []extractor.Location{{Path: "testdata/netcoreapp.runtimeconfig.json"}},
+				Metadata:  &runtimeconfig.Metadata{TFM: "netcoreapp3.1"},
+			}},
+		},
+		{
+			Name:          "no framework",
+			InputConfig:   extracttest.ScanInputMockConfig{Path: "testdata/noframework.runtimeconfig.json"},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name:        "invalid json",
+			InputConfig: extracttest.ScanInputMockConfig{Path: "testdata/invalid.runtimeconfig.json"},
+			WantErr:     extracttest.ContainsErrStr{Str: "could not extract from"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := runtimeconfig.Extractor{}
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+			if !cmp.Equal(err, tt.WantErr, cmpopts.EquateErrors()) {
+				t.Fatalf("%s.Extract(%q) error diff: got %v, want %v", extr.Name(), tt.InputConfig.Path, err, tt.WantErr)
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToCPEs(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		want    []string
+	}{
+		{name: "Microsoft.NETCore.App", version: "6.0.0", want: []string{"cpe:2.3:a:microsoft:.net:6.0.0:*:*:*:*:*:*:*"}},
+		{name: "Microsoft.NETCore.App", version: "3.1.0", want: []string{"cpe:2.3:a:microsoft:.net_core:3.1.0:*:*:*:*:*:*:*"}},
+		{name: "Microsoft.AspNetCore.App", version: "8.0.2", want: []string{"cpe:2.3:a:microsoft:asp.net_core:8.0.2:*:*:*:*:*:*:*"}},
+		{name: "Microsoft.WindowsDesktop.App", version: "8.0.2", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+"@"+tt.version, func(t *testing.T) {
+			i := &extractor.Inventory{Name: tt.name, Version: tt.version}
+			if diff := cmp.Diff(tt.want, (runtimeconfig.Extractor{}).ToCPEs(i)); diff != "" {
+				t.Errorf("ToCPEs(%v) (-want +got):\n%s", i, diff)
+			}
+		})
+	}
+}
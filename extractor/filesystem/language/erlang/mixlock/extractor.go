@@ -52,6 +52,11 @@ func (e Extractor) Requirements() *plugin.Capabilities {
 	return &plugin.Capabilities{}
 }
 
+// FileHints returns the file names the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{BaseNames: []string{"mix.lock"}}
+}
+
 // FileRequired returns true if the specified file is a mix.lock file.
 func (e Extractor) FileRequired(path string, fileInfo fs.FileInfo) bool {
 	return filepath.Base(path) == "mix.lock"
@@ -98,7 +103,7 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 		packages = append(packages, &extractor.Inventory{
 			Name:      name,
 			Version:   version,
-			Locations: []string{input.Path},
+			Locations: extractor.DeclaredLocations(input.Path),
 			SourceCode: &extractor.SourceCodeIdentifier{
 				Commit: commit,
 			},
@@ -121,6 +126,9 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	}
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeHex} }
+
 // Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
 func (e Extractor) Ecosystem(i *extractor.Inventory) string {
 	return "Hex"
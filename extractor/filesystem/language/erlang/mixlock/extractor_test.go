@@ -84,7 +84,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "plug",
 					Version:   "1.11.1",
-					Locations: []string{"testdata/one-package.lock"},
+					Locations: extractor.DeclaredLocations("testdata/one-package.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "f2992bac66fdae679453c9e86134a4201f6f43a687d8ff1cd1b2862d53c80259",
 					},
@@ -100,7 +100,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "plug",
 					Version:   "1.11.1",
-					Locations: []string{"testdata/two-packages.lock"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "f2992bac66fdae679453c9e86134a4201f6f43a687d8ff1cd1b2862d53c80259",
 					},
@@ -108,7 +108,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "plug_crypto",
 					Version:   "1.2.2",
-					Locations: []string{"testdata/two-packages.lock"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "05654514ac717ff3a1843204b424477d9e60c143406aa94daf2274fdd280794d",
 					},
@@ -124,7 +124,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "backoff",
 					Version:   "1.1.6",
-					Locations: []string{"testdata/many.lock"},
+					Locations: extractor.DeclaredLocations("testdata/many.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "83b72ed2108ba1ee8f7d1c22e0b4a00cfe3593a67dbc792799e8cce9f42f796b",
 					},
@@ -132,7 +132,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "decimal",
 					Version:   "2.0.0",
-					Locations: []string{"testdata/many.lock"},
+					Locations: extractor.DeclaredLocations("testdata/many.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "a78296e617b0f5dd4c6caf57c714431347912ffb1d0842e998e9792b5642d697",
 					},
@@ -140,7 +140,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "dialyxir",
 					Version:   "1.1.0",
-					Locations: []string{"testdata/many.lock"},
+					Locations: extractor.DeclaredLocations("testdata/many.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "c5aab0d6e71e5522e77beff7ba9e08f8e02bad90dfbeffae60eaf0cb47e29488",
 					},
@@ -148,7 +148,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "earmark",
 					Version:   "1.4.3",
-					Locations: []string{"testdata/many.lock"},
+					Locations: extractor.DeclaredLocations("testdata/many.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "364ca2e9710f6bff494117dbbd53880d84bebb692dafc3a78eb50aa3183f2bfd",
 					},
@@ -156,7 +156,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "earmark_parser",
 					Version:   "1.4.10",
-					Locations: []string{"testdata/many.lock"},
+					Locations: extractor.DeclaredLocations("testdata/many.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "6603d7a603b9c18d3d20db69921527f82ef09990885ed7525003c7fe7dc86c56",
 					},
@@ -164,7 +164,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "ecto",
 					Version:   "3.5.5",
-					Locations: []string{"testdata/many.lock"},
+					Locations: extractor.DeclaredLocations("testdata/many.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "48219a991bb86daba6e38a1e64f8cea540cded58950ff38fbc8163e062281a07",
 					},
@@ -172,7 +172,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "erlex",
 					Version:   "0.2.6",
-					Locations: []string{"testdata/many.lock"},
+					Locations: extractor.DeclaredLocations("testdata/many.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "c7987d15e899c7a2f34f5420d2a2ea0d659682c06ac607572df55a43753aa12e",
 					},
@@ -180,7 +180,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "ex_doc",
 					Version:   "0.23.0",
-					Locations: []string{"testdata/many.lock"},
+					Locations: extractor.DeclaredLocations("testdata/many.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "a069bc9b0bf8efe323ecde8c0d62afc13d308b1fa3d228b65bca5cf8703a529d",
 					},
@@ -188,7 +188,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "makeup",
 					Version:   "1.0.5",
-					Locations: []string{"testdata/many.lock"},
+					Locations: extractor.DeclaredLocations("testdata/many.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "d5a830bc42c9800ce07dd97fa94669dfb93d3bf5fcf6ea7a0c67b2e0e4a7f26c",
 					},
@@ -196,7 +196,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "makeup_elixir",
 					Version:   "0.15.0",
-					Locations: []string{"testdata/many.lock"},
+					Locations: extractor.DeclaredLocations("testdata/many.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "98312c9f0d3730fde4049985a1105da5155bfe5c11e47bdc7406d88e01e4219b",
 					},
@@ -204,7 +204,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "meck",
 					Version:   "0.9.2",
-					Locations: []string{"testdata/many.lock"},
+					Locations: extractor.DeclaredLocations("testdata/many.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "85ccbab053f1db86c7ca240e9fc718170ee5bda03810a6292b5306bf31bae5f5",
 					},
@@ -212,7 +212,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "mime",
 					Version:   "1.5.0",
-					Locations: []string{"testdata/many.lock"},
+					Locations: extractor.DeclaredLocations("testdata/many.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "203ef35ef3389aae6d361918bf3f952fa17a09e8e43b5aa592b93eba05d0fb8d",
 					},
@@ -220,7 +220,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "nimble_parsec",
 					Version:   "1.1.0",
-					Locations: []string{"testdata/many.lock"},
+					Locations: extractor.DeclaredLocations("testdata/many.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "3a6fca1550363552e54c216debb6a9e95bd8d32348938e13de5eda962c0d7f89",
 					},
@@ -228,7 +228,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "phoenix",
 					Version:   "1.4.17",
-					Locations: []string{"testdata/many.lock"},
+					Locations: extractor.DeclaredLocations("testdata/many.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "1b1bd4cff7cfc87c94deaa7d60dd8c22e04368ab95499483c50640ef3bd838d8",
 					},
@@ -236,7 +236,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "phoenix_html",
 					Version:   "2.14.3",
-					Locations: []string{"testdata/many.lock"},
+					Locations: extractor.DeclaredLocations("testdata/many.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "51f720d0d543e4e157ff06b65de38e13303d5778a7919bcc696599e5934271b8",
 					},
@@ -244,7 +244,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "phoenix_pubsub",
 					Version:   "1.1.2",
-					Locations: []string{"testdata/many.lock"},
+					Locations: extractor.DeclaredLocations("testdata/many.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "496c303bdf1b2e98a9d26e89af5bba3ab487ba3a3735f74bf1f4064d2a845a3e",
 					},
@@ -252,7 +252,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "plug",
 					Version:   "1.11.1",
-					Locations: []string{"testdata/many.lock"},
+					Locations: extractor.DeclaredLocations("testdata/many.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "f2992bac66fdae679453c9e86134a4201f6f43a687d8ff1cd1b2862d53c80259",
 					},
@@ -260,7 +260,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "plug_crypto",
 					Version:   "1.2.2",
-					Locations: []string{"testdata/many.lock"},
+					Locations: extractor.DeclaredLocations("testdata/many.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "05654514ac717ff3a1843204b424477d9e60c143406aa94daf2274fdd280794d",
 					},
@@ -268,7 +268,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "poolboy",
 					Version:   "1.5.2",
-					Locations: []string{"testdata/many.lock"},
+					Locations: extractor.DeclaredLocations("testdata/many.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "392b007a1693a64540cead79830443abf5762f5d30cf50bc95cb2c1aaafa006b",
 					},
@@ -276,7 +276,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "pow",
 					Version:   "1.0.15",
-					Locations: []string{"testdata/many.lock"},
+					Locations: extractor.DeclaredLocations("testdata/many.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "9267b5c75df2d59968585c042e2a0ec6217b1959d3afd629817461f0a20e903c",
 					},
@@ -284,7 +284,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "telemetry",
 					Version:   "0.4.2",
-					Locations: []string{"testdata/many.lock"},
+					Locations: extractor.DeclaredLocations("testdata/many.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "2808c992455e08d6177322f14d3bdb6b625fbcfd233a73505870d8738a2f4599",
 					},
@@ -300,7 +300,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "foe",
 					Version:   "",
-					Locations: []string{"testdata/git.lock"},
+					Locations: extractor.DeclaredLocations("testdata/git.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "a9574ab75d6ed01e1288c453ae1d943d7a964595",
 					},
@@ -308,7 +308,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "foo",
 					Version:   "",
-					Locations: []string{"testdata/git.lock"},
+					Locations: extractor.DeclaredLocations("testdata/git.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "fc94cce7830fa4dc455024bc2a83720afe244531",
 					},
@@ -316,7 +316,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "bar",
 					Version:   "",
-					Locations: []string{"testdata/git.lock"},
+					Locations: extractor.DeclaredLocations("testdata/git.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "bef3ee1d3618017061498b96c75043e8449ef9b5",
 					},
@@ -36,6 +36,10 @@ import (
 const (
 	// Name is the unique name of this extractor.
 	Name = "go/binary"
+
+	// develVersion is the version Go reports for main modules built from a
+	// local, untagged source tree.
+	develVersion = "(devel)"
 )
 
 // Config is the configuration for the Extractor.
@@ -86,6 +90,28 @@ func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabili
 
 // FileRequired returns true if the specified file is marked executable.
 func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
+	if !e.fileMatches(path, fileinfo) {
+		return false
+	}
+
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// FileSizeLimitExceeded returns true if the file would be required if it
+// didn't exceed the maximum file size.
+func (e Extractor) FileSizeLimitExceeded(path string, fileinfo fs.FileInfo) bool {
+	return e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes && e.fileMatches(path, fileinfo)
+}
+
+// fileMatches returns true if the file is one the extractor is interested in,
+// regardless of its size.
+func (e Extractor) fileMatches(path string, fileinfo fs.FileInfo) bool {
 	if !fileinfo.Mode().IsRegular() {
 		// Includes dirs, symlinks, sockets, pipes...
 		return false
@@ -97,12 +123,6 @@ func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
 		return false
 	}
 
-	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
-		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
-		return false
-	}
-
-	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
 	return true
 }
 
@@ -111,6 +131,7 @@ func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result s
 		return
 	}
 	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Extractor:     e.Name(),
 		Path:          path,
 		Result:        result,
 		FileSizeBytes: fileSizeBytes,
@@ -157,7 +178,17 @@ func (e *Extractor) extractPackagesFromBuildInfo(binfo *buildinfo.BuildInfo, fil
 		res = append(res, &extractor.Inventory{
 			Name:      "go",
 			Version:   validatedGoVers,
-			Locations: []string{filename},
+			Locations: extractor.InstalledLocations(filename),
+		})
+	}
+
+	// The main module is only reported if it carries a real version. Binaries built
+	// outside of a tagged VCS checkout report "(devel)" instead.
+	if binfo.Main.Path != "" && binfo.Main.Version != "" && binfo.Main.Version != develVersion {
+		res = append(res, &extractor.Inventory{
+			Name:      binfo.Main.Path,
+			Version:   strings.TrimPrefix(binfo.Main.Version, "v"),
+			Locations: extractor.InstalledLocations(filename),
 		})
 	}
 
@@ -172,7 +203,7 @@ func (e *Extractor) extractPackagesFromBuildInfo(binfo *buildinfo.BuildInfo, fil
 		pkg := &extractor.Inventory{
 			Name:      pkgName,
 			Version:   pkgVers,
-			Locations: []string{filename},
+			Locations: extractor.InstalledLocations(filename),
 		}
 		res = append(res, pkg)
 	}
@@ -215,5 +246,8 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	}
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeGolang} }
+
 // Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
 func (Extractor) Ecosystem(i *extractor.Inventory) string { return "Go" }
@@ -239,6 +239,14 @@ func TestExtract(t *testing.T) {
 			path:          "testdata/binary_with_modules-windows-arm64",
 			wantInventory: createInventories(append(BinaryWithModulesPackagesWindows, Toolchain), "testdata/binary_with_modules-windows-arm64"),
 		},
+		{
+			name: "stripped binary with versioned main module",
+			path: "testdata/binary_with_main_module-linux-amd64",
+			wantInventory: createInventories(
+				[]*extractor.Inventory{goPackage("example.com/binary_with_main_module", "1.2.3"), goPackage("go", "1.27.1")},
+				"testdata/binary_with_main_module-linux-amd64",
+			),
+		},
 		{
 			name:             "dummy file that fails to parse will log an error metric, but won't fail extraction",
 			path:             "testdata/dummy",
@@ -296,7 +304,7 @@ func TestToPURL(t *testing.T) {
 	i := &extractor.Inventory{
 		Name:      "name",
 		Version:   "1.2.3",
-		Locations: []string{"location"},
+		Locations: extractor.InstalledLocations("location"),
 	}
 	want := &purl.PackageURL{
 		Type:    purl.TypeGolang,
@@ -359,7 +367,7 @@ func createInventories(invs []*extractor.Inventory, location string) []*extracto
 	res := []*extractor.Inventory{}
 	for _, i := range invs {
 		res = append(res, &extractor.Inventory{
-			Name: i.Name, Version: i.Version, Locations: []string{location},
+			Name: i.Name, Version: i.Version, Locations: extractor.InstalledLocations(location),
 		})
 	}
 	return res
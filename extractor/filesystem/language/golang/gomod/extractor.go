@@ -48,6 +48,11 @@ func (e Extractor) Requirements() *plugin.Capabilities {
 	return &plugin.Capabilities{}
 }
 
+// FileHints returns the file names the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{BaseNames: []string{"go.mod"}}
+}
+
 // FileRequired returns true if the specified file matches go.mod files.
 func (e Extractor) FileRequired(path string, fileInfo fs.FileInfo) bool {
 	return filepath.Base(path) == "go.mod"
@@ -77,7 +82,7 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 		packages[mapKey{name: name, version: version}] = &extractor.Inventory{
 			Name:      name,
 			Version:   version,
-			Locations: []string{input.Path},
+			Locations: extractor.DeclaredLocations(input.Path),
 		}
 	}
 
@@ -108,7 +113,7 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 			packages[replacement] = &extractor.Inventory{
 				Name:      replace.New.Path,
 				Version:   strings.TrimPrefix(replace.New.Version, "v"),
-				Locations: []string{input.Path},
+				Locations: extractor.DeclaredLocations(input.Path),
 			}
 		}
 	}
@@ -118,7 +123,7 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 		packages[mapKey{name: "stdlib"}] = &extractor.Inventory{
 			Name:      "stdlib",
 			Version:   parsedLockfile.Go.Version,
-			Locations: []string{input.Path},
+			Locations: extractor.DeclaredLocations(input.Path),
 		}
 	}
 
@@ -140,6 +145,9 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	}
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeGolang} }
+
 // Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
 func (e Extractor) Ecosystem(i *extractor.Inventory) string {
 	return "Go"
@@ -97,7 +97,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "github.com/BurntSushi/toml",
 					Version:   "1.0.0",
-					Locations: []string{"testdata/one-package.mod"},
+					Locations: extractor.DeclaredLocations("testdata/one-package.mod"),
 				},
 			},
 		},
@@ -110,17 +110,17 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "github.com/BurntSushi/toml",
 					Version:   "1.0.0",
-					Locations: []string{"testdata/two-packages.mod"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.mod"),
 				},
 				{
 					Name:      "gopkg.in/yaml.v2",
 					Version:   "2.4.0",
-					Locations: []string{"testdata/two-packages.mod"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.mod"),
 				},
 				{
 					Name:      "stdlib",
 					Version:   "1.17",
-					Locations: []string{"testdata/two-packages.mod"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.mod"),
 				},
 			},
 		},
@@ -133,32 +133,32 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "github.com/BurntSushi/toml",
 					Version:   "1.0.0",
-					Locations: []string{"testdata/indirect-packages.mod"},
+					Locations: extractor.DeclaredLocations("testdata/indirect-packages.mod"),
 				},
 				{
 					Name:      "gopkg.in/yaml.v2",
 					Version:   "2.4.0",
-					Locations: []string{"testdata/indirect-packages.mod"},
+					Locations: extractor.DeclaredLocations("testdata/indirect-packages.mod"),
 				},
 				{
 					Name:      "github.com/mattn/go-colorable",
 					Version:   "0.1.9",
-					Locations: []string{"testdata/indirect-packages.mod"},
+					Locations: extractor.DeclaredLocations("testdata/indirect-packages.mod"),
 				},
 				{
 					Name:      "github.com/mattn/go-isatty",
 					Version:   "0.0.14",
-					Locations: []string{"testdata/indirect-packages.mod"},
+					Locations: extractor.DeclaredLocations("testdata/indirect-packages.mod"),
 				},
 				{
 					Name:      "golang.org/x/sys",
 					Version:   "0.0.0-20210630005230-0f9fa26af87c",
-					Locations: []string{"testdata/indirect-packages.mod"},
+					Locations: extractor.DeclaredLocations("testdata/indirect-packages.mod"),
 				},
 				{
 					Name:      "stdlib",
 					Version:   "1.17",
-					Locations: []string{"testdata/indirect-packages.mod"},
+					Locations: extractor.DeclaredLocations("testdata/indirect-packages.mod"),
 				},
 			},
 		},
@@ -171,7 +171,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "example.com/fork/net",
 					Version:   "1.4.5",
-					Locations: []string{"testdata/replace-one.mod"},
+					Locations: extractor.DeclaredLocations("testdata/replace-one.mod"),
 				},
 			},
 		},
@@ -184,12 +184,12 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "example.com/fork/net",
 					Version:   "1.4.5",
-					Locations: []string{"testdata/replace-mixed.mod"},
+					Locations: extractor.DeclaredLocations("testdata/replace-mixed.mod"),
 				},
 				{
 					Name:      "golang.org/x/net",
 					Version:   "0.5.6",
-					Locations: []string{"testdata/replace-mixed.mod"},
+					Locations: extractor.DeclaredLocations("testdata/replace-mixed.mod"),
 				},
 			},
 		},
@@ -202,12 +202,12 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "./fork/net",
 					Version:   "",
-					Locations: []string{"testdata/replace-local.mod"},
+					Locations: extractor.DeclaredLocations("testdata/replace-local.mod"),
 				},
 				{
 					Name:      "github.com/BurntSushi/toml",
 					Version:   "1.0.0",
-					Locations: []string{"testdata/replace-local.mod"},
+					Locations: extractor.DeclaredLocations("testdata/replace-local.mod"),
 				},
 			},
 		},
@@ -220,12 +220,12 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "example.com/fork/foe",
 					Version:   "1.4.5",
-					Locations: []string{"testdata/replace-different.mod"},
+					Locations: extractor.DeclaredLocations("testdata/replace-different.mod"),
 				},
 				{
 					Name:      "example.com/fork/foe",
 					Version:   "1.4.2",
-					Locations: []string{"testdata/replace-different.mod"},
+					Locations: extractor.DeclaredLocations("testdata/replace-different.mod"),
 				},
 			},
 		},
@@ -238,12 +238,12 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "golang.org/x/net",
 					Version:   "0.5.6",
-					Locations: []string{"testdata/replace-not-required.mod"},
+					Locations: extractor.DeclaredLocations("testdata/replace-not-required.mod"),
 				},
 				{
 					Name:      "github.com/BurntSushi/toml",
 					Version:   "1.0.0",
-					Locations: []string{"testdata/replace-not-required.mod"},
+					Locations: extractor.DeclaredLocations("testdata/replace-not-required.mod"),
 				},
 			},
 		},
@@ -256,7 +256,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "example.com/fork/net",
 					Version:   "1.4.5",
-					Locations: []string{"testdata/replace-no-version.mod"},
+					Locations: extractor.DeclaredLocations("testdata/replace-no-version.mod"),
 				},
 			},
 		},
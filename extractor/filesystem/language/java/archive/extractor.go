@@ -45,6 +45,9 @@ const (
 	// defaultMaxZipDepth is the maximum number of inner zip files within an archive the default extractor will unzip.
 	// Once reached, no more inner zip files will be explored during extraction.
 	defaultMaxZipDepth = 16
+	// defaultMaxZipEntries is the maximum number of entries the default extractor
+	// walks in an archive, including the entries of nested archives.
+	defaultMaxZipEntries = 1_000_000
 	// defaultMaxZipBytes in the maximum number of bytes recursively read from an archive file.
 	// If this limit is reached, the default extractor is halted and results so far are returned.
 	defaultMaxZipBytes = 4 * units.GiB
@@ -62,6 +65,10 @@ type Config struct {
 	// MaxZipDepth is the maximum number of inner zip files within an archive the extractor will unzip.
 	// Once reached, no more inner zip files will be explored during extraction.
 	MaxZipDepth int
+	// MaxZipEntries is the maximum number of entries the extractor walks in an
+	// archive, summed up over all nested archives. Once reached, extraction is
+	// halted and results so far are returned. If 0, no limit is applied.
+	MaxZipEntries int
 	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
 	// If this limit is greater than zero and a file is encountered that is larger
 	// than this limit, the file is ignored by returning false for `FileRequired`.
@@ -83,6 +90,7 @@ type Config struct {
 // Extractor extracts Java packages from archive files.
 type Extractor struct {
 	maxZipDepth         int
+	maxZipEntries       int
 	maxFileSizeBytes    int64
 	maxOpenedBytes      int64
 	minZipBytes         int
@@ -95,6 +103,7 @@ type Extractor struct {
 func DefaultConfig() Config {
 	return Config{
 		MaxZipDepth:         defaultMaxZipDepth,
+		MaxZipEntries:       defaultMaxZipEntries,
 		MaxFileSizeBytes:    0,
 		MaxOpenedBytes:      defaultMaxZipBytes,
 		MinZipBytes:         defaultMinZipBytes,
@@ -113,6 +122,7 @@ func DefaultConfig() Config {
 func New(cfg Config) *Extractor {
 	return &Extractor{
 		maxZipDepth:         cfg.MaxZipDepth,
+		maxZipEntries:       cfg.MaxZipEntries,
 		maxFileSizeBytes:    cfg.MaxFileSizeBytes,
 		maxOpenedBytes:      cfg.MaxOpenedBytes,
 		minZipBytes:         cfg.MinZipBytes,
@@ -133,7 +143,7 @@ func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabili
 
 // FileRequired returns true if the specified file matches java archive file patterns.
 func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
-	if !isArchive(filepath.ToSlash(path)) {
+	if !e.fileMatches(path, fileinfo) {
 		return false
 	}
 
@@ -146,11 +156,24 @@ func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
 	return true
 }
 
+// FileSizeLimitExceeded returns true if the file would be required if it
+// didn't exceed the maximum file size.
+func (e Extractor) FileSizeLimitExceeded(path string, fileinfo fs.FileInfo) bool {
+	return e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes && e.fileMatches(path, fileinfo)
+}
+
+// fileMatches returns true if the file is one the extractor is interested in,
+// regardless of its size.
+func (e Extractor) fileMatches(path string, fileinfo fs.FileInfo) bool {
+	return isArchive(filepath.ToSlash(path))
+}
+
 func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
 	if e.stats == nil {
 		return
 	}
 	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Extractor:     e.Name(),
 		Path:          path,
 		Result:        result,
 		FileSizeBytes: fileSizeBytes,
@@ -159,7 +182,8 @@ func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result s
 
 // Extract extracts java packages from archive files passed through input.
 func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
-	inventory, openedBytes, err := e.extractWithMax(ctx, input, 1, 0)
+	entries := 0
+	inventory, openedBytes, err := e.extractWithMax(ctx, input, 1, 0, &entries)
 	if e.stats != nil {
 		var fileSizeBytes int64
 		if input.Info != nil {
@@ -177,12 +201,13 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 
 // extractWithMax recursively unzips and extracts packages from archive files starting at input.
 //
-// It returns early with an error if max depth or max opened bytes is reached.
-// Extracted packages are returned even if an error has occurred.
-func (e Extractor) extractWithMax(ctx context.Context, input *filesystem.ScanInput, depth int, openedBytes int64) ([]*extractor.Inventory, int64, error) {
+// It returns early with an error if max depth, max entries or max opened bytes
+// is reached. entries counts the entries walked so far across all nested
+// archives. Extracted packages are returned even if an error has occurred.
+func (e Extractor) extractWithMax(ctx context.Context, input *filesystem.ScanInput, depth int, openedBytes int64, entries *int) ([]*extractor.Inventory, int64, error) {
 	// Return early if any max/min thresholds are hit.
 	if depth > e.maxZipDepth {
-		return nil, openedBytes, fmt.Errorf("%s reached max zip depth %d at %q", e.Name(), depth, input.Path)
+		return nil, openedBytes, fmt.Errorf("%s: %w", e.Name(), &filesystem.ArchiveLimitError{Path: input.Path, MaxDepth: e.maxZipDepth})
 	}
 	if oBytes := openedBytes + input.Info.Size(); oBytes > e.maxOpenedBytes {
 		return nil, oBytes, fmt.Errorf(
@@ -255,6 +280,12 @@ func (e Extractor) extractWithMax(ctx context.Context, input *filesystem.ScanInp
 		}
 
 		path := filepath.Join(input.Path, file.Name)
+		*entries++
+		if e.maxZipEntries > 0 && *entries > e.maxZipEntries {
+			// Ignore local findings from pom and manifest, as they are incomplete.
+			errs = append(errs, &filesystem.ArchiveLimitError{Path: path, MaxEntries: e.maxZipEntries})
+			return inventory, openedBytes, fmt.Errorf("error(s) in extractor %s: %w", e.Name(), multierr.Combine(errs...))
+		}
 		switch {
 		case filepath.Base(file.Name) == "pom.properties":
 			pp, err := parsePomProps(file)
@@ -272,7 +303,7 @@ func (e Extractor) extractWithMax(ctx context.Context, input *filesystem.ScanInp
 						GroupID:    pp.GroupID,
 						SHA1:       sha1,
 					},
-					Locations: []string{path},
+					Locations: extractor.InstalledLocations(path),
 				})
 			}
 
@@ -292,7 +323,7 @@ func (e Extractor) extractWithMax(ctx context.Context, input *filesystem.ScanInp
 						GroupID:    mf.GroupID,
 						SHA1:       sha1,
 					},
-					Locations: []string{path},
+					Locations: extractor.InstalledLocations(path),
 				})
 			}
 
@@ -309,11 +340,13 @@ func (e Extractor) extractWithMax(ctx context.Context, input *filesystem.ScanInp
 				defer f.Close()
 				subInput := &filesystem.ScanInput{Path: path, Info: file.FileInfo(), Reader: f}
 				var subInventory []*extractor.Inventory
-				subInventory, openedBytes, err = e.extractWithMax(ctx, subInput, depth+1, openedBytes)
+				subInventory, openedBytes, err = e.extractWithMax(ctx, subInput, depth+1, openedBytes, entries)
 				if err != nil {
 					log.Errorf("%s failed to extract %q: %v", e.Name(), path, err)
 					errs = append(errs, err)
-					return
+					if !errors.Is(err, filesystem.ErrArchiveLimitExceeded) {
+						return
+					}
 				}
 				inventory = append(inventory, subInventory...)
 			}()
@@ -358,7 +391,7 @@ func (e Extractor) extractWithMax(ctx context.Context, input *filesystem.ScanInp
 					GroupID:    groupID,
 					SHA1:       sha1,
 				},
-				Locations: []string{input.Path},
+				Locations: extractor.InstalledLocations(input.Path),
 			})
 		}
 	}
@@ -378,7 +411,7 @@ func (e Extractor) extractWithMax(ctx context.Context, input *filesystem.ScanInp
 				GroupID:    "unknown",
 				SHA1:       sha1,
 			},
-			Locations: []string{input.Path},
+			Locations: extractor.InstalledLocations(input.Path),
 		})
 	}
 
@@ -430,5 +463,8 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	}
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeMaven} }
+
 // Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
 func (Extractor) Ecosystem(i *extractor.Inventory) string { return "Maven" }
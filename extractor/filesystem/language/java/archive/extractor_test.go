@@ -217,9 +217,9 @@ func TestExtract(t *testing.T) {
 				Name:     "package-name",
 				Version:  "1.2.3",
 				Metadata: &archive.Metadata{ArtifactID: "package-name", GroupID: "com.some.package"},
-				Locations: []string{
+				Locations: extractor.InstalledLocations(
 					filepath.FromSlash("testdata/simple.jar/pom.properties"),
-				},
+				),
 			}},
 		},
 		{
@@ -242,9 +242,9 @@ func TestExtract(t *testing.T) {
 				Name:     "package-name",
 				Version:  "1.2.3",
 				Metadata: &archive.Metadata{ArtifactID: "package-name", GroupID: "com.some.package"},
-				Locations: []string{
+				Locations: extractor.InstalledLocations(
 					filepath.FromSlash("testdata/simple.jar/pom.properties"),
-				},
+				),
 			}},
 		},
 		{
@@ -258,9 +258,9 @@ func TestExtract(t *testing.T) {
 				Name:     "no_pom_properties",
 				Version:  "2.4.0",
 				Metadata: &archive.Metadata{ArtifactID: "no_pom_properties", GroupID: "no_pom_properties"},
-				Locations: []string{
+				Locations: extractor.InstalledLocations(
 					filepath.FromSlash("testdata/no_pom_properties-2.4.0.jar"),
-				},
+				),
 			}},
 		},
 		{
@@ -278,9 +278,9 @@ func TestExtract(t *testing.T) {
 					ArtifactID: "no_pom_properties",
 					GroupID:    "org.apache.ivy", // Group ID overridden by manifest.
 				},
-				Locations: []string{
+				Locations: extractor.InstalledLocations(
 					filepath.FromSlash("testdata/no_pom_properties-2.4.0.jar"),
-				},
+				),
 			}},
 		},
 		{
@@ -300,9 +300,9 @@ func TestExtract(t *testing.T) {
 					// manifest.
 					GroupID: "no_pom_properties",
 				},
-				Locations: []string{
+				Locations: extractor.InstalledLocations(
 					filepath.FromSlash("testdata/no_pom_properties-2.4.0.jar"),
-				},
+				),
 			}},
 		},
 		{
@@ -316,9 +316,9 @@ func TestExtract(t *testing.T) {
 				Name:     "pom_missing_group_id",
 				Version:  "2.4.0",
 				Metadata: &archive.Metadata{ArtifactID: "pom_missing_group_id", GroupID: "pom_missing_group_id"},
-				Locations: []string{
+				Locations: extractor.InstalledLocations(
 					filepath.FromSlash("testdata/pom_missing_group_id-2.4.0.jar"),
-				},
+				),
 			}},
 		},
 		{
@@ -332,9 +332,9 @@ func TestExtract(t *testing.T) {
 				Name:     "org.eclipse.sisu.inject",
 				Version:  "0.3.5",
 				Metadata: &archive.Metadata{ArtifactID: "org.eclipse.sisu.inject", GroupID: "org.eclipse.sisu"},
-				Locations: []string{
+				Locations: extractor.InstalledLocations(
 					filepath.FromSlash("testdata/org.eclipse.sisu.inject-0.3.5.jar"),
-				},
+				),
 			}},
 		},
 		{
@@ -349,9 +349,9 @@ func TestExtract(t *testing.T) {
 					GroupID:    "com.some.package",
 					SHA1:       "PO6pevcX8f2Rkpv4xB6NYviFokQ=", // inner most nested.jar
 				},
-				Locations: []string{
+				Locations: extractor.InstalledLocations(
 					filepath.FromSlash("testdata/nested_at_10.jar/nested.jar/nested.jar/nested.jar/nested.jar/nested.jar/nested.jar/nested.jar/nested.jar/nested.jar/pom.properties"),
-				},
+				),
 			}},
 		},
 		{
@@ -359,7 +359,30 @@ func TestExtract(t *testing.T) {
 			description: "Returns error with no results because max depth is reached before getting to pom.properties",
 			path:        filepath.FromSlash("testdata/nested_at_100.jar"),
 			want:        []*extractor.Inventory{},
-			wantErr:     errAny,
+			wantErr:     filesystem.ErrArchiveLimitExceeded,
+		},
+		{
+			name:        "Nested jars beyond a custom max depth",
+			description: "Returns a typed error with no results because pom.properties is nested deeper than the limit",
+			path:        filepath.FromSlash("testdata/nested_at_10.jar"),
+			cfg:         archive.Config{MaxZipDepth: 5},
+			want:        []*extractor.Inventory{},
+			wantErr:     filesystem.ErrArchiveLimitExceeded,
+		},
+		{
+			name:        "Jar with more entries than the limit",
+			description: "Returns a typed error and the outer package once the entries of the outer and inner jars exceed the limit",
+			path:        filepath.FromSlash("testdata/complex.jar"),
+			cfg:         archive.Config{MaxZipEntries: 2},
+			want: []*extractor.Inventory{{
+				Name:     "package-name",
+				Version:  "1.2.3",
+				Metadata: &archive.Metadata{ArtifactID: "package-name", GroupID: "com.some.package"},
+				Locations: extractor.InstalledLocations(
+					filepath.FromSlash("testdata/complex.jar/pom.properties"),
+				),
+			}},
+			wantErr: filesystem.ErrArchiveLimitExceeded,
 		},
 		{
 			name:        "Jar file with pom.properties at multiple depths",
@@ -370,17 +393,40 @@ func TestExtract(t *testing.T) {
 					Name:     "package-name",
 					Version:  "1.2.3",
 					Metadata: &archive.Metadata{ArtifactID: "package-name", GroupID: "com.some.package"},
-					Locations: []string{
+					Locations: extractor.InstalledLocations(
 						filepath.FromSlash("testdata/complex.jar/pom.properties"),
-					},
+					),
 				},
 				{
 					Name:     "another-package-name",
 					Version:  "3.2.1",
 					Metadata: &archive.Metadata{ArtifactID: "another-package-name", GroupID: "com.some.anotherpackage"},
-					Locations: []string{
+					Locations: extractor.InstalledLocations(
 						filepath.FromSlash("testdata/complex.jar/BOOT-INF/lib/inner.jar/pom.properties"),
-					},
+					),
+				},
+			},
+		},
+		{
+			name:        "Uber jar with multiple pom.properties",
+			description: "A shaded jar bundling two pom.properties under META-INF/maven and a manifest, which is ignored since pom.properties were found.",
+			path:        filepath.FromSlash("testdata/uber.jar"),
+			want: []*extractor.Inventory{
+				{
+					Name:     "guava",
+					Version:  "31.1-jre",
+					Metadata: &archive.Metadata{ArtifactID: "guava", GroupID: "com.google.guava"},
+					Locations: extractor.InstalledLocations(
+						filepath.FromSlash("testdata/uber.jar/META-INF/maven/com.google.guava/guava/pom.properties"),
+					),
+				},
+				{
+					Name:     "commons-text",
+					Version:  "1.10.0",
+					Metadata: &archive.Metadata{ArtifactID: "commons-text", GroupID: "org.apache.commons"},
+					Locations: extractor.InstalledLocations(
+						filepath.FromSlash("testdata/uber.jar/META-INF/maven/org.apache.commons/commons-text/pom.properties"),
+					),
 				},
 			},
 		},
@@ -396,9 +442,9 @@ func TestExtract(t *testing.T) {
 				Name:     "package-name",
 				Version:  "1.2.3",
 				Metadata: &archive.Metadata{ArtifactID: "package-name", GroupID: "com.some.package"},
-				Locations: []string{
+				Locations: extractor.InstalledLocations(
 					filepath.FromSlash("testdata/complex.jar/pom.properties"),
-				},
+				),
 			}},
 			wantErr:          filesystem.ErrExtractorMemoryLimitExceeded,
 			wantResultMetric: stats.FileExtractedResultErrorMemoryLimitExceeded,
@@ -417,9 +463,9 @@ func TestExtract(t *testing.T) {
 						// openssl sha1 -binary third_party/scalibr/extractor/filesystem/language/java/archive/testdata/guava-31.1-jre.jar | base64
 						SHA1: "YEWPh30FXQyRFNnhou+3N7S8KCw=",
 					},
-					Locations: []string{
+					Locations: extractor.InstalledLocations(
 						filepath.FromSlash("testdata/guava-31.1-jre.jar/META-INF/maven/com.google.guava/guava/pom.properties"),
-					},
+					),
 				},
 			},
 		},
@@ -439,9 +485,9 @@ func TestExtract(t *testing.T) {
 					ArtifactID: "failureaccess",
 					GroupID:    "com.google.guava.failureaccess",
 				},
-				Locations: []string{
+				Locations: extractor.InstalledLocations(
 					filepath.FromSlash("testdata/manifest-symbolicname/MANIFEST.MF"),
-				},
+				),
 			}},
 		},
 		{
@@ -455,9 +501,9 @@ func TestExtract(t *testing.T) {
 					ArtifactID: "correct.name",
 					GroupID:    "test.group",
 				},
-				Locations: []string{
+				Locations: extractor.InstalledLocations(
 					filepath.FromSlash("testdata/invalid-ids/MANIFEST.MF"),
-				},
+				),
 			}},
 		},
 		{
@@ -471,9 +517,9 @@ func TestExtract(t *testing.T) {
 					ArtifactID: "spring-web",
 					GroupID:    "org.springframework",
 				},
-				Locations: []string{
+				Locations: extractor.InstalledLocations(
 					filepath.FromSlash("testdata/known-group-id/MANIFEST.MF"),
-				},
+				),
 			}},
 		},
 		{
@@ -488,9 +534,9 @@ func TestExtract(t *testing.T) {
 					ArtifactID: "ivy",
 					GroupID:    "org.apache.ivy",
 				},
-				Locations: []string{
+				Locations: extractor.InstalledLocations(
 					filepath.FromSlash("testdata/ivy-2.4.0.jar"),
-				},
+				),
 			}},
 		},
 		{
@@ -506,9 +552,9 @@ func TestExtract(t *testing.T) {
 					ArtifactID: "no_pom_properties",
 					GroupID:    "org.elasticsearch",
 				},
-				Locations: []string{
+				Locations: extractor.InstalledLocations(
 					filepath.FromSlash("testdata/no_pom_properties-2.4.0.jar"),
-				},
+				),
 			}},
 		},
 		{
@@ -523,9 +569,9 @@ func TestExtract(t *testing.T) {
 				Name:     "axis",
 				Version:  "1.4",
 				Metadata: &archive.Metadata{ArtifactID: "axis", GroupID: "org.apache.axis"},
-				Locations: []string{
+				Locations: extractor.InstalledLocations(
 					filepath.FromSlash("testdata/axis/MANIFEST.MF"),
-				},
+				),
 			}},
 		},
 	}
@@ -597,7 +643,7 @@ func TestToPURL(t *testing.T) {
 			ArtifactID: "ArtifactID",
 			GroupID:    "GroupID",
 		},
-		Locations: []string{"location"},
+		Locations: extractor.InstalledLocations("location"),
 	}
 	want := &purl.PackageURL{
 		Type:      purl.TypeMaven,
@@ -626,6 +672,9 @@ func defaultConfigWith(cfg archive.Config) archive.Config {
 	if cfg.MaxZipDepth > 0 {
 		newCfg.MaxZipDepth = cfg.MaxZipDepth
 	}
+	if cfg.MaxZipEntries > 0 {
+		newCfg.MaxZipEntries = cfg.MaxZipEntries
+	}
 	if cfg.MaxOpenedBytes > 0 {
 		newCfg.MaxOpenedBytes = cfg.MaxOpenedBytes
 	}
@@ -56,8 +56,9 @@ func parseToGradlePackageDetail(line string) (*extractor.Inventory, error) {
 	version = strings.SplitN(version, "=", 2)[0]
 
 	return &extractor.Inventory{
-		Name:    fmt.Sprintf("%s:%s", group, artifact),
-		Version: version,
+		Name:      fmt.Sprintf("%s:%s", group, artifact),
+		Namespace: group,
+		Version:   version,
 		Metadata: &javalockfile.Metadata{
 			ArtifactID: artifact,
 			GroupID:    group,
@@ -79,6 +80,11 @@ func (e Extractor) Requirements() *plugin.Capabilities {
 	return &plugin.Capabilities{}
 }
 
+// FileHints returns the file names the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{BaseNames: []string{"buildscript-gradle.lockfile", "gradle.lockfile"}}
+}
+
 // FileRequired returns true if the specified file matches Gradle lockfile patterns.
 func (e Extractor) FileRequired(path string, fileInfo fs.FileInfo) bool {
 	base := filepath.Base(path)
@@ -102,7 +108,7 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 			continue
 		}
 
-		pkg.Locations = []string{input.Path}
+		pkg.Locations = extractor.DeclaredLocations(input.Path)
 
 		pkgs = append(pkgs, pkg)
 	}
@@ -125,6 +131,9 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	}
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeMaven} }
+
 // Ecosystem returns the OSV ecosystem ('Maven') of the software extracted by this extractor.
 func (e Extractor) Ecosystem(i *extractor.Inventory) string {
 	return "Maven"
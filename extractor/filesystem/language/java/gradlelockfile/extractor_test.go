@@ -115,8 +115,9 @@ func TestExtractor_Extract(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "org.springframework.security:spring-security-crypto",
+					Namespace: "org.springframework.security",
 					Version:   "5.7.3",
-					Locations: []string{"testdata/one-pkg"},
+					Locations: extractor.DeclaredLocations("testdata/one-pkg"),
 					Metadata: &javalockfile.Metadata{
 						ArtifactID: "spring-security-crypto",
 						GroupID:    "org.springframework.security",
@@ -132,8 +133,9 @@ func TestExtractor_Extract(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "org.springframework.boot:spring-boot-autoconfigure",
+					Namespace: "org.springframework.boot",
 					Version:   "2.7.4",
-					Locations: []string{"testdata/5-pkg"},
+					Locations: extractor.DeclaredLocations("testdata/5-pkg"),
 					Metadata: &javalockfile.Metadata{
 						ArtifactID: "spring-boot-autoconfigure",
 						GroupID:    "org.springframework.boot",
@@ -141,8 +143,9 @@ func TestExtractor_Extract(t *testing.T) {
 				},
 				{
 					Name:      "org.springframework.boot:spring-boot-configuration-processor",
+					Namespace: "org.springframework.boot",
 					Version:   "2.7.5",
-					Locations: []string{"testdata/5-pkg"},
+					Locations: extractor.DeclaredLocations("testdata/5-pkg"),
 					Metadata: &javalockfile.Metadata{
 						ArtifactID: "spring-boot-configuration-processor",
 						GroupID:    "org.springframework.boot",
@@ -150,8 +153,9 @@ func TestExtractor_Extract(t *testing.T) {
 				},
 				{
 					Name:      "org.springframework.boot:spring-boot-devtools",
+					Namespace: "org.springframework.boot",
 					Version:   "2.7.6",
-					Locations: []string{"testdata/5-pkg"},
+					Locations: extractor.DeclaredLocations("testdata/5-pkg"),
 					Metadata: &javalockfile.Metadata{
 						ArtifactID: "spring-boot-devtools",
 						GroupID:    "org.springframework.boot",
@@ -159,8 +163,9 @@ func TestExtractor_Extract(t *testing.T) {
 				},
 				{
 					Name:      "org.springframework.boot:spring-boot-starter-aop",
+					Namespace: "org.springframework.boot",
 					Version:   "2.7.7",
-					Locations: []string{"testdata/5-pkg"},
+					Locations: extractor.DeclaredLocations("testdata/5-pkg"),
 					Metadata: &javalockfile.Metadata{
 						ArtifactID: "spring-boot-starter-aop",
 						GroupID:    "org.springframework.boot",
@@ -168,8 +173,9 @@ func TestExtractor_Extract(t *testing.T) {
 				},
 				{
 					Name:      "org.springframework.boot:spring-boot-starter-data-jpa",
+					Namespace: "org.springframework.boot",
 					Version:   "2.7.8",
-					Locations: []string{"testdata/5-pkg"},
+					Locations: extractor.DeclaredLocations("testdata/5-pkg"),
 					Metadata: &javalockfile.Metadata{
 						ArtifactID: "spring-boot-starter-data-jpa",
 						GroupID:    "org.springframework.boot",
@@ -185,8 +191,9 @@ func TestExtractor_Extract(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "org.springframework.boot:spring-boot-autoconfigure",
+					Namespace: "org.springframework.boot",
 					Version:   "2.7.4",
-					Locations: []string{"testdata/with-bad-pkg"},
+					Locations: extractor.DeclaredLocations("testdata/with-bad-pkg"),
 					Metadata: &javalockfile.Metadata{
 						ArtifactID: "spring-boot-autoconfigure",
 						GroupID:    "org.springframework.boot",
@@ -194,8 +201,9 @@ func TestExtractor_Extract(t *testing.T) {
 				},
 				{
 					Name:      "org.springframework.boot:spring-boot-configuration-processor",
+					Namespace: "org.springframework.boot",
 					Version:   "2.7.5",
-					Locations: []string{"testdata/with-bad-pkg"},
+					Locations: extractor.DeclaredLocations("testdata/with-bad-pkg"),
 					Metadata: &javalockfile.Metadata{
 						ArtifactID: "spring-boot-configuration-processor",
 						GroupID:    "org.springframework.boot",
@@ -52,6 +52,11 @@ func (e Extractor) Requirements() *plugin.Capabilities {
 	return &plugin.Capabilities{}
 }
 
+// FileHints returns the file names the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{BaseNames: []string{"verification-metadata.xml"}}
+}
+
 // FileRequired returns true if the specified file matches Gradle verification metadata lockfile patterns.
 func (e Extractor) FileRequired(path string, fileInfo fs.FileInfo) bool {
 	return filepath.Base(filepath.Dir(path)) == "gradle" && filepath.Base(path) == "verification-metadata.xml"
@@ -71,13 +76,14 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 
 	for _, component := range parsedLockfile.Components {
 		pkgs = append(pkgs, &extractor.Inventory{
-			Name:    component.Group + ":" + component.Name,
-			Version: component.Version,
+			Name:      component.Group + ":" + component.Name,
+			Namespace: component.Group,
+			Version:   component.Version,
 			Metadata: &javalockfile.Metadata{
 				ArtifactID: component.Name,
 				GroupID:    component.Group,
 			},
-			Locations: []string{input.Path},
+			Locations: extractor.DeclaredLocations(input.Path),
 		})
 	}
 
@@ -95,6 +101,9 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	}
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeMaven} }
+
 // Ecosystem returns the OSV ecosystem ('Maven') of the software extracted by this extractor.
 func (e Extractor) Ecosystem(i *extractor.Inventory) string {
 	return "Maven"
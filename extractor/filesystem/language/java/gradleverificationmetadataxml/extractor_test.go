@@ -128,8 +128,9 @@ func TestExtractor_Extract(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "org.apache.pdfbox:pdfbox",
+					Namespace: "org.apache.pdfbox",
 					Version:   "2.0.17",
-					Locations: []string{"testdata/one-package.xml"},
+					Locations: extractor.DeclaredLocations("testdata/one-package.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "pdfbox", GroupID: "org.apache.pdfbox"},
 				},
 			},
@@ -142,14 +143,16 @@ func TestExtractor_Extract(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "org.apache.pdfbox:pdfbox",
+					Namespace: "org.apache.pdfbox",
 					Version:   "2.0.17",
-					Locations: []string{"testdata/two-packages.xml"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "pdfbox", GroupID: "org.apache.pdfbox"},
 				},
 				{
 					Name:      "com.github.javaparser:javaparser-core",
+					Namespace: "com.github.javaparser",
 					Version:   "3.6.11",
-					Locations: []string{"testdata/two-packages.xml"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "javaparser-core", GroupID: "com.github.javaparser"},
 				},
 			},
@@ -162,110 +165,128 @@ func TestExtractor_Extract(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "androidx.activity:activity",
+					Namespace: "androidx.activity",
 					Version:   "1.2.1",
-					Locations: []string{"testdata/multiple-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "activity", GroupID: "androidx.activity"},
 				},
 				{
 					Name:      "androidx.activity:activity",
+					Namespace: "androidx.activity",
 					Version:   "1.2.3",
-					Locations: []string{"testdata/multiple-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "activity", GroupID: "androidx.activity"},
 				},
 				{
 					Name:      "androidx.activity:activity",
+					Namespace: "androidx.activity",
 					Version:   "1.5.1",
-					Locations: []string{"testdata/multiple-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "activity", GroupID: "androidx.activity"},
 				},
 				{
 					Name:      "androidx.activity:activity",
+					Namespace: "androidx.activity",
 					Version:   "1.6.0",
-					Locations: []string{"testdata/multiple-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "activity", GroupID: "androidx.activity"},
 				},
 				{
 					Name:      "androidx.activity:activity",
+					Namespace: "androidx.activity",
 					Version:   "1.7.0",
-					Locations: []string{"testdata/multiple-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "activity", GroupID: "androidx.activity"},
 				},
 				{
 					Name:      "androidx.activity:activity",
+					Namespace: "androidx.activity",
 					Version:   "1.7.2",
-					Locations: []string{"testdata/multiple-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "activity", GroupID: "androidx.activity"},
 				},
 				{
 					Name:      "androidx.activity:activity-compose",
+					Namespace: "androidx.activity",
 					Version:   "1.5.0",
-					Locations: []string{"testdata/multiple-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "activity-compose", GroupID: "androidx.activity"},
 				},
 				{
 					Name:      "androidx.activity:activity-compose",
+					Namespace: "androidx.activity",
 					Version:   "1.7.0",
-					Locations: []string{"testdata/multiple-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "activity-compose", GroupID: "androidx.activity"},
 				},
 				{
 					Name:      "androidx.activity:activity-compose",
+					Namespace: "androidx.activity",
 					Version:   "1.7.2",
-					Locations: []string{"testdata/multiple-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "activity-compose", GroupID: "androidx.activity"},
 				},
 				{
 					Name:      "androidx.activity:activity-ktx",
+					Namespace: "androidx.activity",
 					Version:   "1.5.1",
-					Locations: []string{"testdata/multiple-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "activity-ktx", GroupID: "androidx.activity"},
 				},
 				{
 					Name:      "androidx.activity:activity-ktx",
+					Namespace: "androidx.activity",
 					Version:   "1.7.0",
-					Locations: []string{"testdata/multiple-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "activity-ktx", GroupID: "androidx.activity"},
 				},
 				{
 					Name:      "androidx.activity:activity-ktx",
+					Namespace: "androidx.activity",
 					Version:   "1.7.2",
-					Locations: []string{"testdata/multiple-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "activity-ktx", GroupID: "androidx.activity"},
 				},
 				{
 					Name:      "io.ktor:ktor-serialization-jvm",
+					Namespace: "io.ktor",
 					Version:   "2.0.0",
-					Locations: []string{"testdata/multiple-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "ktor-serialization-jvm", GroupID: "io.ktor"},
 				},
 				{
 					Name:      "io.ktor:ktor-serialization-jvm",
+					Namespace: "io.ktor",
 					Version:   "2.0.0-beta-1",
-					Locations: []string{"testdata/multiple-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "ktor-serialization-jvm", GroupID: "io.ktor"},
 				},
 				{
 					Name:      "io.ktor:ktor-serialization-jvm",
+					Namespace: "io.ktor",
 					Version:   "2.0.3",
-					Locations: []string{"testdata/multiple-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "ktor-serialization-jvm", GroupID: "io.ktor"},
 				},
 				{
 					Name:      "com.google.auto.service:auto-service",
+					Namespace: "com.google.auto.service",
 					Version:   "1.0-rc4",
-					Locations: []string{"testdata/multiple-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "auto-service", GroupID: "com.google.auto.service"},
 				},
 				{
 					Name:      "com.google.auto.service:auto-service",
+					Namespace: "com.google.auto.service",
 					Version:   "1.0.1",
-					Locations: []string{"testdata/multiple-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "auto-service", GroupID: "com.google.auto.service"},
 				},
 				{
 					Name:      "com.google.auto.service:auto-service",
+					Namespace: "com.google.auto.service",
 					Version:   "1.1.1",
-					Locations: []string{"testdata/multiple-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "auto-service", GroupID: "com.google.auto.service"},
 				},
 			},
@@ -278,68 +299,79 @@ func TestExtractor_Extract(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "com.google:google",
+					Namespace: "com.google",
 					Version:   "1",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "google", GroupID: "com.google"},
 				},
 				{
 					Name:      "com.almworks.sqlite4java:sqlite4java",
+					Namespace: "com.almworks.sqlite4java",
 					Version:   "0.282",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "sqlite4java", GroupID: "com.almworks.sqlite4java"},
 				},
 				{
 					Name:      "com.google.errorprone:javac",
+					Namespace: "com.google.errorprone",
 					Version:   "9+181-r4173-1",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "javac", GroupID: "com.google.errorprone"},
 				},
 				{
 					Name:      "com.android.tools.build:aapt2",
+					Namespace: "com.android.tools.build",
 					Version:   "8.3.0-10880808",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "aapt2", GroupID: "com.android.tools.build"},
 				},
 				{
 					Name:      "com.android.tools.build:aapt2-proto",
+					Namespace: "com.android.tools.build",
 					Version:   "8.3.0-10880808",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "aapt2-proto", GroupID: "com.android.tools.build"},
 				},
 				{
 					Name:      "com.android.tools.build:transform-api",
+					Namespace: "com.android.tools.build",
 					Version:   "2.0.0-deprecated-use-gradle-api",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "transform-api", GroupID: "com.android.tools.build"},
 				},
 				{
 					Name:      "com.android.tools.build.jetifier:jetifier-core",
+					Namespace: "com.android.tools.build.jetifier",
 					Version:   "1.0.0-beta10",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "jetifier-core", GroupID: "com.android.tools.build.jetifier"},
 				},
 				{
 					Name:      "com.google.apis:google-api-services-androidpublisher",
+					Namespace: "com.google.apis",
 					Version:   "v3-rev20231115-2.0.0",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "google-api-services-androidpublisher", GroupID: "com.google.apis"},
 				},
 				{
 					Name:      "com.google.devtools.ksp:symbol-processing",
+					Namespace: "com.google.devtools.ksp",
 					Version:   "1.9.22-1.0.17",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "symbol-processing", GroupID: "com.google.devtools.ksp"},
 				},
 				{
 					Name:      "com.google.devtools.ksp:symbol-processing-api",
+					Namespace: "com.google.devtools.ksp",
 					Version:   "1.9.22-1.0.17",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "symbol-processing-api", GroupID: "com.google.devtools.ksp"},
 				},
 				{
 					Name:      "com.google.devtools.ksp:symbol-processing-gradle-plugin",
+					Namespace: "com.google.devtools.ksp",
 					Version:   "1.9.22-1.0.17",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata: &javalockfile.Metadata{
 						ArtifactID: "symbol-processing-gradle-plugin",
 						GroupID:    "com.google.devtools.ksp",
@@ -347,104 +379,121 @@ func TestExtractor_Extract(t *testing.T) {
 				},
 				{
 					Name:      "com.google.guava:guava",
+					Namespace: "com.google.guava",
 					Version:   "32.0.0-jre",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "guava", GroupID: "com.google.guava"},
 				},
 				{
 					Name:      "com.google.guava:guava",
+					Namespace: "com.google.guava",
 					Version:   "32.1.3-jre",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "guava", GroupID: "com.google.guava"},
 				},
 				{
 					Name:      "com.google.guava:listenablefuture",
+					Namespace: "com.google.guava",
 					Version:   "9999.0-empty-to-avoid-conflict-with-guava",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "listenablefuture", GroupID: "com.google.guava"},
 				},
 				{
 					Name:      "com.google.testing.platform:core",
+					Namespace: "com.google.testing.platform",
 					Version:   "0.0.9-alpha02",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "core", GroupID: "com.google.testing.platform"},
 				},
 				{
 					Name:      "com.jakewharton.android.repackaged:dalvik-dx",
+					Namespace: "com.jakewharton.android.repackaged",
 					Version:   "9.0.0_r3",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "dalvik-dx", GroupID: "com.jakewharton.android.repackaged"},
 				},
 				{
 					Name:      "com.vaadin.external.google:android-json",
+					Namespace: "com.vaadin.external.google",
 					Version:   "0.0.20131108.vaadin1",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "android-json", GroupID: "com.vaadin.external.google"},
 				},
 				{
 					Name:      "de.mannodermaus.gradle.plugins:android-junit5",
+					Namespace: "de.mannodermaus.gradle.plugins",
 					Version:   "1.10.0.0",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "android-junit5", GroupID: "de.mannodermaus.gradle.plugins"},
 				},
 				{
 					Name:      "io.netty:netty-codec-http",
+					Namespace: "io.netty",
 					Version:   "4.1.93.Final",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "netty-codec-http", GroupID: "io.netty"},
 				},
 				{
 					Name:      "io.netty:netty-codec-http2",
+					Namespace: "io.netty",
 					Version:   "4.1.93.Final",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "netty-codec-http2", GroupID: "io.netty"},
 				},
 				{
 					Name:      "javax.inject:javax.inject",
+					Namespace: "javax.inject",
 					Version:   "1",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "javax.inject", GroupID: "javax.inject"},
 				},
 				{
 					Name:      "junit:junit",
+					Namespace: "junit",
 					Version:   "4.13.2",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "junit", GroupID: "junit"},
 				},
 				{
 					Name:      "org.apache:apache",
+					Namespace: "org.apache",
 					Version:   "13",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "apache", GroupID: "org.apache"},
 				},
 				{
 					Name:      "org.jetbrains.intellij.deps:trove4j",
+					Namespace: "org.jetbrains.intellij.deps",
 					Version:   "1.0.20200330",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "trove4j", GroupID: "org.jetbrains.intellij.deps"},
 				},
 				{
 					Name:      "org.json:json",
+					Namespace: "org.json",
 					Version:   "20180813",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "json", GroupID: "org.json"},
 				},
 				{
 					Name:      "org.tensorflow:tensorflow-lite-metadata",
+					Namespace: "org.tensorflow",
 					Version:   "0.1.0-rc2",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "tensorflow-lite-metadata", GroupID: "org.tensorflow"},
 				},
 				{
 					Name:      "org.tukaani:xz",
+					Namespace: "org.tukaani",
 					Version:   "1.9",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "xz", GroupID: "org.tukaani"},
 				},
 				{
 					Name:      "org.whitesource:pecoff4j",
+					Namespace: "org.whitesource",
 					Version:   "0.0.2.1",
-					Locations: []string{"testdata/odd-versions.xml"},
+					Locations: extractor.DeclaredLocations("testdata/odd-versions.xml"),
 					Metadata:  &javalockfile.Metadata{ArtifactID: "pecoff4j", GroupID: "org.whitesource"},
 				},
 			},
@@ -46,6 +46,22 @@ type mavenLockDependency struct {
 	ArtifactID string   `xml:"artifactId"`
 	Version    string   `xml:"version"`
 	Scope      string   `xml:"scope"`
+	// Line is the line of the dependency element in the file.
+	Line int `xml:"-"`
+}
+
+// UnmarshalXML decodes the dependency element and records the line it starts
+// at.
+func (mld *mavenLockDependency) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	// The decoder is positioned right after the start element.
+	line, _ := d.InputPos()
+	// Decode through a type without this method to get the default decoding.
+	type plainDependency mavenLockDependency
+	if err := d.DecodeElement((*plainDependency)(mld), &start); err != nil {
+		return err
+	}
+	mld.Line = line
+	return nil
 }
 
 func (mld mavenLockDependency) parseResolvedVersion(version string) string {
@@ -142,6 +158,11 @@ func (e Extractor) Requirements() *plugin.Capabilities {
 	return &plugin.Capabilities{}
 }
 
+// FileHints returns the file names the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{BaseNames: []string{"pom.xml"}}
+}
+
 // FileRequired returns true if the specified file matches Maven POM lockfile patterns.
 func (e Extractor) FileRequired(path string, fileInfo fs.FileInfo) bool {
 	return filepath.Base(path) == "pom.xml"
@@ -169,8 +190,9 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 
 		pkgDetails := &extractor.Inventory{
 			Name:      finalName,
+			Namespace: lockPackage.GroupID,
 			Version:   lockPackage.ResolveVersion(*parsedLockfile),
-			Locations: []string{input.Path},
+			Locations: []extractor.Location{{Path: input.Path, Line: lockPackage.Line, Reason: extractor.LocationDeclared}},
 			Metadata:  &metadata,
 		}
 		if scope := strings.TrimSpace(lockPackage.Scope); scope != "" && scope != "compile" {
@@ -190,8 +212,9 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 		}
 		pkgDetails := &extractor.Inventory{
 			Name:      finalName,
+			Namespace: lockPackage.GroupID,
 			Version:   lockPackage.ResolveVersion(*parsedLockfile),
-			Locations: []string{input.Path},
+			Locations: []extractor.Location{{Path: input.Path, Line: lockPackage.Line, Reason: extractor.LocationDeclared}},
 			Metadata:  &metadata,
 		}
 		if scope := strings.TrimSpace(lockPackage.Scope); scope != "" && scope != "compile" {
@@ -215,6 +238,9 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	}
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeMaven} }
+
 // Ecosystem returns the OSV ecosystem ('Maven') of the software extracted by this extractor.
 func (e Extractor) Ecosystem(i *extractor.Inventory) string {
 	return "Maven"
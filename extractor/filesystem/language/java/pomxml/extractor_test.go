@@ -16,6 +16,8 @@ package pomxml_test
 
 import (
 	"context"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -105,8 +107,9 @@ func TestExtractor_Extract(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "org.apache.maven:maven-artifact",
+					Namespace: "org.apache.maven",
 					Version:   "1.0.0",
-					Locations: []string{"testdata/one-package.xml"},
+					Locations: []extractor.Location{{Path: "testdata/one-package.xml", Reason: extractor.LocationDeclared, Line: 7}},
 					Metadata: &javalockfile.Metadata{
 						ArtifactID:   "maven-artifact",
 						GroupID:      "org.apache.maven",
@@ -123,8 +126,9 @@ func TestExtractor_Extract(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "io.netty:netty-all",
+					Namespace: "io.netty",
 					Version:   "4.1.42.Final",
-					Locations: []string{"testdata/two-packages.xml"},
+					Locations: []extractor.Location{{Path: "testdata/two-packages.xml", Reason: extractor.LocationDeclared, Line: 7}},
 					Metadata: &javalockfile.Metadata{
 						ArtifactID:   "netty-all",
 						GroupID:      "io.netty",
@@ -133,8 +137,9 @@ func TestExtractor_Extract(t *testing.T) {
 				},
 				{
 					Name:      "org.slf4j:slf4j-log4j12",
+					Namespace: "org.slf4j",
 					Version:   "1.7.25",
-					Locations: []string{"testdata/two-packages.xml"},
+					Locations: []extractor.Location{{Path: "testdata/two-packages.xml", Reason: extractor.LocationDeclared, Line: 12}},
 					Metadata: &javalockfile.Metadata{
 						ArtifactID:   "slf4j-log4j12",
 						GroupID:      "org.slf4j",
@@ -151,8 +156,9 @@ func TestExtractor_Extract(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "io.netty:netty-all",
+					Namespace: "io.netty",
 					Version:   "4.1.9",
-					Locations: []string{"testdata/with-dependency-management.xml"},
+					Locations: []extractor.Location{{Path: "testdata/with-dependency-management.xml", Reason: extractor.LocationDeclared, Line: 7}},
 					Metadata: &javalockfile.Metadata{
 						ArtifactID:   "netty-all",
 						GroupID:      "io.netty",
@@ -161,8 +167,9 @@ func TestExtractor_Extract(t *testing.T) {
 				},
 				{
 					Name:      "org.slf4j:slf4j-log4j12",
+					Namespace: "org.slf4j",
 					Version:   "1.7.25",
-					Locations: []string{"testdata/with-dependency-management.xml"},
+					Locations: []extractor.Location{{Path: "testdata/with-dependency-management.xml", Reason: extractor.LocationDeclared, Line: 12}},
 					Metadata: &javalockfile.Metadata{
 						ArtifactID:   "slf4j-log4j12",
 						GroupID:      "org.slf4j",
@@ -171,8 +178,9 @@ func TestExtractor_Extract(t *testing.T) {
 				},
 				{
 					Name:      "com.google.code.findbugs:jsr305",
+					Namespace: "com.google.code.findbugs",
 					Version:   "3.0.2",
-					Locations: []string{"testdata/with-dependency-management.xml"},
+					Locations: []extractor.Location{{Path: "testdata/with-dependency-management.xml", Reason: extractor.LocationDeclared, Line: 26}},
 					Metadata: &javalockfile.Metadata{
 						ArtifactID:   "jsr305",
 						GroupID:      "com.google.code.findbugs",
@@ -189,8 +197,9 @@ func TestExtractor_Extract(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "org.mine:mypackage",
+					Namespace: "org.mine",
 					Version:   "1.0.0",
-					Locations: []string{"testdata/interpolation.xml"},
+					Locations: []extractor.Location{{Path: "testdata/interpolation.xml", Reason: extractor.LocationDeclared, Line: 18}},
 					Metadata: &javalockfile.Metadata{
 						ArtifactID:   "mypackage",
 						GroupID:      "org.mine",
@@ -199,8 +208,9 @@ func TestExtractor_Extract(t *testing.T) {
 				},
 				{
 					Name:      "org.mine:my.package",
+					Namespace: "org.mine",
 					Version:   "2.3.4",
-					Locations: []string{"testdata/interpolation.xml"},
+					Locations: []extractor.Location{{Path: "testdata/interpolation.xml", Reason: extractor.LocationDeclared, Line: 24}},
 					Metadata: &javalockfile.Metadata{
 						ArtifactID:   "my.package",
 						GroupID:      "org.mine",
@@ -209,8 +219,9 @@ func TestExtractor_Extract(t *testing.T) {
 				},
 				{
 					Name:      "org.mine:ranged-package",
+					Namespace: "org.mine",
 					Version:   "9.4.35.v20201120",
-					Locations: []string{"testdata/interpolation.xml"},
+					Locations: []extractor.Location{{Path: "testdata/interpolation.xml", Reason: extractor.LocationDeclared, Line: 33}},
 					Metadata: &javalockfile.Metadata{
 						ArtifactID:   "ranged-package",
 						GroupID:      "org.mine",
@@ -227,8 +238,9 @@ func TestExtractor_Extract(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "abc:xyz",
+					Namespace: "abc",
 					Version:   "1.2.3",
-					Locations: []string{"testdata/with-scope.xml"},
+					Locations: []extractor.Location{{Path: "testdata/with-scope.xml", Reason: extractor.LocationDeclared, Line: 3}},
 					Metadata: &javalockfile.Metadata{
 						ArtifactID:   "xyz",
 						GroupID:      "abc",
@@ -237,8 +249,9 @@ func TestExtractor_Extract(t *testing.T) {
 				},
 				{
 					Name:      "junit:junit",
+					Namespace: "junit",
 					Version:   "4.12",
-					Locations: []string{"testdata/with-scope.xml"},
+					Locations: []extractor.Location{{Path: "testdata/with-scope.xml", Reason: extractor.LocationDeclared, Line: 9}},
 					Metadata: &javalockfile.Metadata{
 						ArtifactID:   "junit",
 						GroupID:      "junit",
@@ -271,3 +284,38 @@ func TestExtractor_Extract(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractor_Extract_LinesPointAtDependencies(t *testing.T) {
+	t.Parallel()
+
+	path := "testdata/with-dependency-management.xml"
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("os.ReadFile(%s): %v", path, err)
+	}
+	lines := strings.Split(string(content), "\n")
+
+	extr := pomxml.Extractor{}
+	scanInput := extracttest.GenerateScanInputMock(t, extracttest.ScanInputMockConfig{Path: path})
+	defer extracttest.CloseTestScanInput(t, scanInput)
+
+	got, err := extr.Extract(context.Background(), &scanInput)
+	if err != nil {
+		t.Fatalf("%s.Extract(%q): %v", extr.Name(), path, err)
+	}
+	for _, inv := range got {
+		m := inv.Metadata.(*javalockfile.Metadata)
+		line := inv.Locations[0].Line
+		if line < 1 || line+2 > len(lines) {
+			t.Fatalf("%s: line %d is outside of %s", inv.Name, line, path)
+		}
+		// The dependency element starts at the recorded line and declares the
+		// artifact two lines below.
+		if !strings.Contains(lines[line-1], "<dependency>") {
+			t.Errorf("%s: line %d is %q, want a <dependency> element", inv.Name, line, lines[line-1])
+		}
+		if want := "<artifactId>" + m.ArtifactID + "</artifactId>"; !strings.Contains(lines[line+1], want) {
+			t.Errorf("%s: line %d is %q, want %q", inv.Name, line+2, lines[line+1], want)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package npmname provides helpers for the names of npm packages shared by the
+// javascript extractors.
+package npmname
+
+import (
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/purl"
+)
+
+// Scope returns the scope of a scoped package name including the "@", e.g.
+// "@babel" for "@babel/core", and an empty string for unscoped names.
+func Scope(name string) string {
+	if !strings.HasPrefix(name, "@") {
+		return ""
+	}
+	scope, _ := extractor.SplitNamespace(name, "/")
+	return scope
+}
+
+// ToPURL returns the npm PURL of the inventory, with the scope as the
+// namespace. npm names are lowercase, so the PURL is too.
+func ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	p := extractor.PURLFromInventory(purl.TypeNPM, i)
+	p.Namespace = strings.ToLower(p.Namespace)
+	p.Name = strings.ToLower(p.Name)
+	return p
+}
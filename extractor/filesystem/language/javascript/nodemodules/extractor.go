@@ -0,0 +1,222 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package nodemodules extracts installed NPM packages from the package.json
+// manifests found inside node_modules directories.
+package nodemodules
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/internal/npmname"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "javascript/nodemodules"
+
+	// defaultMaxFileSizeBytes is the default maximum file size the extractor will
+	// attempt to extract. If a file is encountered that is larger than this
+	// limit, the file is ignored by `FileRequired`.
+	defaultMaxFileSizeBytes = 100 * units.MiB
+)
+
+type packageJSON struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the node_modules extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+}
+
+// Extractor extracts installed NPM packages from node_modules directories.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns a node_modules extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileRequired returns true if the specified file is the package.json at the
+// root of an installed package, i.e. node_modules/<name>/package.json or
+// node_modules/@<scope>/<name>/package.json.
+//
+// Only the innermost node_modules directory of the path is considered, so a
+// package nested in another package's node_modules is reported once on its own
+// rather than being attributed to its parent. Manifests deeper inside a package
+// (e.g. node_modules/foo/lib/package.json) are not package roots and are skipped.
+func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
+	if !e.fileMatches(path, fileinfo) {
+		return false
+	}
+
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// FileSizeLimitExceeded returns true if the file would be required if it
+// didn't exceed the maximum file size.
+func (e Extractor) FileSizeLimitExceeded(path string, fileinfo fs.FileInfo) bool {
+	return e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes && e.fileMatches(path, fileinfo)
+}
+
+// fileMatches returns true if the file is one the extractor is interested in,
+// regardless of its size.
+func (e Extractor) fileMatches(path string, fileinfo fs.FileInfo) bool {
+	parts := strings.Split(filepath.ToSlash(path), "/")
+	if len(parts) < 3 || parts[len(parts)-1] != "package.json" {
+		return false
+	}
+
+	// Segments between the innermost node_modules dir and package.json.
+	var pkgDir []string
+	switch {
+	case parts[len(parts)-3] == "node_modules":
+		pkgDir = parts[len(parts)-2 : len(parts)-1]
+	case len(parts) >= 4 && parts[len(parts)-4] == "node_modules":
+		pkgDir = parts[len(parts)-3 : len(parts)-1]
+	default:
+		return false
+	}
+
+	// Dot-prefixed dirs hold package manager state (e.g. .bin, .pnpm, .cache).
+	if strings.HasPrefix(pkgDir[0], ".") {
+		return false
+	}
+	if len(pkgDir) == 2 {
+		// The only two-level layout is a scoped package.
+		if !strings.HasPrefix(pkgDir[0], "@") || strings.HasPrefix(pkgDir[1], "@") {
+			return false
+		}
+	} else if strings.HasPrefix(pkgDir[0], "@") {
+		return false
+	}
+
+	return true
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Extractor:     e.Name(),
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// Extract extracts the installed package from a package.json file passed through the scan input.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	var p packageJSON
+	if err := json.NewDecoder(input.Reader).Decode(&p); err != nil {
+		err = fmt.Errorf("could not extract from %s: %w", input.Path, err)
+		e.reportFileExtracted(input.Path, input.Info, err)
+		return []*extractor.Inventory{}, err
+	}
+	e.reportFileExtracted(input.Path, input.Info, nil)
+
+	// Some packages ship placeholder manifests without a name or version, e.g.
+	// to mark a directory as an ES module. These don't describe a package.
+	if p.Name == "" || p.Version == "" {
+		return []*extractor.Inventory{}, nil
+	}
+
+	return []*extractor.Inventory{{
+		Name:      p.Name,
+		Namespace: npmname.Scope(p.Name),
+		Version:   p.Version,
+		Locations: extractor.InstalledLocations(input.Path),
+	}}, nil
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return npmname.ToPURL(i)
+}
+
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeNPM} }
+
+// Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "npm" }
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,297 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodemodules_test
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/nodemodules"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestExtractor_FileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		inputPath        string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		want             bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:      "project package.json",
+			inputPath: "project/package.json",
+			want:      false,
+		},
+		{
+			name:             "installed package",
+			inputPath:        "project/node_modules/express/package.json",
+			want:             true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "installed package at root",
+			inputPath:        "node_modules/express/package.json",
+			want:             true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "installed scoped package",
+			inputPath:        "project/node_modules/@babel/core/package.json",
+			want:             true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "package nested in another package's node_modules",
+			inputPath:        "project/node_modules/express/node_modules/debug/package.json",
+			want:             true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "package nested in a scoped package's node_modules",
+			inputPath:        "project/node_modules/@babel/core/node_modules/semver/package.json",
+			want:             true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:      "manifest inside a package subdirectory",
+			inputPath: "project/node_modules/express/lib/package.json",
+			want:      false,
+		},
+		{
+			name:      "manifest deep inside a scoped package",
+			inputPath: "project/node_modules/@babel/core/lib/config/package.json",
+			want:      false,
+		},
+		{
+			name:      "scope dir without package name",
+			inputPath: "project/node_modules/@babel/package.json",
+			want:      false,
+		},
+		{
+			name:      "package manager state dir",
+			inputPath: "project/node_modules/.pnpm/package.json",
+			want:      false,
+		},
+		{
+			name:      "non package.json file",
+			inputPath: "project/node_modules/express/index.js",
+			want:      false,
+		},
+		{
+			name:             "windows path separators",
+			inputPath:        filepath.Join("project", "node_modules", "express", "package.json"),
+			want:             true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "installed package required if file size < max file size",
+			inputPath:        "project/node_modules/express/package.json",
+			fileSizeBytes:    1 * units.KiB,
+			maxFileSizeBytes: 1000 * units.KiB,
+			want:             true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "installed package not required if file size > max file size",
+			inputPath:        "project/node_modules/express/package.json",
+			fileSizeBytes:    1000 * units.KiB,
+			maxFileSizeBytes: 100 * units.KiB,
+			want:             false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := nodemodules.New(nodemodules.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			// Set a default file size if not specified.
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			got := e.FileRequired(tt.inputPath, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.inputPath),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			})
+			if got != tt.want {
+				t.Errorf("FileRequired(%s, FileInfo) got = %v, want %v", tt.inputPath, got, tt.want)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.inputPath)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.inputPath, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtractor_Extract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "installed package",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/project/node_modules/express/package.json",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "express",
+					Version:   "4.18.2",
+					Locations: extractor.InstalledLocations("testdata/project/node_modules/express/package.json"),
+				},
+			},
+		},
+		{
+			Name: "scoped package",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/project/node_modules/@babel/core/package.json",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "@babel/core",
+					Namespace: "@babel",
+					Version:   "7.23.2",
+					Locations: extractor.InstalledLocations("testdata/project/node_modules/@babel/core/package.json"),
+				},
+			},
+		},
+		{
+			Name: "manifest without name and version",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/no-version/package.json",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "invalid json",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/invalid/package.json",
+			},
+			WantInventory: []*extractor.Inventory{},
+			WantErr:       extracttest.ContainsErrStr{Str: "could not extract from"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := nodemodules.New(nodemodules.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestExtractor_NestedTree(t *testing.T) {
+	e := nodemodules.New(nodemodules.DefaultConfig())
+	root := "testdata/project"
+	fsys := scalibrfs.DirFS(root)
+
+	var got []*extractor.Inventory
+	err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if !e.FileRequired(path, info) {
+			return nil
+		}
+		f, err := os.Open(filepath.Join(root, path))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		inv, err := e.Extract(context.Background(), &filesystem.ScanInput{FS: fsys, Path: path, Info: info, Reader: f})
+		got = append(got, inv...)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("WalkDir(%s): %v", root, err)
+	}
+
+	want := []*extractor.Inventory{
+		{Name: "@babel/core", Namespace: "@babel", Version: "7.23.2", Locations: extractor.InstalledLocations("node_modules/@babel/core/package.json")},
+		{Name: "semver", Version: "6.3.1", Locations: extractor.InstalledLocations("node_modules/@babel/core/node_modules/semver/package.json")},
+		{Name: "debug", Version: "4.3.4", Locations: extractor.InstalledLocations("node_modules/debug/package.json")},
+		{Name: "esm-only", Version: "2.0.0", Locations: extractor.InstalledLocations("node_modules/esm-only/package.json")},
+		{Name: "express", Version: "4.18.2", Locations: extractor.InstalledLocations("node_modules/express/package.json")},
+		{Name: "debug", Version: "2.6.9", Locations: extractor.InstalledLocations("node_modules/express/node_modules/debug/package.json")},
+	}
+	if diff := cmp.Diff(want, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+		t.Errorf("walking %s (-want +got):\n%s", root, diff)
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	tests := []struct {
+		name string
+		inv  *extractor.Inventory
+		want *purl.PackageURL
+	}{
+		{
+			name: "unscoped package",
+			inv:  &extractor.Inventory{Name: "Express", Version: "4.18.2"},
+			want: &purl.PackageURL{Type: purl.TypeNPM, Name: "express", Version: "4.18.2"},
+		},
+		{
+			name: "scoped package",
+			inv:  &extractor.Inventory{Name: "@babel/core", Version: "7.23.2"},
+			want: &purl.PackageURL{Type: purl.TypeNPM, Name: "@babel/core", Version: "7.23.2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := nodemodules.New(nodemodules.DefaultConfig())
+			got := e.ToPURL(tt.inv)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ToPURL(%v) (-want +got):\n%s", tt.inv, diff)
+			}
+		})
+	}
+}
@@ -22,11 +22,12 @@ import (
 	"io"
 	"io/fs"
 	"path/filepath"
-	"strings"
 
 	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/license"
 	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/internal/npmname"
 	"github.com/google/osv-scalibr/log"
 	"github.com/google/osv-scalibr/plugin"
 	"github.com/google/osv-scalibr/purl"
@@ -44,12 +45,15 @@ const (
 )
 
 type packageJSON struct {
-	Version      string    `json:"version"`
-	Name         string    `json:"name"`
-	Engines      any       `json:"engines"`
-	Author       *Person   `json:"author"`
-	Maintainers  []*Person `json:"maintainers"`
-	Contributors []*Person `json:"contributors"`
+	Version      string      `json:"version"`
+	Name         string      `json:"name"`
+	Engines      any         `json:"engines"`
+	Author       *Person     `json:"author"`
+	Maintainers  []*Person   `json:"maintainers"`
+	Contributors []*Person   `json:"contributors"`
+	License      *npmLicense `json:"license"`
+	// Deprecated by NPM in favor of License but still found in older packages.
+	Licenses []*npmLicense `json:"licenses"`
 	// Not an NPM field but present for VSCode Extension Manifest files.
 	Contributes *struct {
 	} `json:"contributes"`
@@ -103,10 +107,15 @@ func (e Extractor) Version() int { return 0 }
 // Requirements of the extractor.
 func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
 
+// FileHints returns the file names the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{BaseNames: []string{"package.json"}}
+}
+
 // FileRequired returns true if the specified file matches javascript Metadata file
 // patterns.
 func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
-	if filepath.Base(path) != "package.json" {
+	if !e.fileMatches(path, fileinfo) {
 		return false
 	}
 
@@ -119,11 +128,24 @@ func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
 	return true
 }
 
+// FileSizeLimitExceeded returns true if the file would be required if it
+// didn't exceed the maximum file size.
+func (e Extractor) FileSizeLimitExceeded(path string, fileinfo fs.FileInfo) bool {
+	return e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes && e.fileMatches(path, fileinfo)
+}
+
+// fileMatches returns true if the file is one the extractor is interested in,
+// regardless of its size.
+func (e Extractor) fileMatches(path string, fileinfo fs.FileInfo) bool {
+	return filepath.Base(path) == "package.json"
+}
+
 func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
 	if e.stats == nil {
 		return
 	}
 	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Extractor:     e.Name(),
 		Path:          path,
 		Result:        result,
 		FileSizeBytes: fileSizeBytes,
@@ -141,7 +163,7 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 	inventory := []*extractor.Inventory{}
 	if i != nil {
 		inventory = append(inventory, i)
-		i.Locations = []string{input.Path}
+		i.Locations = extractor.InstalledLocations(input.Path)
 	}
 
 	e.reportFileExtracted(input.Path, input.Info, nil)
@@ -187,16 +209,51 @@ func parse(path string, r io.Reader) (*extractor.Inventory, error) {
 	}
 
 	return &extractor.Inventory{
-		Name:    p.Name,
-		Version: p.Version,
+		Name:      p.Name,
+		Namespace: npmname.Scope(p.Name),
+		Version:   p.Version,
 		Metadata: &JavascriptPackageJSONMetadata{
 			Author:       p.Author,
 			Maintainers:  removeEmptyPersons(p.Maintainers),
 			Contributors: removeEmptyPersons(p.Contributors),
+			Licenses:     p.declaredLicenses(),
 		},
 	}, nil
 }
 
+// npmLicense is a license in package.json, either an SPDX expression or, in
+// older packages, an object like {"type": "MIT", "url": "..."}.
+type npmLicense struct {
+	Type string `json:"type"`
+}
+
+// UnmarshalJSON parses a JSON string or object into an npmLicense.
+func (l *npmLicense) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, &l.Type); err == nil {
+		return nil
+	}
+	type license npmLicense
+	var parsed license
+	if err := json.Unmarshal(b, &parsed); err != nil {
+		// Don't fail the extraction over a malformed license.
+		log.Debugf("failed to parse package.json license %s: %v", b, err)
+		return nil
+	}
+	*l = npmLicense(parsed)
+	return nil
+}
+
+// declaredLicenses returns the normalized licenses of the package.
+func (p packageJSON) declaredLicenses() []string {
+	var res []string
+	for _, l := range append([]*npmLicense{p.License}, p.Licenses...) {
+		if l != nil {
+			res = append(res, license.Normalize(l.Type))
+		}
+	}
+	return license.Dedupe(res)
+}
+
 func (p packageJSON) hasNameAndVersionValues() bool {
 	return p.Name != "" && p.Version != ""
 }
@@ -241,13 +298,12 @@ func removeEmptyPersons(persons []*Person) []*Person {
 
 // ToPURL converts an inventory created by this extractor into a PURL.
 func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
-	return &purl.PackageURL{
-		Type:    purl.TypeNPM,
-		Name:    strings.ToLower(i.Name),
-		Version: i.Version,
-	}
+	return npmname.ToPURL(i)
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeNPM} }
+
 // Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
 // OSV requires the name field to be a npm package. This is a javascript extractor, there is no
 // guarantee that the package is an npm package.
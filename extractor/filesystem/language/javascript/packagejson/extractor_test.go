@@ -148,7 +148,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "testdata",
 					Version:   "10.46.8",
-					Locations: []string{"testdata/package.json"},
+					Locations: extractor.InstalledLocations("testdata/package.json"),
 					Metadata: &packagejson.JavascriptPackageJSONMetadata{
 						Author: &packagejson.Person{
 							Name:  "Developer",
@@ -166,7 +166,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "accepts",
 					Version:   "1.3.8",
-					Locations: []string{"testdata/deps/accepts/package.json"},
+					Locations: extractor.InstalledLocations("testdata/deps/accepts/package.json"),
 					Metadata: &packagejson.JavascriptPackageJSONMetadata{
 						Contributors: []*packagejson.Person{
 							{
@@ -179,6 +179,7 @@ func TestExtract(t *testing.T) {
 								URL:   "http://jongleberry.com",
 							},
 						},
+						Licenses: []string{"MIT"},
 					},
 				},
 			},
@@ -190,7 +191,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "accepts",
 					Version:   "1.3.8",
-					Locations: []string{"testdata/deps/no-person-name/package.json"},
+					Locations: extractor.InstalledLocations("testdata/deps/no-person-name/package.json"),
 					Metadata: &packagejson.JavascriptPackageJSONMetadata{
 						Contributors: []*packagejson.Person{
 							{
@@ -199,6 +200,7 @@ func TestExtract(t *testing.T) {
 								URL:   "http://jongleberry.com",
 							},
 						},
+						Licenses: []string{"MIT"},
 					},
 				},
 			},
@@ -210,7 +212,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "acorn",
 					Version:   "1.2.2",
-					Locations: []string{"testdata/deps/with/deps/acorn/package.json"},
+					Locations: extractor.InstalledLocations("testdata/deps/with/deps/acorn/package.json"),
 					Metadata: &packagejson.JavascriptPackageJSONMetadata{
 						Maintainers: []*packagejson.Person{
 							{
@@ -222,6 +224,21 @@ func TestExtract(t *testing.T) {
 								Email: "me@rreverser.com",
 							},
 						},
+						Licenses: []string{"MIT"},
+					},
+				},
+			},
+		},
+		{
+			name: "deprecated licenses field",
+			path: "testdata/deps/legacy-licenses/package.json",
+			wantInventory: []*extractor.Inventory{
+				{
+					Name:      "legacy-licenses",
+					Version:   "1.0.0",
+					Locations: extractor.InstalledLocations("testdata/deps/legacy-licenses/package.json"),
+					Metadata: &packagejson.JavascriptPackageJSONMetadata{
+						Licenses: []string{"MIT", "Apache-2.0"},
 					},
 				},
 			},
@@ -263,9 +280,9 @@ func TestExtract(t *testing.T) {
 				{
 					Name:    "undici",
 					Version: "5.28.3",
-					Locations: []string{
+					Locations: extractor.InstalledLocations(
 						"testdata/undici-package.json",
-					},
+					),
 					Metadata: &packagejson.JavascriptPackageJSONMetadata{
 						Contributors: []*packagejson.Person{
 							{
@@ -280,6 +297,7 @@ func TestExtract(t *testing.T) {
 								Name: "Invalid URL NoCrash",
 							},
 						},
+						Licenses: []string{"MIT"},
 					},
 				},
 			},
@@ -291,12 +309,13 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "jsonparse",
 					Version:   "1.3.1",
-					Locations: []string{"testdata/not-vscode.json"},
+					Locations: extractor.InstalledLocations("testdata/not-vscode.json"),
 					Metadata: &packagejson.JavascriptPackageJSONMetadata{
 						Author: &packagejson.Person{
 							Name:  "Tim Caswell",
 							Email: "tim@creationix.com",
 						},
+						Licenses: []string{"MIT"},
 					},
 				},
 			},
@@ -387,7 +406,7 @@ func TestToPURL(t *testing.T) {
 	i := &extractor.Inventory{
 		Name:      "Name",
 		Version:   "1.2.3",
-		Locations: []string{"location"},
+		Locations: extractor.InstalledLocations("location"),
 	}
 	want := &purl.PackageURL{
 		Type:    purl.TypeNPM,
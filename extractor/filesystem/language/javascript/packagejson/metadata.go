@@ -83,6 +83,8 @@ type JavascriptPackageJSONMetadata struct {
 	Author       *Person   `json:"author"`
 	Maintainers  []*Person `json:"maintainers"`
 	Contributors []*Person `json:"contributors"`
+	// Licenses are the declared licenses as SPDX license expressions.
+	Licenses []string `json:"licenses,omitempty"`
 }
 
 func rawToPerson(rawJSON map[string]any) map[string]string {
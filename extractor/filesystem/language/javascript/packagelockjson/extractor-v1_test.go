@@ -55,7 +55,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "wrappy",
 					Version:    "1.0.2",
-					Locations:  []string{"testdata/one-package.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/one-package.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -72,7 +72,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "wrappy",
 					Version:    "1.0.2",
-					Locations:  []string{"testdata/one-package-dev.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/one-package-dev.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{"dev"},
@@ -89,7 +89,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "wrappy",
 					Version:    "1.0.2",
-					Locations:  []string{"testdata/two-packages.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/two-packages.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -98,7 +98,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "supports-color",
 					Version:    "5.5.0",
-					Locations:  []string{"testdata/two-packages.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/two-packages.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -115,7 +115,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "wrappy",
 					Version:    "1.0.2",
-					Locations:  []string{"testdata/scoped-packages.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/scoped-packages.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -123,8 +123,9 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				},
 				{
 					Name:       "@babel/code-frame",
+					Namespace:  "@babel",
 					Version:    "7.0.0",
-					Locations:  []string{"testdata/scoped-packages.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/scoped-packages.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -141,7 +142,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss",
 					Version:    "6.0.23",
-					Locations:  []string{"testdata/nested-dependencies.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -150,7 +151,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss",
 					Version:    "7.0.16",
-					Locations:  []string{"testdata/nested-dependencies.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -159,7 +160,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-calc",
 					Version:    "7.0.1",
-					Locations:  []string{"testdata/nested-dependencies.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -168,7 +169,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "supports-color",
 					Version:    "6.1.0",
-					Locations:  []string{"testdata/nested-dependencies.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -177,7 +178,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "supports-color",
 					Version:    "5.5.0",
-					Locations:  []string{"testdata/nested-dependencies.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -194,7 +195,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "supports-color",
 					Version:    "2.0.0",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -203,7 +204,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-normalize-display-values",
 					Version:    "4.0.2",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -212,7 +213,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-normalize-timing-functions",
 					Version:    "4.0.2",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -221,7 +222,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-normalize-string",
 					Version:    "4.0.2",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -230,7 +231,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-normalize-whitespace",
 					Version:    "4.0.2",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -239,7 +240,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "supports-color",
 					Version:    "6.1.0",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -248,7 +249,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "cssnano-preset-default",
 					Version:    "4.0.7",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -257,7 +258,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-merge-longhand",
 					Version:    "4.0.11",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -266,7 +267,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-discard-overridden",
 					Version:    "4.0.1",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -275,7 +276,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-reduce-transforms",
 					Version:    "4.0.2",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -284,7 +285,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-svgo",
 					Version:    "4.0.2",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -293,7 +294,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-ordered-values",
 					Version:    "4.1.2",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -302,7 +303,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-minify-selectors",
 					Version:    "4.0.2",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -311,7 +312,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "babel-code-frame",
 					Version:    "6.26.0",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -320,7 +321,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "css-declaration-sorter",
 					Version:    "4.0.1",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -329,7 +330,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-normalize-url",
 					Version:    "4.0.1",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -338,7 +339,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-minify-params",
 					Version:    "4.0.2",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -347,7 +348,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "supports-color",
 					Version:    "5.5.0",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -356,7 +357,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-colormin",
 					Version:    "4.0.3",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -365,7 +366,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "autoprefixer",
 					Version:    "9.5.1",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -374,7 +375,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-normalize-charset",
 					Version:    "4.0.1",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -383,7 +384,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-unique-selectors",
 					Version:    "4.0.1",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -392,7 +393,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-reduce-initial",
 					Version:    "4.0.3",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -401,7 +402,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-normalize-positions",
 					Version:    "4.0.2",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -410,7 +411,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-discard-duplicates",
 					Version:    "4.0.2",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -419,7 +420,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-loader",
 					Version:    "3.0.0",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -428,7 +429,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "cssnano",
 					Version:    "4.1.10",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -437,7 +438,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-discard-empty",
 					Version:    "4.0.1",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -446,7 +447,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-normalize-repeat-style",
 					Version:    "4.0.2",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -455,7 +456,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-convert-values",
 					Version:    "4.0.1",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -464,7 +465,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "friendly-errors-webpack-plugin",
 					Version:    "1.7.0",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -472,8 +473,9 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				},
 				{
 					Name:       "@vue/component-compiler-utils",
+					Namespace:  "@vue",
 					Version:    "2.6.0",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -482,7 +484,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-merge-rules",
 					Version:    "4.0.3",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -491,7 +493,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-normalize-unicode",
 					Version:    "4.0.1",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -500,7 +502,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-minify-font-values",
 					Version:    "4.0.2",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -509,7 +511,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-minify-gradients",
 					Version:    "4.0.2",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -518,7 +520,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "cssnano-util-raw-cache",
 					Version:    "4.0.1",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -527,7 +529,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-calc",
 					Version:    "7.0.1",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -536,7 +538,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-discard-comments",
 					Version:    "4.0.2",
-					Locations:  []string{"testdata/nested-dependencies-dup.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/nested-dependencies-dup.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -552,8 +554,9 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "@segment/analytics.js-integration-facebook-pixel",
+					Namespace: "@segment",
 					Version:   "",
-					Locations: []string{"testdata/commits.v1.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "3b1bb80b302c2e552685dc8a029797ec832ea7c9",
 					},
@@ -564,7 +567,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "ansi-styles",
 					Version:    "1.0.0",
-					Locations:  []string{"testdata/commits.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/commits.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -573,7 +576,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:      "babel-preset-php",
 					Version:   "",
-					Locations: []string{"testdata/commits.v1.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "c5a7ba5e0ad98b8db1cb8ce105403dd4b768cced",
 					},
@@ -584,7 +587,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:      "is-number-1",
 					Version:   "",
-					Locations: []string{"testdata/commits.v1.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "af885e2e890b9ef0875edd2b117305119ee5bdc5",
 					},
@@ -595,7 +598,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:      "is-number-1",
 					Version:   "",
-					Locations: []string{"testdata/commits.v1.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "be5935f8d2595bcd97b05718ef1eeae08d812e10",
 					},
@@ -606,7 +609,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:      "is-number-2",
 					Version:   "",
-					Locations: []string{"testdata/commits.v1.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "d5ac0584ee9ae7bd9288220a39780f155b9ad4c8",
 					},
@@ -617,7 +620,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:      "is-number-2",
 					Version:   "",
-					Locations: []string{"testdata/commits.v1.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "82dcc8e914dabd9305ab9ae580709a7825e824f5",
 					},
@@ -628,7 +631,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:      "is-number-3",
 					Version:   "",
-					Locations: []string{"testdata/commits.v1.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "d5ac0584ee9ae7bd9288220a39780f155b9ad4c8",
 					},
@@ -639,7 +642,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:      "is-number-3",
 					Version:   "",
-					Locations: []string{"testdata/commits.v1.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "82ae8802978da40d7f1be5ad5943c9e550ab2c89",
 					},
@@ -650,7 +653,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:      "is-number-4",
 					Version:   "",
-					Locations: []string{"testdata/commits.v1.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "af885e2e890b9ef0875edd2b117305119ee5bdc5",
 					},
@@ -661,7 +664,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:      "is-number-5",
 					Version:   "",
-					Locations: []string{"testdata/commits.v1.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "af885e2e890b9ef0875edd2b117305119ee5bdc5",
 					},
@@ -672,7 +675,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:      "is-number-6",
 					Version:   "",
-					Locations: []string{"testdata/commits.v1.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "af885e2e890b9ef0875edd2b117305119ee5bdc5",
 					},
@@ -683,7 +686,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "postcss-calc",
 					Version:    "7.0.1",
-					Locations:  []string{"testdata/commits.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/commits.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -692,7 +695,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:      "raven-js",
 					Version:   "",
-					Locations: []string{"testdata/commits.v1.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "c2b377e7a254264fd4a1fe328e4e3cfc9e245570",
 					},
@@ -703,7 +706,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:      "slick-carousel",
 					Version:   "",
-					Locations: []string{"testdata/commits.v1.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "280b560161b751ba226d50c7db1e0a14a78c2de0",
 					},
@@ -722,7 +725,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "lodash",
 					Version:    "1.3.1",
-					Locations:  []string{"testdata/files.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/files.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -731,7 +734,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "other_package",
 					Version:    "",
-					Locations:  []string{"testdata/files.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/files.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -747,8 +750,9 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:       "@babel/code-frame",
+					Namespace:  "@babel",
 					Version:    "7.0.0",
-					Locations:  []string{"testdata/alias.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/alias.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -757,7 +761,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "string-width",
 					Version:    "4.2.0",
-					Locations:  []string{"testdata/alias.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/alias.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -766,7 +770,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "string-width",
 					Version:    "5.1.2",
-					Locations:  []string{"testdata/alias.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/alias.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -783,7 +787,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "wrappy",
 					Version:    "1.0.2",
-					Locations:  []string{"testdata/optional-package.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/optional-package.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{"dev", "optional"},
@@ -792,7 +796,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "supports-color",
 					Version:    "5.5.0",
-					Locations:  []string{"testdata/optional-package.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/optional-package.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{"optional"},
@@ -809,7 +813,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "eslint",
 					Version:    "1.2.3",
-					Locations:  []string{"testdata/same-package-different-groups.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/same-package-different-groups.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{"dev"},
@@ -818,7 +822,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "table",
 					Version:    "1.0.0",
-					Locations:  []string{"testdata/same-package-different-groups.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/same-package-different-groups.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -827,7 +831,7 @@ func TestNPMLockExtractor_Extract_V1(t *testing.T) {
 				{
 					Name:       "ajv",
 					Version:    "5.5.2",
-					Locations:  []string{"testdata/same-package-different-groups.v1.json"},
+					Locations:  extractor.DeclaredLocations("testdata/same-package-different-groups.v1.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
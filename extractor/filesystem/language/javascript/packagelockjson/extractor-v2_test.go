@@ -54,7 +54,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "wrappy",
 					Version:   "1.0.2",
-					Locations: []string{"testdata/one-package.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/one-package.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -73,7 +73,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "wrappy",
 					Version:   "1.0.2",
-					Locations: []string{"testdata/one-package-dev.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/one-package-dev.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -92,7 +92,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "wrappy",
 					Version:   "1.0.2",
-					Locations: []string{"testdata/two-packages.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -103,7 +103,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "supports-color",
 					Version:   "5.5.0",
-					Locations: []string{"testdata/two-packages.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -122,7 +122,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "wrappy",
 					Version:   "1.0.2",
-					Locations: []string{"testdata/scoped-packages.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/scoped-packages.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -132,8 +132,9 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				},
 				{
 					Name:      "@babel/code-frame",
+					Namespace: "@babel",
 					Version:   "7.0.0",
-					Locations: []string{"testdata/scoped-packages.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/scoped-packages.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -152,7 +153,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "postcss",
 					Version:   "6.0.23",
-					Locations: []string{"testdata/nested-dependencies.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/nested-dependencies.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -163,7 +164,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "postcss",
 					Version:   "7.0.16",
-					Locations: []string{"testdata/nested-dependencies.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/nested-dependencies.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -174,7 +175,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "postcss-calc",
 					Version:   "7.0.1",
-					Locations: []string{"testdata/nested-dependencies.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/nested-dependencies.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -185,7 +186,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "supports-color",
 					Version:   "6.1.0",
-					Locations: []string{"testdata/nested-dependencies.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/nested-dependencies.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -196,7 +197,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "supports-color",
 					Version:   "5.5.0",
-					Locations: []string{"testdata/nested-dependencies.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/nested-dependencies.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -215,7 +216,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "supports-color",
 					Version:   "6.1.0",
-					Locations: []string{"testdata/nested-dependencies-dup.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/nested-dependencies-dup.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -226,7 +227,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "supports-color",
 					Version:   "2.0.0",
-					Locations: []string{"testdata/nested-dependencies-dup.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/nested-dependencies-dup.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -244,8 +245,9 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "@segment/analytics.js-integration-facebook-pixel",
+					Namespace: "@segment",
 					Version:   "2.4.1",
-					Locations: []string{"testdata/commits.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "3b1bb80b302c2e552685dc8a029797ec832ea7c9",
 					},
@@ -256,7 +258,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "ansi-styles",
 					Version:   "1.0.0",
-					Locations: []string{"testdata/commits.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -267,7 +269,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "babel-preset-php",
 					Version:   "1.1.1",
-					Locations: []string{"testdata/commits.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "c5a7ba5e0ad98b8db1cb8ce105403dd4b768cced",
 					},
@@ -278,7 +280,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "is-number-1",
 					Version:   "3.0.0",
-					Locations: []string{"testdata/commits.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "af885e2e890b9ef0875edd2b117305119ee5bdc5",
 					},
@@ -289,7 +291,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "is-number-1",
 					Version:   "3.0.0",
-					Locations: []string{"testdata/commits.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "be5935f8d2595bcd97b05718ef1eeae08d812e10",
 					},
@@ -300,7 +302,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "is-number-2",
 					Version:   "2.0.0",
-					Locations: []string{"testdata/commits.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "d5ac0584ee9ae7bd9288220a39780f155b9ad4c8",
 					},
@@ -311,7 +313,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "is-number-2",
 					Version:   "2.0.0",
-					Locations: []string{"testdata/commits.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "82dcc8e914dabd9305ab9ae580709a7825e824f5",
 					},
@@ -322,7 +324,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "is-number-3",
 					Version:   "2.0.0",
-					Locations: []string{"testdata/commits.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "d5ac0584ee9ae7bd9288220a39780f155b9ad4c8",
 					},
@@ -333,7 +335,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "is-number-3",
 					Version:   "3.0.0",
-					Locations: []string{"testdata/commits.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "82ae8802978da40d7f1be5ad5943c9e550ab2c89",
 					},
@@ -344,7 +346,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "is-number-4",
 					Version:   "3.0.0",
-					Locations: []string{"testdata/commits.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "af885e2e890b9ef0875edd2b117305119ee5bdc5",
 					},
@@ -355,7 +357,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "is-number-5",
 					Version:   "3.0.0",
-					Locations: []string{"testdata/commits.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "af885e2e890b9ef0875edd2b117305119ee5bdc5",
 					},
@@ -366,7 +368,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "postcss-calc",
 					Version:   "7.0.1",
-					Locations: []string{"testdata/commits.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -377,7 +379,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "raven-js",
 					Version:   "",
-					Locations: []string{"testdata/commits.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "c2b377e7a254264fd4a1fe328e4e3cfc9e245570",
 					},
@@ -388,7 +390,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "slick-carousel",
 					Version:   "1.7.1",
-					Locations: []string{"testdata/commits.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "280b560161b751ba226d50c7db1e0a14a78c2de0",
 					},
@@ -407,7 +409,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "etag",
 					Version:   "1.8.0",
-					Locations: []string{"testdata/files.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/files.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -418,7 +420,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "abbrev",
 					Version:   "1.0.9",
-					Locations: []string{"testdata/files.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/files.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -429,7 +431,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "abbrev",
 					Version:   "2.3.4",
-					Locations: []string{"testdata/files.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/files.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -447,8 +449,9 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "@babel/code-frame",
+					Namespace: "@babel",
 					Version:   "7.0.0",
-					Locations: []string{"testdata/alias.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/alias.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -459,7 +462,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "string-width",
 					Version:   "4.2.0",
-					Locations: []string{"testdata/alias.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/alias.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -470,7 +473,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "string-width",
 					Version:   "5.1.2",
-					Locations: []string{"testdata/alias.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/alias.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -489,7 +492,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "wrappy",
 					Version:   "1.0.2",
-					Locations: []string{"testdata/optional-package.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/optional-package.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -500,7 +503,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "supports-color",
 					Version:   "5.5.0",
-					Locations: []string{"testdata/optional-package.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/optional-package.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -519,7 +522,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "eslint",
 					Version:   "1.2.3",
-					Locations: []string{"testdata/same-package-different-groups.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/same-package-different-groups.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -530,7 +533,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "table",
 					Version:   "1.0.0",
-					Locations: []string{"testdata/same-package-different-groups.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/same-package-different-groups.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -541,7 +544,7 @@ func TestNPMLockExtractor_Extract_V2(t *testing.T) {
 				{
 					Name:      "ajv",
 					Version:   "5.5.2",
-					Locations: []string{"testdata/same-package-different-groups.v2.json"},
+					Locations: extractor.DeclaredLocations("testdata/same-package-different-groups.v2.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
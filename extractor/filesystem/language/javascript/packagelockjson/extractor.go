@@ -28,6 +28,7 @@ import (
 	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/internal/commitextractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/internal/npmname"
 	"github.com/google/osv-scalibr/extractor/filesystem/osv"
 	"github.com/google/osv-scalibr/plugin"
 	"github.com/google/osv-scalibr/purl"
@@ -284,8 +285,35 @@ func (e Extractor) Requirements() *plugin.Capabilities {
 	return &plugin.Capabilities{}
 }
 
+// FileHints returns the file names the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{BaseNames: []string{"package-lock.json"}}
+}
+
 // FileRequired returns true if the specified file matches npm lockfile patterns.
 func (e Extractor) FileRequired(path string, fileInfo fs.FileInfo) bool {
+	if !e.fileMatches(path, fileInfo) {
+		return false
+	}
+
+	if e.maxFileSizeBytes > 0 && fileInfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileInfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileInfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// FileSizeLimitExceeded returns true if the file would be required if it
+// didn't exceed the maximum file size.
+func (e Extractor) FileSizeLimitExceeded(path string, fileInfo fs.FileInfo) bool {
+	return e.maxFileSizeBytes > 0 && fileInfo.Size() > e.maxFileSizeBytes && e.fileMatches(path, fileInfo)
+}
+
+// fileMatches returns true if the file is one the extractor is interested in,
+// regardless of its size.
+func (e Extractor) fileMatches(path string, fileInfo fs.FileInfo) bool {
 	if filepath.Base(path) != "package-lock.json" {
 		return false
 	}
@@ -297,12 +325,6 @@ func (e Extractor) FileRequired(path string, fileInfo fs.FileInfo) bool {
 		return false
 	}
 
-	if e.maxFileSizeBytes > 0 && fileInfo.Size() > e.maxFileSizeBytes {
-		e.reportFileRequired(path, fileInfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
-		return false
-	}
-
-	e.reportFileRequired(path, fileInfo.Size(), stats.FileRequiredResultOK)
 	return true
 }
 
@@ -311,6 +333,7 @@ func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result s
 		return
 	}
 	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Extractor:     e.Name(),
 		Path:          path,
 		Result:        result,
 		FileSizeBytes: fileSizeBytes,
@@ -354,7 +377,8 @@ func (e Extractor) extractPkgLock(_ context.Context, input *filesystem.ScanInput
 		}
 
 		inventories[i] = &extractor.Inventory{
-			Name: pkg.Name,
+			Name:      pkg.Name,
+			Namespace: npmname.Scope(pkg.Name),
 			SourceCode: &extractor.SourceCodeIdentifier{
 				Commit: pkg.Commit,
 			},
@@ -362,7 +386,7 @@ func (e Extractor) extractPkgLock(_ context.Context, input *filesystem.ScanInput
 			Metadata: osv.DepGroupMetadata{
 				DepGroupVals: pkg.DepGroups,
 			},
-			Locations: []string{input.Path},
+			Locations: extractor.DeclaredLocations(input.Path),
 		}
 	}
 
@@ -371,12 +395,11 @@ func (e Extractor) extractPkgLock(_ context.Context, input *filesystem.ScanInput
 
 // ToPURL converts an inventory created by this extractor into a PURL.
 func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
-	return &purl.PackageURL{
-		Type:    purl.TypeNPM,
-		Name:    strings.ToLower(i.Name),
-		Version: i.Version,
-	}
+	return npmname.ToPURL(i)
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeNPM} }
+
 // Ecosystem returns the OSV ecosystem ('npm') of the software extracted by this extractor.
 func (e Extractor) Ecosystem(_ *extractor.Inventory) string { return "npm" }
@@ -150,7 +150,7 @@ func TestToPURL(t *testing.T) {
 	i := &extractor.Inventory{
 		Name:      "Name",
 		Version:   "1.2.3",
-		Locations: []string{"location"},
+		Locations: extractor.DeclaredLocations("location"),
 	}
 	want := &purl.PackageURL{
 		Type:    purl.TypeNPM,
@@ -163,6 +163,49 @@ func TestToPURL(t *testing.T) {
 	}
 }
 
+func TestToPURL_Scoped(t *testing.T) {
+	e := packagelockjson.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "@Babel/Code-Frame",
+		Namespace: "@Babel",
+		Version:   "7.0.0",
+	}
+	want := &purl.PackageURL{
+		Type:      purl.TypeNPM,
+		Namespace: "@babel",
+		Name:      "code-frame",
+		Version:   "7.0.0",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+	if got, want := got.String(), "pkg:npm/%40babel/code-frame@7.0.0"; got != want {
+		t.Errorf("ToPURL(%v).String() = %q, want %q", i, got, want)
+	}
+}
+
+func TestExtract_ScopedNamespace(t *testing.T) {
+	e := packagelockjson.Extractor{}
+	scanInput := extracttest.GenerateScanInputMock(t, extracttest.ScanInputMockConfig{
+		Path: "testdata/scoped-packages.v2.json",
+	})
+	defer extracttest.CloseTestScanInput(t, scanInput)
+
+	invs, err := e.Extract(context.Background(), &scanInput)
+	if err != nil {
+		t.Fatalf("Extract(): %v", err)
+	}
+	got := map[string]string{}
+	for _, i := range invs {
+		got[i.Name] = i.Namespace
+	}
+	want := map[string]string{"@babel/code-frame": "@babel", "wrappy": ""}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Extract() namespaces by name (-want +got):\n%s", diff)
+	}
+}
+
 func TestMetricCollector(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -44,7 +44,7 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				{
 					Name:       "acorn",
 					Version:    "8.11.3",
-					Locations:  []string{"testdata/one-package.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/one-package.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -61,7 +61,7 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				{
 					Name:       "acorn",
 					Version:    "8.11.3",
-					Locations:  []string{"testdata/one-package-dev.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/one-package-dev.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -77,8 +77,9 @@ func TestExtractor_Extract_v9(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:       "@typescript-eslint/types",
+					Namespace:  "@typescript-eslint",
 					Version:    "5.62.0",
-					Locations:  []string{"testdata/scoped-packages.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/scoped-packages.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -95,7 +96,7 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				{
 					Name:       "acorn-jsx",
 					Version:    "5.3.2",
-					Locations:  []string{"testdata/peer-dependencies.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -104,7 +105,7 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				{
 					Name:       "acorn",
 					Version:    "8.11.3",
-					Locations:  []string{"testdata/peer-dependencies.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -120,8 +121,9 @@ func TestExtractor_Extract_v9(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:       "@eslint-community/eslint-utils",
+					Namespace:  "@eslint-community",
 					Version:    "4.4.0",
-					Locations:  []string{"testdata/peer-dependencies-advanced.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies-advanced.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -129,8 +131,9 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				},
 				{
 					Name:       "@eslint/eslintrc",
+					Namespace:  "@eslint",
 					Version:    "2.1.4",
-					Locations:  []string{"testdata/peer-dependencies-advanced.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies-advanced.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -138,8 +141,9 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				},
 				{
 					Name:       "@typescript-eslint/eslint-plugin",
+					Namespace:  "@typescript-eslint",
 					Version:    "5.62.0",
-					Locations:  []string{"testdata/peer-dependencies-advanced.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies-advanced.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -147,8 +151,9 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				},
 				{
 					Name:       "@typescript-eslint/parser",
+					Namespace:  "@typescript-eslint",
 					Version:    "5.62.0",
-					Locations:  []string{"testdata/peer-dependencies-advanced.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies-advanced.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -156,8 +161,9 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				},
 				{
 					Name:       "@typescript-eslint/type-utils",
+					Namespace:  "@typescript-eslint",
 					Version:    "5.62.0",
-					Locations:  []string{"testdata/peer-dependencies-advanced.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies-advanced.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -165,8 +171,9 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				},
 				{
 					Name:       "@typescript-eslint/typescript-estree",
+					Namespace:  "@typescript-eslint",
 					Version:    "5.62.0",
-					Locations:  []string{"testdata/peer-dependencies-advanced.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies-advanced.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -174,8 +181,9 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				},
 				{
 					Name:       "@typescript-eslint/utils",
+					Namespace:  "@typescript-eslint",
 					Version:    "5.62.0",
-					Locations:  []string{"testdata/peer-dependencies-advanced.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies-advanced.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -184,7 +192,7 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				{
 					Name:       "debug",
 					Version:    "4.3.4",
-					Locations:  []string{"testdata/peer-dependencies-advanced.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies-advanced.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -193,7 +201,7 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				{
 					Name:       "eslint",
 					Version:    "8.57.0",
-					Locations:  []string{"testdata/peer-dependencies-advanced.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies-advanced.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -202,7 +210,7 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				{
 					Name:       "has-flag",
 					Version:    "4.0.0",
-					Locations:  []string{"testdata/peer-dependencies-advanced.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies-advanced.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -211,7 +219,7 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				{
 					Name:       "supports-color",
 					Version:    "7.2.0",
-					Locations:  []string{"testdata/peer-dependencies-advanced.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies-advanced.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -220,7 +228,7 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				{
 					Name:       "tsutils",
 					Version:    "3.21.0",
-					Locations:  []string{"testdata/peer-dependencies-advanced.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies-advanced.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -229,7 +237,7 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				{
 					Name:       "typescript",
 					Version:    "4.9.5",
-					Locations:  []string{"testdata/peer-dependencies-advanced.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies-advanced.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -246,7 +254,7 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				{
 					Name:       "uuid",
 					Version:    "8.0.0",
-					Locations:  []string{"testdata/multiple-versions.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/multiple-versions.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -255,7 +263,7 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				{
 					Name:       "uuid",
 					Version:    "8.3.2",
-					Locations:  []string{"testdata/multiple-versions.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/multiple-versions.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -264,7 +272,7 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				{
 					Name:       "xmlbuilder",
 					Version:    "11.0.1",
-					Locations:  []string{"testdata/multiple-versions.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/multiple-versions.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -281,7 +289,7 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				{
 					Name:      "ansi-regex",
 					Version:   "6.0.1",
-					Locations: []string{"testdata/commits.v9.yaml"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "02fa893d619d3da85411acc8fd4e2eea0e95a9d9",
 					},
@@ -292,7 +300,7 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				{
 					Name:      "is-number",
 					Version:   "7.0.0",
-					Locations: []string{"testdata/commits.v9.yaml"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "98e8ff1da1a89f93d1397a24d7413ed15421c139",
 					},
@@ -311,7 +319,7 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				{
 					Name:       "ansi-regex",
 					Version:    "5.0.1",
-					Locations:  []string{"testdata/mixed-groups.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/mixed-groups.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -320,7 +328,7 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				{
 					Name:       "uuid",
 					Version:    "8.3.2",
-					Locations:  []string{"testdata/mixed-groups.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/mixed-groups.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -329,7 +337,7 @@ func TestExtractor_Extract_v9(t *testing.T) {
 				{
 					Name:       "is-number",
 					Version:    "7.0.0",
-					Locations:  []string{"testdata/mixed-groups.v9.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/mixed-groups.v9.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -28,6 +28,7 @@ import (
 
 	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/internal/npmname"
 	"github.com/google/osv-scalibr/extractor/filesystem/osv"
 	"github.com/google/osv-scalibr/log"
 	"github.com/google/osv-scalibr/plugin"
@@ -204,8 +205,9 @@ func parsePnpmLock(lockfile pnpmLockfile) ([]*extractor.Inventory, error) {
 		}
 
 		packages = append(packages, &extractor.Inventory{
-			Name:    name,
-			Version: version,
+			Name:      name,
+			Namespace: npmname.Scope(name),
+			Version:   version,
 			SourceCode: &extractor.SourceCodeIdentifier{
 				Commit: commit,
 			},
@@ -230,6 +232,11 @@ func (e Extractor) Version() int { return 0 }
 // Requirements of the extractor.
 func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
 
+// FileHints returns the file names the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{BaseNames: []string{"pnpm-lock.yaml"}}
+}
+
 // FileRequired returns true if the specified file matches pnpm-lock.yaml files.
 func (e Extractor) FileRequired(path string, fileInfo fs.FileInfo) bool {
 	return filepath.Base(path) == "pnpm-lock.yaml"
@@ -252,7 +259,7 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 
 	inventories, err := parsePnpmLock(*parsedLockfile)
 	for i := range inventories {
-		inventories[i].Locations = []string{input.Path}
+		inventories[i].Locations = extractor.DeclaredLocations(input.Path)
 	}
 
 	return inventories, err
@@ -260,13 +267,12 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 
 // ToPURL converts an inventory created by this extractor into a PURL.
 func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
-	return &purl.PackageURL{
-		Type:    purl.TypeNPM,
-		Name:    strings.ToLower(i.Name),
-		Version: i.Version,
-	}
+	return npmname.ToPURL(i)
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeNPM} }
+
 // Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
 func (e Extractor) Ecosystem(i *extractor.Inventory) string {
 	return "npm"
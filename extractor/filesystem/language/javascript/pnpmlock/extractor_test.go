@@ -117,7 +117,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "acorn",
 					Version:    "8.7.0",
-					Locations:  []string{"testdata/one-package.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/one-package.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -134,7 +134,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "acorn",
 					Version:    "8.7.0",
-					Locations:  []string{"testdata/one-package-v6-lockfile.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/one-package-v6-lockfile.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -151,7 +151,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "acorn",
 					Version:    "8.7.0",
-					Locations:  []string{"testdata/one-package-dev.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/one-package-dev.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -167,8 +167,9 @@ func TestExtractor_Extract(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:       "@typescript-eslint/types",
+					Namespace:  "@typescript-eslint",
 					Version:    "5.13.0",
-					Locations:  []string{"testdata/scoped-packages.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/scoped-packages.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -184,8 +185,9 @@ func TestExtractor_Extract(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:       "@typescript-eslint/types",
+					Namespace:  "@typescript-eslint",
 					Version:    "5.57.1",
-					Locations:  []string{"testdata/scoped-packages-v6-lockfile.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/scoped-packages-v6-lockfile.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -202,7 +204,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "acorn-jsx",
 					Version:    "5.3.2",
-					Locations:  []string{"testdata/peer-dependencies.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -211,7 +213,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "acorn",
 					Version:    "8.7.0",
-					Locations:  []string{"testdata/peer-dependencies.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -227,8 +229,9 @@ func TestExtractor_Extract(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:       "@typescript-eslint/eslint-plugin",
+					Namespace:  "@typescript-eslint",
 					Version:    "5.13.0",
-					Locations:  []string{"testdata/peer-dependencies-advanced.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies-advanced.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -236,8 +239,9 @@ func TestExtractor_Extract(t *testing.T) {
 				},
 				{
 					Name:       "@typescript-eslint/parser",
+					Namespace:  "@typescript-eslint",
 					Version:    "5.13.0",
-					Locations:  []string{"testdata/peer-dependencies-advanced.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies-advanced.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -245,8 +249,9 @@ func TestExtractor_Extract(t *testing.T) {
 				},
 				{
 					Name:       "@typescript-eslint/type-utils",
+					Namespace:  "@typescript-eslint",
 					Version:    "5.13.0",
-					Locations:  []string{"testdata/peer-dependencies-advanced.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies-advanced.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -254,8 +259,9 @@ func TestExtractor_Extract(t *testing.T) {
 				},
 				{
 					Name:       "@typescript-eslint/types",
+					Namespace:  "@typescript-eslint",
 					Version:    "5.13.0",
-					Locations:  []string{"testdata/peer-dependencies-advanced.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies-advanced.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -263,8 +269,9 @@ func TestExtractor_Extract(t *testing.T) {
 				},
 				{
 					Name:       "@typescript-eslint/typescript-estree",
+					Namespace:  "@typescript-eslint",
 					Version:    "5.13.0",
-					Locations:  []string{"testdata/peer-dependencies-advanced.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies-advanced.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -272,8 +279,9 @@ func TestExtractor_Extract(t *testing.T) {
 				},
 				{
 					Name:       "@typescript-eslint/utils",
+					Namespace:  "@typescript-eslint",
 					Version:    "5.13.0",
-					Locations:  []string{"testdata/peer-dependencies-advanced.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies-advanced.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -282,7 +290,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "eslint-utils",
 					Version:    "3.0.0",
-					Locations:  []string{"testdata/peer-dependencies-advanced.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies-advanced.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -291,7 +299,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "eslint",
 					Version:    "8.10.0",
-					Locations:  []string{"testdata/peer-dependencies-advanced.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies-advanced.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -300,7 +308,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "tsutils",
 					Version:    "3.21.0",
-					Locations:  []string{"testdata/peer-dependencies-advanced.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/peer-dependencies-advanced.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -317,7 +325,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "aws-sdk",
 					Version:    "2.1087.0",
-					Locations:  []string{"testdata/multiple-packages.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/multiple-packages.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -326,7 +334,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "base64-js",
 					Version:    "1.5.1",
-					Locations:  []string{"testdata/multiple-packages.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/multiple-packages.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -335,7 +343,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "buffer",
 					Version:    "4.9.2",
-					Locations:  []string{"testdata/multiple-packages.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/multiple-packages.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -344,7 +352,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "events",
 					Version:    "1.1.1",
-					Locations:  []string{"testdata/multiple-packages.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/multiple-packages.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -353,7 +361,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "ieee754",
 					Version:    "1.1.13",
-					Locations:  []string{"testdata/multiple-packages.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/multiple-packages.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -362,7 +370,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "isarray",
 					Version:    "1.0.0",
-					Locations:  []string{"testdata/multiple-packages.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/multiple-packages.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -371,7 +379,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "jmespath",
 					Version:    "0.16.0",
-					Locations:  []string{"testdata/multiple-packages.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/multiple-packages.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -380,7 +388,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "punycode",
 					Version:    "1.3.2",
-					Locations:  []string{"testdata/multiple-packages.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/multiple-packages.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -389,7 +397,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "querystring",
 					Version:    "0.2.0",
-					Locations:  []string{"testdata/multiple-packages.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/multiple-packages.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -398,7 +406,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "sax",
 					Version:    "1.2.1",
-					Locations:  []string{"testdata/multiple-packages.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/multiple-packages.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -407,7 +415,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "url",
 					Version:    "0.10.3",
-					Locations:  []string{"testdata/multiple-packages.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/multiple-packages.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -416,7 +424,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "uuid",
 					Version:    "3.3.2",
-					Locations:  []string{"testdata/multiple-packages.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/multiple-packages.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -425,7 +433,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "xml2js",
 					Version:    "0.4.19",
-					Locations:  []string{"testdata/multiple-packages.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/multiple-packages.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -434,7 +442,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "xmlbuilder",
 					Version:    "9.0.7",
-					Locations:  []string{"testdata/multiple-packages.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/multiple-packages.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -451,7 +459,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "uuid",
 					Version:    "3.3.2",
-					Locations:  []string{"testdata/multiple-versions.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/multiple-versions.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -460,7 +468,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "uuid",
 					Version:    "8.3.2",
-					Locations:  []string{"testdata/multiple-versions.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/multiple-versions.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -469,7 +477,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "xmlbuilder",
 					Version:    "9.0.7",
-					Locations:  []string{"testdata/multiple-versions.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/multiple-versions.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -485,8 +493,9 @@ func TestExtractor_Extract(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:       "@my-org/my-package",
+					Namespace:  "@my-org",
 					Version:    "3.2.3",
-					Locations:  []string{"testdata/tarball.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/tarball.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{"dev"},
@@ -503,7 +512,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "foo",
 					Version:    "1.0.0",
-					Locations:  []string{"testdata/exotic.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/exotic.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -511,8 +520,9 @@ func TestExtractor_Extract(t *testing.T) {
 				},
 				{
 					Name:       "@foo/bar",
+					Namespace:  "@foo",
 					Version:    "1.0.0",
-					Locations:  []string{"testdata/exotic.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/exotic.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -521,7 +531,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "foo",
 					Version:    "1.1.0",
-					Locations:  []string{"testdata/exotic.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/exotic.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -529,8 +539,9 @@ func TestExtractor_Extract(t *testing.T) {
 				},
 				{
 					Name:       "@foo/bar",
+					Namespace:  "@foo",
 					Version:    "1.1.0",
-					Locations:  []string{"testdata/exotic.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/exotic.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -539,7 +550,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "foo",
 					Version:    "1.2.0",
-					Locations:  []string{"testdata/exotic.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/exotic.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -548,7 +559,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "foo",
 					Version:    "1.3.0",
-					Locations:  []string{"testdata/exotic.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/exotic.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -557,7 +568,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "foo",
 					Version:    "1.4.0",
-					Locations:  []string{"testdata/exotic.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/exotic.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -574,7 +585,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "my-bitbucket-package",
 					Version:   "1.0.0",
-					Locations: []string{"testdata/commits.yaml"},
+					Locations: extractor.DeclaredLocations("testdata/commits.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "6104ae42cd32c3d724036d3964678f197b2c9cdb",
 					},
@@ -584,8 +595,9 @@ func TestExtractor_Extract(t *testing.T) {
 				},
 				{
 					Name:      "@my-scope/my-package",
+					Namespace: "@my-scope",
 					Version:   "1.0.0",
-					Locations: []string{"testdata/commits.yaml"},
+					Locations: extractor.DeclaredLocations("testdata/commits.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "267087851ad5fac92a184749c27cd539e2fc862e",
 					},
@@ -595,8 +607,9 @@ func TestExtractor_Extract(t *testing.T) {
 				},
 				{
 					Name:      "@my-scope/my-other-package",
+					Namespace: "@my-scope",
 					Version:   "1.0.0",
-					Locations: []string{"testdata/commits.yaml"},
+					Locations: extractor.DeclaredLocations("testdata/commits.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "fbfc962ab51eb1d754749b68c064460221fbd689",
 					},
@@ -607,7 +620,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "faker-parser",
 					Version:   "0.0.1",
-					Locations: []string{"testdata/commits.yaml"},
+					Locations: extractor.DeclaredLocations("testdata/commits.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "d2dc42a9351d4d89ec48c525e34f612b6d77993f",
 					},
@@ -618,7 +631,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "mocks",
 					Version:   "20.0.1",
-					Locations: []string{"testdata/commits.yaml"},
+					Locations: extractor.DeclaredLocations("testdata/commits.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "590f321b4eb3f692bb211bd74e22947639a6f79d",
 					},
@@ -637,7 +650,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "my-file-package",
 					Version:    "0.0.0",
-					Locations:  []string{"testdata/files.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/files.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -646,7 +659,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "a-local-package",
 					Version:    "1.0.0",
-					Locations:  []string{"testdata/files.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/files.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -655,7 +668,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "a-nested-local-package",
 					Version:    "1.0.0",
-					Locations:  []string{"testdata/files.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/files.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -664,7 +677,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "one-up",
 					Version:    "1.0.0",
-					Locations:  []string{"testdata/files.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/files.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
@@ -673,7 +686,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:       "one-up-with-peer",
 					Version:    "1.0.0",
-					Locations:  []string{"testdata/files.yaml"},
+					Locations:  extractor.DeclaredLocations("testdata/files.yaml"),
 					SourceCode: &extractor.SourceCodeIdentifier{},
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
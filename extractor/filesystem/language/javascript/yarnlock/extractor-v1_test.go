@@ -43,7 +43,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "balanced-match",
 					Version:   "1.0.2",
-					Locations: []string{"testdata/one-package.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/one-package.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -59,7 +59,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "concat-stream",
 					Version:   "1.6.2",
-					Locations: []string{"testdata/two-packages.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -67,7 +67,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "concat-map",
 					Version:   "0.0.1",
-					Locations: []string{"testdata/two-packages.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -83,7 +83,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "concat-stream",
 					Version:   "1.6.2",
-					Locations: []string{"testdata/with-quotes.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-quotes.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -91,7 +91,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "concat-map",
 					Version:   "0.0.1",
-					Locations: []string{"testdata/with-quotes.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-quotes.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -107,7 +107,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "define-properties",
 					Version:   "1.1.3",
-					Locations: []string{"testdata/multiple-versions.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -115,7 +115,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "define-property",
 					Version:   "0.2.5",
-					Locations: []string{"testdata/multiple-versions.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -123,7 +123,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "define-property",
 					Version:   "1.0.0",
-					Locations: []string{"testdata/multiple-versions.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -131,7 +131,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "define-property",
 					Version:   "2.0.2",
-					Locations: []string{"testdata/multiple-versions.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -146,8 +146,9 @@ func TestExtractor_Extract_v1(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "@babel/code-frame",
+					Namespace: "@babel",
 					Version:   "7.12.13",
-					Locations: []string{"testdata/multiple-constraints.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-constraints.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -155,7 +156,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "domelementtype",
 					Version:   "1.3.1",
-					Locations: []string{"testdata/multiple-constraints.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-constraints.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -170,16 +171,18 @@ func TestExtractor_Extract_v1(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "@babel/code-frame",
+					Namespace: "@babel",
 					Version:   "7.12.11",
-					Locations: []string{"testdata/scoped-packages.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/scoped-packages.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
 				},
 				{
 					Name:      "@babel/compat-data",
+					Namespace: "@babel",
 					Version:   "7.14.0",
-					Locations: []string{"testdata/scoped-packages.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/scoped-packages.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -195,7 +198,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "css-tree",
 					Version:   "1.0.0-alpha.37",
-					Locations: []string{"testdata/with-prerelease.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-prerelease.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -203,7 +206,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "gensync",
 					Version:   "1.0.0-beta.2",
-					Locations: []string{"testdata/with-prerelease.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-prerelease.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -211,7 +214,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "node-fetch",
 					Version:   "3.0.0-beta.9",
-					Locations: []string{"testdata/with-prerelease.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-prerelease.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -219,7 +222,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "resolve",
 					Version:   "1.20.0",
-					Locations: []string{"testdata/with-prerelease.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-prerelease.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -227,7 +230,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "resolve",
 					Version:   "2.0.0-next.3",
-					Locations: []string{"testdata/with-prerelease.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-prerelease.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -243,7 +246,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "domino",
 					Version:   "2.1.6+git",
-					Locations: []string{"testdata/with-build-string.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-build-string.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -251,7 +254,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "tslib",
 					Version:   "2.6.2",
-					Locations: []string{"testdata/with-build-string.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-build-string.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -267,7 +270,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "mine1",
 					Version:   "1.0.0-alpha.37",
-					Locations: []string{"testdata/commits.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "0a2d2506c1fe299691fc5db53a2097db3bd615bc",
 					},
@@ -275,7 +278,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "mine2",
 					Version:   "0.0.1",
-					Locations: []string{"testdata/commits.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "0a2d2506c1fe299691fc5db53a2097db3bd615bc",
 					},
@@ -283,7 +286,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "mine3",
 					Version:   "1.2.3",
-					Locations: []string{"testdata/commits.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "094e581aaf927d010e4b61d706ba584551dac502",
 					},
@@ -291,7 +294,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "mine4",
 					Version:   "0.0.2",
-					Locations: []string{"testdata/commits.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "aa3bdfcb1d845c79f14abb66f60d35b8a3ee5998",
 					},
@@ -299,7 +302,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "mine4",
 					Version:   "0.0.4",
-					Locations: []string{"testdata/commits.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "aa3bdfcb1d845c79f14abb66f60d35b8a3ee5998",
 					},
@@ -307,23 +310,25 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "my-package",
 					Version:   "1.8.3",
-					Locations: []string{"testdata/commits.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "b3bd3f1b3dad036e671251f5258beaae398f983a",
 					},
 				},
 				{
 					Name:      "@bower_components/angular-animate",
+					Namespace: "@bower_components",
 					Version:   "1.4.14",
-					Locations: []string{"testdata/commits.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "e7f778fc054a086ba3326d898a00fa1bc78650a8",
 					},
 				},
 				{
 					Name:      "@bower_components/alertify",
+					Namespace: "@bower_components",
 					Version:   "0.0.0",
-					Locations: []string{"testdata/commits.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "e7b6c46d76604d297c389d830817b611c9a8f17c",
 					},
@@ -331,7 +336,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "minimist",
 					Version:   "0.0.8",
-					Locations: []string{"testdata/commits.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "3754568bfd43a841d2d72d7fb54598635aea8fa4",
 					},
@@ -339,7 +344,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "bats-assert",
 					Version:   "2.0.0",
-					Locations: []string{"testdata/commits.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "4bdd58d3fbcdce3209033d44d884e87add1d8405",
 					},
@@ -347,7 +352,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "bats-support",
 					Version:   "0.3.0",
-					Locations: []string{"testdata/commits.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "d140a65044b2d6810381935ae7f0c94c7023c8c3",
 					},
@@ -355,7 +360,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "bats",
 					Version:   "1.5.0",
-					Locations: []string{"testdata/commits.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "172580d2ce19ee33780b5f1df817bbddced43789",
 					},
@@ -363,7 +368,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "vue",
 					Version:   "2.6.12",
-					Locations: []string{"testdata/commits.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "bb253db0b3e17124b6d1fe93fbf2db35470a1347",
 					},
@@ -371,7 +376,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "kit",
 					Version:   "1.0.0",
-					Locations: []string{"testdata/commits.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "5b6830c0252eb73c6024d40a8ff5106d3023a2a6",
 					},
@@ -379,7 +384,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "casadistance",
 					Version:   "1.0.0",
-					Locations: []string{"testdata/commits.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "f0308391f0c50104182bfb2332a53e4e523a4603",
 					},
@@ -387,7 +392,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "babel-preset-php",
 					Version:   "1.1.1",
-					Locations: []string{"testdata/commits.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "c5a7ba5e0ad98b8db1cb8ce105403dd4b768cced",
 					},
@@ -395,7 +400,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "is-number",
 					Version:   "2.0.0",
-					Locations: []string{"testdata/commits.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "d5ac0584ee9ae7bd9288220a39780f155b9ad4c8",
 					},
@@ -403,7 +408,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "is-number",
 					Version:   "5.0.0",
-					Locations: []string{"testdata/commits.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "af885e2e890b9ef0875edd2b117305119ee5bdc5",
 					},
@@ -419,7 +424,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "etag",
 					Version:   "1.8.1",
-					Locations: []string{"testdata/files.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/files.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -427,7 +432,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "filedep",
 					Version:   "1.2.0",
-					Locations: []string{"testdata/files.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/files.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -435,7 +440,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "lodash",
 					Version:   "1.3.1",
-					Locations: []string{"testdata/files.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/files.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -443,7 +448,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "other_package",
 					Version:   "0.0.2",
-					Locations: []string{"testdata/files.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/files.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -451,7 +456,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "sprintf-js",
 					Version:   "0.0.0",
-					Locations: []string{"testdata/files.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/files.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -459,7 +464,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "etag",
 					Version:   "1.8.0",
-					Locations: []string{"testdata/files.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/files.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -474,8 +479,9 @@ func TestExtractor_Extract_v1(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "@babel/helper-validator-identifier",
+					Namespace: "@babel",
 					Version:   "7.22.20",
-					Locations: []string{"testdata/with-aliases.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-aliases.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -483,7 +489,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "ansi-regex",
 					Version:   "6.0.1",
-					Locations: []string{"testdata/with-aliases.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-aliases.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -491,7 +497,7 @@ func TestExtractor_Extract_v1(t *testing.T) {
 				{
 					Name:      "ansi-regex",
 					Version:   "5.0.1",
-					Locations: []string{"testdata/with-aliases.v1.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-aliases.v1.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
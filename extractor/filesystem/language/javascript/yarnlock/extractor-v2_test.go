@@ -43,7 +43,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "balanced-match",
 					Version:   "1.0.2",
-					Locations: []string{"testdata/one-package.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/one-package.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -59,7 +59,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "compare-func",
 					Version:   "2.0.0",
-					Locations: []string{"testdata/two-packages.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -67,7 +67,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "concat-map",
 					Version:   "0.0.1",
-					Locations: []string{"testdata/two-packages.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -83,7 +83,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "compare-func",
 					Version:   "2.0.0",
-					Locations: []string{"testdata/with-quotes.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-quotes.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -91,7 +91,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "concat-map",
 					Version:   "0.0.1",
-					Locations: []string{"testdata/with-quotes.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-quotes.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -107,7 +107,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "debug",
 					Version:   "4.3.3",
-					Locations: []string{"testdata/multiple-versions.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -115,7 +115,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "debug",
 					Version:   "2.6.9",
-					Locations: []string{"testdata/multiple-versions.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -123,7 +123,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "debug",
 					Version:   "3.2.7",
-					Locations: []string{"testdata/multiple-versions.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-versions.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -138,24 +138,27 @@ func TestExtractor_Extract_v2(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "@babel/cli",
+					Namespace: "@babel",
 					Version:   "7.16.8",
-					Locations: []string{"testdata/scoped-packages.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/scoped-packages.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
 				},
 				{
 					Name:      "@babel/code-frame",
+					Namespace: "@babel",
 					Version:   "7.16.7",
-					Locations: []string{"testdata/scoped-packages.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/scoped-packages.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
 				},
 				{
 					Name:      "@babel/compat-data",
+					Namespace: "@babel",
 					Version:   "7.16.8",
-					Locations: []string{"testdata/scoped-packages.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/scoped-packages.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -170,8 +173,9 @@ func TestExtractor_Extract_v2(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "@nicolo-ribaudo/chokidar-2",
+					Namespace: "@nicolo-ribaudo",
 					Version:   "2.1.8-no-fsevents.3",
-					Locations: []string{"testdata/with-prerelease.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-prerelease.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -179,7 +183,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "gensync",
 					Version:   "1.0.0-beta.2",
-					Locations: []string{"testdata/with-prerelease.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-prerelease.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -187,7 +191,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "eslint-plugin-jest",
 					Version:   "0.0.0-use.local",
-					Locations: []string{"testdata/with-prerelease.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-prerelease.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -203,7 +207,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "domino",
 					Version:   "2.1.6+git",
-					Locations: []string{"testdata/with-build-string.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-build-string.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "f2435fe1f9f7c91ade0bd472c4723e5eacd7d19a",
 					},
@@ -211,7 +215,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "tslib",
 					Version:   "2.6.2",
-					Locations: []string{"testdata/with-build-string.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-build-string.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -219,7 +223,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "zone.js",
 					Version:   "0.0.0-use.local",
-					Locations: []string{"testdata/with-build-string.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-build-string.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -234,8 +238,9 @@ func TestExtractor_Extract_v2(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "@my-scope/my-first-package",
+					Namespace: "@my-scope",
 					Version:   "0.0.6",
-					Locations: []string{"testdata/commits.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "0b824c650d3a03444dbcf2b27a5f3566f6e41358",
 					},
@@ -243,15 +248,16 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "my-second-package",
 					Version:   "0.2.2",
-					Locations: []string{"testdata/commits.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "59e2127b9f9d4fda5f928c4204213b3502cd5bb0",
 					},
 				},
 				{
 					Name:      "@typegoose/typegoose",
+					Namespace: "@typegoose",
 					Version:   "7.2.0",
-					Locations: []string{"testdata/commits.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "3ed06e5097ab929f69755676fee419318aaec73a",
 					},
@@ -259,7 +265,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "vuejs",
 					Version:   "2.5.0",
-					Locations: []string{"testdata/commits.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "0948d999f2fddf9f90991956493f976273c5da1f",
 					},
@@ -267,7 +273,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "my-third-package",
 					Version:   "0.16.1-dev",
-					Locations: []string{"testdata/commits.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "5675a0aed98e067ff6ecccc5ac674fe8995960e0",
 					},
@@ -275,7 +281,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "my-node-sdk",
 					Version:   "1.1.0",
-					Locations: []string{"testdata/commits.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "053dea9e0b8af442d8f867c8e690d2fb0ceb1bf5",
 					},
@@ -283,7 +289,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "is-really-great",
 					Version:   "1.0.0",
-					Locations: []string{"testdata/commits.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/commits.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "191eeef50c584714e1fb8927d17ee72b3b8c97c4",
 					},
@@ -299,7 +305,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "my-package",
 					Version:   "0.0.2",
-					Locations: []string{"testdata/files.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/files.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -314,8 +320,9 @@ func TestExtractor_Extract_v2(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "@babel/helper-validator-identifier",
+					Namespace: "@babel",
 					Version:   "7.22.20",
-					Locations: []string{"testdata/with-aliases.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-aliases.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -323,7 +330,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "ansi-regex",
 					Version:   "6.0.1",
-					Locations: []string{"testdata/with-aliases.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-aliases.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -331,7 +338,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "ansi-regex",
 					Version:   "5.0.1",
-					Locations: []string{"testdata/with-aliases.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-aliases.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -339,7 +346,7 @@ func TestExtractor_Extract_v2(t *testing.T) {
 				{
 					Name:      "mine",
 					Version:   "0.0.0-use.local",
-					Locations: []string{"testdata/with-aliases.v2.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-aliases.v2.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
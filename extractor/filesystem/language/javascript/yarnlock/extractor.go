@@ -27,6 +27,7 @@ import (
 	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/internal/commitextractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/internal/npmname"
 	"github.com/google/osv-scalibr/log"
 	"github.com/google/osv-scalibr/plugin"
 	"github.com/google/osv-scalibr/purl"
@@ -170,8 +171,9 @@ func parseYarnPackageGroup(desc *packageDescription) *extractor.Inventory {
 	}
 
 	return &extractor.Inventory{
-		Name:    name,
-		Version: version,
+		Name:      name,
+		Namespace: npmname.Scope(name),
+		Version:   version,
 		SourceCode: &extractor.SourceCodeIdentifier{
 			Commit: commitextractor.TryExtractCommit(resolution),
 		},
@@ -192,6 +194,11 @@ func (e Extractor) Requirements() *plugin.Capabilities {
 	return &plugin.Capabilities{}
 }
 
+// FileHints returns the file names the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{BaseNames: []string{"yarn.lock"}}
+}
+
 // FileRequired returns true if the specified file is an NPM yarn.lock file.
 func (e Extractor) FileRequired(path string, fileInfo fs.FileInfo) bool {
 	return filepath.Base(path) == "yarn.lock"
@@ -214,7 +221,7 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 			continue
 		}
 		inv := parseYarnPackageGroup(group)
-		inv.Locations = []string{input.Path}
+		inv.Locations = extractor.DeclaredLocations(input.Path)
 		packages = append(packages, inv)
 	}
 
@@ -223,13 +230,12 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 
 // ToPURL converts an inventory created by this extractor into a PURL.
 func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
-	return &purl.PackageURL{
-		Type:    purl.TypeNPM,
-		Name:    i.Name,
-		Version: i.Version,
-	}
+	return npmname.ToPURL(i)
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeNPM} }
+
 // Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
 func (e Extractor) Ecosystem(i *extractor.Inventory) string { return "npm" }
 
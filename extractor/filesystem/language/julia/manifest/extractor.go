@@ -0,0 +1,155 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package manifest extracts the packages of Julia environments from their
+// Manifest.toml files.
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+// Name is the unique name of this extractor.
+const Name = "julia/manifest"
+
+// versionedManifest matches the manifests of specific Julia versions, e.g.
+// Manifest-v1.11.toml, which Julia 1.11+ prefers over Manifest.toml.
+var versionedManifest = regexp.MustCompile(`^(Julia)?Manifest-v\d+\.\d+\.toml$`)
+
+// Metadata holds additional information about a Julia package.
+type Metadata struct {
+	// UUID identifies the package; packages in different registries can have
+	// the same name.
+	UUID string `json:"uuid"`
+}
+
+// manifestEntry is a package entry of a manifest.
+type manifestEntry struct {
+	UUID    string `toml:"uuid"`
+	Version string `toml:"version"`
+}
+
+// Extractor extracts Julia packages from Manifest.toml files.
+type Extractor struct{}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileHints returns the file extensions the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{Extensions: []string{".toml"}}
+}
+
+// FileRequired returns true for Manifest.toml and JuliaManifest.toml files,
+// including the ones for specific Julia versions.
+func (e Extractor) FileRequired(path string, _ fs.FileInfo) bool {
+	base := filepath.Base(path)
+	return base == "Manifest.toml" || base == "JuliaManifest.toml" || versionedManifest.MatchString(base)
+}
+
+// Extract returns the packages of the environment, sorted by name. Standard
+// libraries without a version of their own are skipped.
+//
+// Julia 1.7 introduced the manifest format 2.0, which nests the packages in a
+// deps table next to the manifest_format and julia_version keys. Older
+// manifests only consist of the package entries.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	var manifest map[string]toml.Primitive
+	md, err := toml.NewDecoder(input.Reader).Decode(&manifest)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+
+	deps := manifest
+	if format, ok := manifest["manifest_format"]; ok {
+		var v string
+		if err := md.PrimitiveDecode(format, &v); err != nil {
+			return nil, fmt.Errorf("could not extract from %s: invalid manifest_format: %w", input.Path, err)
+		}
+		if !strings.HasPrefix(v, "2.") {
+			return nil, fmt.Errorf("could not extract from %s: unsupported manifest_format %q", input.Path, v)
+		}
+		deps = map[string]toml.Primitive{}
+		if err := md.PrimitiveDecode(manifest["deps"], &deps); err != nil {
+			return nil, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+		}
+	}
+
+	names := make([]string, 0, len(deps))
+	for name := range deps {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	res := []*extractor.Inventory{}
+	for _, name := range names {
+		var entries []manifestEntry
+		if err := md.PrimitiveDecode(deps[name], &entries); err != nil {
+			return nil, fmt.Errorf("could not extract from %s: package %s: %w", input.Path, name, err)
+		}
+		for _, entry := range entries {
+			if entry.Version == "" {
+				continue
+			}
+			res = append(res, &extractor.Inventory{
+				Name:      name,
+				Version:   entry.Version,
+				Locations: extractor.DeclaredLocations(input.Path),
+				Metadata:  &Metadata{UUID: entry.UUID},
+			})
+		}
+	}
+	return res, nil
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL with the
+// package UUID as qualifier.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	p := &purl.PackageURL{
+		Type:    purl.TypeJulia,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+	if m, ok := i.Metadata.(*Metadata); ok && m.UUID != "" {
+		p.Qualifiers = purl.QualifiersFromMap(map[string]string{"uuid": m.UUID})
+	}
+	return p
+}
+
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeJulia} }
+
+// Ecosystem returns the OSV ecosystem ('Julia') of the software extracted by this extractor.
+func (e Extractor) Ecosystem(i *extractor.Inventory) string { return "Julia" }
+
+var _ filesystem.Extractor = Extractor{}
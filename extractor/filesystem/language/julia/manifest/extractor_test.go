@@ -0,0 +1,138 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/julia/manifest"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/testing/extracttest"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "Manifest.toml", want: true},
+		{path: "project/JuliaManifest.toml", want: true},
+		{path: "project/Manifest-v1.11.toml", want: true},
+		{path: "project/JuliaManifest-v1.11.toml", want: true},
+		{path: "project/Project.toml", want: false},
+		{path: "project/Manifest.toml.bak", want: false},
+		{path: "project/Cargo.toml", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := (manifest.Extractor{}).FileRequired(tt.path, nil); got != tt.want {
+				t.Errorf("FileRequired(%s) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+// pkg returns the inventory of a package in the given manifest.
+func pkg(path, name, version, uuid string) *extractor.Inventory {
+	return &extractor.Inventory{
+		Name:      name,
+		Version:   version,
+		Locations: extractor.DeclaredLocations(path),
+		Metadata:  &manifest.Metadata{UUID: uuid},
+	}
+}
+
+func TestExtract(t *testing.T) {
+	const v1 = "testdata/v1/Manifest.toml"
+	const v2 = "testdata/v2/Manifest.toml"
+	const versioned = "testdata/versioned/Manifest-v1.11.toml"
+	tests := []extracttest.TestTableEntry{
+		{
+			Name:        "Julia 1.6 manifest",
+			InputConfig: extracttest.ScanInputMockConfig{Path: v1},
+			WantInventory: []*extractor.Inventory{
+				pkg(v1, "DataStructures", "0.17.20", "864edb3b-99cc-5e75-8d2d-829cb0a9cfe8"),
+				pkg(v1, "JSON", "0.21.1", "682c06a0-de6a-54ab-a142-c8b1cf79cde6"),
+				pkg(v1, "OrderedCollections", "1.4.1", "bac558e1-5e72-5ebc-8fee-abe8a469f55d"),
+			},
+		},
+		{
+			Name:        "Julia 1.7+ manifest",
+			InputConfig: extracttest.ScanInputMockConfig{Path: v2},
+			WantInventory: []*extractor.Inventory{
+				pkg(v2, "CSV", "0.10.11", "336ed68f-0bac-5ca0-87d4-7b16caf5d00b"),
+				pkg(v2, "DataFrames", "1.6.1", "a93c6f00-e57d-5684-b7b6-d8193f3e46c0"),
+				pkg(v2, "Example", "0.5.4", "7876af07-990d-54b4-ab0e-23690620f79a"),
+			},
+		},
+		{
+			Name:        "versioned manifest",
+			InputConfig: extracttest.ScanInputMockConfig{Path: versioned},
+			WantInventory: []*extractor.Inventory{
+				pkg(versioned, "JSON3", "1.14.1", "0f8b85d8-7281-11e9-16c2-39a750bddbf1"),
+				pkg(versioned, "Statistics", "1.11.1", "10745b16-79ce-11e8-11f9-7d13ad32a3b2"),
+			},
+		},
+		{
+			Name:        "unsupported manifest format",
+			InputConfig: extracttest.ScanInputMockConfig{Path: "testdata/unsupported/Manifest.toml"},
+			WantErr:     extracttest.ContainsErrStr{Str: `unsupported manifest_format "3.0"`},
+		},
+		{
+			Name:        "invalid toml",
+			InputConfig: extracttest.ScanInputMockConfig{Path: "testdata/invalid/Manifest.toml"},
+			WantErr:     extracttest.ContainsErrStr{Str: "could not extract from"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := manifest.Extractor{}
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+			if !cmp.Equal(err, tt.WantErr, cmpopts.EquateErrors()) {
+				t.Fatalf("%s.Extract(%q) error diff: got %v, want %v", extr.Name(), tt.InputConfig.Path, err, tt.WantErr)
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	i := &extractor.Inventory{
+		Name:     "JSON",
+		Version:  "0.21.1",
+		Metadata: &manifest.Metadata{UUID: "682c06a0-de6a-54ab-a142-c8b1cf79cde6"},
+	}
+	want := &purl.PackageURL{
+		Type:       purl.TypeJulia,
+		Name:       "JSON",
+		Version:    "0.21.1",
+		Qualifiers: purl.QualifiersFromMap(map[string]string{"uuid": "682c06a0-de6a-54ab-a142-c8b1cf79cde6"}),
+	}
+	if diff := cmp.Diff(want, (manifest.Extractor{}).ToPURL(i)); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
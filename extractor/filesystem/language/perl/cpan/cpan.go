@@ -0,0 +1,96 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cpan provides the metadata and PURL conversion shared by the
+// extractors for the requirements of Perl projects.
+package cpan
+
+import (
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/purl"
+)
+
+// Metadata holds additional information about a requirement on a Perl module.
+type Metadata struct {
+	// Phase in which the module is needed: "runtime", "test", "build",
+	// "configure" or "develop".
+	Phase string `json:"phase"`
+	// Relationship is the strength of the requirement: "requires",
+	// "recommends" or "suggests".
+	Relationship string `json:"relationship"`
+	// VersionRequirement is the version range as declared, e.g.
+	// ">= 1.0, < 2.0". Empty if any version is accepted.
+	VersionRequirement string `json:"versionRequirement,omitempty"`
+	// Feature is the optional feature that needs the module, if any.
+	Feature string `json:"feature,omitempty"`
+}
+
+// Annotations returns the annotations of a requirement with the given
+// metadata: modules only needed for tests or development are DevOnly, and
+// recommended or suggested modules and the ones of optional features are
+// OptionalDependency.
+func Annotations(m *Metadata) []extractor.Annotation {
+	var res []extractor.Annotation
+	if m.Phase == "test" || m.Phase == "develop" {
+		res = append(res, extractor.DevOnly)
+	}
+	if m.Relationship != "requires" || m.Feature != "" {
+		res = append(res, extractor.OptionalDependency)
+	}
+	return res
+}
+
+// LowestVersion returns the lowest version a version requirement accepts, e.g.
+// "1.2" for "1.2", ">= 1.2, < 2.0" and "== 1.2". A bare version is the minimum
+// version in CPAN requirements. Returns "" if the requirement accepts any
+// version ("0") or has no lower bound.
+func LowestVersion(requirement string) string {
+	for _, clause := range strings.Split(requirement, ",") {
+		clause = strings.TrimSpace(clause)
+		switch {
+		case strings.HasPrefix(clause, ">="):
+			return normalize(strings.TrimSpace(clause[2:]))
+		case strings.HasPrefix(clause, "=="):
+			return normalize(strings.TrimSpace(clause[2:]))
+		case clause != "" && !strings.ContainsAny(clause[:1], "<>!="):
+			return normalize(clause)
+		}
+	}
+	return ""
+}
+
+func normalize(version string) string {
+	if version == "0" {
+		return ""
+	}
+	return version
+}
+
+// DistributionName returns the name of the distribution that conventionally
+// ships a module, e.g. "Foo-Bar" for the module "Foo::Bar". Modules that are
+// part of a differently named distribution can't be told apart by name.
+func DistributionName(module string) string {
+	return strings.ReplaceAll(module, "::", "-")
+}
+
+// ToPURL returns the CPAN PURL of the distribution of the required module.
+func ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:    purl.TypeCPAN,
+		Name:    DistributionName(i.Name),
+		Version: i.Version,
+	}
+}
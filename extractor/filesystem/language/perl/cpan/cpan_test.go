@@ -0,0 +1,77 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cpan_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/perl/cpan"
+	"github.com/google/osv-scalibr/purl"
+)
+
+func TestLowestVersion(t *testing.T) {
+	tests := []struct {
+		requirement string
+		want        string
+	}{
+		{requirement: "1.23", want: "1.23"},
+		{requirement: "v1.2.3", want: "v1.2.3"},
+		{requirement: "0", want: ""},
+		{requirement: "", want: ""},
+		{requirement: ">= 1.0, < 2.0", want: "1.0"},
+		{requirement: "< 2.0, >= 1.5", want: "1.5"},
+		{requirement: "== 1.5", want: "1.5"},
+		{requirement: "> 1.0", want: ""},
+		{requirement: "!= 1.1", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.requirement, func(t *testing.T) {
+			if got := cpan.LowestVersion(tt.requirement); got != tt.want {
+				t.Errorf("LowestVersion(%q) = %q, want %q", tt.requirement, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnnotations(t *testing.T) {
+	tests := []struct {
+		name string
+		m    *cpan.Metadata
+		want []extractor.Annotation
+	}{
+		{name: "runtime requirement", m: &cpan.Metadata{Phase: "runtime", Relationship: "requires"}},
+		{name: "test requirement", m: &cpan.Metadata{Phase: "test", Relationship: "requires"}, want: []extractor.Annotation{extractor.DevOnly}},
+		{name: "recommendation", m: &cpan.Metadata{Phase: "runtime", Relationship: "recommends"}, want: []extractor.Annotation{extractor.OptionalDependency}},
+		{name: "feature", m: &cpan.Metadata{Phase: "runtime", Relationship: "requires", Feature: "sqlite"}, want: []extractor.Annotation{extractor.OptionalDependency}},
+		{name: "develop suggestion", m: &cpan.Metadata{Phase: "develop", Relationship: "suggests"}, want: []extractor.Annotation{extractor.DevOnly, extractor.OptionalDependency}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, cpan.Annotations(tt.m)); diff != "" {
+				t.Errorf("Annotations(%+v) (-want +got):\n%s", tt.m, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	i := &extractor.Inventory{Name: "Plack::Middleware::ReverseProxy", Version: "0.16"}
+	want := &purl.PackageURL{Type: purl.TypeCPAN, Name: "Plack-Middleware-ReverseProxy", Version: "0.16"}
+	if diff := cmp.Diff(want, cpan.ToPURL(i)); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
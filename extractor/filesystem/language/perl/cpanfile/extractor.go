@@ -0,0 +1,117 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cpanfile extracts the requirements of Perl projects from cpanfile
+// files.
+package cpanfile
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/perl/cpan"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+// Name is the unique name of this extractor.
+const Name = "perl/cpanfile"
+
+// relationships maps the functions declaring requirements to their phase and
+// relationship. An empty phase means the phase of the enclosing "on" block.
+var relationships = map[string]struct{ phase, relationship string }{
+	"requires":           {"", "requires"},
+	"recommends":         {"", "recommends"},
+	"suggests":           {"", "suggests"},
+	"conflicts":          {"", "conflicts"},
+	"test_requires":      {"test", "requires"},
+	"build_requires":     {"build", "requires"},
+	"configure_requires": {"configure", "requires"},
+	"author_requires":    {"develop", "requires"},
+}
+
+// Extractor extracts the requirements declared in cpanfile files.
+type Extractor struct{}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileHints returns the file names the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{BaseNames: []string{"cpanfile"}}
+}
+
+// FileRequired returns true for cpanfile files.
+func (e Extractor) FileRequired(path string, _ fs.FileInfo) bool {
+	return filepath.Base(path) == "cpanfile"
+}
+
+// Extract returns the modules the cpanfile requires, recommends or suggests.
+// cpanfiles are Perl code, so only the common statements of the cpanfile DSL
+// are understood: requirements at the top level, in "on <phase>" blocks and in
+// "feature" blocks. The perl interpreter itself and conflicting modules aren't
+// reported.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	content, err := io.ReadAll(input.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s: %w", input.Path, err)
+	}
+	p := &parser{toks: tokenize(string(content)), input: input}
+	p.parseBlock(scope{phase: "runtime"})
+
+	res := []*extractor.Inventory{}
+	for _, r := range p.reqs {
+		if r.module == "perl" || r.relationship == "conflicts" {
+			continue
+		}
+		m := &cpan.Metadata{
+			Phase:              r.phase,
+			Relationship:       r.relationship,
+			VersionRequirement: r.version,
+			Feature:            r.feature,
+		}
+		res = append(res, &extractor.Inventory{
+			Name:        r.module,
+			Version:     cpan.LowestVersion(r.version),
+			Locations:   []extractor.Location{{Path: input.Path, Line: r.line, Reason: extractor.LocationDeclared}},
+			Metadata:    m,
+			Annotations: cpan.Annotations(m),
+		})
+	}
+	return res, nil
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return cpan.ToPURL(i)
+}
+
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeCPAN} }
+
+// Ecosystem returns no ecosystem since OSV does not support CPAN yet.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
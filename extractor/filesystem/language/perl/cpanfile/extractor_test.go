@@ -0,0 +1,146 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cpanfile_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/perl/cpan"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/perl/cpanfile"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/testing/extracttest"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "cpanfile", want: true},
+		{path: "project/cpanfile", want: true},
+		{path: "project/cpanfile.snapshot", want: false},
+		{path: "project/Makefile.PL", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := (cpanfile.Extractor{}).FileRequired(tt.path, nil); got != tt.want {
+				t.Errorf("FileRequired(%s) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+// requirement returns the inventory of a module required in the given line.
+func requirement(path, name, version string, line int, m *cpan.Metadata) *extractor.Inventory {
+	return &extractor.Inventory{
+		Name:        name,
+		Version:     version,
+		Locations:   []extractor.Location{{Path: path, Line: line, Reason: extractor.LocationDeclared}},
+		Metadata:    m,
+		Annotations: cpan.Annotations(m),
+	}
+}
+
+func TestExtract(t *testing.T) {
+	const phases = "testdata/phases/cpanfile"
+	const pod = "testdata/pod/cpanfile"
+	const noSemicolon = "testdata/nosemicolon/cpanfile"
+	tests := []extracttest.TestTableEntry{
+		{
+			Name:        "phase scoped requirements",
+			InputConfig: extracttest.ScanInputMockConfig{Path: phases},
+			WantInventory: []*extractor.Inventory{
+				requirement(phases, "Plack", "1.0047", 3, &cpan.Metadata{Phase: "runtime", Relationship: "requires", VersionRequirement: "1.0047"}),
+				requirement(phases, "JSON::MaybeXS", "1.004", 4, &cpan.Metadata{Phase: "runtime", Relationship: "requires", VersionRequirement: "1.004"}),
+				requirement(phases, "Try::Tiny", "", 5, &cpan.Metadata{Phase: "runtime", Relationship: "requires"}),
+				requirement(phases, "DBI", "1.6", 6, &cpan.Metadata{Phase: "runtime", Relationship: "requires", VersionRequirement: ">= 1.6, < 2.0"}),
+				requirement(phases, "JSON::XS", "4.0", 7, &cpan.Metadata{Phase: "runtime", Relationship: "recommends", VersionRequirement: "4.0"}),
+				requirement(phases, "URI", "1.76", 8, &cpan.Metadata{Phase: "runtime", Relationship: "requires", VersionRequirement: "1.76"}),
+				requirement(phases, "Test::More", "0.98", 12, &cpan.Metadata{Phase: "test", Relationship: "requires", VersionRequirement: "0.98"}),
+				requirement(phases, "Test::Deep", "", 13, &cpan.Metadata{Phase: "test", Relationship: "requires"}),
+				requirement(phases, "Test::Pod", "1.41", 14, &cpan.Metadata{Phase: "test", Relationship: "suggests", VersionRequirement: "1.41"}),
+				requirement(phases, "Module::Build::Tiny", "0.039", 18, &cpan.Metadata{Phase: "configure", Relationship: "requires", VersionRequirement: "0.039"}),
+				requirement(phases, "Perl::Critic", "1.140", 22, &cpan.Metadata{Phase: "develop", Relationship: "requires", VersionRequirement: "== 1.140"}),
+				requirement(phases, "DBD::SQLite", "1.70", 26, &cpan.Metadata{Phase: "runtime", Relationship: "requires", VersionRequirement: "1.70", Feature: "sqlite"}),
+				requirement(phases, "Test::mysqld", "", 28, &cpan.Metadata{Phase: "test", Relationship: "requires", Feature: "sqlite"}),
+				requirement(phases, "Test::Exception", "0.43", 32, &cpan.Metadata{Phase: "test", Relationship: "requires", VersionRequirement: "0.43"}),
+			},
+		},
+		{
+			Name:        "comments, POD and __END__ are skipped",
+			InputConfig: extracttest.ScanInputMockConfig{Path: pod},
+			WantInventory: []*extractor.Inventory{
+				requirement(pod, "Moo", "2.004", 1, &cpan.Metadata{Phase: "runtime", Relationship: "requires", VersionRequirement: "2.004"}),
+				requirement(pod, "Type::Tiny", "1.012", 9, &cpan.Metadata{Phase: "runtime", Relationship: "requires", VersionRequirement: "1.012"}),
+			},
+		},
+		{
+			Name:        "missing semicolons",
+			InputConfig: extracttest.ScanInputMockConfig{Path: noSemicolon},
+			WantInventory: []*extractor.Inventory{
+				requirement(noSemicolon, "Test2::V0", "0.000139", 2, &cpan.Metadata{Phase: "test", Relationship: "requires", VersionRequirement: "0.000139"}),
+				requirement(noSemicolon, "Mojolicious", "9.0", 5, &cpan.Metadata{Phase: "runtime", Relationship: "requires", VersionRequirement: "9.0"}),
+			},
+		},
+		{
+			Name:          "empty file",
+			InputConfig:   extracttest.ScanInputMockConfig{Path: "testdata/empty/cpanfile"},
+			WantInventory: []*extractor.Inventory{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := cpanfile.Extractor{}
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+			if !cmp.Equal(err, tt.WantErr, cmpopts.EquateErrors()) {
+				t.Fatalf("%s.Extract(%q) error diff: got %v, want %v", extr.Name(), tt.InputConfig.Path, err, tt.WantErr)
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestExtract_WarnsAboutMissingModules(t *testing.T) {
+	scanInput := extracttest.GenerateScanInputMock(t, extracttest.ScanInputMockConfig{Path: "testdata/nosemicolon/cpanfile"})
+	defer extracttest.CloseTestScanInput(t, scanInput)
+
+	if _, err := (cpanfile.Extractor{}).Extract(context.Background(), &scanInput); err != nil {
+		t.Fatalf("Extract(): %v", err)
+	}
+	want := []string{"line 4: requires without a module"}
+	if diff := cmp.Diff(want, scanInput.Warnings()); diff != "" {
+		t.Errorf("Extract() warnings (-want +got):\n%s", diff)
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	i := &extractor.Inventory{Name: "JSON::MaybeXS", Version: "1.004"}
+	want := &purl.PackageURL{Type: purl.TypeCPAN, Name: "JSON-MaybeXS", Version: "1.004"}
+	if diff := cmp.Diff(want, (cpanfile.Extractor{}).ToPURL(i)); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
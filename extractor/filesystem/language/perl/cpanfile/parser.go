@@ -0,0 +1,258 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cpanfile
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/google/osv-scalibr/extractor/filesystem"
+)
+
+type tokenKind int
+
+const (
+	// tokenWord is a bareword or number, e.g. requires, Foo::Bar or 1.23.
+	tokenWord tokenKind = iota
+	// tokenString is the value of a quoted string.
+	tokenString
+	// tokenPunct is one of , => ; ( ) { }.
+	tokenPunct
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	line int
+}
+
+func (t token) is(kind tokenKind, text string) bool {
+	return t.kind == kind && t.text == text
+}
+
+// tokenize splits the cpanfile into the tokens relevant for the DSL. Comments,
+// POD and everything after __END__ are dropped, as are operators and other
+// characters the DSL doesn't use.
+func tokenize(src string) []token {
+	var toks []token
+	line := 1
+	atLineStart := true
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+			atLineStart = true
+			continue
+		case c == '=' && atLineStart && i+1 < len(src) && isWordChar(rune(src[i+1])):
+			// POD runs until the end of a =cut line.
+			cut := strings.Index(src[i:], "\n=cut")
+			if cut < 0 {
+				return toks
+			}
+			end := i + cut + 1
+			if nl := strings.IndexByte(src[end:], '\n'); nl >= 0 {
+				end += nl
+			} else {
+				end = len(src)
+			}
+			line += strings.Count(src[i:end], "\n")
+			i = end
+			continue
+		case c == '#':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+			continue
+		case c == '\'' || c == '"':
+			start := line
+			var sb strings.Builder
+			i++
+			for i < len(src) && src[i] != c {
+				if src[i] == '\\' && i+1 < len(src) {
+					i++
+				}
+				if src[i] == '\n' {
+					line++
+				}
+				sb.WriteByte(src[i])
+				i++
+			}
+			i++
+			toks = append(toks, token{kind: tokenString, text: sb.String(), line: start})
+		case c == '=' && i+1 < len(src) && src[i+1] == '>':
+			toks = append(toks, token{kind: tokenPunct, text: "=>", line: line})
+			i += 2
+		case strings.IndexByte(",;(){}", c) >= 0:
+			toks = append(toks, token{kind: tokenPunct, text: string(c), line: line})
+			i++
+		case isWordChar(rune(c)):
+			start := i
+			for i < len(src) && isWordChar(rune(src[i])) {
+				i++
+			}
+			word := src[start:i]
+			if word == "__END__" || word == "__DATA__" {
+				return toks
+			}
+			toks = append(toks, token{kind: tokenWord, text: word, line: line})
+		default:
+			i++
+		}
+		atLineStart = false
+	}
+	return toks
+}
+
+func isWordChar(r rune) bool {
+	return r == '_' || r == ':' || r == '.' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// scope is the context of the statements in a block.
+type scope struct {
+	phase   string
+	feature string
+}
+
+// requirement is a module required by a statement.
+type requirement struct {
+	module       string
+	version      string
+	phase        string
+	relationship string
+	feature      string
+	line         int
+}
+
+type parser struct {
+	toks  []token
+	pos   int
+	reqs  []requirement
+	input *filesystem.ScanInput
+}
+
+func (p *parser) done() bool { return p.pos >= len(p.toks) }
+
+func (p *parser) peek() token { return p.toks[p.pos] }
+
+// parseBlock parses statements until the end of the enclosing block or file.
+func (p *parser) parseBlock(s scope) {
+	for !p.done() {
+		t := p.peek()
+		switch {
+		case t.is(tokenPunct, "}"):
+			p.pos++
+			return
+		case t.is(tokenPunct, "{"):
+			p.pos++
+			p.parseBlock(s)
+		case t.is(tokenWord, "on"):
+			p.pos++
+			if args, ok := p.parseSubHeader(); ok && len(args) > 0 {
+				inner := s
+				inner.phase = args[0].text
+				p.parseBlock(inner)
+			}
+		case t.is(tokenWord, "feature"):
+			p.pos++
+			if args, ok := p.parseSubHeader(); ok && len(args) > 0 {
+				inner := s
+				inner.feature = args[0].text
+				p.parseBlock(inner)
+			}
+		case t.kind == tokenWord:
+			p.pos++
+			if rel, ok := relationships[t.text]; ok {
+				p.parseRequirement(s, t, rel.phase, rel.relationship)
+			}
+		default:
+			p.pos++
+		}
+	}
+}
+
+// parseSubHeader parses the arguments of a block function up to and including
+// the opening brace of its "sub { ... }" argument, e.g. 'test' => sub { for
+// on 'test' => sub { ... }. Returns false if the statement has no such block.
+func (p *parser) parseSubHeader() ([]token, bool) {
+	var args []token
+	for !p.done() {
+		t := p.peek()
+		switch {
+		case t.is(tokenWord, "sub"):
+			p.pos++
+			if !p.done() && p.peek().is(tokenPunct, "{") {
+				p.pos++
+				return args, true
+			}
+			return nil, false
+		case t.is(tokenPunct, ";"), t.is(tokenPunct, "{"), t.is(tokenPunct, "}"):
+			return nil, false
+		case t.kind == tokenWord || t.kind == tokenString:
+			args = append(args, t)
+		}
+		p.pos++
+	}
+	return nil, false
+}
+
+// parseRequirement parses the arguments of a requirement function, the module
+// and an optional version requirement, followed by options such as
+// dist => '...'.
+func (p *parser) parseRequirement(s scope, fn token, phase, relationship string) {
+	type arg struct {
+		token
+		fatComma bool
+	}
+	var args []arg
+	for !p.done() {
+		t := p.peek()
+		if t.is(tokenPunct, ";") {
+			p.pos++
+			break
+		}
+		if t.is(tokenPunct, "}") || t.is(tokenPunct, "{") {
+			// The statement lacks its semicolon, leave the brace to the block.
+			break
+		}
+		switch {
+		case t.kind == tokenWord || t.kind == tokenString:
+			args = append(args, arg{token: t})
+		case t.is(tokenPunct, "=>") && len(args) > 0:
+			args[len(args)-1].fatComma = true
+		}
+		p.pos++
+	}
+
+	if len(args) == 0 {
+		p.input.Warnf("line %d: %s without a module", fn.line, fn.text)
+		return
+	}
+	if phase == "" {
+		phase = s.phase
+	}
+	r := requirement{
+		module:       args[0].text,
+		phase:        phase,
+		relationship: relationship,
+		feature:      s.feature,
+		line:         args[0].line,
+	}
+	if len(args) > 1 && !args[1].fatComma {
+		r.version = args[1].text
+	}
+	p.reqs = append(p.reqs, r)
+}
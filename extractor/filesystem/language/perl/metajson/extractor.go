@@ -0,0 +1,146 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metajson extracts the requirements of Perl distributions from their
+// META.json and MYMETA.json files.
+package metajson
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"slices"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/perl/cpan"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+// Name is the unique name of this extractor.
+const Name = "perl/metajson"
+
+var (
+	// phases are the phases of the CPAN::Meta::Spec in the order they're
+	// reported in. Custom phases are skipped.
+	phases = []string{"runtime", "test", "build", "configure", "develop"}
+	// relationships are the reported relationships. Conflicts aren't
+	// requirements and thus skipped.
+	relationships = []string{"requires", "recommends", "suggests"}
+)
+
+// metaJSON is the part of a version 2 META.json file the extractor uses,
+// mapping phases and relationships to module names and version requirements.
+type metaJSON struct {
+	Prereqs map[string]map[string]map[string]requirement `json:"prereqs"`
+}
+
+// requirement is a version requirement. The spec mandates strings but some
+// tools write plain versions as numbers, e.g. 0.98.
+type requirement string
+
+func (r *requirement) UnmarshalJSON(b []byte) error {
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err == nil {
+		*r = requirement(n)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*r = requirement(s)
+	return nil
+}
+
+// Extractor extracts the requirements from META.json and MYMETA.json files.
+type Extractor struct{}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileHints returns the file names the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{BaseNames: []string{"META.json", "MYMETA.json"}}
+}
+
+// FileRequired returns true for META.json and MYMETA.json files.
+func (e Extractor) FileRequired(path string, _ fs.FileInfo) bool {
+	base := filepath.Base(path)
+	return base == "META.json" || base == "MYMETA.json"
+}
+
+// Extract returns the modules the distribution requires, recommends or
+// suggests, ordered by phase, relationship and module name. The perl
+// interpreter itself isn't reported.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	var meta metaJSON
+	if err := json.NewDecoder(input.Reader).Decode(&meta); err != nil {
+		return nil, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+
+	res := []*extractor.Inventory{}
+	for _, phase := range phases {
+		for _, relationship := range relationships {
+			modules := meta.Prereqs[phase][relationship]
+			names := make([]string, 0, len(modules))
+			for name := range modules {
+				if name != "perl" {
+					names = append(names, name)
+				}
+			}
+			slices.Sort(names)
+			for _, name := range names {
+				m := &cpan.Metadata{
+					Phase:              phase,
+					Relationship:       relationship,
+					VersionRequirement: string(modules[name]),
+				}
+				if m.VersionRequirement == "0" {
+					m.VersionRequirement = ""
+				}
+				res = append(res, &extractor.Inventory{
+					Name:        name,
+					Version:     cpan.LowestVersion(m.VersionRequirement),
+					Locations:   []extractor.Location{{Path: input.Path, Reason: extractor.LocationDeclared}},
+					Metadata:    m,
+					Annotations: cpan.Annotations(m),
+				})
+			}
+		}
+	}
+	return res, nil
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return cpan.ToPURL(i)
+}
+
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeCPAN} }
+
+// Ecosystem returns no ecosystem since OSV does not support CPAN yet.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,103 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metajson_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/perl/cpan"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/perl/metajson"
+	"github.com/google/osv-scalibr/testing/extracttest"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "META.json", want: true},
+		{path: "Example-App-1.2.0/MYMETA.json", want: true},
+		{path: "Example-App-1.2.0/META.yml", want: false},
+		{path: "Example-App-1.2.0/package.json", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := (metajson.Extractor{}).FileRequired(tt.path, nil); got != tt.want {
+				t.Errorf("FileRequired(%s) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+// requirement returns the inventory of a module required by the given file.
+func requirement(path, name, version string, m *cpan.Metadata) *extractor.Inventory {
+	return &extractor.Inventory{
+		Name:        name,
+		Version:     version,
+		Locations:   []extractor.Location{{Path: path, Reason: extractor.LocationDeclared}},
+		Metadata:    m,
+		Annotations: cpan.Annotations(m),
+	}
+}
+
+func TestExtract(t *testing.T) {
+	const dist = "testdata/dist/META.json"
+	tests := []extracttest.TestTableEntry{
+		{
+			Name:        "phase scoped requirements",
+			InputConfig: extracttest.ScanInputMockConfig{Path: dist},
+			WantInventory: []*extractor.Inventory{
+				requirement(dist, "DBI", "1.6", &cpan.Metadata{Phase: "runtime", Relationship: "requires", VersionRequirement: ">= 1.6, < 2.0"}),
+				requirement(dist, "Plack", "1.0047", &cpan.Metadata{Phase: "runtime", Relationship: "requires", VersionRequirement: "1.0047"}),
+				requirement(dist, "JSON::XS", "4.0", &cpan.Metadata{Phase: "runtime", Relationship: "recommends", VersionRequirement: "4.0"}),
+				requirement(dist, "Test::More", "0.98", &cpan.Metadata{Phase: "test", Relationship: "requires", VersionRequirement: "0.98"}),
+				requirement(dist, "ExtUtils::MakeMaker", "", &cpan.Metadata{Phase: "build", Relationship: "requires"}),
+				requirement(dist, "ExtUtils::MakeMaker", "6.64", &cpan.Metadata{Phase: "configure", Relationship: "requires", VersionRequirement: "6.64"}),
+			},
+		},
+		{
+			Name:          "no prereqs",
+			InputConfig:   extracttest.ScanInputMockConfig{Path: "testdata/mymeta/MYMETA.json"},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name:        "invalid json",
+			InputConfig: extracttest.ScanInputMockConfig{Path: "testdata/invalid/META.json"},
+			WantErr:     extracttest.ContainsErrStr{Str: "could not extract from"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := metajson.Extractor{}
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+			if !cmp.Equal(err, tt.WantErr, cmpopts.EquateErrors()) {
+				t.Fatalf("%s.Extract(%q) error diff: got %v, want %v", extr.Name(), tt.InputConfig.Path, err, tt.WantErr)
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
@@ -56,6 +56,11 @@ func (e Extractor) Requirements() *plugin.Capabilities {
 	return &plugin.Capabilities{}
 }
 
+// FileHints returns the file names the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{BaseNames: []string{"composer.lock"}}
+}
+
 // FileRequired returns true if the specified file matches composer.lock files.
 func (e Extractor) FileRequired(path string, fileInfo fs.FileInfo) bool {
 	return filepath.Base(path) == "composer.lock"
@@ -81,8 +86,9 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 	for _, composerPackage := range parsedLockfile.Packages {
 		packages = append(packages, &extractor.Inventory{
 			Name:      composerPackage.Name,
+			Namespace: vendor(composerPackage.Name),
 			Version:   composerPackage.Version,
-			Locations: []string{input.Path},
+			Locations: extractor.DeclaredLocations(input.Path),
 			SourceCode: &extractor.SourceCodeIdentifier{
 				Commit: composerPackage.Dist.Reference,
 			},
@@ -95,8 +101,9 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 	for _, composerPackage := range parsedLockfile.PackagesDev {
 		packages = append(packages, &extractor.Inventory{
 			Name:      composerPackage.Name,
+			Namespace: vendor(composerPackage.Name),
 			Version:   composerPackage.Version,
-			Locations: []string{input.Path},
+			Locations: extractor.DeclaredLocations(input.Path),
 			SourceCode: &extractor.SourceCodeIdentifier{
 				Commit: composerPackage.Dist.Reference,
 			},
@@ -111,11 +118,16 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 
 // ToPURL converts an inventory created by this extractor into a PURL.
 func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
-	return &purl.PackageURL{
-		Type:    purl.TypeComposer,
-		Name:    i.Name,
-		Version: i.Version,
-	}
+	return extractor.PURLFromInventory(purl.TypeComposer, i)
+}
+
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeComposer} }
+
+// vendor returns the vendor of a "vendor/name" package name.
+func vendor(name string) string {
+	v, _ := extractor.SplitNamespace(name, "/")
+	return v
 }
 
 // Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
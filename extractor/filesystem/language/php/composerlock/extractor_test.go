@@ -105,8 +105,9 @@ func TestExtractor_Extract(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "sentry/sdk",
+					Namespace: "sentry",
 					Version:   "2.0.4",
-					Locations: []string{"testdata/one-package.json"},
+					Locations: extractor.DeclaredLocations("testdata/one-package.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "4c115873c86ad5bd0ac6d962db70ca53bf8fb874",
 					},
@@ -124,8 +125,9 @@ func TestExtractor_Extract(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "sentry/sdk",
+					Namespace: "sentry",
 					Version:   "2.0.4",
-					Locations: []string{"testdata/one-package-dev.json"},
+					Locations: extractor.DeclaredLocations("testdata/one-package-dev.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "4c115873c86ad5bd0ac6d962db70ca53bf8fb874",
 					},
@@ -143,8 +145,9 @@ func TestExtractor_Extract(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "sentry/sdk",
+					Namespace: "sentry",
 					Version:   "2.0.4",
-					Locations: []string{"testdata/two-packages.json"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "4c115873c86ad5bd0ac6d962db70ca53bf8fb874",
 					},
@@ -154,8 +157,9 @@ func TestExtractor_Extract(t *testing.T) {
 				},
 				{
 					Name:      "theseer/tokenizer",
+					Namespace: "theseer",
 					Version:   "1.1.3",
-					Locations: []string{"testdata/two-packages.json"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "11336f6f84e16a720dae9d8e6ed5019efa85a0f9",
 					},
@@ -173,8 +177,9 @@ func TestExtractor_Extract(t *testing.T) {
 			WantInventory: []*extractor.Inventory{
 				{
 					Name:      "sentry/sdk",
+					Namespace: "sentry",
 					Version:   "2.0.4",
-					Locations: []string{"testdata/two-packages-alt.json"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages-alt.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "4c115873c86ad5bd0ac6d962db70ca53bf8fb874",
 					},
@@ -184,8 +189,9 @@ func TestExtractor_Extract(t *testing.T) {
 				},
 				{
 					Name:      "theseer/tokenizer",
+					Namespace: "theseer",
 					Version:   "1.1.3",
-					Locations: []string{"testdata/two-packages-alt.json"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages-alt.json"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "11336f6f84e16a720dae9d8e6ed5019efa85a0f9",
 					},
@@ -0,0 +1,156 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package condameta extracts the packages installed in conda environments
+// from their conda-meta/*.json records.
+package condameta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+// Name is the unique name of this extractor.
+const Name = "python/condameta"
+
+// Metadata holds additional information about an installed conda package.
+type Metadata struct {
+	// Build is the build string of the package, e.g. "pyhd8ed1ab_0".
+	Build string `json:"build,omitempty"`
+	// Channel is the channel the package was installed from, as recorded by
+	// conda, e.g. "https://conda.anaconda.org/conda-forge/noarch".
+	Channel string `json:"channel,omitempty"`
+	// Subdir is the platform subdirectory of the channel, e.g. "linux-64".
+	Subdir string `json:"subdir,omitempty"`
+	// Depends are the package's dependency specs, e.g. "python >=3.8".
+	Depends []string `json:"depends,omitempty"`
+}
+
+// record is the part of a conda-meta/*.json file the extractor uses.
+type record struct {
+	Name    string   `json:"name"`
+	Version string   `json:"version"`
+	Build   string   `json:"build"`
+	Channel string   `json:"channel"`
+	Subdir  string   `json:"subdir"`
+	Depends []string `json:"depends"`
+}
+
+// Extractor extracts the packages installed in conda environments.
+type Extractor struct{}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileHints returns the file extensions the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{Extensions: []string{".json"}}
+}
+
+// FileRequired returns true for the JSON records in conda-meta directories.
+func (e Extractor) FileRequired(p string, _ fs.FileInfo) bool {
+	p = filepath.ToSlash(p)
+	return strings.HasSuffix(p, ".json") && path.Base(path.Dir(p)) == "conda-meta"
+}
+
+// Extract returns the package described by the conda-meta record.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	var r record
+	if err := json.NewDecoder(input.Reader).Decode(&r); err != nil {
+		return nil, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+	if r.Name == "" || r.Version == "" {
+		return []*extractor.Inventory{}, nil
+	}
+	return []*extractor.Inventory{{
+		Name:    r.Name,
+		Version: r.Version,
+		Locations: []extractor.Location{
+			{Path: input.Path, Reason: extractor.LocationInstalled},
+		},
+		Metadata: &Metadata{
+			Build:   r.Build,
+			Channel: r.Channel,
+			Subdir:  r.Subdir,
+			Depends: r.Depends,
+		},
+	}}, nil
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	m := i.Metadata.(*Metadata)
+	q := map[string]string{}
+	if m.Build != "" {
+		q["build"] = m.Build
+	}
+	if c := channelName(m.Channel, m.Subdir); c != "" {
+		q["channel"] = c
+	}
+	if m.Subdir != "" {
+		q["subdir"] = m.Subdir
+	}
+	return &purl.PackageURL{
+		Type:       purl.TypeConda,
+		Name:       strings.ToLower(i.Name),
+		Version:    i.Version,
+		Qualifiers: purl.QualifiersFromMap(q),
+	}
+}
+
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeConda} }
+
+// channelName returns the short name of a channel conda recorded as a URL,
+// e.g. "conda-forge" for "https://conda.anaconda.org/conda-forge/noarch" and
+// "main" for "https://repo.anaconda.com/pkgs/main/linux-64". Channels that are
+// already names and URLs of other hosts are returned without the subdir.
+func channelName(channel, subdir string) string {
+	u, err := url.Parse(channel)
+	if err != nil || u.Host == "" {
+		return channel
+	}
+	p := strings.Trim(u.Path, "/")
+	if subdir != "" {
+		p = strings.TrimSuffix(strings.TrimSuffix(p, subdir), "/")
+	}
+	switch u.Host {
+	case "conda.anaconda.org", "repo.anaconda.com":
+		return path.Base(p)
+	}
+	u.Path = "/" + p
+	return u.String()
+}
+
+// Ecosystem returns no ecosystem since OSV does not support conda.
+func (e Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,181 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package condameta_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/python/condameta"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/testing/extracttest"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "opt/conda/conda-meta/requests-2.32.3-pyhd8ed1ab_0.json", want: true},
+		{path: "opt/conda/envs/app/conda-meta/openssl-3.0.14-h5eee18b_0.json", want: true},
+		{path: "opt/conda/conda-meta/history", want: false},
+		{path: "opt/conda/pkgs/requests-2.32.3-pyhd8ed1ab_0/info/index.json", want: false},
+		{path: "conda-meta.json", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := (condameta.Extractor{}).FileRequired(tt.path, nil); got != tt.want {
+				t.Errorf("FileRequired(%s) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name:        "conda-forge package",
+			InputConfig: extracttest.ScanInputMockConfig{Path: "testdata/env/conda-meta/requests-2.32.3-pyhd8ed1ab_0.json"},
+			WantInventory: []*extractor.Inventory{{
+				Name:    "requests",
+				Version: "2.32.3",
+				Locations: []extractor.Location{{
+					Path:   "testdata/env/conda-meta/requests-2.32.3-pyhd8ed1ab_0.json",
+					Reason: extractor.LocationInstalled,
+				}},
+				Metadata: &condameta.Metadata{
+					Build:   "pyhd8ed1ab_0",
+					Channel: "https://conda.anaconda.org/conda-forge/noarch",
+					Subdir:  "noarch",
+					Depends: []string{
+						"certifi >=2017.4.17",
+						"charset-normalizer >=2,<4",
+						"idna >=2.5,<4",
+						"python >=3.8",
+						"urllib3 >=1.21.1,<3",
+					},
+				},
+			}},
+		},
+		{
+			Name:        "defaults package",
+			InputConfig: extracttest.ScanInputMockConfig{Path: "testdata/env/conda-meta/openssl-3.0.14-h5eee18b_0.json"},
+			WantInventory: []*extractor.Inventory{{
+				Name:    "openssl",
+				Version: "3.0.14",
+				Locations: []extractor.Location{{
+					Path:   "testdata/env/conda-meta/openssl-3.0.14-h5eee18b_0.json",
+					Reason: extractor.LocationInstalled,
+				}},
+				Metadata: &condameta.Metadata{
+					Build:   "h5eee18b_0",
+					Channel: "https://repo.anaconda.com/pkgs/main/linux-64",
+					Subdir:  "linux-64",
+					Depends: []string{"ca-certificates", "libgcc-ng >=11.2.0"},
+				},
+			}},
+		},
+		{
+			Name:        "invalid json",
+			InputConfig: extracttest.ScanInputMockConfig{Path: "testdata/invalid/conda-meta/broken-1.0-0.json"},
+			WantErr:     extracttest.ContainsErrStr{Str: "could not extract from"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := condameta.Extractor{}
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+			if !cmp.Equal(err, tt.WantErr, cmpopts.EquateErrors()) {
+				t.Fatalf("%s.Extract(%q) error diff: got %v, want %v", extr.Name(), tt.InputConfig.Path, err, tt.WantErr)
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata *condameta.Metadata
+		want     *purl.PackageURL
+	}{
+		{
+			name:     "conda-forge channel",
+			metadata: &condameta.Metadata{Build: "pyhd8ed1ab_0", Channel: "https://conda.anaconda.org/conda-forge/noarch", Subdir: "noarch"},
+			want: &purl.PackageURL{
+				Type:    purl.TypeConda,
+				Name:    "requests",
+				Version: "2.32.3",
+				Qualifiers: purl.QualifiersFromMap(map[string]string{
+					"build":   "pyhd8ed1ab_0",
+					"channel": "conda-forge",
+					"subdir":  "noarch",
+				}),
+			},
+		},
+		{
+			name:     "defaults channel",
+			metadata: &condameta.Metadata{Channel: "https://repo.anaconda.com/pkgs/main/linux-64", Subdir: "linux-64"},
+			want: &purl.PackageURL{
+				Type:       purl.TypeConda,
+				Name:       "requests",
+				Version:    "2.32.3",
+				Qualifiers: purl.QualifiersFromMap(map[string]string{"channel": "main", "subdir": "linux-64"}),
+			},
+		},
+		{
+			name:     "custom channel host",
+			metadata: &condameta.Metadata{Channel: "https://conda.example.com/internal/linux-64", Subdir: "linux-64"},
+			want: &purl.PackageURL{
+				Type:    purl.TypeConda,
+				Name:    "requests",
+				Version: "2.32.3",
+				Qualifiers: purl.QualifiersFromMap(map[string]string{
+					"channel": "https://conda.example.com/internal",
+					"subdir":  "linux-64",
+				}),
+			},
+		},
+		{
+			name:     "channel name",
+			metadata: &condameta.Metadata{Channel: "bioconda"},
+			want: &purl.PackageURL{
+				Type:       purl.TypeConda,
+				Name:       "requests",
+				Version:    "2.32.3",
+				Qualifiers: purl.QualifiersFromMap(map[string]string{"channel": "bioconda"}),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &extractor.Inventory{Name: "Requests", Version: "2.32.3", Metadata: tt.metadata}
+			if diff := cmp.Diff(tt.want, (condameta.Extractor{}).ToPURL(i)); diff != "" {
+				t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+			}
+		})
+	}
+}
@@ -56,6 +56,11 @@ func (e Extractor) Requirements() *plugin.Capabilities {
 	return &plugin.Capabilities{}
 }
 
+// FileHints returns the file names the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{BaseNames: []string{"pdm.lock"}}
+}
+
 // FileRequired returns true if the specified file matches PDM lockfile patterns.
 func (e Extractor) FileRequired(path string, fileInfo fs.FileInfo) bool {
 	return filepath.Base(path) == "pdm.lock"
@@ -75,7 +80,7 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 		inventory := &extractor.Inventory{
 			Name:      pkg.Name,
 			Version:   pkg.Version,
-			Locations: []string{input.Path},
+			Locations: extractor.DeclaredLocations(input.Path),
 		}
 
 		depGroups := parseGroupsToDepGroups(pkg.Groups)
@@ -125,6 +130,9 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	return pypipurl.MakePackageURL(i)
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypePyPi} }
+
 // Ecosystem returns the OSV ecosystem ('PyPI') of the software extracted by this extractor.
 func (e Extractor) Ecosystem(i *extractor.Inventory) string {
 	return "PyPI"
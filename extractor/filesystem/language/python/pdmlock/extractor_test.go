@@ -112,7 +112,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "toml",
 					Version:   "0.10.2",
-					Locations: []string{"testdata/single-package.toml"},
+					Locations: extractor.DeclaredLocations("testdata/single-package.toml"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -128,7 +128,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "toml",
 					Version:   "0.10.2",
-					Locations: []string{"testdata/two-packages.toml"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.toml"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -136,7 +136,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "six",
 					Version:   "1.16.0",
-					Locations: []string{"testdata/two-packages.toml"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.toml"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -152,7 +152,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "toml",
 					Version:   "0.10.2",
-					Locations: []string{"testdata/dev-dependency.toml"},
+					Locations: extractor.DeclaredLocations("testdata/dev-dependency.toml"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -160,7 +160,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "pyroute2",
 					Version:   "0.7.11",
-					Locations: []string{"testdata/dev-dependency.toml"},
+					Locations: extractor.DeclaredLocations("testdata/dev-dependency.toml"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{"dev"},
 					},
@@ -168,7 +168,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "win-inet-pton",
 					Version:   "1.1.0",
-					Locations: []string{"testdata/dev-dependency.toml"},
+					Locations: extractor.DeclaredLocations("testdata/dev-dependency.toml"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{"dev"},
 					},
@@ -184,7 +184,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "toml",
 					Version:   "0.10.2",
-					Locations: []string{"testdata/optional-dependency.toml"},
+					Locations: extractor.DeclaredLocations("testdata/optional-dependency.toml"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -192,7 +192,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "pyroute2",
 					Version:   "0.7.11",
-					Locations: []string{"testdata/optional-dependency.toml"},
+					Locations: extractor.DeclaredLocations("testdata/optional-dependency.toml"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{"optional"},
 					},
@@ -200,7 +200,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "win-inet-pton",
 					Version:   "1.1.0",
-					Locations: []string{"testdata/optional-dependency.toml"},
+					Locations: extractor.DeclaredLocations("testdata/optional-dependency.toml"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{"optional"},
 					},
@@ -216,7 +216,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "toml",
 					Version:   "0.10.2",
-					Locations: []string{"testdata/git-dependency.toml"},
+					Locations: extractor.DeclaredLocations("testdata/git-dependency.toml"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -106,7 +106,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "markupsafe",
 					Version:   "2.1.1",
-					Locations: []string{"testdata/one-package.json"},
+					Locations: extractor.DeclaredLocations("testdata/one-package.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -122,7 +122,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "markupsafe",
 					Version:   "2.1.1",
-					Locations: []string{"testdata/one-package-dev.json"},
+					Locations: extractor.DeclaredLocations("testdata/one-package-dev.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{"dev"},
 					},
@@ -138,7 +138,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "itsdangerous",
 					Version:   "2.1.2",
-					Locations: []string{"testdata/two-packages.json"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -146,7 +146,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "markupsafe",
 					Version:   "2.1.1",
-					Locations: []string{"testdata/two-packages.json"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{"dev"},
 					},
@@ -162,7 +162,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "itsdangerous",
 					Version:   "2.1.2",
-					Locations: []string{"testdata/two-packages-alt.json"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages-alt.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -170,7 +170,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "markupsafe",
 					Version:   "2.1.1",
-					Locations: []string{"testdata/two-packages-alt.json"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages-alt.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -186,7 +186,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "itsdangerous",
 					Version:   "2.1.2",
-					Locations: []string{"testdata/multiple-packages.json"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-packages.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -194,7 +194,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "pluggy",
 					Version:   "1.0.1",
-					Locations: []string{"testdata/multiple-packages.json"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-packages.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
@@ -202,7 +202,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "pluggy",
 					Version:   "1.0.0",
-					Locations: []string{"testdata/multiple-packages.json"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-packages.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{"dev"},
 					},
@@ -210,7 +210,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "markupsafe",
 					Version:   "2.1.1",
-					Locations: []string{"testdata/multiple-packages.json"},
+					Locations: extractor.DeclaredLocations("testdata/multiple-packages.json"),
 					Metadata: osv.DepGroupMetadata{
 						DepGroupVals: []string{},
 					},
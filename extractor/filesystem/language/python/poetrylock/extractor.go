@@ -61,6 +61,11 @@ func (e Extractor) Requirements() *plugin.Capabilities {
 	return &plugin.Capabilities{}
 }
 
+// FileHints returns the file names the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{BaseNames: []string{"poetry.lock"}}
+}
+
 // FileRequired returns true if the specified file matches poetry lockfile patterns
 func (e Extractor) FileRequired(path string, fileInfo fs.FileInfo) bool {
 	return filepath.Base(path) == "poetry.lock"
@@ -82,7 +87,7 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 		pkgDetails := &extractor.Inventory{
 			Name:      lockPackage.Name,
 			Version:   lockPackage.Version,
-			Locations: []string{input.Path},
+			Locations: extractor.DeclaredLocations(input.Path),
 			SourceCode: &extractor.SourceCodeIdentifier{
 				Commit: lockPackage.Source.Commit,
 			},
@@ -107,6 +112,9 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	return pypipurl.MakePackageURL(i)
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypePyPi} }
+
 // Ecosystem returns the OSV ecosystem ('PyPI') of the software extracted by this extractor.
 func (e Extractor) Ecosystem(i *extractor.Inventory) string {
 	return "PyPI"
@@ -105,7 +105,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "numpy",
 					Version:   "1.23.3",
-					Locations: []string{"testdata/one-package.lock"},
+					Locations: extractor.DeclaredLocations("testdata/one-package.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -124,7 +124,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "proto-plus",
 					Version:   "1.22.0",
-					Locations: []string{"testdata/two-packages.lock"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -135,7 +135,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "protobuf",
 					Version:   "4.21.5",
-					Locations: []string{"testdata/two-packages.lock"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -154,7 +154,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "emoji",
 					Version:   "2.0.0",
-					Locations: []string{"testdata/one-package-with-metadata.lock"},
+					Locations: extractor.DeclaredLocations("testdata/one-package-with-metadata.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -173,7 +173,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "ike",
 					Version:   "0.2.0",
-					Locations: []string{"testdata/source-git.lock"},
+					Locations: extractor.DeclaredLocations("testdata/source-git.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "cd66602cd29f61a2d2e7fb995fef1e61708c034d",
 					},
@@ -192,7 +192,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "appdirs",
 					Version:   "1.4.4",
-					Locations: []string{"testdata/source-legacy.lock"},
+					Locations: extractor.DeclaredLocations("testdata/source-legacy.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -211,7 +211,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "numpy",
 					Version:   "1.23.3",
-					Locations: []string{"testdata/optional-package.lock"},
+					Locations: extractor.DeclaredLocations("testdata/optional-package.lock"),
 					SourceCode: &extractor.SourceCodeIdentifier{
 						Commit: "",
 					},
@@ -96,10 +96,15 @@ func (e Extractor) Version() int { return 0 }
 // Requirements of the extractor.
 func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
 
+// FileHints returns the file extensions the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{Extensions: []string{".txt"}}
+}
+
 // FileRequired returns true if the specified file matches python Metadata file
 // patterns.
 func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
-	if filepath.Ext(path) != ".txt" || !strings.Contains(filepath.Base(path), "requirements") {
+	if !e.fileMatches(path, fileinfo) {
 		return false
 	}
 
@@ -112,11 +117,24 @@ func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
 	return true
 }
 
+// FileSizeLimitExceeded returns true if the file would be required if it
+// didn't exceed the maximum file size.
+func (e Extractor) FileSizeLimitExceeded(path string, fileinfo fs.FileInfo) bool {
+	return e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes && e.fileMatches(path, fileinfo)
+}
+
+// fileMatches returns true if the file is one the extractor is interested in,
+// regardless of its size.
+func (e Extractor) fileMatches(path string, fileinfo fs.FileInfo) bool {
+	return filepath.Ext(path) == ".txt" && strings.Contains(filepath.Base(path), "requirements")
+}
+
 func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
 	if e.stats == nil {
 		return
 	}
 	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Extractor:     e.Name(),
 		Path:          path,
 		Result:        result,
 		FileSizeBytes: fileSizeBytes,
@@ -125,10 +143,19 @@ func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result s
 
 type pathQueue []string
 
+// include is a requirements file referenced by another one with `-r`.
+type include struct {
+	path string
+	// Depth of the include, starting with 1 for the files referenced by the
+	// extracted file.
+	depth int
+}
+
 // Extract extracts packages from requirements files passed through the scan input.
+// If the referenced files exceed the include limits of the scan input, the
+// packages found so far are returned along with a
+// filesystem.IncludeLimitError.
 func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
-	// Additional paths to recursive files found during extraction.
-	var extraPaths pathQueue
 	var inv []*extractor.Inventory
 	newRepos, newPaths, err := extractFromPath(input.Reader, input.Path, input.FS)
 	if err != nil {
@@ -137,44 +164,57 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 	if e.stats != nil {
 		e.exportStats(input, err)
 	}
-	extraPaths = append(extraPaths, newPaths...)
 	inv = append(inv, newRepos...)
 
 	// Process all the recursive files that we found.
-	extraInv := extractFromExtraPaths(input.Path, extraPaths, input.FS)
+	extraInv, err := extractFromExtraPaths(input, newPaths)
 	inv = append(inv, extraInv...)
 
-	return inv, nil
+	return inv, err
 }
 
-func extractFromExtraPaths(initPath string, extraPaths pathQueue, fs scalibrfs.FS) []*extractor.Inventory {
-	// File paths with inventories already found in this extraction.
-	// We store these to remove duplicates in diamond dependency cases and prevent
-	// infinite loops in misconfigured lockfiles with cyclical deps.
-	var found = map[string]bool{initPath: true}
+func extractFromExtraPaths(input *filesystem.ScanInput, extraPaths pathQueue) ([]*extractor.Inventory, error) {
+	// Files that were already followed in this extraction are skipped to remove
+	// duplicates in diamond dependency cases and prevent infinite loops in
+	// misconfigured lockfiles with cyclical deps.
+	includes := filesystem.NewIncludes(input.Path, input.IncludeLimits)
+	var queue []include
+	for _, p := range extraPaths {
+		queue = append(queue, include{path: p, depth: 1})
+	}
 	var inv []*extractor.Inventory
+	var limitErr error
 
-	for len(extraPaths) > 0 {
-		path := extraPaths[0]
-		extraPaths = extraPaths[1:]
-		if _, exists := found[path]; exists {
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+		follow, err := includes.Follow(next.path, next.depth)
+		if err != nil {
+			// Keep going since other includes might still be within the limits.
+			if limitErr == nil {
+				limitErr = err
+			}
+			continue
+		}
+		if !follow {
 			continue
 		}
-		newInv, newPaths, err := openAndExtractFromFile(path, fs)
+		newInv, newPaths, err := openAndExtractFromFile(next.path, input.FS)
 		if err != nil {
-			log.Warnf("openAndExtractFromFile(%s): %w", path, err)
+			log.Warnf("openAndExtractFromFile(%s): %w", next.path, err)
 			continue
 		}
-		found[path] = true
-		extraPaths = append(extraPaths, newPaths...)
+		for _, p := range newPaths {
+			queue = append(queue, include{path: p, depth: next.depth + 1})
+		}
 		for _, i := range newInv {
 			// Note the path through which we refer to this requirements.txt file.
-			i.Locations[0] = initPath + ":" + filepath.ToSlash(i.Locations[0])
+			i.Locations[0].Path = input.Path + ":" + filepath.ToSlash(i.Locations[0].Path)
 		}
 		inv = append(inv, newInv...)
 	}
 
-	return inv
+	return inv, limitErr
 }
 
 func openAndExtractFromFile(path string, fs scalibrfs.FS) ([]*extractor.Inventory, pathQueue, error) {
@@ -189,9 +229,19 @@ func openAndExtractFromFile(path string, fs scalibrfs.FS) ([]*extractor.Inventor
 func extractFromPath(reader io.Reader, path string, fs scalibrfs.FS) ([]*extractor.Inventory, pathQueue, error) {
 	var inv []*extractor.Inventory
 	var extraPaths pathQueue
+	// Metadata of the package on the previous requirement line, which hash
+	// lines without a requirement of their own belong to.
+	var last *Metadata
 	s := bufio.NewScanner(reader)
 	for s.Scan() {
 		l := readLine(s, &strings.Builder{})
+		if isHashLine(l) {
+			addHashLine(path, l, last)
+			continue
+		}
+		if strings.TrimSpace(l) != "" {
+			last = nil
+		}
 		// Per-requirement options may be present. We extract the --hash options, and discard the others.
 		l, hashOptions := splitPerRequirementOptions(l)
 		l = removeWhiteSpaces(l)
@@ -227,14 +277,15 @@ func extractFromPath(reader io.Reader, path string, fs scalibrfs.FS) ([]*extract
 			continue
 		}
 
+		last = &Metadata{
+			HashCheckingModeValues: hashOptions,
+			VersionComparator:      comp,
+		}
 		inv = append(inv, &extractor.Inventory{
 			Name:      name,
 			Version:   version,
-			Locations: []string{path},
-			Metadata: &Metadata{
-				HashCheckingModeValues: hashOptions,
-				VersionComparator:      comp,
-			},
+			Locations: extractor.DeclaredLocations(path),
+			Metadata:  last,
 		})
 	}
 
@@ -337,10 +388,34 @@ func splitPerRequirementOptions(s string) (string, []string) {
 	return reTextAfterFirstOptionInclusive.ReplaceAllString(s, ""), hashes
 }
 
+// isHashLine returns true if the line only holds --hash options, e.g. because
+// the requirement they belong to was on the previous line without a line
+// continuation.
+func isHashLine(l string) bool {
+	return strings.HasPrefix(strings.TrimSpace(l), "--hash")
+}
+
+// addHashLine adds the values of the --hash options on the hash line to the
+// metadata of the previous requirement. Malformed lines are skipped.
+func addHashLine(path, l string, last *Metadata) {
+	_, hashes := splitPerRequirementOptions(l)
+	switch {
+	case last == nil:
+		log.Warnf("%s: skipping hash line without a preceding requirement: %q", path, l)
+	case len(hashes) == 0:
+		log.Warnf("%s: skipping malformed hash line: %q", path, l)
+	default:
+		last.HashCheckingModeValues = append(last.HashCheckingModeValues, hashes...)
+	}
+}
+
 // ToPURL converts an inventory created by this extractor into a PURL.
 func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	return pypipurl.MakePackageURL(i)
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypePyPi} }
+
 // Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
 func (Extractor) Ecosystem(i *extractor.Inventory) string { return "PyPI" }
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem"
 	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
@@ -209,7 +210,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "transitive-req",
 					Version:   "1",
-					Locations: []string{"testdata/example.txt:testdata/other-requirements.txt"},
+					Locations: extractor.DeclaredLocations("testdata/example.txt:testdata/other-requirements.txt"),
 				},
 			},
 			wantResultMetric: stats.FileExtractedResultSuccess,
@@ -232,6 +233,47 @@ func TestExtract(t *testing.T) {
 			},
 			wantResultMetric: stats.FileExtractedResultSuccess,
 		},
+		{
+			name: "hashes on separate lines",
+			path: "testdata/hashes.txt",
+			wantInventory: []*extractor.Inventory{
+				{
+					Name:    "certifi",
+					Version: "2024.7.4",
+					Metadata: &requirements.Metadata{HashCheckingModeValues: []string{
+						"sha256:5a1e7645bc0ec61a09e26c36f6106dd4cf40c6db3a1fb6352b0244e7fb057c7b",
+						"sha256:c198e21b1289c2ab85ee4e67bb4b4ef3ead0892059901a8d5b622f24a1101e90",
+					}},
+				},
+				{
+					Name:    "idna",
+					Version: "3.7",
+					Metadata: &requirements.Metadata{HashCheckingModeValues: []string{
+						"sha256:028ff3aadf0609c1fd278d8ea3089299412a7a8b9bd005dd08b9f8285bcb5cfc",
+						"sha256:82fee1fc78add43492d3a1898bfa6d8a904cc97d8427f683ed8e798d07761aa0",
+					}},
+				},
+				{
+					// The malformed hash lines are skipped.
+					Name:    "requests",
+					Version: "2.32.3",
+					Metadata: &requirements.Metadata{HashCheckingModeValues: []string{
+						"sha256:55365417734eb18255590a9ff9eb97e9e1da868d4ccd6402399eaf68af20a760",
+						"sha256:70761cfe03c773ceb22aa2f671b4757976145175cdfca038c02654d061d6dcc6",
+					}},
+				},
+				{
+					Name:    "urllib3",
+					Version: "2.2.2",
+					Metadata: &requirements.Metadata{
+						HashCheckingModeValues: []string{"sha256:a448b2f64d686155468037e1ace9f2d2199776e17f0a46610480d311f73e3472"},
+						VersionComparator:      "~=",
+					},
+				},
+				// not pytest, because no version
+			},
+			wantResultMetric: stats.FileExtractedResultSuccess,
+		},
 		{
 			name:             "invalid",
 			path:             "testdata/invalid.txt",
@@ -340,7 +382,7 @@ func TestExtract(t *testing.T) {
 	for _, t := range tests {
 		for _, i := range t.wantInventory {
 			if i.Locations == nil {
-				i.Locations = []string{t.path}
+				i.Locations = extractor.DeclaredLocations(t.path)
 			}
 			if i.Metadata == nil {
 				i.Metadata = &requirements.Metadata{}
@@ -401,12 +443,78 @@ func TestExtract(t *testing.T) {
 	}
 }
 
+func TestExtractIncludeLimits(t *testing.T) {
+	tests := []struct {
+		name      string
+		path      string
+		limits    filesystem.IncludeLimits
+		wantNames []string
+		wantErr   error
+	}{
+		{
+			name:      "cycle without limits",
+			path:      "testdata/includes/cycle/a.txt",
+			wantNames: []string{"alpha", "beta", "gamma"},
+		},
+		{
+			name:      "cycle within limits",
+			path:      "testdata/includes/cycle/a.txt",
+			limits:    filesystem.IncludeLimits{MaxFiles: 2, MaxDepth: 2},
+			wantNames: []string{"alpha", "beta", "gamma"},
+		},
+		{
+			name:      "cycle exceeding file limit",
+			path:      "testdata/includes/cycle/a.txt",
+			limits:    filesystem.IncludeLimits{MaxFiles: 1},
+			wantNames: []string{"alpha", "beta"},
+			wantErr:   filesystem.ErrIncludeLimitExceeded,
+		},
+		{
+			name:      "deep chain without limits",
+			path:      "testdata/includes/deep/requirements.txt",
+			wantNames: []string{"root", "one", "two", "three"},
+		},
+		{
+			name:      "deep chain exceeding depth limit",
+			path:      "testdata/includes/deep/requirements.txt",
+			limits:    filesystem.IncludeLimits{MaxDepth: 2},
+			wantNames: []string{"root", "one", "two"},
+			wantErr:   filesystem.ErrIncludeLimitExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys := scalibrfs.DirFS(".")
+			r, err := fsys.Open(tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Close()
+
+			input := &filesystem.ScanInput{FS: fsys, Path: tt.path, Reader: r, IncludeLimits: tt.limits}
+			got, err := requirements.New(requirements.DefaultConfig()).Extract(context.Background(), input)
+			if diff := cmp.Diff(tt.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Extract(%s) error diff (-want +got):\n%s", tt.path, diff)
+			}
+
+			var gotNames []string
+			for _, i := range got {
+				gotNames = append(gotNames, i.Name)
+			}
+			if diff := cmp.Diff(tt.wantNames, gotNames); diff != "" {
+				t.Errorf("Extract(%s) package names (-want +got):\n%s", tt.path, diff)
+			}
+		})
+	}
+}
+
 func TestToPURL(t *testing.T) {
 	e := requirements.Extractor{}
 	i := &extractor.Inventory{
 		Name:      "Name",
 		Version:   "1.2.3",
-		Locations: []string{"location"},
+		Locations: extractor.DeclaredLocations("location"),
 		Metadata:  &requirements.Metadata{HashCheckingModeValues: []string{"sha256:123xyz"}},
 	}
 	want := &purl.PackageURL{
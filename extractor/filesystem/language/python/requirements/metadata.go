@@ -17,7 +17,8 @@ package requirements
 // Metadata contains additional information from a package in a requirements file.
 type Metadata struct {
 	// The values from the --hash flags, as in https://pip.pypa.io/en/stable/topics/secure-installs/#hash-checking-mode.
-	// These are the hashes of the distributions of the package.
+	// These are the hashes of the distributions of the package. Hash options on
+	// the lines following the requirement are included.
 	HashCheckingModeValues []string
 	// The comparator used to compare the package version, e.g. ==, ~=, >=
 	VersionComparator string
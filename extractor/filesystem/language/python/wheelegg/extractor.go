@@ -30,6 +30,7 @@ import (
 
 	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/license"
 	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/python/internal/pypipurl"
 	"github.com/google/osv-scalibr/plugin"
@@ -44,6 +45,10 @@ const (
 	// defaultMaxFileSizeBytes is the maximum file size an extractor will unmarshal.
 	// If Extract gets a bigger file, it will return an error.
 	defaultMaxFileSizeBytes = 100 * units.MiB
+
+	// maxLicenseFieldLength is the length above which the License field is
+	// assumed to hold a license text rather than a license name.
+	maxLicenseFieldLength = 100
 )
 
 // Extractor extracts python packages from wheel/egg files.
@@ -106,19 +111,33 @@ var (
 // FileRequired returns true if the specified file matches python Metadata file
 // patterns.
 func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
+	if !fileMatches(path) {
+		return false
+	}
+
+	// We only want to skip the file for being too large if it is a relevant
+	// file at all, so we check the file size after checking the file suffix.
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// FileSizeLimitExceeded returns true if the file would be required if it
+// didn't exceed the maximum file size.
+func (e Extractor) FileSizeLimitExceeded(path string, fileinfo fs.FileInfo) bool {
+	return e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes && fileMatches(path)
+}
+
+func fileMatches(path string) bool {
 	// For Windows
 	normalizedPath := filepath.ToSlash(path)
 
 	for _, r := range requiredFiles {
 		if strings.HasSuffix(normalizedPath, r) {
-			// We only want to skip the file for being too large if it is a relevant
-			// file at all, so we check the file size after checking the file suffix.
-			if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
-				e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
-				return false
-			}
-
-			e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
 			return true
 		}
 	}
@@ -130,6 +149,7 @@ func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result s
 		return
 	}
 	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Extractor:     e.Name(),
 		Path:          path,
 		Result:        result,
 		FileSizeBytes: fileSizeBytes,
@@ -236,7 +256,7 @@ func (e Extractor) extractSingleFile(r io.Reader, path string) (*extractor.Inven
 		return nil, fmt.Errorf("wheelegg.parse(%s): %w", path, err)
 	}
 
-	i.Locations = []string{path}
+	i.Locations = extractor.InstalledLocations(path)
 	return i, nil
 }
 
@@ -258,16 +278,41 @@ func parse(r io.Reader) (*extractor.Inventory, error) {
 		Name:    name,
 		Version: version,
 		Metadata: &PythonPackageMetadata{
-			Author:      h.Get("Author"),
-			AuthorEmail: h.Get("Author-email"),
+			Author:       h.Get("Author"),
+			AuthorEmail:  h.Get("Author-email"),
+			Dependencies: h.Values("Requires-Dist"),
+			Licenses:     licenses(h),
 		},
 	}, nil
 }
 
+// licenses returns the licenses declared in the package metadata. The
+// License-Expression field takes precedence over the free-form License field,
+// and trove classifiers are only used if neither is set.
+func licenses(h textproto.MIMEHeader) []string {
+	if l := license.Normalize(h.Get("License-Expression")); l != "" {
+		return []string{l}
+	}
+	// Some packages put the full license text into the License field.
+	if l := h.Get("License"); len(l) <= maxLicenseFieldLength {
+		if l = license.Normalize(l); l != "" {
+			return []string{l}
+		}
+	}
+	var res []string
+	for _, c := range h.Values("Classifier") {
+		res = append(res, license.FromClassifier(c))
+	}
+	return license.Dedupe(res)
+}
+
 // ToPURL converts an inventory created by this extractor into a PURL.
 func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	return pypipurl.MakePackageURL(i)
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypePyPi} }
+
 // Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
 func (Extractor) Ecosystem(i *extractor.Inventory) string { return "PyPI" }
@@ -157,10 +157,11 @@ func TestExtract(t *testing.T) {
 			wantInventory: []*extractor.Inventory{{
 				Name:      "pip",
 				Version:   "22.2.2",
-				Locations: []string{"testdata/distinfo_meta"},
+				Locations: extractor.InstalledLocations("testdata/distinfo_meta"),
 				Metadata: &wheelegg.PythonPackageMetadata{
 					Author:      "The pip developers",
 					AuthorEmail: "distutils-sig@python.org",
+					Licenses:    []string{"MIT"},
 				},
 			}},
 		},
@@ -170,10 +171,48 @@ func TestExtract(t *testing.T) {
 			wantInventory: []*extractor.Inventory{{
 				Name:      "setuptools",
 				Version:   "57.4.0",
-				Locations: []string{"testdata/egginfo_pkginfo"},
+				Locations: extractor.InstalledLocations("testdata/egginfo_pkginfo"),
 				Metadata: &wheelegg.PythonPackageMetadata{
 					Author:      "Python Packaging Authority",
 					AuthorEmail: "distutils-sig@python.org",
+					Licenses:    []string{"MIT"},
+				},
+			}},
+		},
+		{
+			name: ".dist-info/METADATA with Requires-Dist",
+			path: "testdata/distinfo_meta_requires_dist",
+			wantInventory: []*extractor.Inventory{{
+				Name:      "requests",
+				Version:   "2.31.0",
+				Locations: extractor.InstalledLocations("testdata/distinfo_meta_requires_dist"),
+				Metadata: &wheelegg.PythonPackageMetadata{
+					Author:      "Kenneth Reitz",
+					AuthorEmail: "me@kennethreitz.org",
+					Licenses:    []string{"Apache-2.0"},
+					Dependencies: []string{
+						"charset-normalizer (<4,>=2)",
+						"idna (<4,>=2.5)",
+						"urllib3 (<3,>=1.21.1)",
+						"certifi (>=2017.4.17)",
+						"PySocks (!=1.5.7,>=1.5.6) ; extra == 'socks'",
+						"chardet (<6,>=3.0.2) ; extra == 'use_chardet_on_py3'",
+					},
+				},
+			}},
+		},
+		{
+			name: ".egg-info/PKG-INFO with Requires-Dist",
+			path: "testdata/egginfo_requires_dist",
+			wantInventory: []*extractor.Inventory{{
+				Name:      "Flask_Login",
+				Version:   "0.6.3",
+				Locations: extractor.InstalledLocations("testdata/egginfo_requires_dist"),
+				Metadata: &wheelegg.PythonPackageMetadata{
+					Author:       "Matthew Frazier",
+					AuthorEmail:  "leafstormrush@gmail.com",
+					Licenses:     []string{"MIT"},
+					Dependencies: []string{"Flask>=1.0.4", "Werkzeug>=1.0.1"},
 				},
 			}},
 		},
@@ -183,10 +222,25 @@ func TestExtract(t *testing.T) {
 			wantInventory: []*extractor.Inventory{{
 				Name:      "pycups",
 				Version:   "2.0.1",
-				Locations: []string{"testdata/egginfo"},
+				Locations: extractor.InstalledLocations("testdata/egginfo"),
 				Metadata: &wheelegg.PythonPackageMetadata{
 					Author:      "Zdenek Dohnal",
 					AuthorEmail: "zdohnal@redhat.com",
+					Licenses:    []string{"GPL-2.0-or-later"},
+				},
+			}},
+		},
+		{
+			name: "licenses from classifiers",
+			path: "testdata/pkginfo_license_classifiers",
+			wantInventory: []*extractor.Inventory{{
+				Name:      "dual-licensed",
+				Version:   "1.0.0",
+				Locations: extractor.InstalledLocations("testdata/pkginfo_license_classifiers"),
+				Metadata: &wheelegg.PythonPackageMetadata{
+					Author:      "Jane Doe",
+					AuthorEmail: "jane@example.com",
+					Licenses:    []string{"Apache-2.0", "MIT"},
 				},
 			}},
 		},
@@ -196,10 +250,11 @@ func TestExtract(t *testing.T) {
 			wantInventory: []*extractor.Inventory{{
 				Name:      "httplib2",
 				Version:   "0.20.4",
-				Locations: []string{"testdata/pkginfo"},
+				Locations: extractor.InstalledLocations("testdata/pkginfo"),
 				Metadata: &wheelegg.PythonPackageMetadata{
 					Author:      "Joe Gregorio",
 					AuthorEmail: "joe@bitworking.org",
+					Licenses:    []string{"MIT"},
 				},
 			},
 			},
@@ -210,10 +265,11 @@ func TestExtract(t *testing.T) {
 			wantInventory: []*extractor.Inventory{{
 				Name:      "passlib",
 				Version:   "1.7.4",
-				Locations: []string{"testdata/malformed_pkginfo"},
+				Locations: extractor.InstalledLocations("testdata/malformed_pkginfo"),
 				Metadata: &wheelegg.PythonPackageMetadata{
 					Author:      "Eli Collins",
 					AuthorEmail: "elic@assurancetechnologies.com",
+					Licenses:    []string{"BSD"},
 				},
 			}},
 		},
@@ -223,10 +279,11 @@ func TestExtract(t *testing.T) {
 			wantInventory: []*extractor.Inventory{{
 				Name:      "monotonic",
 				Version:   "1.6",
-				Locations: []string{"testdata/monotonic-1.6-py3.10.egg"},
+				Locations: extractor.InstalledLocations("testdata/monotonic-1.6-py3.10.egg"),
 				Metadata: &wheelegg.PythonPackageMetadata{
 					Author:      "Ori Livneh",
 					AuthorEmail: "ori@wikimedia.org",
+					Licenses:    []string{"Apache"},
 				},
 			}},
 		},
@@ -316,10 +373,11 @@ func TestExtractWithoutReadAt(t *testing.T) {
 			wantInventory: &extractor.Inventory{
 				Name:      "monotonic",
 				Version:   "1.6",
-				Locations: []string{"testdata/monotonic-1.6-py3.10.egg"},
+				Locations: extractor.InstalledLocations("testdata/monotonic-1.6-py3.10.egg"),
 				Metadata: &wheelegg.PythonPackageMetadata{
 					Author:      "Ori Livneh",
 					AuthorEmail: "ori@wikimedia.org",
+					Licenses:    []string{"Apache"},
 				},
 			},
 		},
@@ -461,7 +519,7 @@ func TestToPURL(t *testing.T) {
 	i := &extractor.Inventory{
 		Name:      "Name",
 		Version:   "1.2.3",
-		Locations: []string{"location"},
+		Locations: extractor.InstalledLocations("location"),
 	}
 	want := &purl.PackageURL{
 		Type:    purl.TypePyPi,
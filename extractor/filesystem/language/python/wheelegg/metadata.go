@@ -18,4 +18,9 @@ package wheelegg
 type PythonPackageMetadata struct {
 	Author      string `json:"author"`
 	AuthorEmail string `json:"authorEmail"`
+	// Dependencies holds the raw Requires-Dist requirement specifiers, including
+	// any version constraints and environment markers.
+	Dependencies []string `json:"dependencies,omitempty"`
+	// Licenses are the declared licenses as SPDX license expressions.
+	Licenses []string `json:"licenses,omitempty"`
 }
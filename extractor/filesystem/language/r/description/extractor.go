@@ -0,0 +1,181 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package description extracts the CRAN packages R projects depend on from
+// their DESCRIPTION files.
+package description
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+// Name is the unique name of this extractor.
+const Name = "r/description"
+
+// fields are the DESCRIPTION fields listing the packages needed at runtime.
+var fields = []string{"Depends", "Imports"}
+
+// basePackages ship with R itself and aren't available from CRAN.
+var basePackages = map[string]bool{
+	"R": true, "base": true, "compiler": true, "datasets": true, "graphics": true,
+	"grDevices": true, "grid": true, "methods": true, "parallel": true, "splines": true,
+	"stats": true, "stats4": true, "tcltk": true, "tools": true, "utils": true,
+}
+
+// Metadata holds additional information about a package dependency declared
+// in a DESCRIPTION file.
+type Metadata struct {
+	// Field is the DESCRIPTION field declaring the dependency, "Depends" or
+	// "Imports".
+	Field string `json:"field"`
+	// VersionRequirement is the declared version constraint, e.g. ">= 1.0".
+	// Empty if any version is accepted.
+	VersionRequirement string `json:"versionRequirement,omitempty"`
+}
+
+// Extractor extracts the dependencies of R projects from DESCRIPTION files.
+type Extractor struct{}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileHints returns the file names the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{BaseNames: []string{"DESCRIPTION"}}
+}
+
+// FileRequired returns true for DESCRIPTION files.
+func (e Extractor) FileRequired(path string, _ fs.FileInfo) bool {
+	return filepath.Base(path) == "DESCRIPTION"
+}
+
+// Extract returns the CRAN packages listed in the Depends and Imports fields.
+// The file is only a fallback for projects without a renv.lock next to it,
+// which pins the exact versions and is extracted by r/renvlock instead. The
+// DESCRIPTION files of installed packages, which have a Built field, are
+// skipped as well.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	if _, err := fs.Stat(input.FS, path.Join(path.Dir(input.Path), "renv.lock")); err == nil {
+		return []*extractor.Inventory{}, nil
+	}
+	dcf, err := parseDCF(input)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+	if _, ok := dcf["Built"]; ok {
+		return []*extractor.Inventory{}, nil
+	}
+
+	res := []*extractor.Inventory{}
+	for _, field := range fields {
+		for _, dep := range strings.Split(dcf[field], ",") {
+			name, requirement := parseDependency(dep)
+			if name == "" || basePackages[name] {
+				continue
+			}
+			res = append(res, &extractor.Inventory{
+				Name:      name,
+				Version:   lowestVersion(requirement),
+				Locations: []extractor.Location{{Path: input.Path, Reason: extractor.LocationDeclared}},
+				Metadata:  &Metadata{Field: field, VersionRequirement: requirement},
+			})
+		}
+	}
+	return res, nil
+}
+
+// parseDCF returns the fields of a file in the Debian control file format R
+// uses for DESCRIPTION files. Values of the fields can be continued on lines
+// indented with whitespace.
+func parseDCF(input *filesystem.ScanInput) (map[string]string, error) {
+	res := map[string]string{}
+	var field string
+	s := bufio.NewScanner(input.Reader)
+	for lineNumber := 1; s.Scan(); lineNumber++ {
+		line := s.Text()
+		switch {
+		case strings.TrimSpace(line) == "":
+			field = ""
+		case line[0] == ' ' || line[0] == '\t':
+			if field == "" {
+				input.Warnf("line %d: continuation line without a field", lineNumber)
+				continue
+			}
+			res[field] += " " + strings.TrimSpace(line)
+		default:
+			name, value, ok := strings.Cut(line, ":")
+			if !ok {
+				input.Warnf("line %d: missing ':' after the field name", lineNumber)
+				field = ""
+				continue
+			}
+			field = name
+			res[field] = strings.TrimSpace(value)
+		}
+	}
+	return res, s.Err()
+}
+
+// parseDependency splits a dependency such as "dplyr (>= 1.0.0)" into the
+// package name and the version requirement.
+func parseDependency(dep string) (name, requirement string) {
+	name, requirement, _ = strings.Cut(dep, "(")
+	requirement = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(requirement), ")"))
+	return strings.TrimSpace(name), strings.Join(strings.Fields(requirement), " ")
+}
+
+// lowestVersion returns the lowest version a requirement accepts, or "" if it
+// has no lower bound.
+func lowestVersion(requirement string) string {
+	for _, op := range []string{">=", "=="} {
+		if v, ok := strings.CutPrefix(requirement, op); ok {
+			return strings.TrimSpace(v)
+		}
+	}
+	return ""
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:    purl.TypeCran,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeCran} }
+
+// Ecosystem returns the OSV ecosystem ('CRAN') of the software extracted by this extractor.
+func (e Extractor) Ecosystem(i *extractor.Inventory) string { return "CRAN" }
+
+var _ filesystem.Extractor = Extractor{}
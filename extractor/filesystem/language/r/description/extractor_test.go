@@ -0,0 +1,117 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package description_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/r/description"
+	"github.com/google/osv-scalibr/testing/extracttest"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "DESCRIPTION", want: true},
+		{path: "project/DESCRIPTION", want: true},
+		{path: "project/DESCRIPTION.txt", want: false},
+		{path: "project/NAMESPACE", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := (description.Extractor{}).FileRequired(tt.path, nil); got != tt.want {
+				t.Errorf("FileRequired(%s) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+// dependency returns the inventory of a package declared in the given file.
+func dependency(path, name, version string, m *description.Metadata) *extractor.Inventory {
+	return &extractor.Inventory{
+		Name:      name,
+		Version:   version,
+		Locations: []extractor.Location{{Path: path, Reason: extractor.LocationDeclared}},
+		Metadata:  m,
+	}
+}
+
+func TestExtract(t *testing.T) {
+	const project = "testdata/project/DESCRIPTION"
+	tests := []extracttest.TestTableEntry{
+		{
+			Name:        "project dependencies",
+			InputConfig: extracttest.ScanInputMockConfig{Path: project},
+			WantInventory: []*extractor.Inventory{
+				dependency(project, "dplyr", "1.1.0", &description.Metadata{Field: "Imports", VersionRequirement: ">= 1.1.0"}),
+				dependency(project, "ggplot2", "", &description.Metadata{Field: "Imports"}),
+				dependency(project, "jsonlite", "1.8.4", &description.Metadata{Field: "Imports", VersionRequirement: ">=1.8.4"}),
+				dependency(project, "rlang", "", &description.Metadata{Field: "Imports", VersionRequirement: "> 1.0.0"}),
+			},
+		},
+		{
+			Name:          "renv.lock takes precedence",
+			InputConfig:   extracttest.ScanInputMockConfig{Path: "testdata/withlock/DESCRIPTION"},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name:          "installed package",
+			InputConfig:   extracttest.ScanInputMockConfig{Path: "testdata/installed/DESCRIPTION"},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name:          "only base packages",
+			InputConfig:   extracttest.ScanInputMockConfig{Path: "testdata/nodeps/DESCRIPTION"},
+			WantInventory: []*extractor.Inventory{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := description.Extractor{}
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+			if !cmp.Equal(err, tt.WantErr, cmpopts.EquateErrors()) {
+				t.Fatalf("%s.Extract(%q) error diff: got %v, want %v", extr.Name(), tt.InputConfig.Path, err, tt.WantErr)
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestExtract_WarnsAboutMalformedLines(t *testing.T) {
+	scanInput := extracttest.GenerateScanInputMock(t, extracttest.ScanInputMockConfig{Path: "testdata/nodeps/DESCRIPTION"})
+	defer extracttest.CloseTestScanInput(t, scanInput)
+
+	if _, err := (description.Extractor{}).Extract(context.Background(), &scanInput); err != nil {
+		t.Fatalf("Extract(): %v", err)
+	}
+	want := []string{"line 4: missing ':' after the field name"}
+	if diff := cmp.Diff(want, scanInput.Warnings()); diff != "" {
+		t.Errorf("Extract() warnings (-want +got):\n%s", diff)
+	}
+}
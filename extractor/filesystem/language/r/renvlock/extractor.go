@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io/fs"
 	"path/filepath"
+	"strings"
 
 	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem"
@@ -28,17 +29,60 @@ import (
 	"github.com/google/osv-scalibr/purl"
 )
 
+// Sources of packages supported by the extractor.
+const (
+	SourceCRAN   = "CRAN"
+	SourceGitHub = "GitHub"
+)
+
+// Metadata holds additional information about a package locked in renv.lock.
+type Metadata struct {
+	// Source is where renv installs the package from, either SourceCRAN or
+	// SourceGitHub.
+	Source string `json:"source"`
+	// RemoteRef is the branch, tag or commit a GitHub package was requested
+	// at, e.g. "main".
+	RemoteRef string `json:"remoteRef,omitempty"`
+	// RemoteSHA is the commit a GitHub package is locked to.
+	RemoteSHA string `json:"remoteSha,omitempty"`
+}
+
 type renvPackage struct {
-	Package    string `json:"Package"`
-	Version    string `json:"Version"`
-	Repository string `json:"Repository"`
+	Package        string `json:"Package"`
+	Version        string `json:"Version"`
+	Source         string `json:"Source"`
+	Repository     string `json:"Repository"`
+	RemoteHost     string `json:"RemoteHost"`
+	RemoteUsername string `json:"RemoteUsername"`
+	RemoteRepo     string `json:"RemoteRepo"`
+	RemoteRef      string `json:"RemoteRef"`
+	RemoteSha      string `json:"RemoteSha"`
+}
+
+// source returns where the package is installed from. Packages from package
+// repositories have the Source "Repository", older lockfiles only set the
+// Repository.
+func (p renvPackage) source() string {
+	if p.Source == "" || p.Source == "Repository" {
+		return p.Repository
+	}
+	return p.Source
+}
+
+// repoURL returns the URL of the GitHub repository of a GitHub package.
+func (p renvPackage) repoURL() string {
+	host := strings.TrimSuffix(p.RemoteHost, "/api/v3")
+	if host == "" || host == "api.github.com" {
+		host = "github.com"
+	}
+	return fmt.Sprintf("https://%s/%s/%s", host, p.RemoteUsername, p.RemoteRepo)
 }
 
 type renvLockfile struct {
 	Packages map[string]renvPackage `json:"Packages"`
 }
 
-// Extractor extracts CRAN packages from renv.lock files.
+// Extractor extracts CRAN and GitHub packages from renv.lock files.
 type Extractor struct{}
 
 // Name of the extractor
@@ -52,6 +96,11 @@ func (e Extractor) Requirements() *plugin.Capabilities {
 	return &plugin.Capabilities{}
 }
 
+// FileHints returns the file names the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{BaseNames: []string{"renv.lock"}}
+}
+
 // FileRequired returns true if the specified file matches renv lockfile patterns.
 func (e Extractor) FileRequired(path string, fileInfo fs.FileInfo) bool {
 	return filepath.Base(path) == "renv.lock"
@@ -70,32 +119,55 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 	packages := make([]*extractor.Inventory, 0, len(parsedLockfile.Packages))
 
 	for _, pkg := range parsedLockfile.Packages {
-		// currently we only support CRAN
-		if pkg.Repository != "CRAN" {
-			continue
-		}
-
-		packages = append(packages, &extractor.Inventory{
+		inv := &extractor.Inventory{
 			Name:      pkg.Package,
 			Version:   pkg.Version,
-			Locations: []string{input.Path},
-		})
+			Locations: extractor.DeclaredLocations(input.Path),
+		}
+		// currently we only support CRAN and GitHub
+		switch pkg.source() {
+		case SourceCRAN:
+			inv.Metadata = &Metadata{Source: SourceCRAN}
+		case SourceGitHub:
+			inv.Metadata = &Metadata{Source: SourceGitHub, RemoteRef: pkg.RemoteRef, RemoteSHA: pkg.RemoteSha}
+			inv.SourceCode = &extractor.SourceCodeIdentifier{Repo: pkg.repoURL(), Commit: pkg.RemoteSha}
+		default:
+			continue
+		}
+		packages = append(packages, inv)
 	}
 
 	return packages, nil
 }
 
-// ToPURL converts an inventory created by this extractor into a PURL.
+// ToPURL converts an inventory created by this extractor into a PURL. GitHub
+// packages have the repository and commit they're locked to as vcs_url.
 func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
-	return &purl.PackageURL{
+	p := &purl.PackageURL{
 		Type:    purl.TypeCran,
 		Name:    i.Name,
 		Version: i.Version,
 	}
+	if i.SourceCode != nil && i.SourceCode.Repo != "" {
+		vcsURL := "git+" + i.SourceCode.Repo
+		if i.SourceCode.Commit != "" {
+			vcsURL += "@" + i.SourceCode.Commit
+		}
+		p.Qualifiers = purl.QualifiersFromMap(map[string]string{"vcs_url": vcsURL})
+	}
+	return p
 }
 
-// Ecosystem returns the OSV ecosystem ('CRAN') of the software extracted by this extractor.
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeCran} }
+
+// Ecosystem returns the OSV ecosystem ('CRAN') of the software extracted by
+// this extractor. GitHub packages have no ecosystem since their versions
+// needn't match any CRAN release.
 func (e Extractor) Ecosystem(i *extractor.Inventory) string {
+	if m, ok := i.Metadata.(*Metadata); ok && m.Source == SourceGitHub {
+		return ""
+	}
 	return "CRAN"
 }
 
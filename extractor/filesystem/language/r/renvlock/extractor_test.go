@@ -22,6 +22,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/r/renvlock"
+	"github.com/google/osv-scalibr/purl"
 	"github.com/google/osv-scalibr/testing/extracttest"
 )
 
@@ -53,7 +54,8 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "morning",
 					Version:   "0.1.0",
-					Locations: []string{"testdata/one-package.lock"},
+					Locations: extractor.DeclaredLocations("testdata/one-package.lock"),
+					Metadata:  &renvlock.Metadata{Source: renvlock.SourceCRAN},
 				},
 			},
 		},
@@ -66,12 +68,14 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "markdown",
 					Version:   "1.0",
-					Locations: []string{"testdata/two-packages.lock"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.lock"),
+					Metadata:  &renvlock.Metadata{Source: renvlock.SourceCRAN},
 				},
 				{
 					Name:      "mime",
 					Version:   "0.7",
-					Locations: []string{"testdata/two-packages.lock"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.lock"),
+					Metadata:  &renvlock.Metadata{Source: renvlock.SourceCRAN},
 				},
 			},
 		},
@@ -84,7 +88,22 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "markdown",
 					Version:   "1.0",
-					Locations: []string{"testdata/with-mixed-sources.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-mixed-sources.lock"),
+					Metadata:  &renvlock.Metadata{Source: renvlock.SourceCRAN},
+				},
+				{
+					Name:    "mime",
+					Version: "0.12.1",
+					SourceCode: &extractor.SourceCodeIdentifier{
+						Repo:   "https://github.com/yihui/mime",
+						Commit: "1763e0dcb72fb58d97bab97bb834fc71f1e012bc",
+					},
+					Locations: extractor.DeclaredLocations("testdata/with-mixed-sources.lock"),
+					Metadata: &renvlock.Metadata{
+						Source:    renvlock.SourceGitHub,
+						RemoteRef: "main",
+						RemoteSHA: "1763e0dcb72fb58d97bab97bb834fc71f1e012bc",
+					},
 				},
 			},
 		},
@@ -97,7 +116,8 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "BH",
 					Version:   "1.75.0-0",
-					Locations: []string{"testdata/with-bioconductor.lock"},
+					Locations: extractor.DeclaredLocations("testdata/with-bioconductor.lock"),
+					Metadata:  &renvlock.Metadata{Source: renvlock.SourceCRAN},
 				},
 			},
 		},
@@ -132,3 +152,55 @@ func TestExtractor_Extract(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractor_ToPURL(t *testing.T) {
+	tests := []struct {
+		name          string
+		inv           *extractor.Inventory
+		want          *purl.PackageURL
+		wantEcosystem string
+	}{
+		{
+			name: "CRAN package",
+			inv: &extractor.Inventory{
+				Name:     "markdown",
+				Version:  "1.0",
+				Metadata: &renvlock.Metadata{Source: renvlock.SourceCRAN},
+			},
+			want:          &purl.PackageURL{Type: purl.TypeCran, Name: "markdown", Version: "1.0"},
+			wantEcosystem: "CRAN",
+		},
+		{
+			name: "GitHub package",
+			inv: &extractor.Inventory{
+				Name:    "mime",
+				Version: "0.12.1",
+				SourceCode: &extractor.SourceCodeIdentifier{
+					Repo:   "https://github.com/yihui/mime",
+					Commit: "1763e0dcb72fb58d97bab97bb834fc71f1e012bc",
+				},
+				Metadata: &renvlock.Metadata{Source: renvlock.SourceGitHub, RemoteRef: "main"},
+			},
+			want: &purl.PackageURL{
+				Type:    purl.TypeCran,
+				Name:    "mime",
+				Version: "0.12.1",
+				Qualifiers: purl.QualifiersFromMap(map[string]string{
+					"vcs_url": "git+https://github.com/yihui/mime@1763e0dcb72fb58d97bab97bb834fc71f1e012bc",
+				}),
+			},
+			wantEcosystem: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := renvlock.Extractor{}
+			if diff := cmp.Diff(tt.want, e.ToPURL(tt.inv)); diff != "" {
+				t.Errorf("ToPURL(%v) (-want +got):\n%s", tt.inv, diff)
+			}
+			if got := e.Ecosystem(tt.inv); got != tt.wantEcosystem {
+				t.Errorf("Ecosystem(%v) = %q, want %q", tt.inv, got, tt.wantEcosystem)
+			}
+		})
+	}
+}
@@ -50,6 +50,11 @@ func (e Extractor) Version() int { return 0 }
 // Requirements of the extractor.
 func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
 
+// FileHints returns the file names the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{BaseNames: []string{"Gemfile.lock"}}
+}
+
 // FileRequired return true if the specified file is a Gemfile.lock file.
 func (e Extractor) FileRequired(path string, fileInfo fs.FileInfo) bool {
 	return filepath.Base(path) == "Gemfile.lock"
@@ -124,7 +129,7 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 			i := &extractor.Inventory{
 				Name:      name,
 				Version:   version,
-				Locations: []string{input.Path},
+				Locations: extractor.DeclaredLocations(input.Path),
 			}
 			if section.revision != "" {
 				i.SourceCode = &extractor.SourceCodeIdentifier{
@@ -146,6 +151,9 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	}
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeGem} }
+
 // Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
 func (e Extractor) Ecosystem(i *extractor.Inventory) string {
 	return "RubyGems"
@@ -87,7 +87,7 @@ func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabili
 // FileRequired return true if the specified file matched the .gemspec file
 // pattern.
 func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
-	if filepath.Ext(path) != ".gemspec" {
+	if !e.fileMatches(path, fileinfo) {
 		return false
 	}
 
@@ -100,11 +100,24 @@ func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
 	return true
 }
 
+// FileSizeLimitExceeded returns true if the file would be required if it
+// didn't exceed the maximum file size.
+func (e Extractor) FileSizeLimitExceeded(path string, fileinfo fs.FileInfo) bool {
+	return e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes && e.fileMatches(path, fileinfo)
+}
+
+// fileMatches returns true if the file is one the extractor is interested in,
+// regardless of its size.
+func (e Extractor) fileMatches(path string, fileinfo fs.FileInfo) bool {
+	return filepath.Ext(path) == ".gemspec"
+}
+
 func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
 	if e.stats == nil {
 		return
 	}
 	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Extractor:     e.Name(),
 		Path:          path,
 		Result:        result,
 		FileSizeBytes: fileSizeBytes,
@@ -122,7 +135,7 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 		return []*extractor.Inventory{}, nil
 	}
 
-	i.Locations = []string{input.Path}
+	i.Locations = extractor.InstalledLocations(input.Path)
 	return []*extractor.Inventory{i}, nil
 }
 
@@ -207,5 +220,8 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	}
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeGem} }
+
 // Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
 func (Extractor) Ecosystem(i *extractor.Inventory) string { return "RubyGems" }
@@ -136,7 +136,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "yaml",
 					Version:   "0.2.1",
-					Locations: []string{"testdata/yaml-0.2.1.gemspec"},
+					Locations: extractor.InstalledLocations("testdata/yaml-0.2.1.gemspec"),
 				},
 			},
 			wantResultMetric: stats.FileExtractedResultSuccess,
@@ -148,7 +148,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "rss",
 					Version:   "0.2.9",
-					Locations: []string{"testdata/rss-0.2.9.gemspec"},
+					Locations: extractor.InstalledLocations("testdata/rss-0.2.9.gemspec"),
 				},
 			},
 			wantResultMetric: stats.FileExtractedResultSuccess,
@@ -226,7 +226,7 @@ func TestToPURL(t *testing.T) {
 	i := &extractor.Inventory{
 		Name:      "name",
 		Version:   "1.2.3",
-		Locations: []string{"location"},
+		Locations: extractor.InstalledLocations("location"),
 	}
 	want := &purl.PackageURL{
 		Type:    purl.TypeGem,
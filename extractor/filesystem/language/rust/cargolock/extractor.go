@@ -48,6 +48,11 @@ func (e Extractor) Name() string { return "rust/Cargolock" }
 // Version of the extractor
 func (e Extractor) Version() int { return 0 }
 
+// FileHints returns the file names the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{BaseNames: []string{"Cargo.lock"}}
+}
+
 // FileRequired returns true if the specified file matches Cargo lockfile patterns.
 func (e Extractor) FileRequired(path string, _ fs.FileInfo) bool {
 	return filepath.Base(path) == "Cargo.lock"
@@ -74,7 +79,7 @@ func (e Extractor) Extract(_ context.Context, input *filesystem.ScanInput) ([]*e
 		packages = append(packages, &extractor.Inventory{
 			Name:      lockPackage.Name,
 			Version:   lockPackage.Version,
-			Locations: []string{input.Path},
+			Locations: extractor.DeclaredLocations(input.Path),
 		})
 	}
 
@@ -90,6 +95,9 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	}
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeCargo} }
+
 // Ecosystem returns the OSV ecosystem ('crates.io') of the software extracted by this extractor.
 func (e Extractor) Ecosystem(_ *extractor.Inventory) string {
 	return "crates.io"
@@ -106,7 +106,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "addr2line",
 					Version:   "0.15.2",
-					Locations: []string{"testdata/one-package.lock"},
+					Locations: extractor.DeclaredLocations("testdata/one-package.lock"),
 				},
 			},
 		},
@@ -119,12 +119,12 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "addr2line",
 					Version:   "0.15.2",
-					Locations: []string{"testdata/two-packages.lock"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.lock"),
 				},
 				{
 					Name:      "syn",
 					Version:   "1.0.73",
-					Locations: []string{"testdata/two-packages.lock"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages.lock"),
 				},
 			},
 		},
@@ -137,12 +137,12 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "addr2line",
 					Version:   "0.15.2",
-					Locations: []string{"testdata/two-packages-with-local.lock"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages-with-local.lock"),
 				},
 				{
 					Name:      "local-rust-pkg",
 					Version:   "0.1.0",
-					Locations: []string{"testdata/two-packages-with-local.lock"},
+					Locations: extractor.DeclaredLocations("testdata/two-packages-with-local.lock"),
 				},
 			},
 		},
@@ -155,7 +155,7 @@ func TestExtractor_Extract(t *testing.T) {
 				{
 					Name:      "wasi",
 					Version:   "0.10.2+wasi-snapshot-preview1",
-					Locations: []string{"testdata/package-with-build-string.lock"},
+					Locations: extractor.DeclaredLocations("testdata/package-with-build-string.lock"),
 				},
 			},
 		},
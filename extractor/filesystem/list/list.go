@@ -19,18 +19,28 @@ import (
 	"fmt"
 	"os"
 	"slices"
+	"sort"
 	"strings"
 
 	// OSV extractors.
 	"github.com/google/osv-scanner/pkg/lockfile"
 
 	// SCALIBR internal extractors.
+	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/binary/elfdeps"
+	"github.com/google/osv-scalibr/extractor/filesystem/cicd/githubactions"
 
 	"github.com/google/osv-scalibr/extractor/filesystem/containers/containerd"
+	"github.com/google/osv-scalibr/extractor/filesystem/containers/dockerfile"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/cpp/conanlock"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/cpp/vendoredheaders"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/dart/pubspec"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/depsjson"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/nugetcache"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/nupkg"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/packageslockjson"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/runtimeconfig"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/erlang/mixlock"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/golang/gobinary"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/golang/gomod"
@@ -38,30 +48,39 @@ import (
 	"github.com/google/osv-scalibr/extractor/filesystem/language/java/gradlelockfile"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/java/gradleverificationmetadataxml"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/java/pomxml"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/nodemodules"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/packagejson"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/packagelockjson"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/pnpmlock"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/yarnlock"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/julia/manifest"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/perl/cpanfile"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/perl/metajson"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/php/composerlock"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/python/condameta"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/python/pdmlock"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/python/pipfilelock"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/python/poetrylock"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/python/requirements"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/python/wheelegg"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/r/description"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/r/renvlock"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/ruby/gemspec"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/rust/cargolock"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/apk"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/cos"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/distro"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/dpkg"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/flatpak"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/homebrew"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/macapps"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/rpm"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/snap"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/wmiproducts"
 	"github.com/google/osv-scalibr/extractor/filesystem/osv"
 	"github.com/google/osv-scalibr/extractor/filesystem/sbom/cdx"
 	"github.com/google/osv-scalibr/extractor/filesystem/sbom/spdx"
+	"github.com/google/osv-scalibr/extractor/filesystem/vcs/gitmodules"
 	"github.com/google/osv-scalibr/log"
 	"github.com/google/osv-scalibr/plugin"
 	"github.com/google/osv-scalibr/purl"
@@ -72,7 +91,10 @@ var (
 	// Language extractors.
 
 	// C++ extractors.
-	Cpp []filesystem.Extractor = []filesystem.Extractor{conanlock.Extractor{}}
+	Cpp []filesystem.Extractor = []filesystem.Extractor{
+		conanlock.Extractor{},
+		vendoredheaders.New(vendoredheaders.DefaultConfig()),
+	}
 	// Java extractors.
 	Java []filesystem.Extractor = []filesystem.Extractor{
 		gradlelockfile.Extractor{},
@@ -87,6 +109,10 @@ var (
 		&pnpmlock.Extractor{},
 		&yarnlock.Extractor{},
 	}
+	// NodeModules extractors report installed NPM packages from node_modules trees,
+	// e.g. for vendored deployments without a lockfile. They overlap with the
+	// package.json extractor and thus need to be enabled explicitly.
+	NodeModules []filesystem.Extractor = []filesystem.Extractor{nodemodules.New(nodemodules.DefaultConfig())}
 	// Python extractors.
 	Python []filesystem.Extractor = []filesystem.Extractor{
 		wheelegg.New(wheelegg.DefaultConfig()),
@@ -94,6 +120,7 @@ var (
 		pipfilelock.Extractor{},
 		pdmlock.Extractor{},
 		poetrylock.Extractor{},
+		condameta.Extractor{},
 	}
 	// Go extractors.
 	Go []filesystem.Extractor = []filesystem.Extractor{
@@ -105,7 +132,7 @@ var (
 	// Erlang extractors.
 	Erlang []filesystem.Extractor = []filesystem.Extractor{mixlock.Extractor{}}
 	// R extractors
-	R []filesystem.Extractor = []filesystem.Extractor{renvlock.Extractor{}}
+	R []filesystem.Extractor = []filesystem.Extractor{renvlock.Extractor{}, description.Extractor{}}
 	// Ruby extractors.
 	Ruby []filesystem.Extractor = []filesystem.Extractor{gemspec.New(gemspec.DefaultConfig())}
 	// Rust extractors.
@@ -113,11 +140,28 @@ var (
 	// SBOM extractors.
 	SBOM []filesystem.Extractor = []filesystem.Extractor{&cdx.Extractor{}, &spdx.Extractor{}}
 	// Dotnet (.NET) extractors.
-	Dotnet []filesystem.Extractor = []filesystem.Extractor{packageslockjson.New(packageslockjson.DefaultConfig())}
+	Dotnet []filesystem.Extractor = []filesystem.Extractor{
+		packageslockjson.New(packageslockjson.DefaultConfig()),
+		nugetcache.New(nugetcache.DefaultConfig()),
+		nupkg.New(nupkg.DefaultConfig()),
+		runtimeconfig.Extractor{},
+		depsjson.New(depsjson.DefaultConfig()),
+	}
+	// Julia extractors.
+	Julia []filesystem.Extractor = []filesystem.Extractor{manifest.Extractor{}}
+	// Perl extractors.
+	Perl []filesystem.Extractor = []filesystem.Extractor{cpanfile.Extractor{}, metajson.Extractor{}}
 	// PHP extractors.
 	PHP []filesystem.Extractor = []filesystem.Extractor{&composerlock.Extractor{}}
+	// CI/CD extractors.
+	CICD []filesystem.Extractor = []filesystem.Extractor{githubactions.Extractor{}}
 	// Containers extractors.
-	Containers []filesystem.Extractor = []filesystem.Extractor{containerd.New(containerd.DefaultConfig())}
+	Containers []filesystem.Extractor = []filesystem.Extractor{
+		containerd.New(containerd.DefaultConfig()),
+		dockerfile.Extractor{},
+	}
+	// Version control extractors.
+	VCS []filesystem.Extractor = []filesystem.Extractor{gitmodules.Extractor{}}
 
 	// OS extractors.
 	OS []filesystem.Extractor = []filesystem.Extractor{
@@ -129,6 +173,15 @@ var (
 		flatpak.New(flatpak.DefaultConfig()),
 		homebrew.Extractor{},
 		macapps.New(macapps.DefaultConfig())}
+	// Windows extractors for offline Windows images.
+	Windows []filesystem.Extractor = []filesystem.Extractor{wmiproducts.Extractor{}}
+	// Binary extractors report the shared libraries native binaries are linked
+	// against. Since they report every linked library of every binary, they
+	// need to be enabled explicitly.
+	Binary []filesystem.Extractor = []filesystem.Extractor{elfdeps.New(elfdeps.DefaultConfig())}
+	// Distro extractors report the OS distribution itself rather than the
+	// packages installed on it and thus need to be enabled explicitly.
+	Distro []filesystem.Extractor = []filesystem.Extractor{distro.Extractor{}}
 
 	// Collections of extractors.
 
@@ -143,6 +196,8 @@ var (
 		Go,
 		Dart,
 		Erlang,
+		Julia,
+		Perl,
 		PHP,
 		R,
 		Ruby,
@@ -150,7 +205,10 @@ var (
 		Dotnet,
 		SBOM,
 		OS,
+		Windows,
+		CICD,
 		Containers,
+		VCS,
 	)
 
 	// Untested extractors are OSV extractors without tests.
@@ -168,15 +226,24 @@ var (
 		"go":         Go,
 		"dart":       Dart,
 		"erlang":     Erlang,
+		"julia":      Julia,
 		"r":          R,
 		"ruby":       Ruby,
 		"dotnet":     Dotnet,
+		"perl":       Perl,
 		"php":        PHP,
 		"rust":       Rust,
 
+		"nodemodules": NodeModules,
+		"distro":      Distro,
+		"binary":      Binary,
+
+		"cicd":       CICD,
 		"sbom":       SBOM,
 		"os":         OS,
+		"windows":    Windows,
 		"containers": Containers,
+		"vcs":        VCS,
 
 		// Collections.
 		"default":  Default,
@@ -188,7 +255,7 @@ var (
 // LINT.ThenChange(/docs/supported_inventory_types.md)
 
 func init() {
-	for _, e := range append(All, Untested...) {
+	for _, e := range slices.Concat(All, NodeModules, Binary, Distro, Untested) {
 		register(e)
 	}
 }
@@ -254,3 +321,21 @@ func ExtractorFromName(name string) (filesystem.Extractor, error) {
 	}
 	return es[0], nil
 }
+
+// Descriptors returns the descriptors of all individual extractors that can be
+// enabled by name, sorted by name.
+func Descriptors() []*extractor.Descriptor {
+	result := []*extractor.Descriptor{}
+	seen := make(map[string]bool)
+	for _, es := range extractorNames {
+		for _, e := range es {
+			if seen[e.Name()] {
+				continue
+			}
+			seen[e.Name()] = true
+			result = append(result, extractor.Describe(e))
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
@@ -15,12 +15,16 @@
 package list_test
 
 import (
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
 	el "github.com/google/osv-scalibr/extractor/filesystem/list"
 	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
 )
 
 func TestFromCapabilities(t *testing.T) {
@@ -69,17 +73,17 @@ func TestExtractorsFromNames(t *testing.T) {
 		{
 			desc:     "Find all extractors of a type",
 			names:    []string{"python"},
-			wantExts: []string{"python/pdmlock", "python/Pipfilelock", "python/poetrylock", "python/wheelegg", "python/requirements"},
+			wantExts: []string{"python/pdmlock", "python/Pipfilelock", "python/poetrylock", "python/wheelegg", "python/requirements", "python/condameta"},
 		},
 		{
 			desc:     "Case-insensitive",
 			names:    []string{"Python"},
-			wantExts: []string{"python/pdmlock", "python/Pipfilelock", "python/poetrylock", "python/wheelegg", "python/requirements"},
+			wantExts: []string{"python/pdmlock", "python/Pipfilelock", "python/poetrylock", "python/wheelegg", "python/requirements", "python/condameta"},
 		},
 		{
 			desc:     "Remove duplicates",
 			names:    []string{"python", "python"},
-			wantExts: []string{"python/pdmlock", "python/Pipfilelock", "python/poetrylock", "python/wheelegg", "python/requirements"},
+			wantExts: []string{"python/pdmlock", "python/Pipfilelock", "python/poetrylock", "python/wheelegg", "python/requirements", "python/condameta"},
 		},
 		{
 			desc:     "Nonexistent plugin",
@@ -134,6 +138,11 @@ func TestExtractorFromName(t *testing.T) {
 			name:    "python/Pipfilelock",
 			wantExt: "python/Pipfilelock",
 		},
+		{
+			desc:    "Extractor outside of All",
+			name:    "os/distro",
+			wantExt: "os/distro",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -151,3 +160,29 @@ func TestExtractorFromName(t *testing.T) {
 		})
 	}
 }
+
+func TestDescriptors(t *testing.T) {
+	descs := el.Descriptors()
+	if !slices.IsSortedFunc(descs, func(a, b *extractor.Descriptor) int { return strings.Compare(a.Name, b.Name) }) {
+		t.Errorf("el.Descriptors(): descriptors not sorted by name")
+	}
+
+	want := &extractor.Descriptor{
+		Name:         "dotnet/packageslockjson",
+		Version:      0,
+		PURLTypes:    []string{purl.TypeNuget},
+		Requirements: &plugin.Capabilities{OS: plugin.OSAny},
+	}
+	i := slices.IndexFunc(descs, func(d *extractor.Descriptor) bool { return d.Name == want.Name })
+	if i < 0 {
+		t.Fatalf("el.Descriptors(): %q not included in results, should be", want.Name)
+	}
+	if diff := cmp.Diff(want, descs[i]); diff != "" {
+		t.Errorf("el.Descriptors(): %q got diff (-want +got):\n%s", want.Name, diff)
+	}
+
+	// Extractors only available as part of a group are listed as well.
+	if !slices.ContainsFunc(descs, func(d *extractor.Descriptor) bool { return d.Name == "os/distro" }) {
+		t.Errorf("el.Descriptors(): %q not included in results, should be", "os/distro")
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filesystem
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/google/osv-scalibr/extractor"
+)
+
+// metadataSize returns the size of the inventory's metadata in its JSON
+// encoding, or -1 if the metadata can't be encoded.
+func metadataSize(m any) int {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return -1
+	}
+	return len(b)
+}
+
+// limitMetadata shrinks the inventory's metadata to at most maxBytes of JSON.
+// The longest strings and lists of the metadata are halved until it fits, and
+// metadata that still doesn't fit is dropped. Returns true if the metadata was
+// changed, in which case the inventory is annotated with MetadataTruncated.
+func limitMetadata(inv *extractor.Inventory, maxBytes int) bool {
+	if maxBytes <= 0 || inv.Metadata == nil {
+		return false
+	}
+	size := metadataSize(inv.Metadata)
+	if size <= maxBytes {
+		// Also covers metadata that can't be measured.
+		return false
+	}
+
+	fields := shrinkableFields(reflect.ValueOf(inv.Metadata), nil)
+	for size > maxBytes {
+		f := largestField(fields)
+		if !f.IsValid() {
+			break
+		}
+		halve(f)
+		size = metadataSize(inv.Metadata)
+	}
+	if size > maxBytes || size < 0 {
+		inv.Metadata = nil
+	}
+	if !slices.Contains(inv.Annotations, extractor.MetadataTruncated) {
+		inv.Annotations = append(inv.Annotations, extractor.MetadataTruncated)
+	}
+	return true
+}
+
+// shrinkableFields returns the settable strings and slices of the value,
+// descending into structs and pointers to them.
+func shrinkableFields(v reflect.Value, fields []reflect.Value) []reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			fields = shrinkableFields(v.Elem(), fields)
+		}
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if v.Type().Field(i).IsExported() {
+				fields = shrinkableFields(v.Field(i), fields)
+			}
+		}
+	case reflect.String, reflect.Slice:
+		if v.CanSet() {
+			fields = append(fields, v)
+		}
+	}
+	return fields
+}
+
+// largestField returns the field that takes up the most bytes, or the zero
+// Value if no field can be shrunk any further.
+func largestField(fields []reflect.Value) reflect.Value {
+	var largest reflect.Value
+	largestSize := 0
+	for _, f := range fields {
+		if f.Len() == 0 {
+			continue
+		}
+		if s := metadataSize(f.Interface()); s > largestSize {
+			largest, largestSize = f, s
+		}
+	}
+	return largest
+}
+
+// halve drops the second half of a string or slice.
+func halve(v reflect.Value) {
+	n := v.Len() / 2
+	if v.Kind() == reflect.Slice {
+		v.Set(v.Slice(0, n))
+		return
+	}
+	s := v.String()
+	// Don't cut multi-byte characters in half.
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	v.SetString(s[:n])
+}
@@ -84,8 +84,7 @@ func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabili
 
 // FileRequired returns true if the specified file matches apk status file pattern.
 func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
-	// Should match the status file.
-	if filepath.ToSlash(path) != "lib/apk/db/installed" {
+	if !e.fileMatches(path, fileinfo) {
 		return false
 	}
 
@@ -98,11 +97,25 @@ func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
 	return true
 }
 
+// FileSizeLimitExceeded returns true if the file would be required if it
+// didn't exceed the maximum file size.
+func (e Extractor) FileSizeLimitExceeded(path string, fileinfo fs.FileInfo) bool {
+	return e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes && e.fileMatches(path, fileinfo)
+}
+
+// fileMatches returns true if the file is one the extractor is interested in,
+// regardless of its size.
+func (e Extractor) fileMatches(path string, fileinfo fs.FileInfo) bool {
+	// Should match the status file.
+	return filepath.ToSlash(path) == "lib/apk/db/installed"
+}
+
 func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
 	if e.stats == nil {
 		return
 	}
 	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Extractor:     e.Name(),
 		Path:          path,
 		Result:        result,
 		FileSizeBytes: fileSizeBytes,
@@ -200,9 +213,11 @@ func (e Extractor) extractFromInput(ctx context.Context, input *filesystem.ScanI
 				Architecture: record["A"],
 				License:      record["L"],
 				Maintainer:   record["m"],
+				Provides:     splitNames(record["p"]),
+				Depends:      splitNames(record["D"]),
 			},
 			SourceCode: sourceCode,
-			Locations:  []string{input.Path},
+			Locations:  extractor.InstalledLocations(input.Path),
 		}
 
 		if pkg.Name == "" || pkg.Version == "" {
@@ -216,6 +231,16 @@ func (e Extractor) extractFromInput(ctx context.Context, input *filesystem.ScanI
 	return inventories, nil
 }
 
+// splitNames splits the space separated names of the provides and depends
+// fields, returning nil if there are none.
+func splitNames(field string) []string {
+	names := strings.Fields(field)
+	if len(names) == 0 {
+		return nil
+	}
+	return names
+}
+
 func toNamespace(m *Metadata) string {
 	if m.OSID != "" {
 		return m.OSID
@@ -256,6 +281,9 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	}
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeApk} }
+
 // Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
 func (Extractor) Ecosystem(i *extractor.Inventory) string {
 	version := toDistro(i.Metadata.(*Metadata))
@@ -146,21 +146,31 @@ func TestExtract(t *testing.T) {
 			path:      "testdata/installed",
 			osrelease: alpine,
 			wantInventory: []*extractor.Inventory{
-				getInventory("testdata/installed", "alpine-baselayout", "alpine-baselayout", "3.4.3-r1", "alpine", "3.18.0", "Natanael Copa <ncopa@alpinelinux.org>", "x86_64", "GPL-2.0-only", "65502ca9379dd29d1ac4b0bf0dcf03a3dd1b324a"),
+				withEdges(getInventory("testdata/installed", "alpine-baselayout", "alpine-baselayout", "3.4.3-r1", "alpine", "3.18.0", "Natanael Copa <ncopa@alpinelinux.org>", "x86_64", "GPL-2.0-only", "65502ca9379dd29d1ac4b0bf0dcf03a3dd1b324a"), nil, []string{"alpine-baselayout-data=3.4.3-r1", "/bin/sh"}),
 				getInventory("testdata/installed", "alpine-baselayout-data", "alpine-baselayout", "3.4.3-r1", "alpine", "3.18.0", "Natanael Copa <ncopa@alpinelinux.org>", "x86_64", "GPL-2.0-only", "65502ca9379dd29d1ac4b0bf0dcf03a3dd1b324a"),
 				getInventory("testdata/installed", "alpine-keys", "alpine-keys", "2.4-r1", "alpine", "3.18.0", "Natanael Copa <ncopa@alpinelinux.org>", "x86_64", "MIT", "aab68f8c9ab434a46710de8e12fb3206e2930a59"),
-				getInventory("testdata/installed", "apk-tools", "apk-tools", "2.14.0-r0", "alpine", "3.18.0", "Natanael Copa <ncopa@alpinelinux.org>", "x86_64", "GPL-2.0-only", "028d34f678a5386c3dc488cc3b62467c7a9d1a0b"),
-				getInventory("testdata/installed", "busybox", "busybox", "1.36.0-r9", "alpine", "3.18.0", "Sören Tempel <soeren+alpine@soeren-tempel.net>", "x86_64", "GPL-2.0-only", "b5c719c244319df3c72ab1f1ee994c2143cab7f0"),
-				getInventory("testdata/installed", "busybox-binsh", "busybox", "1.36.0-r9", "alpine", "3.18.0", "Sören Tempel <soeren+alpine@soeren-tempel.net>", "x86_64", "GPL-2.0-only", "b5c719c244319df3c72ab1f1ee994c2143cab7f0"),
-				getInventory("testdata/installed", "ca-certificates-bundle", "ca-certificates", "20230506-r0", "alpine", "3.18.0", "Natanael Copa <ncopa@alpinelinux.org>", "x86_64", "MPL-2.0 AND MIT", "59534a02716a92a10d177a118c34066162eff4a6"),
-				getInventory("testdata/installed", "libc-utils", "libc-dev", "0.7.2-r5", "alpine", "3.18.0", "Natanael Copa <ncopa@alpinelinux.org>", "x86_64", "BSD-2-Clause AND BSD-3-Clause", "988f183cc9d6699930c3e18ccf4a9e36010afb56"),
-				getInventory("testdata/installed", "libcrypto3", "openssl", "3.1.0-r4", "alpine", "3.18.0", "Ariadne Conill <ariadne@dereferenced.org>", "x86_64", "Apache-2.0", "730b75e01c670e3dba5d6c05420b5f605edb6201"),
-				getInventory("testdata/installed", "libssl3", "openssl", "3.1.0-r4", "alpine", "3.18.0", "Ariadne Conill <ariadne@dereferenced.org>", "x86_64", "Apache-2.0", "730b75e01c670e3dba5d6c05420b5f605edb6201"),
-				getInventory("testdata/installed", "musl", "musl", "1.2.4-r0", "alpine", "3.18.0", "Timo Teräs <timo.teras@iki.fi>", "x86_64", "MIT", "b0d8a9d948174e28a4aefcee4ef6be872225ccce"),
-				getInventory("testdata/installed", "musl-utils", "musl", "1.2.4-r0", "alpine", "3.18.0", "Timo Teräs <timo.teras@iki.fi>", "x86_64", "MIT AND BSD-2-Clause AND GPL-2.0-or-later", "b0d8a9d948174e28a4aefcee4ef6be872225ccce"),
-				getInventory("testdata/installed", "scanelf", "pax-utils", "1.3.7-r1", "alpine", "3.18.0", "Natanael Copa <ncopa@alpinelinux.org>", "x86_64", "GPL-2.0-only", "84a227baf001b6e0208e3352b294e4d7a40e93de"),
-				getInventory("testdata/installed", "ssl_client", "busybox", "1.36.0-r9", "alpine", "3.18.0", "Sören Tempel <soeren+alpine@soeren-tempel.net>", "x86_64", "GPL-2.0-only", "b5c719c244319df3c72ab1f1ee994c2143cab7f0"),
-				getInventory("testdata/installed", "zlib", "zlib", "1.2.13-r1", "alpine", "3.18.0", "Natanael Copa <ncopa@alpinelinux.org>", "x86_64", "Zlib", "84a227baf001b6e0208e3352b294e4d7a40e93de"),
+				withEdges(getInventory("testdata/installed", "apk-tools", "apk-tools", "2.14.0-r0", "alpine", "3.18.0", "Natanael Copa <ncopa@alpinelinux.org>", "x86_64", "GPL-2.0-only", "028d34f678a5386c3dc488cc3b62467c7a9d1a0b"), []string{"so:libapk.so.2.14.0=2.14.0", "cmd:apk=2.14.0-r0"}, []string{"musl>=1.2.3_git20230424", "ca-certificates-bundle", "so:libc.musl-x86_64.so.1", "so:libcrypto.so.3", "so:libssl.so.3", "so:libz.so.1"}),
+				withEdges(getInventory("testdata/installed", "busybox", "busybox", "1.36.0-r9", "alpine", "3.18.0", "Sören Tempel <soeren+alpine@soeren-tempel.net>", "x86_64", "GPL-2.0-only", "b5c719c244319df3c72ab1f1ee994c2143cab7f0"), []string{"cmd:busybox=1.36.0-r9"}, []string{"so:libc.musl-x86_64.so.1"}),
+				withEdges(getInventory("testdata/installed", "busybox-binsh", "busybox", "1.36.0-r9", "alpine", "3.18.0", "Sören Tempel <soeren+alpine@soeren-tempel.net>", "x86_64", "GPL-2.0-only", "b5c719c244319df3c72ab1f1ee994c2143cab7f0"), []string{"/bin/sh", "cmd:sh=1.36.0-r9"}, []string{"busybox=1.36.0-r9"}),
+				withEdges(getInventory("testdata/installed", "ca-certificates-bundle", "ca-certificates", "20230506-r0", "alpine", "3.18.0", "Natanael Copa <ncopa@alpinelinux.org>", "x86_64", "MPL-2.0 AND MIT", "59534a02716a92a10d177a118c34066162eff4a6"), []string{"ca-certificates-cacert=20230506-r0"}, nil),
+				withEdges(getInventory("testdata/installed", "libc-utils", "libc-dev", "0.7.2-r5", "alpine", "3.18.0", "Natanael Copa <ncopa@alpinelinux.org>", "x86_64", "BSD-2-Clause AND BSD-3-Clause", "988f183cc9d6699930c3e18ccf4a9e36010afb56"), nil, []string{"musl-utils"}),
+				withEdges(getInventory("testdata/installed", "libcrypto3", "openssl", "3.1.0-r4", "alpine", "3.18.0", "Ariadne Conill <ariadne@dereferenced.org>", "x86_64", "Apache-2.0", "730b75e01c670e3dba5d6c05420b5f605edb6201"), []string{"so:libcrypto.so.3=3"}, []string{"so:libc.musl-x86_64.so.1"}),
+				withEdges(getInventory("testdata/installed", "libssl3", "openssl", "3.1.0-r4", "alpine", "3.18.0", "Ariadne Conill <ariadne@dereferenced.org>", "x86_64", "Apache-2.0", "730b75e01c670e3dba5d6c05420b5f605edb6201"), []string{"so:libssl.so.3=3"}, []string{"so:libc.musl-x86_64.so.1", "so:libcrypto.so.3"}),
+				withEdges(getInventory("testdata/installed", "musl", "musl", "1.2.4-r0", "alpine", "3.18.0", "Timo Teräs <timo.teras@iki.fi>", "x86_64", "MIT", "b0d8a9d948174e28a4aefcee4ef6be872225ccce"), []string{"so:libc.musl-x86_64.so.1=1"}, nil),
+				withEdges(getInventory("testdata/installed", "musl-utils", "musl", "1.2.4-r0", "alpine", "3.18.0", "Timo Teräs <timo.teras@iki.fi>", "x86_64", "MIT AND BSD-2-Clause AND GPL-2.0-or-later", "b0d8a9d948174e28a4aefcee4ef6be872225ccce"), []string{"cmd:getconf=1.2.4-r0", "cmd:getent=1.2.4-r0", "cmd:iconv=1.2.4-r0", "cmd:ldconfig=1.2.4-r0", "cmd:ldd=1.2.4-r0"}, []string{"scanelf", "so:libc.musl-x86_64.so.1"}),
+				withEdges(getInventory("testdata/installed", "scanelf", "pax-utils", "1.3.7-r1", "alpine", "3.18.0", "Natanael Copa <ncopa@alpinelinux.org>", "x86_64", "GPL-2.0-only", "84a227baf001b6e0208e3352b294e4d7a40e93de"), []string{"cmd:scanelf=1.3.7-r1"}, []string{"so:libc.musl-x86_64.so.1"}),
+				withEdges(getInventory("testdata/installed", "ssl_client", "busybox", "1.36.0-r9", "alpine", "3.18.0", "Sören Tempel <soeren+alpine@soeren-tempel.net>", "x86_64", "GPL-2.0-only", "b5c719c244319df3c72ab1f1ee994c2143cab7f0"), []string{"cmd:ssl_client=1.36.0-r9"}, []string{"so:libc.musl-x86_64.so.1", "so:libcrypto.so.3", "so:libssl.so.3"}),
+				withEdges(getInventory("testdata/installed", "zlib", "zlib", "1.2.13-r1", "alpine", "3.18.0", "Natanael Copa <ncopa@alpinelinux.org>", "x86_64", "Zlib", "84a227baf001b6e0208e3352b294e4d7a40e93de"), []string{"so:libz.so.1=1.2.13"}, []string{"so:libc.musl-x86_64.so.1"}),
+			},
+			wantResultMetric: stats.FileExtractedResultSuccess,
+		},
+		{
+			name:      "virtual package provided by one package and depended on by another",
+			path:      "testdata/virtual",
+			osrelease: alpine,
+			wantInventory: []*extractor.Inventory{
+				withEdges(getInventory("testdata/virtual", "libcrypto3", "openssl", "3.1.0-r4", "alpine", "3.18.0", "Ariadne Conill <ariadne@dereferenced.org>", "x86_64", "Apache-2.0", "730b75e01c670e3dba5d6c05420b5f605edb6201"), []string{"so:libcrypto.so.3=3"}, []string{"so:libc.musl-x86_64.so.1"}),
+				withEdges(getInventory("testdata/virtual", "libssl3", "openssl", "3.1.0-r4", "alpine", "3.18.0", "Ariadne Conill <ariadne@dereferenced.org>", "x86_64", "Apache-2.0", "730b75e01c670e3dba5d6c05420b5f605edb6201"), []string{"so:libssl.so.3=3"}, []string{"so:libc.musl-x86_64.so.1", "so:libcrypto.so.3"}),
 			},
 			wantResultMetric: stats.FileExtractedResultSuccess,
 		},
@@ -334,7 +344,7 @@ func TestToPURL(t *testing.T) {
 				Name:      "Name",
 				Version:   "1.2.3",
 				Metadata:  tt.metadata,
-				Locations: []string{"location"},
+				Locations: extractor.InstalledLocations("location"),
 			}
 			got := e.ToPURL(i)
 			if diff := cmp.Diff(tt.want, got); diff != "" {
@@ -377,7 +387,7 @@ func TestEcosystem(t *testing.T) {
 				Name:      "Name",
 				Version:   "1.2.3",
 				Metadata:  tt.metadata,
-				Locations: []string{"location"},
+				Locations: extractor.InstalledLocations("location"),
 			}
 			got := e.Ecosystem(i)
 			if diff := cmp.Diff(tt.want, got); diff != "" {
@@ -389,7 +399,7 @@ func TestEcosystem(t *testing.T) {
 
 func getInventory(path, pkgName, origin, version, osID, osVersionID, maintainer, arch, license string, commit string) *extractor.Inventory {
 	i := &extractor.Inventory{
-		Locations: []string{path},
+		Locations: extractor.InstalledLocations(path),
 		Name:      pkgName,
 		Version:   version,
 		Metadata: &apk.Metadata{
@@ -410,6 +420,14 @@ func getInventory(path, pkgName, origin, version, osID, osVersionID, maintainer,
 	return i
 }
 
+// withEdges sets the provided and depended on names of the inventory.
+func withEdges(i *extractor.Inventory, provides, depends []string) *extractor.Inventory {
+	m := i.Metadata.(*apk.Metadata)
+	m.Provides = provides
+	m.Depends = depends
+	return i
+}
+
 func createOsRelease(t *testing.T, root string, content string) {
 	t.Helper()
 	os.MkdirAll(filepath.Join(root, "etc"), 0755)
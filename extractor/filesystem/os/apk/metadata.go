@@ -23,4 +23,11 @@ type Metadata struct {
 	Maintainer   string
 	Architecture string
 	License      string
+	// Provides are the names the package provides in addition to its own,
+	// e.g. virtual packages like so:libcrypto.so.3 or cmd:openssl.
+	Provides []string
+	// Depends are the names the package depends on, which can refer to
+	// virtual packages provided by other packages. Entries can carry version
+	// constraints like musl>=1.2 or be negated conflicts like !libressl.
+	Depends []string
 }
@@ -97,7 +97,7 @@ func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabili
 
 // FileRequired returns true if the specified file matches cos package info file pattern.
 func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
-	if filepath.ToSlash(path) != "etc/cos-package-info.json" {
+	if !e.fileMatches(path, fileinfo) {
 		return false
 	}
 
@@ -110,11 +110,24 @@ func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
 	return true
 }
 
+// FileSizeLimitExceeded returns true if the file would be required if it
+// didn't exceed the maximum file size.
+func (e Extractor) FileSizeLimitExceeded(path string, fileinfo fs.FileInfo) bool {
+	return e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes && e.fileMatches(path, fileinfo)
+}
+
+// fileMatches returns true if the file is one the extractor is interested in,
+// regardless of its size.
+func (e Extractor) fileMatches(path string, fileinfo fs.FileInfo) bool {
+	return filepath.ToSlash(path) == "etc/cos-package-info.json"
+}
+
 func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
 	if e.stats == nil {
 		return
 	}
 	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Extractor:     e.Name(),
 		Path:          path,
 		Result:        result,
 		FileSizeBytes: fileSizeBytes,
@@ -167,7 +180,7 @@ func (e Extractor) extractFromInput(ctx context.Context, input *filesystem.ScanI
 				OSVersion:   m["VERSION"],
 				OSVersionID: m["VERSION_ID"],
 			},
-			Locations: []string{input.Path},
+			Locations: extractor.InstalledLocations(input.Path),
 		}
 		inventory = append(inventory, i)
 	}
@@ -204,5 +217,8 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	}
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeCOS} }
+
 // Ecosystem returns no Ecosystem since the ecosystem is not known by OSV yet.
 func (e Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
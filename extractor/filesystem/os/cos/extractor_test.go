@@ -158,7 +158,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "python-exec",
 					Version:   "17162.336.16",
-					Locations: []string{"testdata/single.json"},
+					Locations: extractor.InstalledLocations("testdata/single.json"),
 					Metadata: &cos.Metadata{
 						Name:        "python-exec",
 						Version:     "17162.336.16",
@@ -178,7 +178,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "python-exec",
 					Version:   "17162.336.16",
-					Locations: []string{"testdata/multiple.json"},
+					Locations: extractor.InstalledLocations("testdata/multiple.json"),
 					Metadata: &cos.Metadata{
 						Name:        "python-exec",
 						Version:     "17162.336.16",
@@ -190,7 +190,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "zlib",
 					Version:   "17162.336.17",
-					Locations: []string{"testdata/multiple.json"},
+					Locations: extractor.InstalledLocations("testdata/multiple.json"),
 					Metadata: &cos.Metadata{
 						Name:        "zlib",
 						Version:     "17162.336.17",
@@ -202,7 +202,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "baselayout",
 					Version:   "17162.336.18",
-					Locations: []string{"testdata/multiple.json"},
+					Locations: extractor.InstalledLocations("testdata/multiple.json"),
 					Metadata: &cos.Metadata{
 						Name:        "baselayout",
 						Version:     "17162.336.18",
@@ -214,7 +214,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "ncurses",
 					Version:   "17162.336.19",
-					Locations: []string{"testdata/multiple.json"},
+					Locations: extractor.InstalledLocations("testdata/multiple.json"),
 					Metadata: &cos.Metadata{
 						Name:        "ncurses",
 						Version:     "17162.336.19",
@@ -234,7 +234,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "python-exec",
 					Version:   "17162.336.16",
-					Locations: []string{"testdata/single.json"},
+					Locations: extractor.InstalledLocations("testdata/single.json"),
 					Metadata: &cos.Metadata{
 						Name:      "python-exec",
 						Version:   "17162.336.16",
@@ -252,7 +252,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "python-exec",
 					Version:   "17162.336.16",
-					Locations: []string{"testdata/single.json"},
+					Locations: extractor.InstalledLocations("testdata/single.json"),
 					Metadata: &cos.Metadata{
 						Name:     "python-exec",
 						Version:  "17162.336.16",
@@ -383,7 +383,7 @@ func TestToPURL(t *testing.T) {
 				Name:      "name",
 				Version:   "1.2.3",
 				Metadata:  tt.metadata,
-				Locations: []string{"location"},
+				Locations: extractor.InstalledLocations("location"),
 			}
 			got := e.ToPURL(i)
 			if diff := cmp.Diff(tt.want, got); diff != "" {
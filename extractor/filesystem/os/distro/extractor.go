@@ -0,0 +1,236 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package distro extracts the operating system itself as inventory from the
+// os-release file.
+package distro
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/osrelease"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "os/distro"
+
+	etcOSRelease = "etc/os-release"
+	libOSRelease = "usr/lib/os-release"
+)
+
+// cpeVendorProduct maps os-release IDs to the vendor and product used in the
+// NVD CPE dictionary.
+var cpeVendorProduct = map[string]string{
+	"almalinux":     "almalinux:almalinux",
+	"alpine":        "alpinelinux:alpine_linux",
+	"amzn":          "amazon:amazon_linux",
+	"centos":        "centos:centos",
+	"debian":        "debian:debian_linux",
+	"fedora":        "fedoraproject:fedora",
+	"opensuse-leap": "opensuse:leap",
+	"rhel":          "redhat:enterprise_linux",
+	"rocky":         "rockylinux:rocky_linux",
+	"sles":          "suse:linux_enterprise_server",
+	"ubuntu":        "canonical:ubuntu_linux",
+}
+
+// ecosystems maps os-release IDs to OSV ecosystems.
+var ecosystems = map[string]string{
+	"alpine": "Alpine",
+	"debian": "Debian",
+	"rhel":   "Red Hat",
+	"rocky":  "Rocky Linux",
+	"ubuntu": "Ubuntu",
+}
+
+// releaseEcosystems are the ecosystems whose advisories are per release, e.g. "Debian:12".
+var releaseEcosystems = map[string]bool{
+	"Alpine": true,
+	"Debian": true,
+	"Ubuntu": true,
+}
+
+// Extractor extracts the operating system described by the os-release file.
+type Extractor struct{}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileRequired returns true if the specified file is an os-release file.
+func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
+	path = filepath.ToSlash(path)
+	return path == etcOSRelease || path == libOSRelease
+}
+
+// Extract returns the operating system described by the os-release file.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	// /etc/os-release takes precedence and is usually a symlink to
+	// /usr/lib/os-release. Only report the latter if it's not shadowed by a
+	// regular /etc/os-release file to avoid duplicates.
+	if filepath.ToSlash(input.Path) == libOSRelease && hasRegularFile(input.FS, etcOSRelease) {
+		return nil, nil
+	}
+
+	m, err := osrelease.GetOSRelease(input.FS)
+	if err != nil {
+		return nil, err
+	}
+	id := m["ID"]
+	if id == "" {
+		// The default according to os-release(5).
+		id = "linux"
+	}
+	metadata := &Metadata{
+		ID:              id,
+		VersionID:       m["VERSION_ID"],
+		VersionCodename: m["VERSION_CODENAME"],
+		PrettyName:      m["PRETTY_NAME"],
+	}
+	if idLike := m["ID_LIKE"]; idLike != "" {
+		metadata.IDLike = strings.Fields(idLike)
+	}
+	metadata.CPE = toCPE(metadata, m["CPE_NAME"])
+	return []*extractor.Inventory{{
+		Name:      id,
+		Version:   m["VERSION_ID"],
+		Metadata:  metadata,
+		Locations: extractor.LocationsFromPaths(input.Path),
+	}}, nil
+}
+
+func hasRegularFile(fsys fs.FS, path string) bool {
+	if fsys == nil {
+		return false
+	}
+	entries, err := fs.ReadDir(fsys, filepath.Dir(path))
+	if err != nil {
+		return false
+	}
+	i := slices.IndexFunc(entries, func(e fs.DirEntry) bool { return e.Name() == filepath.Base(path) })
+	return i >= 0 && entries[i].Type().IsRegular()
+}
+
+// toCPE returns the CPE 2.3 name of the OS. The CPE_NAME from os-release is
+// preferred, otherwise it's derived from the ID for well-known distributions.
+// Derivatives get the CPE of their parent without a version since their
+// version numbers don't match the parent's releases.
+func toCPE(m *Metadata, cpeName string) string {
+	if cpeName != "" {
+		return cpe22To23(cpeName)
+	}
+	version := m.VersionID
+	vendorProduct, ok := cpeVendorProduct[m.ID]
+	if !ok {
+		for _, like := range m.IDLike {
+			if vendorProduct, ok = cpeVendorProduct[like]; ok {
+				break
+			}
+		}
+		if !ok {
+			return ""
+		}
+		version = ""
+	}
+	if version == "" {
+		version = "*"
+	}
+	return "cpe:2.3:o:" + vendorProduct + ":" + version + ":*:*:*:*:*:*:*"
+}
+
+// cpe22To23 converts a CPE 2.2 URI such as "cpe:/o:fedoraproject:fedora:39"
+// into the CPE 2.3 formatted string binding. Other strings are returned as-is.
+func cpe22To23(uri string) string {
+	rest, ok := strings.CutPrefix(uri, "cpe:/")
+	if !ok {
+		return uri
+	}
+	parts := strings.Split(rest, ":")
+	if len(parts) > 11 {
+		return uri
+	}
+	for i, p := range parts {
+		if p == "" {
+			parts[i] = "*"
+		}
+	}
+	for len(parts) < 11 {
+		parts = append(parts, "*")
+	}
+	return "cpe:2.3:" + strings.Join(parts, ":")
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeGeneric} }
+
+// ToCPEs returns the CPE of the distribution, if known.
+func (e Extractor) ToCPEs(i *extractor.Inventory) []string {
+	if m, ok := i.Metadata.(*Metadata); ok && m.CPE != "" {
+		return []string{m.CPE}
+	}
+	return nil
+}
+
+// Ecosystem returns the OSV Ecosystem of the distribution. Derivatives of
+// supported distributions get the ecosystem of their parent, without a release.
+func (Extractor) Ecosystem(i *extractor.Inventory) string {
+	m := i.Metadata.(*Metadata)
+	e, ok := ecosystems[m.ID]
+	if !ok {
+		for _, like := range m.IDLike {
+			if e, ok := ecosystems[like]; ok {
+				return e
+			}
+		}
+		return ""
+	}
+	switch {
+	case m.VersionID == "" || !releaseEcosystems[e]:
+		return e
+	case e == "Alpine":
+		return e + ":v" + majorMinor(m.VersionID)
+	default:
+		return e + ":" + m.VersionID
+	}
+}
+
+func majorMinor(v string) string {
+	parts := strings.SplitN(v, ".", 3)
+	return strings.Join(parts[:min(len(parts), 2)], ".")
+}
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,218 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distro_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/distro"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/testing/extracttest"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "etc/os-release", want: true},
+		{path: "usr/lib/os-release", want: true},
+		{path: "etc/lsb-release", want: false},
+		{path: "home/user/etc/os-release", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			e := distro.Extractor{}
+			if got := e.FileRequired(tt.path, nil); got != tt.want {
+				t.Errorf("FileRequired(%s, FileInfo) got = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		extracttest.TestTableEntry
+		wantEcosystem string
+	}{
+		{
+			TestTableEntry: extracttest.TestTableEntry{
+				Name: "debian",
+				InputConfig: extracttest.ScanInputMockConfig{
+					Path:         "etc/os-release",
+					FakeScanRoot: "testdata/debian",
+				},
+				WantInventory: []*extractor.Inventory{{
+					Name:    "debian",
+					Version: "12",
+					Metadata: &distro.Metadata{
+						ID:              "debian",
+						VersionID:       "12",
+						VersionCodename: "bookworm",
+						PrettyName:      "Debian GNU/Linux 12 (bookworm)",
+						CPE:             "cpe:2.3:o:debian:debian_linux:12:*:*:*:*:*:*:*",
+					},
+					Locations: extractor.LocationsFromPaths("etc/os-release"),
+				}},
+			},
+			wantEcosystem: "Debian:12",
+		},
+		{
+			TestTableEntry: extracttest.TestTableEntry{
+				Name: "ubuntu",
+				InputConfig: extracttest.ScanInputMockConfig{
+					Path:         "etc/os-release",
+					FakeScanRoot: "testdata/ubuntu",
+				},
+				WantInventory: []*extractor.Inventory{{
+					Name:    "ubuntu",
+					Version: "22.04",
+					Metadata: &distro.Metadata{
+						ID:              "ubuntu",
+						IDLike:          []string{"debian"},
+						VersionID:       "22.04",
+						VersionCodename: "jammy",
+						PrettyName:      "Ubuntu 22.04.4 LTS",
+						CPE:             "cpe:2.3:o:canonical:ubuntu_linux:22.04:*:*:*:*:*:*:*",
+					},
+					Locations: extractor.LocationsFromPaths("etc/os-release"),
+				}},
+			},
+			wantEcosystem: "Ubuntu:22.04",
+		},
+		{
+			TestTableEntry: extracttest.TestTableEntry{
+				Name: "alpine",
+				InputConfig: extracttest.ScanInputMockConfig{
+					Path:         "etc/os-release",
+					FakeScanRoot: "testdata/alpine",
+				},
+				WantInventory: []*extractor.Inventory{{
+					Name:    "alpine",
+					Version: "3.18.4",
+					Metadata: &distro.Metadata{
+						ID:         "alpine",
+						VersionID:  "3.18.4",
+						PrettyName: "Alpine Linux v3.18",
+						CPE:        "cpe:2.3:o:alpinelinux:alpine_linux:3.18.4:*:*:*:*:*:*:*",
+					},
+					Locations: extractor.LocationsFromPaths("etc/os-release"),
+				}},
+			},
+			wantEcosystem: "Alpine:v3.18",
+		},
+		{
+			TestTableEntry: extracttest.TestTableEntry{
+				Name: "derivative",
+				InputConfig: extracttest.ScanInputMockConfig{
+					Path:         "etc/os-release",
+					FakeScanRoot: "testdata/linuxmint",
+				},
+				WantInventory: []*extractor.Inventory{{
+					Name:    "linuxmint",
+					Version: "21.3",
+					Metadata: &distro.Metadata{
+						ID:              "linuxmint",
+						IDLike:          []string{"ubuntu", "debian"},
+						VersionID:       "21.3",
+						VersionCodename: "virginia",
+						PrettyName:      "Linux Mint 21.3",
+						CPE:             "cpe:2.3:o:canonical:ubuntu_linux:*:*:*:*:*:*:*:*",
+					},
+					Locations: extractor.LocationsFromPaths("etc/os-release"),
+				}},
+			},
+			wantEcosystem: "Ubuntu",
+		},
+		{
+			TestTableEntry: extracttest.TestTableEntry{
+				Name: "cpe from os-release",
+				InputConfig: extracttest.ScanInputMockConfig{
+					Path:         "usr/lib/os-release",
+					FakeScanRoot: "testdata/fedora",
+				},
+				WantInventory: []*extractor.Inventory{{
+					Name:    "fedora",
+					Version: "39",
+					Metadata: &distro.Metadata{
+						ID:         "fedora",
+						VersionID:  "39",
+						PrettyName: "Fedora Linux 39 (Container Image)",
+						CPE:        "cpe:2.3:o:fedoraproject:fedora:39:*:*:*:*:*:*:*",
+					},
+					Locations: extractor.LocationsFromPaths("usr/lib/os-release"),
+				}},
+			},
+		},
+		{
+			TestTableEntry: extracttest.TestTableEntry{
+				Name: "usr/lib/os-release shadowed by /etc/os-release",
+				InputConfig: extracttest.ScanInputMockConfig{
+					Path:         "usr/lib/os-release",
+					FakeScanRoot: "testdata/shadowed",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := distro.Extractor{}
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+
+			for _, i := range got {
+				if eco := extr.Ecosystem(i); eco != tt.wantEcosystem {
+					t.Errorf("%s.Ecosystem(%v) = %q, want %q", extr.Name(), i, eco, tt.wantEcosystem)
+				}
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := distro.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "debian",
+		Version:   "12",
+		Locations: extractor.LocationsFromPaths("etc/os-release"),
+	}
+	want := &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    "debian",
+		Version: "12",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
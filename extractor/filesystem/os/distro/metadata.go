@@ -0,0 +1,28 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distro
+
+// Metadata holds the os-release fields describing the distribution.
+type Metadata struct {
+	// ID is the lower-case identifier of the distribution, e.g. "debian".
+	ID string `json:"id"`
+	// IDLike lists the distributions this one is derived from, closest first.
+	IDLike          []string `json:"idLike,omitempty"`
+	VersionID       string   `json:"versionId,omitempty"`
+	VersionCodename string   `json:"versionCodename,omitempty"`
+	PrettyName      string   `json:"prettyName,omitempty"`
+	// CPE in the CPE 2.3 formatted string binding, if known.
+	CPE string `json:"cpe,omitempty"`
+}
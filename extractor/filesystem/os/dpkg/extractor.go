@@ -12,11 +12,15 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Package dpkg extracts packages from dpkg database.
+// Package dpkg extracts packages from dpkg database: the var/lib/dpkg/status
+// file and the per-package status files in var/lib/dpkg/status.d that
+// distroless images ship instead.
 package dpkg
 
 import (
 	"bufio"
+	"bytes"
+	"compress/gzip"
 	"context"
 	"errors"
 	"fmt"
@@ -124,11 +128,17 @@ func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
 	return true
 }
 
+// FileSizeLimitExceeded returns true if the file would be required if it
+// didn't exceed the maximum file size.
+func (e Extractor) FileSizeLimitExceeded(path string, fileinfo fs.FileInfo) bool {
+	return e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes && fileRequired(path)
+}
+
 func fileRequired(path string) bool {
 	normalized := filepath.ToSlash(path)
 
-	// Normal status file
-	if normalized == "var/lib/dpkg/status" {
+	// Normal status file, possibly gzipped
+	if normalized == "var/lib/dpkg/status" || normalized == "var/lib/dpkg/status.gz" {
 		return true
 	}
 
@@ -141,6 +151,7 @@ func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result s
 		return
 	}
 	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Extractor:     e.Name(),
 		Path:          path,
 		Result:        result,
 		FileSizeBytes: fileSizeBytes,
@@ -170,7 +181,11 @@ func (e Extractor) extractFromInput(ctx context.Context, input *filesystem.ScanI
 		log.Errorf("osrelease.ParseOsRelease(): %v", err)
 	}
 
-	rd := textproto.NewReader(bufio.NewReader(input.Reader))
+	r, err := decompressed(input.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("%s could not decompress %q: %w", e.Name(), input.Path, err)
+	}
+	rd := textproto.NewReader(r)
 	pkgs := []*extractor.Inventory{}
 	for eof := false; !eof; {
 		// Return if canceled or exceeding deadline.
@@ -241,7 +256,7 @@ func (e Extractor) extractFromInput(ctx context.Context, input *filesystem.ScanI
 				Maintainer:        h.Get("Maintainer"),
 				Architecture:      h.Get("Architecture"),
 			},
-			Locations:   []string{input.Path},
+			Locations:   extractor.InstalledLocations(input.Path),
 			Annotations: annotations,
 		}
 		sourceName, sourceVersion, err := parseSourceNameVersion(h.Get("Source"))
@@ -258,6 +273,26 @@ func (e Extractor) extractFromInput(ctx context.Context, input *filesystem.ScanI
 	return pkgs, nil
 }
 
+// gzipMagic are the first bytes of gzip-compressed files.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// decompressed returns a reader of the status file's contents, which are
+// transparently decompressed if the file is gzipped. Compression is detected by
+// the file's magic bytes rather than its name.
+func decompressed(r io.Reader) (*bufio.Reader, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(len(gzipMagic))
+	if err != nil || !bytes.Equal(magic, gzipMagic) {
+		// Files too short to be gzipped are left to the status parser.
+		return br, nil
+	}
+	gz, err := gzip.NewReader(br)
+	if err != nil {
+		return nil, err
+	}
+	return bufio.NewReader(gz), nil
+}
+
 func statusInstalled(status string) (bool, error) {
 	// Status field format: "want flag status", e.g. "install ok installed"
 	// The package is currently installed if the status field is set to installed.
@@ -334,6 +369,9 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	}
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeDebian} }
+
 // Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
 func (Extractor) Ecosystem(i *extractor.Inventory) string {
 	m := i.Metadata.(*Metadata)
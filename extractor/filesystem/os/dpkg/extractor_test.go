@@ -90,6 +90,12 @@ func TestFileRequired(t *testing.T) {
 			wantRequired:     true,
 			wantResultMetric: stats.FileRequiredResultOK,
 		},
+		{
+			name:             "gzipped status file",
+			path:             "var/lib/dpkg/status.gz",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
 		{
 			name:             "file in status.d",
 			path:             "var/lib/dpkg/status.d/foo",
@@ -209,7 +215,7 @@ func TestExtract(t *testing.T) {
 						Maintainer:        "Debian freedesktop.org maintainers <pkg-freedesktop-maintainers@lists.alioth.debian.org>",
 						Architecture:      "amd64",
 					},
-					Locations: []string{"testdata/valid"},
+					Locations: extractor.InstalledLocations("testdata/valid"),
 				},
 				{
 					Name:    "acl",
@@ -224,7 +230,7 @@ func TestExtract(t *testing.T) {
 						Maintainer:        "Guillem Jover <guillem@debian.org>",
 						Architecture:      "amd64",
 					},
-					Locations: []string{"testdata/valid"},
+					Locations: extractor.InstalledLocations("testdata/valid"),
 				},
 				{
 					Name:    "adduser",
@@ -239,7 +245,7 @@ func TestExtract(t *testing.T) {
 						Maintainer:        "Debian Adduser Developers <adduser@packages.debian.org>",
 						Architecture:      "all",
 					},
-					Locations: []string{"testdata/valid"},
+					Locations: extractor.InstalledLocations("testdata/valid"),
 				},
 				{
 					Name:    "admin-session",
@@ -254,7 +260,7 @@ func TestExtract(t *testing.T) {
 						Maintainer:        "nobody@google.com",
 						Architecture:      "amd64",
 					},
-					Locations: []string{"testdata/valid"},
+					Locations: extractor.InstalledLocations("testdata/valid"),
 				},
 				{
 					Name:    "attr",
@@ -269,7 +275,7 @@ func TestExtract(t *testing.T) {
 						Maintainer:        "Guillem Jover <guillem@debian.org>",
 						Architecture:      "amd64",
 					},
-					Locations: []string{"testdata/valid"},
+					Locations: extractor.InstalledLocations("testdata/valid"),
 				},
 				// Expect source name.
 				{
@@ -286,7 +292,7 @@ func TestExtract(t *testing.T) {
 						Maintainer:        "Guillem Jover <guillem@debian.org>",
 						Architecture:      "amd64",
 					},
-					Locations: []string{"testdata/valid"},
+					Locations: extractor.InstalledLocations("testdata/valid"),
 				},
 				// Expect source name and version.
 				{
@@ -304,7 +310,7 @@ func TestExtract(t *testing.T) {
 						Maintainer:        "util-linux packagers <util-linux@packages.debian.org>",
 						Architecture:      "amd64",
 					},
-					Locations: []string{"testdata/valid"},
+					Locations: extractor.InstalledLocations("testdata/valid"),
 				},
 			},
 			wantResultMetric: stats.FileExtractedResultSuccess,
@@ -325,7 +331,7 @@ func TestExtract(t *testing.T) {
 						OSVersionCodename: "bookworm",
 						OSVersionID:       "12",
 					},
-					Locations: []string{"testdata/noversion"},
+					Locations: extractor.InstalledLocations("testdata/noversion"),
 				},
 				{
 					Name:    "bar",
@@ -338,7 +344,7 @@ func TestExtract(t *testing.T) {
 						OSVersionCodename: "bookworm",
 						OSVersionID:       "12",
 					},
-					Locations: []string{"testdata/noversion"},
+					Locations: extractor.InstalledLocations("testdata/noversion"),
 				},
 			},
 			wantResultMetric: stats.FileExtractedResultSuccess,
@@ -360,7 +366,7 @@ func TestExtract(t *testing.T) {
 						OSVersionCodename: "bookworm",
 						OSVersionID:       "12",
 					},
-					Locations: []string{"testdata/nopackage"},
+					Locations: extractor.InstalledLocations("testdata/nopackage"),
 				},
 				{
 					Name:    "bar",
@@ -373,7 +379,7 @@ func TestExtract(t *testing.T) {
 						OSVersionCodename: "bookworm",
 						OSVersionID:       "12",
 					},
-					Locations: []string{"testdata/nopackage"},
+					Locations: extractor.InstalledLocations("testdata/nopackage"),
 				},
 			},
 			wantResultMetric: stats.FileExtractedResultSuccess,
@@ -395,7 +401,7 @@ func TestExtract(t *testing.T) {
 						OSVersionCodename: "bookworm",
 						OSVersionID:       "12",
 					},
-					Locations: []string{"testdata/statusfield"},
+					Locations: extractor.InstalledLocations("testdata/statusfield"),
 				},
 				{
 					Name:    "wantdeinstall_installed",
@@ -408,7 +414,7 @@ func TestExtract(t *testing.T) {
 						OSVersionCodename: "bookworm",
 						OSVersionID:       "12",
 					},
-					Locations: []string{"testdata/statusfield"},
+					Locations: extractor.InstalledLocations("testdata/statusfield"),
 				},
 				{
 					Name:    "wantpurge_installed",
@@ -421,7 +427,7 @@ func TestExtract(t *testing.T) {
 						OSVersionCodename: "bookworm",
 						OSVersionID:       "12",
 					},
-					Locations: []string{"testdata/statusfield"},
+					Locations: extractor.InstalledLocations("testdata/statusfield"),
 				},
 			},
 			wantResultMetric: stats.FileExtractedResultSuccess,
@@ -446,7 +452,7 @@ func TestExtract(t *testing.T) {
 						OSVersionCodename: "bookworm",
 						OSVersionID:       "12",
 					},
-					Locations: []string{"testdata/statusfield"},
+					Locations: extractor.InstalledLocations("testdata/statusfield"),
 				},
 				{
 					Name:    "wantdeinstall_installed",
@@ -459,7 +465,7 @@ func TestExtract(t *testing.T) {
 						OSVersionCodename: "bookworm",
 						OSVersionID:       "12",
 					},
-					Locations: []string{"testdata/statusfield"},
+					Locations: extractor.InstalledLocations("testdata/statusfield"),
 				},
 				{
 					Name:    "wantdeinstall_configfiles",
@@ -472,7 +478,7 @@ func TestExtract(t *testing.T) {
 						OSVersionCodename: "bookworm",
 						OSVersionID:       "12",
 					},
-					Locations: []string{"testdata/statusfield"},
+					Locations: extractor.InstalledLocations("testdata/statusfield"),
 				},
 				{
 					Name:    "wantinstall_unpacked",
@@ -485,7 +491,7 @@ func TestExtract(t *testing.T) {
 						OSVersionCodename: "bookworm",
 						OSVersionID:       "12",
 					},
-					Locations: []string{"testdata/statusfield"},
+					Locations: extractor.InstalledLocations("testdata/statusfield"),
 				},
 				{
 					Name:    "wantpurge_installed",
@@ -498,7 +504,7 @@ func TestExtract(t *testing.T) {
 						OSVersionCodename: "bookworm",
 						OSVersionID:       "12",
 					},
-					Locations: []string{"testdata/statusfield"},
+					Locations: extractor.InstalledLocations("testdata/statusfield"),
 				},
 				{
 					Name:    "wantinstall_halfinstalled",
@@ -511,7 +517,7 @@ func TestExtract(t *testing.T) {
 						OSVersionCodename: "bookworm",
 						OSVersionID:       "12",
 					},
-					Locations: []string{"testdata/statusfield"},
+					Locations: extractor.InstalledLocations("testdata/statusfield"),
 				},
 				{
 					Name:    "wantnostatus",
@@ -523,7 +529,7 @@ func TestExtract(t *testing.T) {
 						OSVersionCodename: "bookworm",
 						OSVersionID:       "12",
 					},
-					Locations: []string{"testdata/statusfield"},
+					Locations: extractor.InstalledLocations("testdata/statusfield"),
 				},
 			},
 			wantResultMetric: stats.FileExtractedResultSuccess,
@@ -543,6 +549,37 @@ func TestExtract(t *testing.T) {
 			wantErr:          cmpopts.AnyError,
 			wantResultMetric: stats.FileExtractedResultErrorUnknown,
 		},
+		{
+			name:      "gzipped status file",
+			path:      "testdata/gzipped",
+			osrelease: DebianBookworm,
+			wantInventory: []*extractor.Inventory{
+				{
+					Name:    "acl",
+					Version: "2.3.1-3",
+					Metadata: &dpkg.Metadata{
+						PackageName:       "acl",
+						PackageVersion:    "2.3.1-3",
+						Status:            "install ok installed",
+						OSID:              "debian",
+						OSVersionCodename: "bookworm",
+						OSVersionID:       "12",
+						Maintainer:        "Guillem Jover <guillem@debian.org>",
+						Architecture:      "amd64",
+					},
+					Locations: extractor.InstalledLocations("testdata/gzipped"),
+				},
+			},
+			wantResultMetric: stats.FileExtractedResultSuccess,
+		},
+		{
+			name:             "truncated gzipped status file",
+			path:             "testdata/gzipped_truncated",
+			osrelease:        DebianBookworm,
+			wantInventory:    []*extractor.Inventory{},
+			wantErr:          cmpopts.AnyError,
+			wantResultMetric: stats.FileExtractedResultErrorUnknown,
+		},
 		{
 			name: "VERSION_CODENAME not set, fallback to VERSION_ID",
 			path: "testdata/single",
@@ -561,7 +598,7 @@ func TestExtract(t *testing.T) {
 						Maintainer:     "Guillem Jover <guillem@debian.org>",
 						Architecture:   "amd64",
 					},
-					Locations: []string{"testdata/single"},
+					Locations: extractor.InstalledLocations("testdata/single"),
 				},
 			},
 			wantResultMetric: stats.FileExtractedResultSuccess,
@@ -582,7 +619,7 @@ func TestExtract(t *testing.T) {
 						Maintainer:     "Guillem Jover <guillem@debian.org>",
 						Architecture:   "amd64",
 					},
-					Locations: []string{"testdata/single"},
+					Locations: extractor.InstalledLocations("testdata/single"),
 				},
 			},
 			wantResultMetric: stats.FileExtractedResultSuccess,
@@ -603,7 +640,7 @@ func TestExtract(t *testing.T) {
 						Maintainer:        "Guillem Jover <guillem@debian.org>",
 						Architecture:      "amd64",
 					},
-					Locations: []string{"testdata/single"},
+					Locations: extractor.InstalledLocations("testdata/single"),
 				},
 			},
 			wantResultMetric: stats.FileExtractedResultSuccess,
@@ -629,7 +666,7 @@ func TestExtract(t *testing.T) {
 						Maintainer:        "Guillem Jover <guillem@debian.org>",
 						Architecture:      "amd64",
 					},
-					Locations: []string{"testdata/single"},
+					Locations: extractor.InstalledLocations("testdata/single"),
 				},
 			},
 			wantResultMetric: stats.FileExtractedResultSuccess,
@@ -655,7 +692,7 @@ func TestExtract(t *testing.T) {
 						Maintainer:        "Guillem Jover <guillem@debian.org>",
 						Architecture:      "amd64",
 					},
-					Locations: []string{"testdata/trailingnewlines"},
+					Locations: extractor.InstalledLocations("testdata/trailingnewlines"),
 				},
 			},
 			wantResultMetric: stats.FileExtractedResultSuccess,
@@ -679,7 +716,7 @@ func TestExtract(t *testing.T) {
 						Maintainer:        "someone",
 						Architecture:      "amd64",
 					},
-					Locations: []string{"testdata/status.d/foo"},
+					Locations: extractor.InstalledLocations("testdata/status.d/foo"),
 				},
 			},
 			wantResultMetric: stats.FileExtractedResultSuccess,
@@ -711,12 +748,37 @@ func TestExtract(t *testing.T) {
 						Maintainer:        "Maintainers of Mozilla-related packages <team+pkg-mozilla@tracker.debian.org>",
 						Architecture:      "all",
 					},
-					Locations:   []string{"testdata/transitional"},
+					Locations:   extractor.InstalledLocations("testdata/transitional"),
 					Annotations: []extractor.Annotation{extractor.Transitional},
 				},
 			},
 			wantResultMetric: stats.FileExtractedResultSuccess,
 		},
+		{
+			name:      "binary package built from differently named source package",
+			path:      "testdata/sourcepackage",
+			osrelease: DebianBookworm,
+			wantInventory: []*extractor.Inventory{
+				{
+					Name:    "libssl3",
+					Version: "3.0.11-1~deb12u2+b1",
+					Metadata: &dpkg.Metadata{
+						PackageName:       "libssl3",
+						Status:            "install ok installed",
+						PackageVersion:    "3.0.11-1~deb12u2+b1",
+						SourceName:        "openssl",
+						SourceVersion:     "3.0.11-1~deb12u2",
+						OSID:              "debian",
+						OSVersionCodename: "bookworm",
+						OSVersionID:       "12",
+						Maintainer:        "Debian OpenSSL Team <pkg-openssl-devel@alioth-lists.debian.net>",
+						Architecture:      "amd64",
+					},
+					Locations: extractor.InstalledLocations("testdata/sourcepackage"),
+				},
+			},
+			wantResultMetric: stats.FileExtractedResultSuccess,
+		},
 	}
 
 	for _, tt := range tests {
@@ -854,7 +916,7 @@ func TestExtractNonexistentOSRelease(t *testing.T) {
 				Maintainer:     "Guillem Jover <guillem@debian.org>",
 				Architecture:   "amd64",
 			},
-			Locations: []string{path},
+			Locations: extractor.InstalledLocations(path),
 		},
 	}
 
@@ -885,6 +947,58 @@ func TestExtractNonexistentOSRelease(t *testing.T) {
 	}
 }
 
+func TestExtractDistrolessStatusD(t *testing.T) {
+	// Distroless images have no monolithic status file and instead keep one
+	// status stanza per package in var/lib/dpkg/status.d.
+	root := "testdata/distroless"
+	osMetadata := func(name, version, maintainer, arch string) *dpkg.Metadata {
+		return &dpkg.Metadata{
+			PackageName:       name,
+			PackageVersion:    version,
+			OSID:              "debian",
+			OSVersionCodename: "bookworm",
+			OSVersionID:       "12",
+			Maintainer:        maintainer,
+			Architecture:      arch,
+		}
+	}
+	e := dpkg.New(dpkg.DefaultConfig())
+	want := []*extractor.Inventory{
+		{
+			Name:      "base-files",
+			Version:   "12.4+deb12u6",
+			Metadata:  osMetadata("base-files", "12.4+deb12u6", "Santiago Vila <sanvila@debian.org>", "amd64"),
+			Locations: extractor.InstalledLocations("var/lib/dpkg/status.d/base-files"),
+			Extractor: e,
+			Ecosystem: "Debian:12",
+		},
+		{
+			Name:      "tzdata",
+			Version:   "2024a-0+deb12u1",
+			Metadata:  osMetadata("tzdata", "2024a-0+deb12u1", "GNU Libc Maintainers <debian-glibc@lists.debian.org>", "all"),
+			Locations: extractor.InstalledLocations("var/lib/dpkg/status.d/tzdata"),
+			Extractor: e,
+			Ecosystem: "Debian:12",
+		},
+	}
+
+	got, _, err := filesystem.Run(context.Background(), &filesystem.Config{
+		Extractors: []filesystem.Extractor{e},
+		ScanRoots:  scalibrfs.RealFSScanRoots(root),
+		Stats:      stats.NoopCollector{},
+	})
+	if err != nil {
+		t.Fatalf("filesystem.Run(%s): %v", root, err)
+	}
+	for _, i := range got {
+		i.ScanRoot = ""
+	}
+	sortInv := cmpopts.SortSlices(func(a, b *extractor.Inventory) bool { return a.Name < b.Name })
+	if diff := cmp.Diff(want, got, sortInv, cmp.AllowUnexported(dpkg.Extractor{})); diff != "" {
+		t.Errorf("filesystem.Run(%s) (-want +got):\n%s", root, diff)
+	}
+}
+
 func TestToPURL(t *testing.T) {
 	pkgname := "pkgname"
 	sourcename := "sourcename"
@@ -968,7 +1082,7 @@ func TestToPURL(t *testing.T) {
 				Name:      pkgname,
 				Version:   version,
 				Metadata:  tt.metadata,
-				Locations: []string{"location"},
+				Locations: extractor.InstalledLocations("location"),
 			}
 			got := e.ToPURL(i)
 			if diff := cmp.Diff(tt.want, got); diff != "" {
@@ -16,9 +16,15 @@ package dpkg
 
 // Metadata holds parsing information for a dpkg package.
 type Metadata struct {
-	PackageName       string
-	Status            string
-	SourceName        string
+	PackageName string
+	Status      string
+	// SourceName is the name of the source package the binary package was
+	// built from, e.g. openssl for libssl3. Debian advisories refer to source
+	// packages. dpkg omits it if it's the same as PackageName.
+	SourceName string
+	// SourceVersion is the version of the source package, from the
+	// "Source: name (version)" form. dpkg omits it if it's the same as
+	// PackageVersion, which isn't the case e.g. for binNMUs.
 	SourceVersion     string
 	PackageVersion    string
 	OSID              string
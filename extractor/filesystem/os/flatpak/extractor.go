@@ -110,7 +110,7 @@ var filePathRegex = regexp.MustCompile(`flatpak/app/.*/export/share/metainfo/.*m
 
 // FileRequired returns true if the specified file matches the metainfo xml file pattern.
 func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
-	if match := filePathRegex.FindString(path); match == "" {
+	if !e.fileMatches(path, fileinfo) {
 		return false
 	}
 
@@ -123,11 +123,24 @@ func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
 	return true
 }
 
+// FileSizeLimitExceeded returns true if the file would be required if it
+// didn't exceed the maximum file size.
+func (e Extractor) FileSizeLimitExceeded(path string, fileinfo fs.FileInfo) bool {
+	return e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes && e.fileMatches(path, fileinfo)
+}
+
+// fileMatches returns true if the file is one the extractor is interested in,
+// regardless of its size.
+func (e Extractor) fileMatches(path string, fileinfo fs.FileInfo) bool {
+	return filePathRegex.FindString(path) != ""
+}
+
 func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
 	if e.stats == nil {
 		return
 	}
 	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Extractor:     e.Name(),
 		Path:          path,
 		Result:        result,
 		FileSizeBytes: fileSizeBytes,
@@ -196,7 +209,7 @@ func (e Extractor) extractFromInput(input *filesystem.ScanInput) (*extractor.Inv
 			OSBuildID:      m["BUILD_ID"],
 			Developer:      f.Developer,
 		},
-		Locations: []string{input.Path},
+		Locations: extractor.InstalledLocations(input.Path),
 	}
 
 	return i, nil
@@ -246,5 +259,8 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	}
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeFlatpak} }
+
 // Ecosystem returns no Ecosystem since the ecosystem is not known by OSV yet.
 func (e Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
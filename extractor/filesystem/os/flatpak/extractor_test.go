@@ -167,7 +167,7 @@ func TestExtract(t *testing.T) {
 						OSName:         "Debian GNU/Linux",
 						Developer:      "The GIMP team",
 					},
-					Locations: []string{"testdata/valid.xml"},
+					Locations: extractor.InstalledLocations("testdata/valid.xml"),
 				},
 			},
 			wantResultMetric: stats.FileExtractedResultSuccess,
@@ -190,7 +190,7 @@ func TestExtract(t *testing.T) {
 						OSName:         "Debian GNU/Linux",
 						Developer:      "The GIMP team",
 					},
-					Locations: []string{"testdata/noname.xml"},
+					Locations: extractor.InstalledLocations("testdata/noname.xml"),
 				},
 			},
 			wantResultMetric: stats.FileExtractedResultSuccess,
@@ -353,7 +353,7 @@ func TestToPURL(t *testing.T) {
 				Name:      pkgname,
 				Version:   pkgversion,
 				Metadata:  tt.metadata,
-				Locations: []string{"location"},
+				Locations: extractor.InstalledLocations("location"),
 			}
 			got := e.ToPURL(i)
 			if diff := cmp.Diff(tt.want, got); diff != "" {
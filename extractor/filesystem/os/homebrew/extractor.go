@@ -97,7 +97,7 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 		{
 			Name:      p.AppName,
 			Version:   p.AppVersion,
-			Locations: []string{input.Path},
+			Locations: extractor.InstalledLocations(input.Path),
 		},
 	}, nil
 }
@@ -129,5 +129,8 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	}
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeBrew} }
+
 // Ecosystem returns no Ecosystem since the ecosystem is not known by OSV yet.
 func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
@@ -122,7 +122,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "rclone",
 					Version:   "1.67.0",
-					Locations: []string{"testdata/Cellar/rclone/1.67.0/INSTALL_RECEIPT.json"},
+					Locations: extractor.InstalledLocations("testdata/Cellar/rclone/1.67.0/INSTALL_RECEIPT.json"),
 				},
 			},
 		},
@@ -133,7 +133,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "testapp",
 					Version:   "1.1.1",
-					Locations: []string{"testdata/Caskroom/testapp/1.1.1/testapp.wrapper.sh"},
+					Locations: extractor.InstalledLocations("testdata/Caskroom/testapp/1.1.1/testapp.wrapper.sh"),
 				},
 			},
 		},
@@ -149,7 +149,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "android-platform-tools",
 					Version:   "35.0.2",
-					Locations: []string{"testdata/Caskroom/android-platform-tools/35.0.2/platform-tools/source.properties"},
+					Locations: extractor.InstalledLocations("testdata/Caskroom/android-platform-tools/35.0.2/platform-tools/source.properties"),
 				},
 			},
 		},
@@ -185,7 +185,7 @@ func TestToPURL(t *testing.T) {
 				{
 					Name:      "firefox",
 					Version:   "129.0",
-					Locations: []string{"System/Volumes/Data/usr/local/Caskroom/firefox/129.0/firefox.wrapper.sh"},
+					Locations: extractor.InstalledLocations("System/Volumes/Data/usr/local/Caskroom/firefox/129.0/firefox.wrapper.sh"),
 				},
 			},
 			want: &purl.PackageURL{
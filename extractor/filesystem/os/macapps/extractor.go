@@ -94,8 +94,7 @@ func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabili
 
 // FileRequired returns true if the specified file matches the Info.plist file pattern.
 func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
-	// Check for the "/Applications" prefix and ".plist" suffix first.
-	if !strings.HasPrefix(path, "Applications/") || !strings.HasSuffix(path, "/Contents/Info.plist") {
+	if !e.fileMatches(path, fileinfo) {
 		return false
 	}
 
@@ -108,11 +107,25 @@ func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
 	return true
 }
 
+// FileSizeLimitExceeded returns true if the file would be required if it
+// didn't exceed the maximum file size.
+func (e Extractor) FileSizeLimitExceeded(path string, fileinfo fs.FileInfo) bool {
+	return e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes && e.fileMatches(path, fileinfo)
+}
+
+// fileMatches returns true if the file is one the extractor is interested in,
+// regardless of its size.
+func (e Extractor) fileMatches(path string, fileinfo fs.FileInfo) bool {
+	// Check for the "/Applications" prefix and ".plist" suffix first.
+	return strings.HasPrefix(path, "Applications/") && strings.HasSuffix(path, "/Contents/Info.plist")
+}
+
 func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
 	if e.stats == nil {
 		return
 	}
 	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Extractor:     e.Name(),
 		Path:          path,
 		Result:        result,
 		FileSizeBytes: fileSizeBytes,
@@ -179,7 +192,7 @@ func (e Extractor) extractFromInput(ctx context.Context, input *filesystem.ScanI
 		Name:      metadata.CFBundleDisplayName,
 		Version:   metadata.CFBundleShortVersionString,
 		Metadata:  &metadata,
-		Locations: []string{input.Path},
+		Locations: extractor.InstalledLocations(input.Path),
 	}
 
 	return i, nil
@@ -194,6 +207,9 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	}
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeMacApps} }
+
 // ToCPEs is not applicable as this extractor does not infer CPEs from the Inventory.
 func (e Extractor) ToCPEs(i *extractor.Inventory) []string { return nil }
 
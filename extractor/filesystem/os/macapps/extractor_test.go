@@ -144,7 +144,7 @@ func TestExtract(t *testing.T) {
 				&extractor.Inventory{
 					Name:      "Google Chrome",
 					Version:   "130.0.6723.69",
-					Locations: []string{"testdata/ValidXML.plist"},
+					Locations: extractor.InstalledLocations("testdata/ValidXML.plist"),
 					Metadata: &macapps.Metadata{
 						CFBundleDisplayName:        "Google Chrome",
 						CFBundleIdentifier:         "com.google.Chrome",
@@ -168,7 +168,7 @@ func TestExtract(t *testing.T) {
 				&extractor.Inventory{
 					Name:      "",
 					Version:   "202410231131",
-					Locations: []string{"testdata/BinaryApp.plist"},
+					Locations: extractor.InstalledLocations("testdata/BinaryApp.plist"),
 					Metadata: &macapps.Metadata{
 						CFBundleDisplayName:        "",
 						CFBundleIdentifier:         "com.google.corp.gMacInformation",
@@ -204,7 +204,7 @@ func TestExtract(t *testing.T) {
 				&extractor.Inventory{
 					Name:      "",
 					Version:   "",
-					Locations: []string{"testdata/MissingData.plist"},
+					Locations: extractor.InstalledLocations("testdata/MissingData.plist"),
 					Metadata: &macapps.Metadata{
 						CFBundleDisplayName:        "",
 						CFBundleIdentifier:         "com.google.Chrome",
@@ -77,5 +77,8 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 // ToPURL converts an inventory created by this extractor into a PURL.
 func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL { return nil }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeRPM} }
+
 // Ecosystem is not defined.
 func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
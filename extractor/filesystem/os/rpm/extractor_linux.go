@@ -19,6 +19,7 @@ package rpm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/fs"
 	"os"
@@ -31,6 +32,8 @@ import (
 	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/osrelease"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/rpm/internal/bdb"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/rpm/internal/header"
 	"github.com/google/osv-scalibr/log"
 	"github.com/google/osv-scalibr/plugin"
 	"github.com/google/osv-scalibr/purl"
@@ -114,8 +117,7 @@ func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabili
 
 // FileRequired returns true if the specified file matches rpm status file pattern.
 func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
-	dir, filename := filepath.Split(filepath.ToSlash(path))
-	if !slices.Contains(requiredDirectory, dir) || !slices.Contains(requiredFilename, filename) {
+	if !e.fileMatches(path, fileinfo) {
 		return false
 	}
 
@@ -128,11 +130,25 @@ func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
 	return true
 }
 
+// FileSizeLimitExceeded returns true if the file would be required if it
+// didn't exceed the maximum file size.
+func (e Extractor) FileSizeLimitExceeded(path string, fileinfo fs.FileInfo) bool {
+	return e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes && e.fileMatches(path, fileinfo)
+}
+
+// fileMatches returns true if the file is one the extractor is interested in,
+// regardless of its size.
+func (e Extractor) fileMatches(path string, fileinfo fs.FileInfo) bool {
+	dir, filename := filepath.Split(filepath.ToSlash(path))
+	return slices.Contains(requiredDirectory, dir) && slices.Contains(requiredFilename, filename)
+}
+
 func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
 	if e.stats == nil {
 		return
 	}
 	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Extractor:     e.Name(),
 		Path:          path,
 		Result:        result,
 		FileSizeBytes: fileSizeBytes,
@@ -170,10 +186,6 @@ func (e Extractor) extractFromInput(ctx context.Context, input *filesystem.ScanI
 			}
 		}()
 	}
-	rpmPkgs, err := e.parseRPMDB(absPath)
-	if err != nil {
-		return nil, fmt.Errorf("ParseRPMDB(%s): %w", absPath, err)
-	}
 
 	m, err := osrelease.GetOSRelease(input.FS)
 	if err != nil {
@@ -181,7 +193,7 @@ func (e Extractor) extractFromInput(ctx context.Context, input *filesystem.ScanI
 	}
 
 	pkgs := []*extractor.Inventory{}
-	for _, p := range rpmPkgs {
+	err = e.parseRPMDB(ctx, absPath, func(p rpmPackageInfo) {
 		metadata := &Metadata{
 			PackageName:  p.Name,
 			SourceRPM:    p.SourceRPM,
@@ -198,44 +210,46 @@ func (e Extractor) extractFromInput(ctx context.Context, input *filesystem.ScanI
 		i := &extractor.Inventory{
 			Name:      p.Name,
 			Version:   fmt.Sprintf("%s-%s", p.Version, p.Release),
-			Locations: []string{input.Path},
+			Locations: extractor.InstalledLocations(input.Path),
 			Metadata:  metadata,
 		}
 
 		pkgs = append(pkgs, i)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ParseRPMDB(%s): %w", absPath, err)
 	}
 
 	return pkgs, nil
 }
 
-// parseRPMDB returns a slice of OS packages parsed from a RPM DB.
-func (e Extractor) parseRPMDB(path string) ([]rpmPackageInfo, error) {
+// parseRPMDB calls fn with every OS package parsed from a RPM DB.
+func (e Extractor) parseRPMDB(ctx context.Context, path string, fn func(rpmPackageInfo)) error {
+	if e.Timeout != 0 {
+		var cancelFunc context.CancelFunc
+		ctx, cancelFunc = context.WithTimeout(ctx, e.Timeout)
+		defer cancelFunc()
+	}
+
+	err := parseBDB(ctx, path, fn)
+	if !errors.Is(err, bdb.ErrNotBDB) {
+		return err
+	}
+
 	db, err := rpmdb.Open(path)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer db.Close()
 
-	var pkgs []*rpmdb.PackageInfo
-	if e.Timeout == 0 {
-		pkgs, err = db.ListPackages()
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		ctx, cancelFunc := context.WithTimeout(context.Background(), e.Timeout)
-		defer cancelFunc()
-
-		// The timeout is only for corrupt bdb databases
-		pkgs, err = db.ListPackagesWithContext(ctx)
-		if err != nil {
-			return nil, err
-		}
+	// The timeout is only for corrupt bdb databases
+	pkgs, err := db.ListPackagesWithContext(ctx)
+	if err != nil {
+		return err
 	}
 
-	var result []rpmPackageInfo
 	for _, pkg := range pkgs {
-		newPkg := rpmPackageInfo{
+		fn(rpmPackageInfo{
 			Name:         pkg.Name,
 			Version:      pkg.Version,
 			Release:      pkg.Release,
@@ -244,12 +258,44 @@ func (e Extractor) parseRPMDB(path string) ([]rpmPackageInfo, error) {
 			Vendor:       pkg.Vendor,
 			Architecture: pkg.Arch,
 			License:      pkg.License,
-		}
+		})
+	}
+
+	return nil
+}
 
-		result = append(result, newPkg)
+// parseBDB streams the packages of a Berkeley DB RPM database to fn. Unlike
+// the other formats, which are small, the whole database is never held in
+// memory. Returns bdb.ErrNotBDB for databases in other formats.
+func parseBDB(ctx context.Context, path string, fn func(rpmPackageInfo)) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
 	}
+	defer f.Close()
 
-	return result, nil
+	db, err := bdb.Open(f)
+	if err != nil {
+		return err
+	}
+
+	return db.Walk(ctx, func(value []byte) error {
+		h, err := header.Parse(value)
+		if err != nil {
+			return fmt.Errorf("invalid package header: %w", err)
+		}
+		fn(rpmPackageInfo{
+			Name:         h.Name,
+			Version:      h.Version,
+			Release:      h.Release,
+			Epoch:        h.Epoch,
+			SourceRPM:    h.SourceRPM,
+			Vendor:       h.Vendor,
+			Architecture: h.Architecture,
+			License:      h.License,
+		})
+		return nil
+	})
 }
 
 type rpmPackageInfo struct {
@@ -317,6 +363,9 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	}
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeRPM} }
+
 // Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
 func (Extractor) Ecosystem(i *extractor.Inventory) string {
 	m := i.Metadata.(*Metadata)
@@ -194,7 +194,7 @@ func TestExtract(t *testing.T) {
 			wantResultMetric: stats.FileExtractedResultSuccess,
 			wantInventory: []*extractor.Inventory{
 				{
-					Locations: []string{"testdata/Packages.db"},
+					Locations: extractor.InstalledLocations("testdata/Packages.db"),
 					Name:      "aaa_base",
 					Version:   "84.87+git20180409.04c9dae-150300.10.3.1",
 					Metadata: &rpm.Metadata{
@@ -210,7 +210,7 @@ func TestExtract(t *testing.T) {
 					},
 				},
 				{
-					Locations: []string{"testdata/Packages.db"},
+					Locations: extractor.InstalledLocations("testdata/Packages.db"),
 					Name:      "bash",
 					Version:   "4.4-150400.25.22",
 					Metadata: &rpm.Metadata{
@@ -226,7 +226,7 @@ func TestExtract(t *testing.T) {
 					},
 				},
 				{
-					Locations: []string{"testdata/Packages.db"},
+					Locations: extractor.InstalledLocations("testdata/Packages.db"),
 					Name:      "bash-sh",
 					Version:   "4.4-150400.25.22",
 					Metadata: &rpm.Metadata{
@@ -252,7 +252,7 @@ func TestExtract(t *testing.T) {
 			wantResultMetric: stats.FileExtractedResultSuccess,
 			wantInventory: []*extractor.Inventory{
 				{
-					Locations: []string{"testdata/Packages"},
+					Locations: extractor.InstalledLocations("testdata/Packages"),
 					Name:      "acl",
 					Version:   "2.2.51-15.el7",
 					Metadata: &rpm.Metadata{
@@ -268,7 +268,7 @@ func TestExtract(t *testing.T) {
 					},
 				},
 				{
-					Locations: []string{"testdata/Packages"},
+					Locations: extractor.InstalledLocations("testdata/Packages"),
 					Name:      "audit-libs",
 					Version:   "2.8.5-4.el7",
 					Metadata: &rpm.Metadata{
@@ -284,7 +284,7 @@ func TestExtract(t *testing.T) {
 					},
 				},
 				{
-					Locations: []string{"testdata/Packages"},
+					Locations: extractor.InstalledLocations("testdata/Packages"),
 					Name:      "basesystem",
 					Version:   "10.0-7.el7.centos",
 					Metadata: &rpm.Metadata{
@@ -327,7 +327,7 @@ func TestExtract(t *testing.T) {
 			wantResultMetric: stats.FileExtractedResultErrorUnknown,
 		},
 		{
-			name:             "corrupt db times out",
+			name:             "corrupt db",
 			path:             "testdata/timeout/Packages",
 			timeoutval:       1 * time.Second,
 			wantInventory:    nil,
@@ -343,7 +343,7 @@ func TestExtract(t *testing.T) {
 			wantResultMetric: stats.FileExtractedResultSuccess,
 			wantInventory: []*extractor.Inventory{
 				{
-					Locations: []string{"testdata/rpmdb.sqlite"},
+					Locations: extractor.InstalledLocations("testdata/rpmdb.sqlite"),
 					Name:      "alternatives",
 					Version:   "1.20-2.el9",
 					Metadata: &rpm.Metadata{
@@ -359,7 +359,7 @@ func TestExtract(t *testing.T) {
 					},
 				},
 				{
-					Locations: []string{"testdata/rpmdb.sqlite"},
+					Locations: extractor.InstalledLocations("testdata/rpmdb.sqlite"),
 					Name:      "audit-libs",
 					Version:   "3.0.7-103.el9",
 					Metadata: &rpm.Metadata{
@@ -375,7 +375,7 @@ func TestExtract(t *testing.T) {
 					},
 				},
 				{
-					Locations: []string{"testdata/rpmdb.sqlite"},
+					Locations: extractor.InstalledLocations("testdata/rpmdb.sqlite"),
 					Name:      "basesystem",
 					Version:   "11-13.el9",
 					Metadata: &rpm.Metadata{
@@ -402,7 +402,7 @@ func TestExtract(t *testing.T) {
 			wantResultMetric: stats.FileExtractedResultSuccess,
 			wantInventory: []*extractor.Inventory{
 				{
-					Locations: []string{"testdata/rpmdb.sqlite"},
+					Locations: extractor.InstalledLocations("testdata/rpmdb.sqlite"),
 					Name:      "alternatives",
 					Version:   "1.20-2.el9",
 					Metadata: &rpm.Metadata{
@@ -417,7 +417,7 @@ func TestExtract(t *testing.T) {
 					},
 				},
 				{
-					Locations: []string{"testdata/rpmdb.sqlite"},
+					Locations: extractor.InstalledLocations("testdata/rpmdb.sqlite"),
 					Name:      "audit-libs",
 					Version:   "3.0.7-103.el9",
 					Metadata: &rpm.Metadata{
@@ -432,7 +432,7 @@ func TestExtract(t *testing.T) {
 					},
 				},
 				{
-					Locations: []string{"testdata/rpmdb.sqlite"},
+					Locations: extractor.InstalledLocations("testdata/rpmdb.sqlite"),
 					Name:      "basesystem",
 					Version:   "11-13.el9",
 					Metadata: &rpm.Metadata{
@@ -464,7 +464,7 @@ func TestExtract(t *testing.T) {
 			wantResultMetric: stats.FileExtractedResultSuccess,
 			wantInventory: []*extractor.Inventory{
 				{
-					Locations: []string{"testdata/Packages"},
+					Locations: extractor.InstalledLocations("testdata/Packages"),
 					Name:      "hello",
 					Version:   "0.0.1-rls",
 					Metadata: &rpm.Metadata{
@@ -518,7 +518,7 @@ func TestExtract(t *testing.T) {
 
 			// Update location with the temp path.
 			for _, i := range tt.wantInventory {
-				i.Locations = []string{filepath.Base(tmpPath)}
+				i.Locations = extractor.InstalledLocations(filepath.Base(tmpPath))
 			}
 
 			sort.Slice(got, func(i, j int) bool { return got[i].Name < got[j].Name })
@@ -572,7 +572,7 @@ func TestExtract_VirtualFilesystem(t *testing.T) {
 			osrelease: fedora38,
 			wantInventory: []*extractor.Inventory{
 				{
-					Locations: []string{"testdata/Packages.db"},
+					Locations: extractor.InstalledLocations("testdata/Packages.db"),
 					Name:      "aaa_base",
 					Version:   "84.87+git20180409.04c9dae-150300.10.3.1",
 					Metadata: &rpm.Metadata{
@@ -588,7 +588,7 @@ func TestExtract_VirtualFilesystem(t *testing.T) {
 					},
 				},
 				{
-					Locations: []string{"testdata/Packages.db"},
+					Locations: extractor.InstalledLocations("testdata/Packages.db"),
 					Name:      "bash",
 					Version:   "4.4-150400.25.22",
 					Metadata: &rpm.Metadata{
@@ -604,7 +604,7 @@ func TestExtract_VirtualFilesystem(t *testing.T) {
 					},
 				},
 				{
-					Locations: []string{"testdata/Packages.db"},
+					Locations: extractor.InstalledLocations("testdata/Packages.db"),
 					Name:      "bash-sh",
 					Version:   "4.4-150400.25.22",
 					Metadata: &rpm.Metadata{
@@ -629,7 +629,7 @@ func TestExtract_VirtualFilesystem(t *testing.T) {
 			osrelease: fedora38,
 			wantInventory: []*extractor.Inventory{
 				{
-					Locations: []string{"testdata/Packages"},
+					Locations: extractor.InstalledLocations("testdata/Packages"),
 					Name:      "acl",
 					Version:   "2.2.51-15.el7",
 					Metadata: &rpm.Metadata{
@@ -645,7 +645,7 @@ func TestExtract_VirtualFilesystem(t *testing.T) {
 					},
 				},
 				{
-					Locations: []string{"testdata/Packages"},
+					Locations: extractor.InstalledLocations("testdata/Packages"),
 					Name:      "audit-libs",
 					Version:   "2.8.5-4.el7",
 					Metadata: &rpm.Metadata{
@@ -661,7 +661,7 @@ func TestExtract_VirtualFilesystem(t *testing.T) {
 					},
 				},
 				{
-					Locations: []string{"testdata/Packages"},
+					Locations: extractor.InstalledLocations("testdata/Packages"),
 					Name:      "basesystem",
 					Version:   "10.0-7.el7.centos",
 					Metadata: &rpm.Metadata{
@@ -686,7 +686,7 @@ func TestExtract_VirtualFilesystem(t *testing.T) {
 			osrelease: fedora38,
 			wantInventory: []*extractor.Inventory{
 				{
-					Locations: []string{"testdata/rpmdb.sqlite"},
+					Locations: extractor.InstalledLocations("testdata/rpmdb.sqlite"),
 					Name:      "alternatives",
 					Version:   "1.20-2.el9",
 					Metadata: &rpm.Metadata{
@@ -702,7 +702,7 @@ func TestExtract_VirtualFilesystem(t *testing.T) {
 					},
 				},
 				{
-					Locations: []string{"testdata/rpmdb.sqlite"},
+					Locations: extractor.InstalledLocations("testdata/rpmdb.sqlite"),
 					Name:      "audit-libs",
 					Version:   "3.0.7-103.el9",
 					Metadata: &rpm.Metadata{
@@ -718,7 +718,7 @@ func TestExtract_VirtualFilesystem(t *testing.T) {
 					},
 				},
 				{
-					Locations: []string{"testdata/rpmdb.sqlite"},
+					Locations: extractor.InstalledLocations("testdata/rpmdb.sqlite"),
 					Name:      "basesystem",
 					Version:   "11-13.el9",
 					Metadata: &rpm.Metadata{
@@ -751,7 +751,7 @@ func TestExtract_VirtualFilesystem(t *testing.T) {
 
 			wantInventory: []*extractor.Inventory{
 				{
-					Locations: []string{"testdata/Packages_epoch"},
+					Locations: extractor.InstalledLocations("testdata/Packages_epoch"),
 					Name:      "hello",
 					Version:   "0.0.1-rls",
 					Metadata: &rpm.Metadata{
@@ -913,7 +913,7 @@ func TestToPURL(t *testing.T) {
 				Name:      pkgname,
 				Version:   version,
 				Metadata:  tt.metadata,
-				Locations: []string{"location"},
+				Locations: extractor.InstalledLocations("location"),
 			}
 			got := e.ToPURL(i)
 			if diff := cmp.Diff(tt.want, got); diff != "" {
@@ -0,0 +1,218 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bdb reads the values of the Berkeley DB hash database used by older
+// RPM versions for /var/lib/rpm/Packages.
+//
+// The database is walked one page at a time and values are handed to a
+// callback as soon as they're read, so memory use is bounded by the page size
+// and the largest value instead of the size of the database.
+package bdb
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Layout of the database, see db_page.h in the Berkeley DB sources.
+const (
+	hashMagic = 0x00061561
+
+	// Offsets into the metadata page.
+	magicOffset      = 12
+	pageSizeOffset   = 20
+	encryptionOffset = 24
+	pageTypeOffset   = 25
+	lastPageNoOffset = 32
+	metadataSize     = 72
+
+	// Offsets into the header of the other pages.
+	nextPageNoOffset     = 16
+	numEntriesOffset     = 20
+	freeAreaOffsetOffset = 22
+	pageHeaderSize       = 26
+
+	// Page types.
+	hashUnsortedPageType = 2 // Hash pages created before Berkeley DB 4.6.
+	overflowPageType     = 7
+	hashMetadataPageType = 8
+	hashPageType         = 13
+
+	// Item types on hash pages.
+	hashOffPageItemType = 3
+	hashOffPageItemSize = 12
+
+	// maxValueSize is the largest value read, matching the maximum size of an
+	// RPM header.
+	maxValueSize = 256 << 20
+)
+
+// ErrNotBDB is returned by Open if the file isn't a Berkeley DB hash database.
+var ErrNotBDB = errors.New("not a Berkeley DB hash database")
+
+// DB is an open Berkeley DB hash database.
+type DB struct {
+	r          io.ReaderAt
+	order      binary.ByteOrder
+	pageSize   uint32
+	lastPageNo uint32
+}
+
+// Open reads the metadata page of the database in r.
+func Open(r io.ReaderAt) (*DB, error) {
+	meta := make([]byte, metadataSize)
+	if _, err := r.ReadAt(meta, 0); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, ErrNotBDB
+		}
+		return nil, fmt.Errorf("failed to read metadata page: %w", err)
+	}
+
+	var order binary.ByteOrder
+	switch uint32(hashMagic) {
+	case binary.LittleEndian.Uint32(meta[magicOffset:]):
+		order = binary.LittleEndian
+	case binary.BigEndian.Uint32(meta[magicOffset:]):
+		order = binary.BigEndian
+	default:
+		return nil, ErrNotBDB
+	}
+	if meta[pageTypeOffset] != hashMetadataPageType {
+		return nil, fmt.Errorf("unexpected metadata page type %d", meta[pageTypeOffset])
+	}
+	if meta[encryptionOffset] != 0 {
+		return nil, fmt.Errorf("unsupported encryption algorithm %d", meta[encryptionOffset])
+	}
+
+	pageSize := order.Uint32(meta[pageSizeOffset:])
+	if pageSize < 512 || pageSize > 64<<10 || pageSize&(pageSize-1) != 0 {
+		return nil, fmt.Errorf("unexpected page size %d", pageSize)
+	}
+
+	return &DB{
+		r:          r,
+		order:      order,
+		pageSize:   pageSize,
+		lastPageNo: order.Uint32(meta[lastPageNoOffset:]),
+	}, nil
+}
+
+// Walk calls fn with every value stored in the database. The value is only
+// valid until fn returns as its buffer is reused for the next one. Walking
+// stops at the first error returned by fn or encountered while reading.
+func (db *DB) Walk(ctx context.Context, fn func(value []byte) error) error {
+	page := make([]byte, db.pageSize)
+	overflow := make([]byte, db.pageSize)
+	var value []byte
+
+	for pageNo := uint32(1); pageNo <= db.lastPageNo; pageNo++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := db.readPage(pageNo, page); err != nil {
+			return err
+		}
+		if t := page[pageTypeOffset]; t != hashPageType && t != hashUnsortedPageType {
+			continue
+		}
+
+		numEntries := int(db.order.Uint16(page[numEntriesOffset:]))
+		if numEntries%2 != 0 {
+			return fmt.Errorf("page %d: odd number of hash entries %d", pageNo, numEntries)
+		}
+		if pageHeaderSize+2*numEntries > len(page) {
+			return fmt.Errorf("page %d: %d hash entries don't fit the page", pageNo, numEntries)
+		}
+
+		// Entries are key/value pairs, only values are of interest.
+		for i := 1; i < numEntries; i += 2 {
+			offset := int(db.order.Uint16(page[pageHeaderSize+2*i:]))
+			if offset >= len(page) {
+				return fmt.Errorf("page %d: hash entry offset %d out of bounds", pageNo, offset)
+			}
+			// RPM stores headers off-page, other values are index data.
+			if page[offset] != hashOffPageItemType {
+				continue
+			}
+			if offset+hashOffPageItemSize > len(page) {
+				return fmt.Errorf("page %d: hash entry offset %d out of bounds", pageNo, offset)
+			}
+			item := page[offset : offset+hashOffPageItemSize]
+
+			var err error
+			value, err = db.readOverflow(ctx, db.order.Uint32(item[4:]), db.order.Uint32(item[8:]), overflow, value[:0])
+			if err != nil {
+				return fmt.Errorf("page %d: %w", pageNo, err)
+			}
+			if err := fn(value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// readOverflow appends the value of length bytes stored in the chain of
+// overflow pages starting at pageNo to value.
+func (db *DB) readOverflow(ctx context.Context, pageNo, length uint32, page, value []byte) ([]byte, error) {
+	if length > maxValueSize {
+		return nil, fmt.Errorf("value size %d exceeds the maximum of %d", length, maxValueSize)
+	}
+
+	for ; pageNo != 0; pageNo = db.order.Uint32(page[nextPageNoOffset:]) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if err := db.readPage(pageNo, page); err != nil {
+			return nil, err
+		}
+		if page[pageTypeOffset] != overflowPageType {
+			return nil, fmt.Errorf("overflow page %d has unexpected type %d", pageNo, page[pageTypeOffset])
+		}
+
+		data := page[pageHeaderSize:]
+		if db.order.Uint32(page[nextPageNoOffset:]) == 0 {
+			// On the last page of the chain the free area offset holds the
+			// number of bytes used.
+			n := int(db.order.Uint16(page[freeAreaOffsetOffset:]))
+			if n > len(data) {
+				return nil, fmt.Errorf("overflow page %d: data length %d out of bounds", pageNo, n)
+			}
+			data = data[:n]
+		}
+		// The length check also stops cycles in the chain of a corrupt database.
+		if uint32(len(value)+len(data)) > length {
+			return nil, fmt.Errorf("overflow chain at page %d is longer than the value size %d", pageNo, length)
+		}
+		value = append(value, data...)
+	}
+	return value, nil
+}
+
+func (db *DB) readPage(pageNo uint32, page []byte) error {
+	if pageNo > db.lastPageNo {
+		return fmt.Errorf("page %d out of bounds, last page is %d", pageNo, db.lastPageNo)
+	}
+	n, err := db.r.ReadAt(page, int64(pageNo)*int64(db.pageSize))
+	if n == len(page) {
+		return nil
+	}
+	if err == nil || errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+	return fmt.Errorf("failed to read page %d: %w", pageNo, err)
+}
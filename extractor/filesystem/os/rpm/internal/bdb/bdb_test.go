@@ -0,0 +1,284 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bdb_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/rpm/internal/bdb"
+)
+
+const pageSize = 4096
+
+// writeDB writes a hash database with one hash page per value to w, each
+// value stored in a chain of overflow pages. If cycle is set, the overflow
+// chain of the first value points back to its own first page. Pages are
+// written as they're created to keep large databases out of memory.
+func writeDB(t testing.TB, w io.Writer, order binary.ByteOrder, values [][]byte, cycle bool) {
+	t.Helper()
+
+	const chunk = pageSize - 26
+	overflowPages := func(v []byte) int { return max(1, (len(v)+chunk-1)/chunk) }
+	lastPageNo := 0
+	for _, v := range values {
+		lastPageNo += 1 + overflowPages(v)
+	}
+
+	page := make([]byte, pageSize)
+	pageNo := 0
+	write := func(pageType byte, fill func(p []byte)) {
+		clear(page)
+		order.PutUint32(page[8:], uint32(pageNo))
+		page[25] = pageType
+		fill(page)
+		if _, err := w.Write(page); err != nil {
+			t.Fatalf("Write(): %v", err)
+		}
+		pageNo++
+	}
+
+	write(8, func(p []byte) {
+		order.PutUint32(p[12:], 0x00061561)
+		order.PutUint32(p[16:], 9)
+		order.PutUint32(p[20:], pageSize)
+		order.PutUint32(p[32:], uint32(lastPageNo))
+	})
+	for _, v := range values {
+		write(13, func(p []byte) {
+			order.PutUint16(p[20:], 2)
+			// Key: an on-page item with the record number.
+			order.PutUint16(p[26:], pageSize-5)
+			p[pageSize-5] = 1
+			// Value: an off-page item.
+			order.PutUint16(p[28:], pageSize-17)
+			item := p[pageSize-17:]
+			item[0] = 3
+			order.PutUint32(item[4:], uint32(pageNo+1))
+			order.PutUint32(item[8:], uint32(len(v)))
+		})
+
+		first := uint32(pageNo)
+		for i := range overflowPages(v) {
+			write(7, func(p []byte) {
+				n := copy(p[26:], v[min(i*chunk, len(v)):])
+				if i < overflowPages(v)-1 {
+					order.PutUint32(p[16:], uint32(pageNo+1))
+					return
+				}
+				order.PutUint16(p[22:], uint16(n))
+				if cycle {
+					order.PutUint32(p[16:], first)
+				}
+			})
+		}
+		cycle = false
+	}
+}
+
+func walk(t *testing.T, r io.ReaderAt) ([][]byte, error) {
+	t.Helper()
+	db, err := bdb.Open(r)
+	if err != nil {
+		return nil, err
+	}
+	var got [][]byte
+	err = db.Walk(context.Background(), func(value []byte) error {
+		got = append(got, bytes.Clone(value))
+		return nil
+	})
+	return got, err
+}
+
+func TestWalk(t *testing.T) {
+	values := [][]byte{
+		[]byte("short"),
+		bytes.Repeat([]byte("a"), pageSize-26),
+		bytes.Repeat([]byte("long"), 3*pageSize),
+	}
+
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		t.Run(order.String(), func(t *testing.T) {
+			var b bytes.Buffer
+			writeDB(t, &b, order, values, false)
+
+			got, err := walk(t, bytes.NewReader(b.Bytes()))
+			if err != nil {
+				t.Fatalf("Walk(): %v", err)
+			}
+			if diff := cmp.Diff(values, got); diff != "" {
+				t.Errorf("Walk() returned unexpected values (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOpen_NotBDB(t *testing.T) {
+	for _, data := range [][]byte{nil, []byte("asdf"), make([]byte, pageSize)} {
+		if _, err := bdb.Open(bytes.NewReader(data)); !errors.Is(err, bdb.ErrNotBDB) {
+			t.Errorf("Open(%q...) returned error %v, want %v", data[:min(len(data), 8)], err, bdb.ErrNotBDB)
+		}
+	}
+}
+
+func TestWalk_Errors(t *testing.T) {
+	var cyclic bytes.Buffer
+	writeDB(t, &cyclic, binary.LittleEndian, [][]byte{[]byte("value")}, true)
+
+	var valid bytes.Buffer
+	writeDB(t, &valid, binary.LittleEndian, [][]byte{[]byte("value")}, false)
+	truncated := valid.Bytes()[:valid.Len()-1]
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr error
+	}{
+		{name: "overflow chain cycle", data: cyclic.Bytes()},
+		{name: "truncated", data: truncated, wantErr: io.ErrUnexpectedEOF},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := walk(t, bytes.NewReader(tt.data))
+			if err == nil {
+				t.Fatalf("Walk() succeeded, want error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Walk() returned error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWalk_StopsOnCallbackError(t *testing.T) {
+	var b bytes.Buffer
+	writeDB(t, &b, binary.LittleEndian, [][]byte{[]byte("a"), []byte("b")}, false)
+	db, err := bdb.Open(bytes.NewReader(b.Bytes()))
+	if err != nil {
+		t.Fatalf("Open(): %v", err)
+	}
+
+	errStop := errors.New("stop")
+	calls := 0
+	err = db.Walk(context.Background(), func([]byte) error {
+		calls++
+		return errStop
+	})
+	if !errors.Is(err, errStop) || calls != 1 {
+		t.Errorf("Walk() returned %v after %d calls, want %v after 1 call", err, calls, errStop)
+	}
+}
+
+func TestWalk_Cancelled(t *testing.T) {
+	var b bytes.Buffer
+	writeDB(t, &b, binary.LittleEndian, [][]byte{[]byte("a")}, false)
+	db, err := bdb.Open(bytes.NewReader(b.Bytes()))
+	if err != nil {
+		t.Fatalf("Open(): %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = db.Walk(ctx, func([]byte) error { return nil })
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Walk() returned error %v, want %v", err, context.Canceled)
+	}
+}
+
+// createLargeDB writes a database of n values of 10 KiB each, similar to the
+// size of real package headers, to a file and opens it.
+func createLargeDB(t testing.TB, n int) (*os.File, int64) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "Packages")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("os.Create(%s): %v", path, err)
+	}
+	t.Cleanup(func() { f.Close() })
+
+	values := make([][]byte, n)
+	v := bytes.Repeat([]byte("x"), 10<<10)
+	for i := range values {
+		values[i] = v
+	}
+	writeDB(t, f, binary.LittleEndian, values, false)
+
+	info, err := f.Stat()
+	if err != nil {
+		t.Fatalf("Stat(%s): %v", path, err)
+	}
+	return f, info.Size()
+}
+
+func TestWalk_BoundedMemory(t *testing.T) {
+	f, size := createLargeDB(t, 5000)
+	db, err := bdb.Open(f)
+	if err != nil {
+		t.Fatalf("Open(): %v", err)
+	}
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	n := 0
+	err = db.Walk(context.Background(), func([]byte) error {
+		n++
+		return nil
+	})
+	runtime.ReadMemStats(&after)
+	if err != nil {
+		t.Fatalf("Walk(): %v", err)
+	}
+	if n != 5000 {
+		t.Errorf("Walk() returned %d values, want 5000", n)
+	}
+
+	// Two page buffers and a value buffer grown a few times, independent of
+	// the number of values.
+	allocated := after.TotalAlloc - before.TotalAlloc
+	if allocated > 1<<20 {
+		t.Errorf("Walk() allocated %d bytes for a %d byte database, want at most 1 MiB", allocated, size)
+	}
+}
+
+func BenchmarkWalk(b *testing.B) {
+	for _, n := range []int{1000, 10000} {
+		b.Run(fmt.Sprintf("%d_values", n), func(b *testing.B) {
+			f, size := createLargeDB(b, n)
+			b.SetBytes(size)
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for range b.N {
+				db, err := bdb.Open(f)
+				if err != nil {
+					b.Fatalf("Open(): %v", err)
+				}
+				if err := db.Walk(context.Background(), func([]byte) error { return nil }); err != nil {
+					b.Fatalf("Walk(): %v", err)
+				}
+			}
+		})
+	}
+}
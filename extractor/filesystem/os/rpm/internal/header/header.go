@@ -0,0 +1,142 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package header parses the RPM package headers stored in RPM databases.
+//
+// Only the tags needed to identify a package are read, see header.c in the
+// RPM sources for the complete format.
+package header
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Tags read from the header, see rpmtag.h in the RPM sources.
+const (
+	tagName      = 1000
+	tagVersion   = 1001
+	tagRelease   = 1002
+	tagEpoch     = 1003
+	tagVendor    = 1011
+	tagLicense   = 1014
+	tagArch      = 1022
+	tagSourceRPM = 1044
+)
+
+// Tag data types.
+const (
+	typeInt32  = 4
+	typeString = 6
+)
+
+const (
+	introSize = 8
+	entrySize = 16
+	// maxEntries is the largest number of index entries in a header, see
+	// hdrchkTags in header.c.
+	maxEntries = 0x00ffffff
+)
+
+var errTruncated = errors.New("header truncated")
+
+// Package is the package described by an RPM header.
+type Package struct {
+	Name         string
+	Version      string
+	Release      string
+	Epoch        int
+	SourceRPM    string
+	Vendor       string
+	Architecture string
+	License      string
+}
+
+// Parse parses the header blob of a package as stored in the RPM database. The
+// returned package doesn't reference blob, so its buffer can be reused.
+func Parse(blob []byte) (*Package, error) {
+	if len(blob) < introSize {
+		return nil, errTruncated
+	}
+	il := binary.BigEndian.Uint32(blob)
+	dl := binary.BigEndian.Uint32(blob[4:])
+	if il == 0 || il > maxEntries {
+		return nil, fmt.Errorf("invalid number of header entries %d", il)
+	}
+	dataStart := uint64(introSize) + uint64(il)*entrySize
+	if dataStart+uint64(dl) > uint64(len(blob)) {
+		return nil, errTruncated
+	}
+	data := blob[dataStart : dataStart+uint64(dl)]
+
+	p := &Package{}
+	for i := uint64(0); i < uint64(il); i++ {
+		e := blob[introSize+i*entrySize:]
+		tag := binary.BigEndian.Uint32(e)
+		typ := binary.BigEndian.Uint32(e[4:])
+		offset := int32(binary.BigEndian.Uint32(e[8:]))
+
+		var dst *string
+		switch tag {
+		case tagName:
+			dst = &p.Name
+		case tagVersion:
+			dst = &p.Version
+		case tagRelease:
+			dst = &p.Release
+		case tagVendor:
+			dst = &p.Vendor
+		case tagLicense:
+			dst = &p.License
+		case tagArch:
+			dst = &p.Architecture
+		case tagSourceRPM:
+			dst = &p.SourceRPM
+		case tagEpoch:
+			if typ != typeInt32 {
+				return nil, fmt.Errorf("tag %d has type %d, want %d", tag, typ, typeInt32)
+			}
+			if offset < 0 || int(offset)+4 > len(data) {
+				return nil, fmt.Errorf("tag %d: offset %d out of bounds", tag, offset)
+			}
+			p.Epoch = int(int32(binary.BigEndian.Uint32(data[offset:])))
+			continue
+		default:
+			continue
+		}
+
+		if typ != typeString {
+			return nil, fmt.Errorf("tag %d has type %d, want %d", tag, typ, typeString)
+		}
+		if offset < 0 || int(offset) >= len(data) {
+			return nil, fmt.Errorf("tag %d: offset %d out of bounds", tag, offset)
+		}
+		s := data[offset:]
+		end := bytes.IndexByte(s, 0)
+		if end < 0 {
+			return nil, fmt.Errorf("tag %d: string isn't terminated", tag)
+		}
+		*dst = string(s[:end])
+	}
+
+	// RPM uses "(none)" for some unset values.
+	for _, s := range []*string{&p.SourceRPM, &p.Vendor, &p.License} {
+		if *s == "(none)" {
+			*s = ""
+		}
+	}
+	return p, nil
+}
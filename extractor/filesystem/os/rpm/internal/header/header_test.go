@@ -0,0 +1,127 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package header_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/rpm/internal/header"
+)
+
+type entry struct {
+	tag  uint32
+	typ  uint32
+	data []byte
+}
+
+func str(tag uint32, s string) entry { return entry{tag, 6, append([]byte(s), 0)} }
+
+func int32Entry(tag uint32, v int32) entry {
+	return entry{tag, 4, binary.BigEndian.AppendUint32(nil, uint32(v))}
+}
+
+// blob encodes the entries into an RPM header blob.
+func blob(entries ...entry) []byte {
+	var index, data []byte
+	for _, e := range entries {
+		index = binary.BigEndian.AppendUint32(index, e.tag)
+		index = binary.BigEndian.AppendUint32(index, e.typ)
+		index = binary.BigEndian.AppendUint32(index, uint32(len(data)))
+		index = binary.BigEndian.AppendUint32(index, 1)
+		data = append(data, e.data...)
+	}
+	b := binary.BigEndian.AppendUint32(nil, uint32(len(entries)))
+	b = binary.BigEndian.AppendUint32(b, uint32(len(data)))
+	return append(append(b, index...), data...)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		blob    []byte
+		want    *header.Package
+		wantErr bool
+	}{
+		{
+			name: "all tags",
+			blob: blob(
+				str(1000, "bash"),
+				str(1001, "5.2.15"),
+				str(1002, "1.fc38"),
+				int32Entry(1003, 2),
+				str(1011, "Fedora Project"),
+				str(1014, "GPL-3.0-or-later"),
+				str(1022, "x86_64"),
+				str(1044, "bash-5.2.15-1.fc38.src.rpm"),
+				// Tags that aren't read are skipped.
+				str(1004, "The GNU Bourne Again shell"),
+			),
+			want: &header.Package{
+				Name:         "bash",
+				Version:      "5.2.15",
+				Release:      "1.fc38",
+				Epoch:        2,
+				SourceRPM:    "bash-5.2.15-1.fc38.src.rpm",
+				Vendor:       "Fedora Project",
+				Architecture: "x86_64",
+				License:      "GPL-3.0-or-later",
+			},
+		},
+		{
+			name: "unset values",
+			blob: blob(str(1000, "gpg-pubkey"), str(1011, "(none)"), str(1014, "(none)"), str(1044, "(none)")),
+			want: &header.Package{Name: "gpg-pubkey"},
+		},
+		{
+			name:    "truncated",
+			blob:    blob(str(1000, "bash"))[:20],
+			wantErr: true,
+		},
+		{
+			name:    "no entries",
+			blob:    blob(),
+			wantErr: true,
+		},
+		{
+			name:    "wrong type",
+			blob:    blob(int32Entry(1000, 1)),
+			wantErr: true,
+		},
+		{
+			name:    "unterminated string",
+			blob:    blob(entry{1000, 6, []byte("bash")}),
+			wantErr: true,
+		},
+		{
+			name:    "epoch out of bounds",
+			blob:    blob(entry{1003, 4, []byte{0, 1}}),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := header.Parse(tt.blob)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() returned error %v, want error: %t", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Parse() returned unexpected package (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
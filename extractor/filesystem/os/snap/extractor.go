@@ -101,7 +101,7 @@ var filePathRegex = regexp.MustCompile(`^snap/[^/]*/[^/]*/meta/snap.yaml$`)
 
 // FileRequired returns true if the specified file matches snap.yaml file pattern.
 func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
-	if match := filePathRegex.FindString(path); match == "" {
+	if !e.fileMatches(path, fileinfo) {
 		return false
 	}
 
@@ -114,11 +114,24 @@ func (e Extractor) FileRequired(path string, fileinfo fs.FileInfo) bool {
 	return true
 }
 
+// FileSizeLimitExceeded returns true if the file would be required if it
+// didn't exceed the maximum file size.
+func (e Extractor) FileSizeLimitExceeded(path string, fileinfo fs.FileInfo) bool {
+	return e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes && e.fileMatches(path, fileinfo)
+}
+
+// fileMatches returns true if the file is one the extractor is interested in,
+// regardless of its size.
+func (e Extractor) fileMatches(path string, fileinfo fs.FileInfo) bool {
+	return filePathRegex.FindString(path) != ""
+}
+
 func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
 	if e.stats == nil {
 		return
 	}
 	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Extractor:     e.Name(),
 		Path:          path,
 		Result:        result,
 		FileSizeBytes: fileSizeBytes,
@@ -175,7 +188,7 @@ func (e Extractor) extractFromInput(ctx context.Context, input *filesystem.ScanI
 			OSVersionCodename: m["VERSION_CODENAME"],
 			OSVersionID:       m["VERSION_ID"],
 		},
-		Locations: []string{input.Path},
+		Locations: extractor.InstalledLocations(input.Path),
 	}
 	return []*extractor.Inventory{inventory}, nil
 }
@@ -220,6 +233,9 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	}
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeSnap} }
+
 // Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
 func (Extractor) Ecosystem(i *extractor.Inventory) string {
 	m := i.Metadata.(*Metadata)
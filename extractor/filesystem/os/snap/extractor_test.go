@@ -170,7 +170,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "core",
 					Version:   "16-2.61.4-20240607",
-					Locations: []string{"testdata/single-arch.yaml"},
+					Locations: extractor.InstalledLocations("testdata/single-arch.yaml"),
 					Metadata: &snap.Metadata{
 						Name:              "core",
 						Version:           "16-2.61.4-20240607",
@@ -193,7 +193,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "core",
 					Version:   "16-2.61.4-20240607",
-					Locations: []string{"testdata/multi-arch.yaml"},
+					Locations: extractor.InstalledLocations("testdata/multi-arch.yaml"),
 					Metadata: &snap.Metadata{
 						Name:              "core",
 						Version:           "16-2.61.4-20240607",
@@ -365,7 +365,7 @@ func TestToPURL(t *testing.T) {
 				Name:      snapName,
 				Version:   snapVersion,
 				Metadata:  tt.metadata,
-				Locations: []string{"location"},
+				Locations: extractor.InstalledLocations("location"),
 			}
 			got := e.ToPURL(i)
 			if diff := cmp.Diff(tt.want, got); diff != "" {
@@ -0,0 +1,363 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wmiproducts
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"unicode/utf16"
+)
+
+// The layout of the CIM repository is undocumented. The structures below
+// follow the reverse-engineered description of the FireEye python-cim project
+// and only cover what is needed to read string properties of instances.
+
+const (
+	// pageSize is the size of the pages of OBJECTS.DATA.
+	pageSize = 0x2000
+	// tocEntrySize is the size of the entries of the table of contents at the
+	// start of each page: record ID, offset, size and CRC32, each 32 bits.
+	tocEntrySize = 16
+
+	// Lengths in UTF-16 characters of the class name hashes that start instance
+	// records: MD5 up to Windows XP, SHA-256 since Windows Vista.
+	md5HashLen    = 32
+	sha256HashLen = 64
+
+	// heapLengthFlag is set in the length of the data heaps.
+	heapLengthFlag = 0x80000000
+
+	// cimTypeString is the CIM type of string properties.
+	cimTypeString = 8
+	// cimFlagArray marks array properties.
+	cimFlagArray = 0x2000
+)
+
+var errTruncated = errors.New("truncated record")
+
+// pageRecords returns the records stored in a page. Records that continue on
+// later pages are skipped since following them requires the mapping files.
+func pageRecords(page []byte) [][]byte {
+	var res [][]byte
+	for off := 0; off+tocEntrySize <= len(page); off += tocEntrySize {
+		id := binary.LittleEndian.Uint32(page[off:])
+		start := binary.LittleEndian.Uint32(page[off+4:])
+		size := binary.LittleEndian.Uint32(page[off+8:])
+		if id == 0 && start == 0 {
+			break
+		}
+		if start < uint32(off+tocEntrySize) || uint64(start)+uint64(size) > uint64(len(page)) {
+			// Not a data page or a record spanning several pages.
+			continue
+		}
+		res = append(res, page[start:start+size])
+	}
+	return res
+}
+
+// classNameHashes returns the hashes that identify the instances of the
+// class in the repository.
+func classNameHashes(class string) []string {
+	b := utf16LE(strings.ToUpper(class))
+	m := md5.Sum(b)
+	s := sha256.Sum256(b)
+	return []string{
+		strings.ToUpper(hex.EncodeToString(m[:])),
+		strings.ToUpper(hex.EncodeToString(s[:])),
+	}
+}
+
+func utf16LE(s string) []byte {
+	u := utf16.Encode([]rune(s))
+	b := make([]byte, 2*len(u))
+	for i, c := range u {
+		binary.LittleEndian.PutUint16(b[2*i:], c)
+	}
+	return b
+}
+
+// cursor reads little-endian values from a record. Reads past the end set
+// err and return zero values.
+type cursor struct {
+	b   []byte
+	off int
+	err error
+}
+
+func (c *cursor) bytes(n int) []byte {
+	if c.err != nil || n < 0 || c.off+n > len(c.b) {
+		c.err = errTruncated
+		return nil
+	}
+	b := c.b[c.off : c.off+n]
+	c.off += n
+	return b
+}
+
+func (c *cursor) u8() uint8 {
+	if b := c.bytes(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (c *cursor) u16() uint16 {
+	if b := c.bytes(2); b != nil {
+		return binary.LittleEndian.Uint16(b)
+	}
+	return 0
+}
+
+func (c *cursor) u32() uint32 {
+	if b := c.bytes(4); b != nil {
+		return binary.LittleEndian.Uint32(b)
+	}
+	return 0
+}
+
+func (c *cursor) u64() uint64 {
+	if b := c.bytes(8); b != nil {
+		return binary.LittleEndian.Uint64(b)
+	}
+	return 0
+}
+
+func (c *cursor) utf16(n int) string {
+	b := c.bytes(2 * n)
+	if b == nil {
+		return ""
+	}
+	u := make([]uint16, n)
+	for i := range u {
+		u[i] = binary.LittleEndian.Uint16(b[2*i:])
+	}
+	return string(utf16.Decode(u))
+}
+
+// heap reads a data heap: its length with heapLengthFlag set, then the data.
+func (c *cursor) heap() []byte {
+	n := c.u32()
+	if c.err == nil && n&heapLengthFlag == 0 {
+		c.err = errors.New("malformed data heap length")
+		return nil
+	}
+	return c.bytes(int(n &^ heapLengthFlag))
+}
+
+// skipList skips a length-prefixed list, e.g. of qualifiers, whose length
+// includes the length field itself.
+func (c *cursor) skipList() {
+	n := c.u32()
+	c.bytes(int(n) - 4)
+}
+
+// heapString returns the string at the offset of the heap. Strings start with
+// a flag byte: 0 for ASCII, 1 for UTF-16, and end with a NUL character.
+func heapString(heap []byte, off uint32) (string, bool) {
+	if uint64(off) >= uint64(len(heap)) {
+		return "", false
+	}
+	b := heap[off+1:]
+	switch heap[off] {
+	case 0:
+		end := 0
+		for end < len(b) && b[end] != 0 {
+			end++
+		}
+		if end == len(b) {
+			return "", false
+		}
+		// The "ASCII" strings are Latin-1.
+		r := make([]rune, end)
+		for i, c := range b[:end] {
+			r[i] = rune(c)
+		}
+		return string(r), true
+	case 1:
+		var u []uint16
+		for i := 0; ; i += 2 {
+			if i+1 >= len(b) {
+				return "", false
+			}
+			c := binary.LittleEndian.Uint16(b[i:])
+			if c == 0 {
+				break
+			}
+			u = append(u, c)
+		}
+		return string(utf16.Decode(u)), true
+	default:
+		return "", false
+	}
+}
+
+// property describes a property of a class.
+type property struct {
+	name    string
+	cimType uint32
+	index   uint16
+	// valueOffset is the offset of the value within the property values of
+	// instances.
+	valueOffset uint32
+}
+
+// size returns the size of the property's value in instances. Strings and
+// other variable-length values are stored as 32-bit heap offsets.
+func (p property) size() uint32 {
+	if p.cimType&cimFlagArray != 0 {
+		return 4
+	}
+	switch p.cimType &^ 0x4000 {
+	case 16, 17: // sint8, uint8
+		return 1
+	case 2, 18, 11, 103: // sint16, uint16, boolean, char16
+		return 2
+	case 20, 21, 5: // sint64, uint64, real64
+		return 8
+	default:
+		return 4
+	}
+}
+
+// classDef is the definition of a class.
+type classDef struct {
+	name       string
+	properties []property
+}
+
+// valuesSize returns the size of the property values of the class' instances.
+func (d *classDef) valuesSize() int {
+	size := 0
+	for _, p := range d.properties {
+		size = max(size, int(p.valueOffset+p.size()))
+	}
+	return size
+}
+
+// parseClassDef parses a class definition record:
+//
+//	super class name length (32 bits) and UTF-16 name
+//	timestamp (64 bits), data length (32 bits), unknown byte
+//	class name offset into the heap (32 bits)
+//	default values size (32 bits)
+//	qualifiers (length-prefixed list)
+//	property count (32 bits), then name and info offsets into the heap
+//	default values
+//	heap
+//
+// Property infos hold the CIM type (32 bits), index (16 bits), value offset
+// (32 bits) and class level (32 bits), followed by qualifiers.
+func parseClassDef(rec []byte) (*classDef, error) {
+	c := &cursor{b: rec}
+	c.utf16(int(c.u32()))
+	c.u64()
+	c.u32()
+	c.u8()
+	nameOffset := c.u32()
+	defaultsSize := c.u32()
+	c.skipList()
+	count := c.u32()
+	if c.err == nil && uint64(count)*8 > uint64(len(rec)) {
+		return nil, errors.New("malformed property count")
+	}
+	type ref struct{ name, info uint32 }
+	refs := make([]ref, 0, count)
+	for range count {
+		refs = append(refs, ref{name: c.u32(), info: c.u32()})
+	}
+	c.bytes(int(defaultsSize))
+	heap := c.heap()
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	name, ok := heapString(heap, nameOffset)
+	if !ok {
+		return nil, errors.New("malformed class name")
+	}
+	d := &classDef{name: name}
+	for _, r := range refs {
+		pname, ok := heapString(heap, r.name)
+		if !ok {
+			return nil, errors.New("malformed property name")
+		}
+		if uint64(r.info) > uint64(len(heap)) {
+			return nil, errTruncated
+		}
+		pc := &cursor{b: heap[r.info:]}
+		p := property{name: pname, cimType: pc.u32(), index: pc.u16(), valueOffset: pc.u32()}
+		if pc.err != nil {
+			return nil, pc.err
+		}
+		d.properties = append(d.properties, p)
+	}
+	return d, nil
+}
+
+// instanceHash returns the class name hash that starts an instance record.
+func instanceHash(rec []byte, hashLen int) string {
+	c := &cursor{b: rec}
+	return c.utf16(hashLen)
+}
+
+// parseInstance returns the string properties of an instance record of the
+// class:
+//
+//	class name hash (UTF-16, hashLen characters)
+//	two timestamps (64 bits each), data length (32 bits)
+//	class name offset into the heap (32 bits), unknown byte
+//	property states, 2 bits per property
+//	property values
+//	qualifiers (length-prefixed list), unknown byte
+//	heap
+//
+// Properties whose state has the low bit set have no value in the instance.
+func parseInstance(rec []byte, hashLen int, d *classDef) (map[string]string, error) {
+	c := &cursor{b: rec}
+	c.bytes(2 * hashLen)
+	c.u64()
+	c.u64()
+	c.u32()
+	c.u32()
+	c.u8()
+	states := c.bytes((2*len(d.properties) + 7) / 8)
+	values := c.bytes(d.valuesSize())
+	c.skipList()
+	c.u8()
+	heap := c.heap()
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	res := map[string]string{}
+	for _, p := range d.properties {
+		if p.cimType&^0x4000 != cimTypeString {
+			continue
+		}
+		bit := 2 * int(p.index)
+		if bit/8 >= len(states) || states[bit/8]&(1<<(bit%8)) != 0 {
+			continue
+		}
+		off := binary.LittleEndian.Uint32(values[p.valueOffset:])
+		if s, ok := heapString(heap, off); ok {
+			res[p.name] = s
+		}
+	}
+	return res, nil
+}
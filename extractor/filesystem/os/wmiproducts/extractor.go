@@ -0,0 +1,202 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wmiproducts extracts installed software from the instances of
+// product classes, e.g. Win32_Product, stored in the WMI (CIM) repository of
+// offline Windows images.
+//
+// Parsing is best-effort: the repository format is undocumented, records
+// that span several pages are skipped and many product classes are served by
+// providers at query time and have no instances stored in the repository.
+package wmiproducts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "windows/wmiproducts"
+
+	// repositoryPath is the location of the objects of the CIM repository.
+	repositoryPath = "windows/system32/wbem/repository/objects.data"
+)
+
+// productClass is a class whose instances describe installed software, along
+// with the names of its properties that hold the product details.
+type productClass struct {
+	name, nameProp, versionProp, vendorProp, idProp string
+}
+
+var productClasses = []productClass{
+	{name: "Win32_Product", nameProp: "Name", versionProp: "Version", vendorProp: "Vendor", idProp: "IdentifyingNumber"},
+	// Populated by the Configuration Manager client's hardware inventory.
+	{name: "Win32Reg_AddRemovePrograms", nameProp: "DisplayName", versionProp: "Version", vendorProp: "Publisher", idProp: "ProdID"},
+	{name: "Win32Reg_AddRemovePrograms64", nameProp: "DisplayName", versionProp: "Version", vendorProp: "Publisher", idProp: "ProdID"},
+}
+
+// Metadata holds additional information about a product.
+type Metadata struct {
+	// Class is the WMI class of the instance, e.g. "Win32_Product".
+	Class string `json:"class"`
+	// Vendor is the publisher of the product.
+	Vendor string `json:"vendor,omitempty"`
+	// IdentifyingNumber is the product code, e.g.
+	// "{90160000-008C-0000-0000-0000000FF1CE}".
+	IdentifyingNumber string `json:"identifyingNumber,omitempty"`
+}
+
+// Extractor extracts installed software from the WMI repository.
+type Extractor struct{}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileRequired returns true for the OBJECTS.DATA file of the CIM repository.
+func (e Extractor) FileRequired(path string, _ fs.FileInfo) bool {
+	return strings.HasSuffix(strings.ToLower(filepath.ToSlash(path)), repositoryPath)
+}
+
+// instance is an instance record of a product class.
+type instance struct {
+	class   *productClass
+	hashLen int
+	rec     []byte
+}
+
+// Extract returns the products whose instances are stored in the repository,
+// in the order of their records. The repository is read page by page; class
+// definitions can follow the instances, so instances of product classes are
+// kept until all pages have been read.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	hashes := map[string]*productClass{}
+	for i := range productClasses {
+		for _, h := range classNameHashes(productClasses[i].name) {
+			hashes[h] = &productClasses[i]
+		}
+	}
+
+	defs := map[string][]*classDef{}
+	var instances []instance
+	page := make([]byte, pageSize)
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if _, err := io.ReadFull(input.Reader, page); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			}
+			return nil, fmt.Errorf("could not read %s: %w", input.Path, err)
+		}
+		for _, rec := range pageRecords(page) {
+			if inst, ok := productInstance(rec, hashes); ok {
+				instances = append(instances, inst)
+				continue
+			}
+			if d, err := parseClassDef(rec); err == nil && isProductClass(d.name) {
+				defs[strings.ToLower(d.name)] = append(defs[strings.ToLower(d.name)], d)
+			}
+		}
+	}
+
+	res := []*extractor.Inventory{}
+	for _, inst := range instances {
+		if inv := newInventory(inst, defs[strings.ToLower(inst.class.name)], input.Path); inv != nil {
+			res = append(res, inv)
+		}
+	}
+	return res, nil
+}
+
+// productInstance returns the record as an instance if it starts with the
+// class name hash of a product class.
+func productInstance(rec []byte, hashes map[string]*productClass) (instance, bool) {
+	for _, n := range []int{sha256HashLen, md5HashLen} {
+		if class, ok := hashes[instanceHash(rec, n)]; ok {
+			// The page is reused for the next records.
+			return instance{class: class, hashLen: n, rec: append([]byte(nil), rec...)}, true
+		}
+	}
+	return instance{}, false
+}
+
+func isProductClass(name string) bool {
+	for _, c := range productClasses {
+		if strings.EqualFold(c.name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// newInventory returns the inventory of the instance, decoded with the first
+// definition of its class that yields a product name. The same class can be
+// defined in several namespaces.
+func newInventory(inst instance, defs []*classDef, path string) *extractor.Inventory {
+	for _, d := range defs {
+		props, err := parseInstance(inst.rec, inst.hashLen, d)
+		if err != nil || props[inst.class.nameProp] == "" {
+			continue
+		}
+		return &extractor.Inventory{
+			Name:    props[inst.class.nameProp],
+			Version: props[inst.class.versionProp],
+			Locations: []extractor.Location{
+				{Path: path, Reason: extractor.LocationInstalled},
+			},
+			Metadata: &Metadata{
+				Class:             d.name,
+				Vendor:            props[inst.class.vendorProp],
+				IdentifyingNumber: props[inst.class.idProp],
+			},
+		}
+	}
+	return nil
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:      purl.TypeGeneric,
+		Namespace: "microsoft",
+		Name:      i.Name,
+		Version:   i.Version,
+	}
+}
+
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeGeneric} }
+
+// Ecosystem returns no ecosystem since OSV does not support Windows software.
+func (e Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
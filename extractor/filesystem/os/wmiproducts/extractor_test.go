@@ -0,0 +1,325 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wmiproducts_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/wmiproducts"
+)
+
+const (
+	objectsPath = "Windows/System32/wbem/Repository/OBJECTS.DATA"
+	pageSize    = 0x2000
+
+	cimString = 8
+	cimUint32 = 19
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: objectsPath, want: true},
+		{path: "mnt/image/windows/system32/wbem/repository/objects.data", want: true},
+		{path: "Windows/System32/wbem/Repository/INDEX.BTR", want: false},
+		{path: "Windows/System32/wbem/Repository/FS/OBJECTS.DATA", want: false},
+		{path: "OBJECTS.DATA", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := (wmiproducts.Extractor{}).FileRequired(tt.path, nil); got != tt.want {
+				t.Errorf("FileRequired(%s) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+// The helpers below synthesize the repository structures parsed by the
+// extractor.
+
+func utf16LE(s string) []byte {
+	u := utf16.Encode([]rune(s))
+	b := make([]byte, 2*len(u))
+	for i, c := range u {
+		binary.LittleEndian.PutUint16(b[2*i:], c)
+	}
+	return b
+}
+
+func u32(v uint32) []byte { return binary.LittleEndian.AppendUint32(nil, v) }
+
+type heap struct{ b []byte }
+
+// str adds a string to the heap and returns its offset.
+func (h *heap) str(s string, wide bool) uint32 {
+	off := uint32(len(h.b))
+	if wide {
+		h.b = append(h.b, 1)
+		h.b = append(h.b, utf16LE(s)...)
+		h.b = append(h.b, 0, 0)
+	} else {
+		h.b = append(h.b, 0)
+		h.b = append(h.b, s...)
+		h.b = append(h.b, 0)
+	}
+	return off
+}
+
+func (h *heap) bytes() []byte {
+	return append(u32(uint32(len(h.b))|0x80000000), h.b...)
+}
+
+type prop struct {
+	name    string
+	cimType uint32
+}
+
+// classDef returns a class definition record. Property i is stored at value
+// offset 4*i.
+func classDef(name string, props []prop) []byte {
+	h := &heap{}
+	nameOff := h.str(name, false)
+	var refs []byte
+	for i, p := range props {
+		refs = append(refs, u32(h.str(p.name, false))...)
+		refs = append(refs, u32(uint32(len(h.b)))...)
+		info := u32(p.cimType)
+		info = binary.LittleEndian.AppendUint16(info, uint16(i))
+		info = append(info, u32(uint32(4*i))...)
+		info = append(info, u32(0)...) // level
+		info = append(info, u32(4)...) // empty qualifiers
+		h.b = append(h.b, info...)
+	}
+	defaults := make([]byte, 3)
+
+	var b []byte
+	super := "CIM_Product"
+	b = append(b, u32(uint32(len(super)))...)
+	b = append(b, utf16LE(super)...)
+	b = append(b, make([]byte, 8)...) // timestamp
+	b = append(b, u32(0)...)          // data length
+	b = append(b, 0)                  // unknown
+	b = append(b, u32(nameOff)...)    // class name
+	b = append(b, u32(uint32(len(defaults)))...)
+	b = append(b, u32(8)...)  // qualifiers
+	b = append(b, 1, 2, 3, 4) // qualifier data
+	b = append(b, u32(uint32(len(props)))...)
+	b = append(b, refs...)
+	b = append(b, defaults...)
+	return append(b, h.bytes()...)
+}
+
+func sha256Hash(class string) string {
+	s := sha256.Sum256(utf16LE(strings.ToUpper(class)))
+	return strings.ToUpper(hex.EncodeToString(s[:]))
+}
+
+func md5Hash(class string) string {
+	s := md5.Sum(utf16LE(strings.ToUpper(class)))
+	return strings.ToUpper(hex.EncodeToString(s[:]))
+}
+
+// instance returns an instance record with the given values of the
+// properties by index. Properties without a value are marked as such in the
+// property states.
+func instance(hash string, numProps int, values map[int]string) []byte {
+	h := &heap{}
+	h.str("Win32_Product", false)
+	states := make([]byte, (2*numProps+7)/8)
+	vals := make([]byte, 4*numProps)
+	for i := range numProps {
+		v, ok := values[i]
+		if !ok {
+			states[2*i/8] |= 1 << (2 * i % 8)
+			continue
+		}
+		binary.LittleEndian.PutUint32(vals[4*i:], h.str(v, strings.ContainsFunc(v, func(r rune) bool { return r > 0xff })))
+	}
+
+	var b []byte
+	b = append(b, utf16LE(hash)...)
+	b = append(b, make([]byte, 16)...) // timestamps
+	b = append(b, u32(0)...)           // data length
+	b = append(b, u32(0)...)           // class name
+	b = append(b, 0)                   // unknown
+	b = append(b, states...)
+	b = append(b, vals...)
+	b = append(b, u32(4)...) // empty qualifiers
+	b = append(b, 0)         // unknown
+	return append(b, h.bytes()...)
+}
+
+// page returns a page holding the records after its table of contents.
+func page(records ...[]byte) []byte {
+	p := make([]byte, pageSize)
+	off := 16 * (len(records) + 1)
+	for i, r := range records {
+		binary.LittleEndian.PutUint32(p[16*i:], uint32(i+1))
+		binary.LittleEndian.PutUint32(p[16*i+4:], uint32(off))
+		binary.LittleEndian.PutUint32(p[16*i+8:], uint32(len(r)))
+		off += copy(p[off:], r)
+	}
+	return p
+}
+
+func TestExtract(t *testing.T) {
+	productProps := []prop{
+		{name: "IdentifyingNumber", cimType: cimString},
+		{name: "Name", cimType: cimString},
+		{name: "Vendor", cimType: cimString},
+		{name: "Version", cimType: cimString},
+		{name: "Language", cimType: cimUint32},
+	}
+	productDef := classDef("Win32_Product", productProps)
+	arpProps := []prop{
+		{name: "DisplayName", cimType: cimString},
+		{name: "ProdID", cimType: cimString},
+		{name: "Publisher", cimType: cimString},
+		{name: "Version", cimType: cimString},
+	}
+	arpDef := classDef("Win32Reg_AddRemovePrograms", arpProps)
+	office := instance(sha256Hash("Win32_Product"), len(productProps), map[int]string{
+		0: "{90160000-008C-0000-0000-0000000FF1CE}",
+		1: "Office 16 Click-to-Run Extensibility Component",
+		2: "Microsoft Corporation",
+		3: "16.0.17328.20162",
+	})
+	location := []extractor.Location{{Path: objectsPath, Reason: extractor.LocationInstalled}}
+	officeInv := &extractor.Inventory{
+		Name:      "Office 16 Click-to-Run Extensibility Component",
+		Version:   "16.0.17328.20162",
+		Locations: location,
+		Metadata: &wmiproducts.Metadata{
+			Class:             "Win32_Product",
+			Vendor:            "Microsoft Corporation",
+			IdentifyingNumber: "{90160000-008C-0000-0000-0000000FF1CE}",
+		},
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+		want []*extractor.Inventory
+	}{
+		{
+			name: "instance before class definition",
+			data: bytes.Join([][]byte{page(office), page(productDef)}, nil),
+			want: []*extractor.Inventory{officeInv},
+		},
+		{
+			name: "pre-Vista MD5 class name hash",
+			data: page(productDef, instance(md5Hash("Win32_Product"), len(productProps), map[int]string{
+				1: "Java 8 Update 202",
+				3: "8.0.2020.8",
+			})),
+			want: []*extractor.Inventory{{
+				Name:      "Java 8 Update 202",
+				Version:   "8.0.2020.8",
+				Locations: location,
+				Metadata:  &wmiproducts.Metadata{Class: "Win32_Product"},
+			}},
+		},
+		{
+			name: "UTF-16 strings",
+			data: page(productDef, instance(sha256Hash("Win32_Product"), len(productProps), map[int]string{
+				1: "Übersetzer ✓",
+				3: "1.0",
+			})),
+			want: []*extractor.Inventory{{
+				Name:      "Übersetzer ✓",
+				Version:   "1.0",
+				Locations: location,
+				Metadata:  &wmiproducts.Metadata{Class: "Win32_Product"},
+			}},
+		},
+		{
+			name: "add/remove programs",
+			data: page(arpDef, instance(sha256Hash("Win32Reg_AddRemovePrograms"), len(arpProps), map[int]string{
+				0: "7-Zip 23.01 (x64)",
+				1: "7-Zip",
+				2: "Igor Pavlov",
+				3: "23.01",
+			})),
+			want: []*extractor.Inventory{{
+				Name:      "7-Zip 23.01 (x64)",
+				Version:   "23.01",
+				Locations: location,
+				Metadata: &wmiproducts.Metadata{
+					Class:             "Win32Reg_AddRemovePrograms",
+					Vendor:            "Igor Pavlov",
+					IdentifyingNumber: "7-Zip",
+				},
+			}},
+		},
+		{
+			name: "no class definition",
+			data: page(office),
+			want: []*extractor.Inventory{},
+		},
+		{
+			name: "instance without name",
+			data: page(productDef, instance(sha256Hash("Win32_Product"), len(productProps), map[int]string{3: "1.0"})),
+			want: []*extractor.Inventory{},
+		},
+		{
+			name: "other classes and garbage",
+			data: bytes.Join([][]byte{
+				page(
+					classDef("Win32_Service", []prop{{name: "Name", cimType: cimString}}),
+					instance(sha256Hash("Win32_Service"), 1, map[int]string{0: "Spooler"}),
+					[]byte("garbage"),
+				),
+				bytes.Repeat([]byte{0xff}, pageSize),
+				page(productDef, office),
+			}, nil),
+			want: []*extractor.Inventory{officeInv},
+		},
+		{
+			name: "truncated last page",
+			data: append(page(productDef, office), 1, 2, 3),
+			want: []*extractor.Inventory{officeInv},
+		},
+		{
+			name: "empty",
+			want: []*extractor.Inventory{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &filesystem.ScanInput{Path: objectsPath, Reader: bytes.NewReader(tt.data)}
+			got, err := wmiproducts.Extractor{}.Extract(context.Background(), input)
+			if err != nil {
+				t.Fatalf("Extract(): %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Extract() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -69,11 +69,18 @@ func (e Wrapper) FileRequired(path string, fileinfo fs.FileInfo) bool {
 	return true
 }
 
+// FileSizeLimitExceeded returns true if the file would be required if it
+// didn't exceed the maximum file size.
+func (e Wrapper) FileSizeLimitExceeded(path string, fileinfo fs.FileInfo) bool {
+	return e.MaxFileSizeBytes > 0 && fileinfo.Size() > e.MaxFileSizeBytes && e.Extractor.ShouldExtract(path)
+}
+
 func (e Wrapper) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
 	if e.Stats == nil {
 		return
 	}
 	e.Stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Extractor:     e.Name(),
 		Path:          path,
 		Result:        result,
 		FileSizeBytes: fileSizeBytes,
@@ -100,7 +107,7 @@ func (e Wrapper) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*e
 				Ecosystem: string(p.Ecosystem),
 				CompareAs: string(p.CompareAs),
 			},
-			Locations: []string{input.Path},
+			Locations: extractor.DeclaredLocations(input.Path),
 		})
 	}
 
@@ -166,6 +173,9 @@ func (e Wrapper) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	}
 }
 
+// PURLTypes returns the PURL type of the wrapped extractor's inventory.
+func (e Wrapper) PURLTypes() []string { return []string{e.PURLType} }
+
 // Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
 func (e Wrapper) Ecosystem(i *extractor.Inventory) string {
 	return i.Metadata.(*Metadata).Ecosystem
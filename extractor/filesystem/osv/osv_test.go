@@ -177,25 +177,25 @@ func TestExtract(t *testing.T) {
 					Name:      "reader content",
 					Version:   "1.0",
 					Metadata:  &osv.Metadata{PURLType: purl.TypeDebian},
-					Locations: []string{"targetfile"},
+					Locations: extractor.DeclaredLocations("targetfile"),
 				},
 				{
 					Name:      "yolo content",
 					Version:   "2.0",
 					Metadata:  &osv.Metadata{PURLType: purl.TypeDebian},
-					Locations: []string{"targetfile"},
+					Locations: extractor.DeclaredLocations("targetfile"),
 				},
 				{
 					Name:      "foobar content",
 					Version:   "3.0",
 					Metadata:  &osv.Metadata{PURLType: purl.TypeDebian},
-					Locations: []string{"targetfile"},
+					Locations: extractor.DeclaredLocations("targetfile"),
 				},
 				{
 					Name:      "targetfile",
 					Version:   "4.0",
 					Metadata:  &osv.Metadata{PURLType: purl.TypeDebian},
-					Locations: []string{"targetfile"},
+					Locations: extractor.DeclaredLocations("targetfile"),
 				},
 			},
 			wantResultMetric: stats.FileExtractedResultSuccess,
@@ -271,7 +271,7 @@ func TestToPURL(t *testing.T) {
 		Metadata: &osv.Metadata{
 			PURLType: purl.TypeMaven,
 		},
-		Locations: []string{"location"},
+		Locations: extractor.DeclaredLocations("location"),
 	}
 	want := &purl.PackageURL{
 		Type:      purl.TypeMaven,
@@ -21,6 +21,7 @@ import (
 	"io"
 	"io/fs"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/CycloneDX/cyclonedx-go"
@@ -53,6 +54,26 @@ var cdxExtensions = map[string]cyclonedx.BOMFileFormat{
 	".bom.xml":  cyclonedx.BOMFileFormatXML,
 }
 
+// Files with these exact names are also CycloneDX SBOMs, see the link above.
+var cdxBaseNames = map[string]cyclonedx.BOMFileFormat{
+	"bom.json": cyclonedx.BOMFileFormatJSON,
+	"bom.xml":  cyclonedx.BOMFileFormatXML,
+}
+
+// FileHints returns the file names and extensions the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	h := filesystem.FileHints{}
+	for name := range cdxBaseNames {
+		h.BaseNames = append(h.BaseNames, name)
+	}
+	for ext := range cdxExtensions {
+		h.Extensions = append(h.Extensions, ext)
+	}
+	sort.Strings(h.BaseNames)
+	sort.Strings(h.Extensions)
+	return h
+}
+
 // FileRequired returns true if the specified file is a supported cdx file.
 func (e Extractor) FileRequired(path string, _ fs.FileInfo) bool {
 	return findExtractor(path) != nil
@@ -78,28 +99,34 @@ func findExtractor(path string) extractFunc {
 	// For Windows
 	path = filepath.ToSlash(path)
 
-	for ext, format := range cdxExtensions {
-		if hasFileExtension(path, ext) {
-			return func(rdr io.Reader) (cyclonedx.BOM, error) {
-				var cdxBOM cyclonedx.BOM
-				return cdxBOM, cyclonedx.NewBOMDecoder(rdr, format).Decode(&cdxBOM)
+	format, ok := cdxBaseNames[strings.ToLower(filepath.Base(path))]
+	if !ok {
+		for ext, f := range cdxExtensions {
+			if hasFileExtension(path, ext) {
+				format, ok = f, true
+				break
 			}
 		}
 	}
-
-	return nil
+	if !ok {
+		return nil
+	}
+	return func(rdr io.Reader) (cyclonedx.BOM, error) {
+		var cdxBOM cyclonedx.BOM
+		return cdxBOM, cyclonedx.NewBOMDecoder(rdr, format).Decode(&cdxBOM)
+	}
 }
 
 func (e Extractor) convertCdxBomToInventory(cdxBom *cyclonedx.BOM, path string) ([]*extractor.Inventory, error) {
 	results := []*extractor.Inventory{}
 
-	if cdxBom == nil {
+	if cdxBom == nil || cdxBom.Components == nil {
 		return results, nil
 	}
 
-	for _, cdxPkg := range *cdxBom.Components {
+	for _, cdxPkg := range flattenComponents(*cdxBom.Components) {
 		inv := &extractor.Inventory{
-			Locations: []string{path},
+			Locations: extractor.LocationsFromPaths(path),
 			Metadata:  &Metadata{},
 		}
 		m := inv.Metadata.(*Metadata)
@@ -133,6 +160,19 @@ func (e Extractor) convertCdxBomToInventory(cdxBom *cyclonedx.BOM, path string)
 	return results, nil
 }
 
+// flattenComponents returns the components along with all components nested
+// inside them, e.g. the libraries bundled with an application.
+func flattenComponents(comps []cyclonedx.Component) []cyclonedx.Component {
+	var result []cyclonedx.Component
+	for _, c := range comps {
+		result = append(result, c)
+		if c.Components != nil {
+			result = append(result, flattenComponents(*c.Components)...)
+		}
+	}
+	return result
+}
+
 func hasFileExtension(path string, extension string) bool {
 	return strings.HasSuffix(strings.ToLower(path), extension)
 }
@@ -142,6 +182,11 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	return i.Metadata.(*Metadata).PURL
 }
 
+// ToCPEs returns the CPEs the SBOM lists for the package.
+func (e Extractor) ToCPEs(i *extractor.Inventory) []string {
+	return i.Metadata.(*Metadata).CPEs
+}
+
 // Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
 func (Extractor) Ecosystem(i *extractor.Inventory) string {
 	purl := i.Metadata.(*Metadata).PURL
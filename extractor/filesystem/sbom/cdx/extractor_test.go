@@ -66,6 +66,21 @@ func TestFileRequired(t *testing.T) {
 			path:           "testdata/sbom.cdx.xml",
 			wantIsRequired: true,
 		},
+		{
+			name:           "bom.json",
+			path:           "testdata/bom.json",
+			wantIsRequired: true,
+		},
+		{
+			name:           "BOM.XML",
+			path:           "testdata/BOM.XML",
+			wantIsRequired: true,
+		},
+		{
+			name:           "notbom.json",
+			path:           "testdata/notbom.json",
+			wantIsRequired: false,
+		},
 		{
 			name:           "random_file.ext",
 			path:           "testdata/random_file.ext",
@@ -106,7 +121,7 @@ func TestExtract(t *testing.T) {
 					Metadata: &cdx.Metadata{
 						CPEs: []string{"cpe:2.3:a:nginx:nginx:1.21.1"},
 					},
-					Locations: []string{"testdata/sbom.cdx.json"},
+					Locations: extractor.LocationsFromPaths("testdata/sbom.cdx.json"),
 				},
 				{
 					Name:    "openssl",
@@ -114,7 +129,7 @@ func TestExtract(t *testing.T) {
 					Metadata: &cdx.Metadata{
 						PURL: purlFromString(t, "pkg:generic/openssl@1.1.1"),
 					},
-					Locations: []string{"testdata/sbom.cdx.json"},
+					Locations: extractor.LocationsFromPaths("testdata/sbom.cdx.json"),
 				},
 			},
 		},
@@ -128,7 +143,7 @@ func TestExtract(t *testing.T) {
 					Metadata: &cdx.Metadata{
 						CPEs: []string{"cpe:2.3:a:nginx:nginx:1.21.1"},
 					},
-					Locations: []string{"testdata/sbom.cdx.xml"},
+					Locations: extractor.LocationsFromPaths("testdata/sbom.cdx.xml"),
 				},
 				{
 					Name:    "openssl",
@@ -136,7 +151,29 @@ func TestExtract(t *testing.T) {
 					Metadata: &cdx.Metadata{
 						PURL: purlFromString(t, "pkg:generic/openssl@1.1.1"),
 					},
-					Locations: []string{"testdata/sbom.cdx.xml"},
+					Locations: extractor.LocationsFromPaths("testdata/sbom.cdx.xml"),
+				},
+			},
+		},
+		{
+			name: "bom.json with nested components",
+			path: "testdata/bom.json",
+			wantInventory: []*extractor.Inventory{
+				{
+					Name:    "vendor-app",
+					Version: "2.4.0",
+					Metadata: &cdx.Metadata{
+						PURL: purlFromString(t, "pkg:generic/vendor-app@2.4.0"),
+					},
+					Locations: extractor.LocationsFromPaths("testdata/bom.json"),
+				},
+				{
+					Name:    "lodash",
+					Version: "4.17.21",
+					Metadata: &cdx.Metadata{
+						PURL: purlFromString(t, "pkg:npm/lodash@4.17.21"),
+					},
+					Locations: extractor.LocationsFromPaths("testdata/bom.json"),
 				},
 			},
 		},
@@ -193,7 +230,7 @@ func TestToPURL(t *testing.T) {
 			PURL: want,
 			CPEs: []string{},
 		},
-		Locations: []string{"location"},
+		Locations: extractor.LocationsFromPaths("location"),
 	}
 	got := e.ToPURL(i)
 	if diff := cmp.Diff(want, got); diff != "" {
@@ -21,6 +21,7 @@ import (
 	"io"
 	"io/fs"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/google/osv-scalibr/extractor"
@@ -58,6 +59,16 @@ var extensionHandlers = map[string]extractFunc{
 	// No support for .xsl files because those are too ambiguous and could be many other things.
 }
 
+// FileHints returns the file extensions the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	h := filesystem.FileHints{}
+	for ext := range extensionHandlers {
+		h.Extensions = append(h.Extensions, ext)
+	}
+	sort.Strings(h.Extensions)
+	return h
+}
+
 // FileRequired returns true if the specified file is a supported spdx file.
 func (e Extractor) FileRequired(path string, _ fs.FileInfo) bool {
 	_, isSupported := findExtractor(path)
@@ -99,7 +110,8 @@ func (e Extractor) convertSpdxDocToInventory(spdxDoc *spdx.Document, path string
 
 	for _, spdxPkg := range spdxDoc.Packages {
 		inv := &extractor.Inventory{
-			Locations: []string{path},
+			Version:   spdxPkg.PackageVersion,
+			Locations: extractor.LocationsFromPaths(path),
 			Metadata:  &Metadata{},
 		}
 		m := inv.Metadata.(*Metadata)
@@ -120,6 +132,9 @@ func (e Extractor) convertSpdxDocToInventory(spdxDoc *spdx.Document, path string
 					log.Warnf("Invalid PURL for package: %q", extRef.Locator)
 				} else {
 					m.PURL = &packageURL
+					if inv.Version == "" {
+						inv.Version = packageURL.Version
+					}
 				}
 			}
 		}
@@ -143,6 +158,11 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	return i.Metadata.(*Metadata).PURL
 }
 
+// ToCPEs returns the CPEs the SBOM lists for the package.
+func (e Extractor) ToCPEs(i *extractor.Inventory) []string {
+	return i.Metadata.(*Metadata).CPEs
+}
+
 // Ecosystem returns the OSV Ecosystem of the software extracted by this extractor.
 func (Extractor) Ecosystem(i *extractor.Inventory) string {
 	purl := i.Metadata.(*Metadata).PURL
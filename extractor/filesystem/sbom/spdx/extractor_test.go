@@ -105,14 +105,37 @@ func TestExtract(t *testing.T) {
 					Metadata: &spdx.Metadata{
 						CPEs: []string{"cpe:2.3:a:nginx:nginx:1.21.1"},
 					},
-					Locations: []string{"testdata/sbom.spdx.json"},
+					Locations: extractor.LocationsFromPaths("testdata/sbom.spdx.json"),
 				},
 				{
-					Name: "openssl",
+					Name:    "openssl",
+					Version: "1.1.1l",
 					Metadata: &spdx.Metadata{
 						PURL: getPURL("openssl", "1.1.1l"),
 					},
-					Locations: []string{"testdata/sbom.spdx.json"},
+					Locations: extractor.LocationsFromPaths("testdata/sbom.spdx.json"),
+				},
+			},
+		},
+		{
+			name: "versions.spdx.json",
+			path: "testdata/versions.spdx.json",
+			wantInventory: []*extractor.Inventory{
+				{
+					Name:    "lodash",
+					Version: "4.17.21",
+					Metadata: &spdx.Metadata{
+						PURL: &purl.PackageURL{Type: purl.TypeNPM, Name: "lodash", Version: "4.17.21", Qualifiers: purl.Qualifiers{}},
+					},
+					Locations: extractor.LocationsFromPaths("testdata/versions.spdx.json"),
+				},
+				{
+					Name:    "cpe:2.3:a:zlib:zlib:1.3.1:*:*:*:*:*:*:*",
+					Version: "1.3.1",
+					Metadata: &spdx.Metadata{
+						CPEs: []string{"cpe:2.3:a:zlib:zlib:1.3.1:*:*:*:*:*:*:*"},
+					},
+					Locations: extractor.LocationsFromPaths("testdata/versions.spdx.json"),
 				},
 			},
 		},
@@ -121,19 +144,21 @@ func TestExtract(t *testing.T) {
 			path: "testdata/purl_and_cpe.spdx.json",
 			wantInventory: []*extractor.Inventory{
 				{
-					Name: "nginx",
+					Name:    "nginx",
+					Version: "1.21.1",
 					Metadata: &spdx.Metadata{
 						CPEs: []string{"cpe:2.3:a:nginx:nginx:1.21.1"},
 						PURL: getPURL("nginx", "1.21.1"),
 					},
-					Locations: []string{"testdata/purl_and_cpe.spdx.json"},
+					Locations: extractor.LocationsFromPaths("testdata/purl_and_cpe.spdx.json"),
 				},
 				{
-					Name: "openssl",
+					Name:    "openssl",
+					Version: "1.1.1l",
 					Metadata: &spdx.Metadata{
 						PURL: getPURL("openssl", "1.1.1l"),
 					},
-					Locations: []string{"testdata/purl_and_cpe.spdx.json"},
+					Locations: extractor.LocationsFromPaths("testdata/purl_and_cpe.spdx.json"),
 				},
 			},
 		},
@@ -146,14 +171,15 @@ func TestExtract(t *testing.T) {
 					Metadata: &spdx.Metadata{
 						CPEs: []string{"cpe:2.3:a:nginx:nginx:1.21.1"},
 					},
-					Locations: []string{"testdata/sbom.spdx"},
+					Locations: extractor.LocationsFromPaths("testdata/sbom.spdx"),
 				},
 				{
-					Name: "openssl",
+					Name:    "openssl",
+					Version: "1.1.1l",
 					Metadata: &spdx.Metadata{
 						PURL: getPURL("openssl", "1.1.1l"),
 					},
-					Locations: []string{"testdata/sbom.spdx"},
+					Locations: extractor.LocationsFromPaths("testdata/sbom.spdx"),
 				},
 			},
 		},
@@ -166,14 +192,15 @@ func TestExtract(t *testing.T) {
 					Metadata: &spdx.Metadata{
 						CPEs: []string{"cpe:2.3:a:nginx:nginx:1.21.1"},
 					},
-					Locations: []string{"testdata/sbom.spdx.yml"},
+					Locations: extractor.LocationsFromPaths("testdata/sbom.spdx.yml"),
 				},
 				{
-					Name: "openssl",
+					Name:    "openssl",
+					Version: "1.1.1l",
 					Metadata: &spdx.Metadata{
 						PURL: getPURL("openssl", "1.1.1l"),
 					},
-					Locations: []string{"testdata/sbom.spdx.yml"},
+					Locations: extractor.LocationsFromPaths("testdata/sbom.spdx.yml"),
 				},
 			},
 		},
@@ -186,14 +213,15 @@ func TestExtract(t *testing.T) {
 					Metadata: &spdx.Metadata{
 						CPEs: []string{"cpe:2.3:a:nginx:nginx:1.21.1"},
 					},
-					Locations: []string{"testdata/sbom.spdx.rdf"},
+					Locations: extractor.LocationsFromPaths("testdata/sbom.spdx.rdf"),
 				},
 				{
-					Name: "openssl",
+					Name:    "openssl",
+					Version: "1.1.1l",
 					Metadata: &spdx.Metadata{
 						PURL: getPURL("openssl", "1.1.1l"),
 					},
-					Locations: []string{"testdata/sbom.spdx.rdf"},
+					Locations: extractor.LocationsFromPaths("testdata/sbom.spdx.rdf"),
 				},
 			},
 		},
@@ -251,7 +279,7 @@ func TestToPURL(t *testing.T) {
 			PURL: want,
 			CPEs: []string{},
 		},
-		Locations: []string{"location"},
+		Locations: extractor.LocationsFromPaths("location"),
 	}
 	got := e.ToPURL(i)
 	if diff := cmp.Diff(want, got); diff != "" {
@@ -0,0 +1,232 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gitmodules extracts the git submodules declared in .gitmodules
+// files.
+package gitmodules
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+// Name is the unique name of this extractor.
+const Name = "vcs/gitmodules"
+
+var (
+	// sectionRe matches section headers like [submodule "name"].
+	sectionRe = regexp.MustCompile(`^\[\s*submodule\s+"(.*)"\s*\]$`)
+	// commitRe matches full SHA-1 and SHA-256 object names.
+	commitRe = regexp.MustCompile(`^(?:[0-9a-f]{40}|[0-9a-f]{64})$`)
+)
+
+// Metadata holds additional information about a git submodule.
+type Metadata struct {
+	// Name is the name of the submodule in .gitmodules.
+	Name string `json:"name"`
+	// Path is the directory of the submodule, relative to the .gitmodules file.
+	Path string `json:"path"`
+	// URL is the URL of the submodule's repository.
+	URL string `json:"url"`
+	// Branch is the branch the submodule tracks, if configured.
+	Branch string `json:"branch,omitempty"`
+}
+
+// Extractor extracts the git submodules declared in .gitmodules files.
+type Extractor struct{}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileHints returns the file names the extractor could require.
+func (e Extractor) FileHints() filesystem.FileHints {
+	return filesystem.FileHints{BaseNames: []string{".gitmodules"}}
+}
+
+// FileRequired returns true for .gitmodules files.
+func (e Extractor) FileRequired(path string, _ fs.FileInfo) bool {
+	return filepath.Base(path) == ".gitmodules"
+}
+
+// Extract returns the submodules declared in the .gitmodules file. If a
+// submodule is checked out next to the file, the commit it's at is used as
+// the version.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	modules, err := parse(input)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+
+	dir := path.Dir(filepath.ToSlash(input.Path))
+	result := []*extractor.Inventory{}
+	for _, m := range modules {
+		if m.URL == "" || m.Path == "" {
+			continue
+		}
+		commit := checkedOutCommit(input.FS, path.Join(dir, m.Path))
+		inv := &extractor.Inventory{
+			Name:      repoName(m.URL),
+			Version:   commit,
+			Locations: []extractor.Location{{Path: input.Path, Reason: extractor.LocationDeclared}},
+			Metadata:  m,
+		}
+		inv.SourceCode = &extractor.SourceCodeIdentifier{Repo: m.URL, Commit: commit}
+		result = append(result, inv)
+	}
+	return result, nil
+}
+
+// parse reads the submodule sections of a git config file.
+func parse(input *filesystem.ScanInput) ([]*Metadata, error) {
+	var modules []*Metadata
+	var current *Metadata
+	s := bufio.NewScanner(input.Reader)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			current = nil
+			if m := sectionRe.FindStringSubmatch(line); m != nil {
+				current = &Metadata{Name: m[1]}
+				modules = append(modules, current)
+			}
+			continue
+		}
+		if current == nil {
+			continue
+		}
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			continue
+		}
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "path":
+			current.Path = value
+		case "url":
+			current.URL = value
+		case "branch":
+			current.Branch = value
+		}
+	}
+	return modules, s.Err()
+}
+
+// checkedOutCommit returns the commit the submodule checked out at dir is at,
+// or "" if it isn't checked out or the commit can't be determined.
+func checkedOutCommit(fsys fs.FS, dir string) string {
+	gitDir := path.Join(dir, ".git")
+	info, err := fs.Stat(fsys, gitDir)
+	if err != nil {
+		return ""
+	}
+	if !info.IsDir() {
+		// Submodules usually have a .git file pointing at their git dir inside
+		// the superproject's .git/modules.
+		content, err := fs.ReadFile(fsys, gitDir)
+		if err != nil {
+			return ""
+		}
+		target, ok := strings.CutPrefix(strings.TrimSpace(string(content)), "gitdir:")
+		target = strings.TrimSpace(target)
+		if !ok || path.IsAbs(target) {
+			return ""
+		}
+		gitDir = path.Join(dir, target)
+	}
+
+	head, err := fs.ReadFile(fsys, path.Join(gitDir, "HEAD"))
+	if err != nil {
+		return ""
+	}
+	ref, isRef := strings.CutPrefix(strings.TrimSpace(string(head)), "ref:")
+	if !isRef {
+		return validCommit(ref)
+	}
+	ref = strings.TrimSpace(ref)
+	if content, err := fs.ReadFile(fsys, path.Join(gitDir, ref)); err == nil {
+		return validCommit(string(content))
+	}
+	packed, err := fs.ReadFile(fsys, path.Join(gitDir, "packed-refs"))
+	if err != nil {
+		return ""
+	}
+	for _, line := range strings.Split(string(packed), "\n") {
+		if commit, name, ok := strings.Cut(strings.TrimSpace(line), " "); ok && name == ref {
+			return validCommit(commit)
+		}
+	}
+	return ""
+}
+
+func validCommit(s string) string {
+	s = strings.TrimSpace(s)
+	if !commitRe.MatchString(s) {
+		return ""
+	}
+	return s
+}
+
+// repoName returns the repository name of a git URL, e.g. "bar" for
+// https://github.com/foo/bar.git.
+func repoName(url string) string {
+	url = strings.TrimSuffix(strings.TrimRight(url, "/"), ".git")
+	if i := strings.LastIndexAny(url, "/:"); i >= 0 {
+		url = url[i+1:]
+	}
+	return url
+}
+
+// ToPURL converts an inventory created by this extractor into a generic PURL
+// with the repository URL and commit as vcs_url.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	m := i.Metadata.(*Metadata)
+	vcsURL := "git+" + m.URL
+	if i.Version != "" {
+		vcsURL += "@" + i.Version
+	}
+	return &purl.PackageURL{
+		Type:       purl.TypeGeneric,
+		Name:       i.Name,
+		Version:    i.Version,
+		Qualifiers: purl.QualifiersFromMap(map[string]string{"vcs_url": vcsURL}),
+	}
+}
+
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeGeneric} }
+
+// Ecosystem returns no ecosystem since git repositories have none in OSV.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,180 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitmodules_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/vcs/gitmodules"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/testing/extracttest"
+)
+
+const (
+	googletestCommit = "b514bdc898e2951020cbdca1304b75f5950d1f59"
+	jsonCommit       = "9cca280a4d0ccf0c08f47a99aa71d1b0e52f8d03"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: ".gitmodules", want: true},
+		{path: "repo/.gitmodules", want: true},
+		{path: ".gitmodules.bak", want: false},
+		{path: "gitmodules", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := (gitmodules.Extractor{}).FileRequired(tt.path, nil); got != tt.want {
+				t.Errorf("FileRequired(%s) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+// checkedOutRoot returns a scan root containing testdata/.gitmodules with both
+// submodules checked out. Git metadata can't be committed as testdata so it's
+// created here: googletest uses a gitlink file pointing into the
+// superproject's .git/modules with a detached HEAD, json has its own .git
+// directory with HEAD pointing at a packed ref.
+func checkedOutRoot(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	content, err := os.ReadFile("testdata/.gitmodules")
+	if err != nil {
+		t.Fatalf("os.ReadFile(): %v", err)
+	}
+	files := map[string]string{
+		".gitmodules":                              string(content),
+		"third_party/googletest/.git":              "gitdir: ../../.git/modules/third_party/googletest\n",
+		".git/modules/third_party/googletest/HEAD": googletestCommit + "\n",
+		"libs/json/.git/HEAD":                      "ref: refs/heads/develop\n",
+		"libs/json/.git/packed-refs":               "# pack-refs with: peeled fully-peeled sorted\n" + jsonCommit + " refs/heads/develop\n",
+	}
+	for path, content := range files {
+		path = filepath.Join(root, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("os.MkdirAll(): %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("os.WriteFile(): %v", err)
+		}
+	}
+	return root
+}
+
+func TestExtract(t *testing.T) {
+	declared := func(path string) []extractor.Location {
+		return []extractor.Location{{Path: path, Reason: extractor.LocationDeclared}}
+	}
+	googletest := &gitmodules.Metadata{
+		Name: "third_party/googletest",
+		Path: "third_party/googletest",
+		URL:  "https://github.com/google/googletest.git",
+	}
+	json := &gitmodules.Metadata{
+		Name:   "libs/json",
+		Path:   "libs/json",
+		URL:    "git@github.com:nlohmann/json.git",
+		Branch: "develop",
+	}
+	tests := []extracttest.TestTableEntry{
+		{
+			Name:        "submodules not checked out",
+			InputConfig: extracttest.ScanInputMockConfig{Path: "testdata/.gitmodules"},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:       "googletest",
+					SourceCode: &extractor.SourceCodeIdentifier{Repo: googletest.URL},
+					Locations:  declared("testdata/.gitmodules"),
+					Metadata:   googletest,
+				},
+				{
+					Name:       "json",
+					SourceCode: &extractor.SourceCodeIdentifier{Repo: json.URL},
+					Locations:  declared("testdata/.gitmodules"),
+					Metadata:   json,
+				},
+			},
+		},
+		{
+			Name:        "submodules checked out",
+			InputConfig: extracttest.ScanInputMockConfig{Path: ".gitmodules", FakeScanRoot: checkedOutRoot(t)},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:       "googletest",
+					Version:    googletestCommit,
+					SourceCode: &extractor.SourceCodeIdentifier{Repo: googletest.URL, Commit: googletestCommit},
+					Locations:  declared(".gitmodules"),
+					Metadata:   googletest,
+				},
+				{
+					Name:       "json",
+					Version:    jsonCommit,
+					SourceCode: &extractor.SourceCodeIdentifier{Repo: json.URL, Commit: jsonCommit},
+					Locations:  declared(".gitmodules"),
+					Metadata:   json,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := gitmodules.Extractor{}
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+			if err != nil {
+				t.Fatalf("%s.Extract(%q) unexpected error: %v", extr.Name(), tt.InputConfig.Path, err)
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	i := &extractor.Inventory{
+		Name:    "googletest",
+		Version: googletestCommit,
+		Metadata: &gitmodules.Metadata{
+			Path: "third_party/googletest",
+			URL:  "https://github.com/google/googletest.git",
+		},
+	}
+	want := &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    "googletest",
+		Version: googletestCommit,
+		Qualifiers: purl.QualifiersFromMap(map[string]string{
+			"vcs_url": "git+https://github.com/google/googletest.git@" + googletestCommit,
+		}),
+	}
+	if diff := cmp.Diff(want, (gitmodules.Extractor{}).ToPURL(i)); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
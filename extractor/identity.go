@@ -0,0 +1,65 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extractor
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Key returns a stable identity of the software the inventory describes. It's
+// the PURL if the inventory's extractor creates one, and the ecosystem, name
+// and version otherwise. Locations, metadata and the extractor aren't part of
+// the identity, so the same package found in several files or by several
+// extractors has the same key. Keys are meant for comparison and as map keys,
+// not for display.
+func (i *Inventory) Key() string {
+	if i.Extractor != nil {
+		if p := i.Extractor.ToPURL(i); p != nil {
+			return p.String()
+		}
+	}
+	return strings.Join([]string{i.EffectiveEcosystem(), i.Name, i.Version}, "\x00")
+}
+
+// SameIdentity returns true if both inventories have the same Key and were
+// found by the same extractor in the same scan root and locations, with the
+// same PURL qualifiers, source code identifiers, metadata and annotations.
+// Relationships are ignored since they're derived from the rest of the scan
+// results. The method isn't called Equal since go-cmp would then use it
+// instead of comparing all fields of the inventory.
+func (i *Inventory) SameIdentity(o *Inventory) bool {
+	if i == nil || o == nil {
+		return i == o
+	}
+	return i.Key() == o.Key() &&
+		extractorName(i.Extractor) == extractorName(o.Extractor) &&
+		i.ScanRoot == o.ScanRoot &&
+		slices.Equal(i.Locations, o.Locations) &&
+		maps.Equal(i.Qualifiers, o.Qualifiers) &&
+		reflect.DeepEqual(i.SourceCode, o.SourceCode) &&
+		reflect.DeepEqual(i.Metadata, o.Metadata) &&
+		slices.Equal(i.Annotations, o.Annotations) &&
+		i.EffectiveConfidence() == o.EffectiveConfidence()
+}
+
+func extractorName(e Extractor) string {
+	if e == nil {
+		return ""
+	}
+	return e.Name()
+}
@@ -0,0 +1,143 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extractor_test
+
+import (
+	"testing"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/testing/fakeextractor"
+)
+
+func TestKey(t *testing.T) {
+	fake := fakeextractor.New("fake", 1, nil, nil)
+	tests := []struct {
+		name string
+		a, b *extractor.Inventory
+		want bool
+	}{
+		{
+			name: "same_purl_in_different_locations",
+			a:    &extractor.Inventory{Name: "requests", Version: "2.31.0", Extractor: fake, Locations: extractor.LocationsFromPaths("a/requirements.txt")},
+			b:    &extractor.Inventory{Name: "requests", Version: "2.31.0", Extractor: fake, Locations: extractor.LocationsFromPaths("b/requirements.txt")},
+			want: true,
+		},
+		{
+			name: "same_purl_with_different_metadata",
+			a:    &extractor.Inventory{Name: "requests", Version: "2.31.0", Extractor: fake, Metadata: "a"},
+			b:    &extractor.Inventory{Name: "requests", Version: "2.31.0", Extractor: fake, Metadata: "b"},
+			want: true,
+		},
+		{
+			name: "different_version",
+			a:    &extractor.Inventory{Name: "requests", Version: "2.31.0", Extractor: fake},
+			b:    &extractor.Inventory{Name: "requests", Version: "2.32.0", Extractor: fake},
+			want: false,
+		},
+		{
+			name: "without_extractor_same_ecosystem_name_and_version",
+			a:    &extractor.Inventory{Name: "requests", Version: "2.31.0", Ecosystem: "PyPI"},
+			b:    &extractor.Inventory{Name: "requests", Version: "2.31.0", Ecosystem: "PyPI"},
+			want: true,
+		},
+		{
+			name: "without_extractor_different_ecosystem",
+			a:    &extractor.Inventory{Name: "requests", Version: "2.31.0", Ecosystem: "PyPI"},
+			b:    &extractor.Inventory{Name: "requests", Version: "2.31.0", Ecosystem: "npm"},
+			want: false,
+		},
+		{
+			name: "without_extractor_fields_are_not_concatenated",
+			a:    &extractor.Inventory{Name: "ab", Version: "c"},
+			b:    &extractor.Inventory{Name: "a", Version: "bc"},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Key() == tt.b.Key(); got != tt.want {
+				t.Errorf("Key() of %+v and %+v are equal: %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeyUsesPURL(t *testing.T) {
+	i := &extractor.Inventory{Name: "requests", Version: "2.31.0", Extractor: fakeextractor.New("fake", 1, nil, nil)}
+	want := "pkg:pypi/requests@2.31.0"
+	if got := i.Key(); got != want {
+		t.Errorf("Key() = %q, want %q", got, want)
+	}
+}
+
+func TestSameIdentity(t *testing.T) {
+	fake := fakeextractor.New("fake", 1, nil, nil)
+	other := fakeextractor.New("other", 1, nil, nil)
+	newInv := func() *extractor.Inventory {
+		return &extractor.Inventory{
+			Name:        "requests",
+			Version:     "2.31.0",
+			SourceCode:  &extractor.SourceCodeIdentifier{Commit: "abc"},
+			Locations:   []extractor.Location{{Path: "requirements.txt", Reason: extractor.LocationDeclared}},
+			Extractor:   fake,
+			ScanRoot:    "/root",
+			Metadata:    &extractor.SourceCodeIdentifier{Repo: "metadata"},
+			Annotations: []extractor.Annotation{extractor.Transitional},
+		}
+	}
+	tests := []struct {
+		name   string
+		modify func(i *extractor.Inventory)
+		want   bool
+	}{
+		{name: "identical", modify: func(i *extractor.Inventory) {}, want: true},
+		{
+			name: "relationships_ignored",
+			modify: func(i *extractor.Inventory) {
+				i.Relationships = []extractor.Relationship{{Type: extractor.RelationshipDiscrepancy, Other: newInv()}}
+			},
+			want: true,
+		},
+		{name: "version", modify: func(i *extractor.Inventory) { i.Version = "2.32.0" }},
+		{name: "extractor", modify: func(i *extractor.Inventory) { i.Extractor = other }},
+		{name: "scan_root", modify: func(i *extractor.Inventory) { i.ScanRoot = "/other" }},
+		{name: "location_path", modify: func(i *extractor.Inventory) { i.Locations[0].Path = "other.txt" }},
+		{name: "location_reason", modify: func(i *extractor.Inventory) { i.Locations[0].Reason = extractor.LocationInstalled }},
+		{name: "qualifiers", modify: func(i *extractor.Inventory) { i.Qualifiers = map[string]string{"arch": "amd64"} }},
+		{name: "source_code", modify: func(i *extractor.Inventory) { i.SourceCode = nil }},
+		{name: "metadata", modify: func(i *extractor.Inventory) { i.Metadata = &extractor.SourceCodeIdentifier{Repo: "other"} }},
+		{name: "annotations", modify: func(i *extractor.Inventory) { i.Annotations = nil }},
+		{name: "confidence", modify: func(i *extractor.Inventory) { i.Confidence = extractor.ConfidenceLow }},
+		{
+			name:   "unset_confidence_is_max",
+			modify: func(i *extractor.Inventory) { i.Confidence = extractor.ConfidenceMax },
+			want:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := newInv(), newInv()
+			tt.modify(b)
+			if got := a.SameIdentity(b); got != tt.want {
+				t.Errorf("%+v.SameIdentity(%+v) = %v, want %v", a, b, got, tt.want)
+			}
+			if got := b.SameIdentity(a); got != tt.want {
+				t.Errorf("%+v.SameIdentity(%+v) = %v, want %v", b, a, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redact removes credentials from URLs captured by extractors.
+package redact
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+)
+
+// tokenParams are query parameters commonly used to pass credentials.
+var tokenParams = map[string]bool{
+	"access_token":  true,
+	"api_key":       true,
+	"apikey":        true,
+	"auth":          true,
+	"key":           true,
+	"password":      true,
+	"private_token": true,
+	"secret":        true,
+	"sig":           true,
+	"signature":     true,
+	"token":         true,
+}
+
+// URL returns s with the userinfo and credential query parameters removed if s
+// is a URL. Other strings are returned unchanged. The rest of the URL is kept
+// as is, so the order and encoding of the remaining query parameters doesn't
+// change.
+func URL(s string) string {
+	if !strings.Contains(s, "://") {
+		return s
+	}
+	u, err := url.Parse(s)
+	if err != nil || u.Host == "" {
+		return s
+	}
+	if u.User != nil {
+		s = removeUserinfo(s)
+	}
+	if u.RawQuery != "" {
+		s = removeTokenParams(s)
+	}
+	return s
+}
+
+// removeUserinfo removes the "user:password@" part of the authority of s.
+func removeUserinfo(s string) string {
+	start := strings.Index(s, "://") + len("://")
+	end := len(s)
+	if i := strings.IndexAny(s[start:], "/?#"); i >= 0 {
+		end = start + i
+	}
+	at := strings.LastIndex(s[start:end], "@")
+	if at < 0 {
+		return s
+	}
+	return s[:start] + s[start+at+1:]
+}
+
+// removeTokenParams removes the query parameters of s whose names are in
+// tokenParams.
+func removeTokenParams(s string) string {
+	start := strings.Index(s, "?")
+	if start < 0 {
+		return s
+	}
+	end := len(s)
+	if i := strings.Index(s[start:], "#"); i >= 0 {
+		end = start + i
+	}
+	params := strings.Split(s[start+1:end], "&")
+	kept := params[:0]
+	for _, p := range params {
+		name, _, _ := strings.Cut(p, "=")
+		if unescaped, err := url.QueryUnescape(name); err == nil {
+			name = unescaped
+		}
+		if !tokenParams[strings.ToLower(name)] {
+			kept = append(kept, p)
+		}
+	}
+	if len(kept) == len(params) {
+		return s
+	}
+	query := strings.Join(kept, "&")
+	if query == "" {
+		return s[:start] + s[end:]
+	}
+	return s[:start+1] + query + s[end:]
+}
+
+// Inventory redacts all URLs in the source code identifiers and the metadata
+// of the given inventory in place.
+func Inventory(i *extractor.Inventory) {
+	if i.SourceCode != nil {
+		i.SourceCode.Repo = URL(i.SourceCode.Repo)
+	}
+	if i.Metadata == nil {
+		return
+	}
+	v := reflect.ValueOf(i.Metadata)
+	if v.Kind() == reflect.Pointer {
+		redactValue(v, map[uintptr]bool{})
+		return
+	}
+	// Metadata stored by value can't be modified in place, so redact a copy.
+	c := reflect.New(v.Type()).Elem()
+	c.Set(v)
+	redactValue(c, map[uintptr]bool{})
+	i.Metadata = c.Interface()
+}
+
+// redactValue redacts all settable strings reachable from v. visited guards
+// against cycles through pointers.
+func redactValue(v reflect.Value, visited map[uintptr]bool) {
+	switch v.Kind() {
+	case reflect.String:
+		if v.CanSet() {
+			v.SetString(URL(v.String()))
+		}
+	case reflect.Pointer:
+		if v.IsNil() || visited[v.Pointer()] {
+			return
+		}
+		visited[v.Pointer()] = true
+		redactValue(v.Elem(), visited)
+	case reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		if !v.CanSet() {
+			redactValue(v.Elem(), visited)
+			return
+		}
+		// The dynamic value of an interface isn't addressable, so redact a copy
+		// and store it back.
+		c := copyOf(v.Elem())
+		redactValue(c, visited)
+		v.Set(c)
+	case reflect.Struct:
+		for i := range v.NumField() {
+			redactValue(v.Field(i), visited)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			redactValue(v.Index(i), visited)
+		}
+	case reflect.Map:
+		// Maps reached through unexported fields are read-only.
+		if !v.CanInterface() {
+			return
+		}
+		// Map elements aren't addressable either, so each one is redacted as a
+		// copy and written back.
+		for _, k := range v.MapKeys() {
+			c := copyOf(v.MapIndex(k))
+			redactValue(c, visited)
+			v.SetMapIndex(k, c)
+		}
+	}
+}
+
+// copyOf returns a settable copy of v.
+func copyOf(v reflect.Value) reflect.Value {
+	c := reflect.New(v.Type()).Elem()
+	c.Set(v)
+	return c
+}
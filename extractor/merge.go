@@ -0,0 +1,59 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extractor
+
+import "slices"
+
+// MergeLocations merges the inventories of the same package that the same
+// extractor found in the same scan root, e.g. a package declared by several
+// lockfiles, into the first of them. Packages are matched by their Key without
+// the PURL subpath, which some extractors set to tell apart the projects
+// declaring a package. The Locations of the first inventory become the union
+// of the merged Locations and its Annotations the ones all merged inventories
+// share, so that e.g. a package is only DevOnly if it is everywhere. Its other
+// fields are kept. The order of the remaining inventories is preserved.
+func MergeLocations(inv []*Inventory) []*Inventory {
+	type key struct{ key, extractor, scanRoot string }
+	merged := make(map[key]*Inventory)
+	res := make([]*Inventory, 0, len(inv))
+	for _, i := range inv {
+		k := key{mergeKey(i), extractorName(i.Extractor), i.ScanRoot}
+		first, ok := merged[k]
+		if !ok {
+			merged[k] = i
+			res = append(res, i)
+			continue
+		}
+		for _, l := range i.Locations {
+			if !slices.Contains(first.Locations, l) {
+				first.Locations = append(first.Locations, l)
+			}
+		}
+		first.Annotations = slices.DeleteFunc(first.Annotations, func(a Annotation) bool {
+			return !slices.Contains(i.Annotations, a)
+		})
+	}
+	return res
+}
+
+func mergeKey(i *Inventory) string {
+	if i.Extractor != nil {
+		if p := i.Extractor.ToPURL(i); p != nil {
+			p.Subpath = ""
+			return p.String()
+		}
+	}
+	return i.Key()
+}
@@ -0,0 +1,63 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extractor_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor"
+)
+
+func TestMergeLocations(t *testing.T) {
+	newInv := func(name, version, path string, annotations ...extractor.Annotation) *extractor.Inventory {
+		return &extractor.Inventory{
+			Name:        name,
+			Version:     version,
+			Ecosystem:   "NuGet",
+			Locations:   []extractor.Location{{Path: path, Reason: extractor.LocationDeclared}},
+			Annotations: annotations,
+		}
+	}
+	inv := []*extractor.Inventory{
+		newInv("Newtonsoft.Json", "13.0.3", "a/packages.lock.json", extractor.Transitive, extractor.DevOnly),
+		newInv("Serilog", "3.1.1", "a/packages.lock.json"),
+		newInv("Newtonsoft.Json", "13.0.3", "b/packages.lock.json", extractor.Transitive),
+		newInv("Newtonsoft.Json", "12.0.1", "b/packages.lock.json"),
+		newInv("Newtonsoft.Json", "13.0.3", "a/packages.lock.json", extractor.Transitive),
+	}
+	otherRoot := newInv("Serilog", "3.1.1", "a/packages.lock.json")
+	otherRoot.ScanRoot = "/other"
+	inv = append(inv, otherRoot)
+
+	want := []*extractor.Inventory{
+		{
+			Name:      "Newtonsoft.Json",
+			Version:   "13.0.3",
+			Ecosystem: "NuGet",
+			Locations: []extractor.Location{
+				{Path: "a/packages.lock.json", Reason: extractor.LocationDeclared},
+				{Path: "b/packages.lock.json", Reason: extractor.LocationDeclared},
+			},
+			Annotations: []extractor.Annotation{extractor.Transitive},
+		},
+		newInv("Serilog", "3.1.1", "a/packages.lock.json"),
+		newInv("Newtonsoft.Json", "12.0.1", "b/packages.lock.json"),
+		otherRoot,
+	}
+	if diff := cmp.Diff(want, extractor.MergeLocations(inv)); diff != "" {
+		t.Errorf("MergeLocations() diff (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extractor
+
+import (
+	"maps"
+	"strings"
+
+	"github.com/google/osv-scalibr/purl"
+)
+
+// PURLFromInventory returns a PURL of the given type with the inventory's
+// namespace, name, version and qualifiers. It's the default for ToPURL
+// implementations, which only need to set the qualifiers or subpath specific
+// to their PURL type on the result, see PURLQualifiers.
+func PURLFromInventory(typ string, i *Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:       typ,
+		Namespace:  i.Namespace,
+		Name:       i.BaseName(),
+		Version:    i.Version,
+		Qualifiers: i.PURLQualifiers(nil),
+	}
+}
+
+// PURLQualifiers returns the inventory's Qualifiers merged with the ones the
+// ToPURL implementation sets itself, which take precedence. The qualifiers are
+// sorted by key, which is their canonical order. Returns nil if there are none.
+func (i *Inventory) PURLQualifiers(own map[string]string) purl.Qualifiers {
+	if len(i.Qualifiers) == 0 && len(own) == 0 {
+		return nil
+	}
+	merged := make(map[string]string, len(i.Qualifiers)+len(own))
+	maps.Copy(merged, i.Qualifiers)
+	maps.Copy(merged, own)
+	return purl.QualifiersFromMap(merged)
+}
+
+// BaseName returns the inventory's Name without its Namespace, e.g. "core" for
+// "@babel/core" and "slf4j-api" for "org.slf4j:slf4j-api". Names that don't
+// start with the namespace are returned as they are.
+func (i *Inventory) BaseName() string {
+	if i.Namespace == "" {
+		return i.Name
+	}
+	rest, ok := strings.CutPrefix(i.Name, i.Namespace)
+	if !ok || len(rest) < 2 || (rest[0] != '/' && rest[0] != ':') {
+		return i.Name
+	}
+	return rest[1:]
+}
+
+// SplitNamespace splits a package name into the namespace before the first
+// separator and the rest, e.g. "@babel" and "core" for "@babel/core" and "/".
+// The namespace is empty if the name has no separator.
+func SplitNamespace(name, sep string) (namespace, base string) {
+	namespace, base, ok := strings.Cut(name, sep)
+	if !ok || namespace == "" || base == "" {
+		return "", name
+	}
+	return namespace, base
+}
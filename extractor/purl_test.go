@@ -0,0 +1,167 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extractor_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/purl"
+)
+
+func TestPURLFromInventory(t *testing.T) {
+	i := &extractor.Inventory{
+		Name:        "Newtonsoft.Json",
+		Version:     "13.0.3",
+		SourceCode:  &extractor.SourceCodeIdentifier{Commit: "abc"},
+		Locations:   []extractor.Location{{Path: "api/packages.lock.json", Reason: extractor.LocationDeclared}},
+		Ecosystem:   "NuGet",
+		Annotations: []extractor.Annotation{extractor.Transitive},
+	}
+	want := &purl.PackageURL{
+		Type:    purl.TypeNuget,
+		Name:    "Newtonsoft.Json",
+		Version: "13.0.3",
+	}
+	got := extractor.PURLFromInventory(purl.TypeNuget, i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("PURLFromInventory(%v) (-want +got):\n%s", i, diff)
+	}
+}
+
+func TestPURLFromInventory_Namespace(t *testing.T) {
+	i := &extractor.Inventory{Name: "symfony/console", Namespace: "symfony", Version: "v6.4.1"}
+	want := &purl.PackageURL{
+		Type:      purl.TypeComposer,
+		Namespace: "symfony",
+		Name:      "console",
+		Version:   "v6.4.1",
+	}
+	got := extractor.PURLFromInventory(purl.TypeComposer, i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("PURLFromInventory(%v) (-want +got):\n%s", i, diff)
+	}
+}
+
+func TestPURLFromInventory_Qualifiers(t *testing.T) {
+	i := &extractor.Inventory{
+		Name:       "openssl",
+		Version:    "3.0.14",
+		Qualifiers: map[string]string{"distro": "debian-12", "arch": "amd64"},
+	}
+	want := &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    "openssl",
+		Version: "3.0.14",
+		Qualifiers: purl.Qualifiers{
+			{Key: "arch", Value: "amd64"},
+			{Key: "distro", Value: "debian-12"},
+		},
+	}
+	got := extractor.PURLFromInventory(purl.TypeGeneric, i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("PURLFromInventory(%v) (-want +got):\n%s", i, diff)
+	}
+	if got, want := got.String(), "pkg:generic/openssl@3.0.14?arch=amd64&distro=debian-12"; got != want {
+		t.Errorf("PURLFromInventory(%v).String() = %q, want %q", i, got, want)
+	}
+}
+
+func TestPURLQualifiers(t *testing.T) {
+	tests := []struct {
+		desc       string
+		qualifiers map[string]string
+		own        map[string]string
+		want       purl.Qualifiers
+	}{
+		{
+			desc: "none",
+			want: nil,
+		},
+		{
+			desc:       "inventory qualifiers only",
+			qualifiers: map[string]string{"source": "openssl", "arch": "amd64"},
+			want:       purl.Qualifiers{{Key: "arch", Value: "amd64"}, {Key: "source", Value: "openssl"}},
+		},
+		{
+			desc: "own qualifiers only",
+			own:  map[string]string{"distro": "debian-12"},
+			want: purl.Qualifiers{{Key: "distro", Value: "debian-12"}},
+		},
+		{
+			desc:       "own qualifiers take precedence",
+			qualifiers: map[string]string{"arch": "x86_64", "checksum": "sha256:00ff"},
+			own:        map[string]string{"arch": "amd64", "distro": "debian-12"},
+			want: purl.Qualifiers{
+				{Key: "arch", Value: "amd64"},
+				{Key: "checksum", Value: "sha256:00ff"},
+				{Key: "distro", Value: "debian-12"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			i := &extractor.Inventory{Qualifiers: tt.qualifiers}
+			if diff := cmp.Diff(tt.want, i.PURLQualifiers(tt.own)); diff != "" {
+				t.Errorf("PURLQualifiers(%v) (-want +got):\n%s", tt.own, diff)
+			}
+		})
+	}
+}
+
+func TestBaseName(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace string
+		want      string
+	}{
+		{name: "@babel/core", namespace: "@babel", want: "core"},
+		{name: "org.slf4j:slf4j-api", namespace: "org.slf4j", want: "slf4j-api"},
+		{name: "symfony/console", namespace: "symfony", want: "console"},
+		{name: "lodash", namespace: "", want: "lodash"},
+		// Names that aren't prefixed with the namespace are kept.
+		{name: "slf4j-api", namespace: "org.slf4j", want: "slf4j-api"},
+		{name: "@babelcore", namespace: "@babel", want: "@babelcore"},
+		{name: "@babel/", namespace: "@babel", want: "@babel/"},
+	}
+	for _, tt := range tests {
+		i := &extractor.Inventory{Name: tt.name, Namespace: tt.namespace}
+		if got := i.BaseName(); got != tt.want {
+			t.Errorf("Inventory{Name: %q, Namespace: %q}.BaseName() = %q, want %q", tt.name, tt.namespace, got, tt.want)
+		}
+	}
+}
+
+func TestSplitNamespace(t *testing.T) {
+	tests := []struct {
+		name          string
+		sep           string
+		wantNamespace string
+		wantBase      string
+	}{
+		{name: "@babel/core", sep: "/", wantNamespace: "@babel", wantBase: "core"},
+		{name: "org.slf4j:slf4j-api", sep: ":", wantNamespace: "org.slf4j", wantBase: "slf4j-api"},
+		{name: "lodash", sep: "/", wantNamespace: "", wantBase: "lodash"},
+		{name: "/core", sep: "/", wantNamespace: "", wantBase: "/core"},
+		{name: "vendor/", sep: "/", wantNamespace: "", wantBase: "vendor/"},
+	}
+	for _, tt := range tests {
+		namespace, base := extractor.SplitNamespace(tt.name, tt.sep)
+		if namespace != tt.wantNamespace || base != tt.wantBase {
+			t.Errorf("SplitNamespace(%q, %q) = %q, %q, want %q, %q", tt.name, tt.sep, namespace, base, tt.wantNamespace, tt.wantBase)
+		}
+	}
+}
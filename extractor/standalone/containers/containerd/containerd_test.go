@@ -62,7 +62,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "image1",
 					Version:   "digest1",
-					Locations: []string{"/run/containerd/io.containerd.runtime.v2.task/default/123456789/rootfs"},
+					Locations: extractor.LocationsFromPaths("/run/containerd/io.containerd.runtime.v2.task/default/123456789/rootfs"),
 					Metadata: &plugin.Metadata{
 						Namespace:   "default",
 						ImageName:   "image1",
@@ -76,7 +76,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "image2",
 					Version:   "digest2",
-					Locations: []string{"/run/containerd/io.containerd.runtime.v2.task/k8s.io/567890123/rootfs"},
+					Locations: extractor.LocationsFromPaths("/run/containerd/io.containerd.runtime.v2.task/k8s.io/567890123/rootfs"),
 					Metadata: &plugin.Metadata{
 						Namespace:   "k8s.io",
 						ImageName:   "image2",
@@ -99,7 +99,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "image1",
 					Version:   "digest1",
-					Locations: []string{"/run/containerd/io.containerd.runtime.v2.task/default/123456789/rootfs"},
+					Locations: extractor.LocationsFromPaths("/run/containerd/io.containerd.runtime.v2.task/default/123456789/rootfs"),
 					Metadata: &plugin.Metadata{
 						Namespace:   "default",
 						ImageName:   "image1",
@@ -113,7 +113,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "image2",
 					Version:   "digest2",
-					Locations: []string{"/run/containerd/io.containerd.runtime.v2.task/k8s.io/567890123/rootfs"},
+					Locations: extractor.LocationsFromPaths("/run/containerd/io.containerd.runtime.v2.task/k8s.io/567890123/rootfs"),
 					Metadata: &plugin.Metadata{
 						Namespace:   "k8s.io",
 						ImageName:   "image2",
@@ -136,7 +136,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "image1",
 					Version:   "digest1",
-					Locations: []string{"/run/containerd/io.containerd.runtime.v2.task/default/123456788/test/rootfs"},
+					Locations: extractor.LocationsFromPaths("/run/containerd/io.containerd.runtime.v2.task/default/123456788/test/rootfs"),
 					Metadata: &plugin.Metadata{
 						Namespace:   "default",
 						ImageName:   "image1",
@@ -150,7 +150,7 @@ func TestExtract(t *testing.T) {
 				{
 					Name:      "image2",
 					Version:   "digest2",
-					Locations: []string{"/run/containerd/io.containerd.runtime.v2.task/k8s.io/567890122/test2/rootfs"},
+					Locations: extractor.LocationsFromPaths("/run/containerd/io.containerd.runtime.v2.task/k8s.io/567890122/test2/rootfs"),
 					Metadata: &plugin.Metadata{
 						Namespace:   "k8s.io",
 						ImageName:   "image2",
@@ -157,7 +157,7 @@ func (e *Extractor) Extract(ctx context.Context, input *standalone.ScanInput) ([
 		pkg := &extractor.Inventory{
 			Name:      ctr.ImageName,
 			Version:   ctr.ImageDigest,
-			Locations: []string{ctr.RootFS},
+			Locations: extractor.LocationsFromPaths(ctr.RootFS),
 			Metadata:  &ctr,
 		}
 		inventory = append(inventory, pkg)
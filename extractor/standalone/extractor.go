@@ -20,6 +20,7 @@ import (
 	"path/filepath"
 
 	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/internal/redact"
 	scalibrfs "github.com/google/osv-scalibr/fs"
 	"github.com/google/osv-scalibr/plugin"
 )
@@ -64,22 +65,25 @@ func Run(ctx context.Context, config *Config) ([]*extractor.Inventory, []*plugin
 		Root: config.ScanRoot.Path,
 	}
 
-	for _, extractor := range config.Extractors {
+	for _, ex := range config.Extractors {
 		if ctx.Err() != nil {
 			return nil, nil, ctx.Err()
 		}
 
-		inv, err := extractor.Extract(ctx, scanInput)
+		inv, err := ex.Extract(ctx, scanInput)
 		if err != nil {
-			statuses = append(statuses, plugin.StatusFromErr(extractor, false, err))
+			statuses = append(statuses, plugin.StatusFromErr(ex, false, err))
 			continue
 		}
 		for _, i := range inv {
-			i.Extractor = extractor
+			i.Extractor = ex
+			i.ScanRoot = scanInput.Root
+			redact.Inventory(i)
+			extractor.FillEcosystem(i)
 		}
 
 		inventories = append(inventories, inv...)
-		statuses = append(statuses, plugin.StatusFromErr(extractor, false, nil))
+		statuses = append(statuses, plugin.StatusFromErr(ex, false, nil))
 	}
 
 	return inventories, statuses, nil
@@ -18,15 +18,21 @@ package list
 import (
 	"fmt"
 	"os"
+	"runtime"
 	"slices"
+	"sort"
 	"strings"
 
+	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/standalone"
 	"github.com/google/osv-scalibr/extractor/standalone/containers/containerd"
+	"github.com/google/osv-scalibr/extractor/standalone/os/kernel"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/appx"
 	"github.com/google/osv-scalibr/extractor/standalone/windows/dismpatch"
 	"github.com/google/osv-scalibr/extractor/standalone/windows/ospackages"
 	"github.com/google/osv-scalibr/extractor/standalone/windows/regosversion"
 	"github.com/google/osv-scalibr/extractor/standalone/windows/regpatchlevel"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/syspath"
 	"github.com/google/osv-scalibr/log"
 	"github.com/google/osv-scalibr/plugin"
 )
@@ -41,8 +47,15 @@ var (
 	// dangerous.
 	WindowsExperimental = []standalone.Extractor{
 		&ospackages.Extractor{},
-		&regosversion.Extractor{},
+		regosversion.New(regosversion.DefaultConfig()),
+		appx.New(appx.DefaultConfig()),
 		&regpatchlevel.Extractor{},
+		syspath.New(syspath.DefaultConfig()),
+	}
+
+	// Linux standalone extractors.
+	Linux = []standalone.Extractor{
+		&kernel.Extractor{},
 	}
 
 	// Containers standalone extractors.
@@ -50,14 +63,17 @@ var (
 		containerd.New(containerd.DefaultConfig()),
 	}
 
-	// Default standalone extractors.
-	Default []standalone.Extractor = slices.Concat(Windows)
+	// Default standalone extractors. The Linux extractors are only included when
+	// SCALIBR itself runs on Linux.
+	Default []standalone.Extractor = slices.Concat(Windows, onLinux(Linux))
 	// All standalone extractors.
-	All []standalone.Extractor = slices.Concat(Windows, WindowsExperimental, Containers)
+	All []standalone.Extractor = slices.Concat(Windows, WindowsExperimental, Linux, Containers)
 
 	extractorNames = map[string][]standalone.Extractor{
 		// Windows
 		"windows": Windows,
+		// Linux
+		"linux": Linux,
 
 		// Collections.
 		"default":    Default,
@@ -72,6 +88,14 @@ func init() {
 	}
 }
 
+// onLinux returns exs if SCALIBR is running on Linux and nil otherwise.
+func onLinux(exs []standalone.Extractor) []standalone.Extractor {
+	if runtime.GOOS != "linux" {
+		return nil
+	}
+	return exs
+}
+
 // register adds the individual extractors to the extractorNames map.
 func register(d standalone.Extractor) {
 	if _, ok := extractorNames[strings.ToLower(d.Name())]; ok {
@@ -134,3 +158,21 @@ func ExtractorsFromNames(names []string) ([]standalone.Extractor, error) {
 	}
 	return result, nil
 }
+
+// Descriptors returns the descriptors of all individual extractors that can be
+// enabled by name, sorted by name.
+func Descriptors() []*extractor.Descriptor {
+	result := []*extractor.Descriptor{}
+	seen := make(map[string]bool)
+	for _, es := range extractorNames {
+		for _, e := range es {
+			if seen[e.Name()] {
+				continue
+			}
+			seen[e.Name()] = true
+			result = append(result, extractor.Describe(e))
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
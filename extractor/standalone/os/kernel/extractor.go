@@ -0,0 +1,213 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kernel extracts the version of the Linux kernel, either from
+// /proc/version on a running system or from the kernel images in /boot.
+package kernel
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/standalone"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "os/kernel"
+
+	// kernelName is the name of the reported inventory.
+	kernelName = "linux"
+
+	procVersionPath = "proc/version"
+	vmlinuzGlob     = "boot/vmlinuz-*"
+	vmlinuzPrefix   = "vmlinuz-"
+)
+
+// debianUpstreamRegex matches the package version that Debian kernels append
+// to the build info in /proc/version, e.g. "Debian 6.1.76-1".
+var debianUpstreamRegex = regexp.MustCompile(`\bDebian (\d+\.\d+(?:\.\d+)?)`)
+
+// Metadata holds additional information about the kernel.
+type Metadata struct {
+	// Release is the full kernel release as reported by `uname -r`, e.g.
+	// "6.1.0-18-amd64".
+	Release string `json:"release"`
+	// UpstreamVersion is the version of the upstream kernel the release is
+	// built from, e.g. "6.1.76". Empty if it can't be determined reliably.
+	UpstreamVersion string `json:"upstreamVersion,omitempty"`
+	// CPE in the CPE 2.3 formatted string binding. Empty if the upstream
+	// version is unknown.
+	CPE string `json:"cpe,omitempty"`
+}
+
+// Extractor extracts the Linux kernel version.
+type Extractor struct{}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities {
+	return &plugin.Capabilities{OS: plugin.OSLinux}
+}
+
+// Extract returns the running kernel from /proc/version. If the file doesn't
+// exist, e.g. when scanning an offline image, every kernel image found in
+// /boot is reported instead.
+func (e Extractor) Extract(ctx context.Context, input *standalone.ScanInput) ([]*extractor.Inventory, error) {
+	content, err := fs.ReadFile(input.FS, procVersionPath)
+	if err == nil {
+		release, upstream, err := parseProcVersion(string(content))
+		if err != nil {
+			return nil, err
+		}
+		return []*extractor.Inventory{newInventory(release, upstream, procVersionPath)}, nil
+	}
+
+	images, err := fs.Glob(input.FS, vmlinuzGlob)
+	if err != nil {
+		return nil, fmt.Errorf("fs.Glob(%s): %w", vmlinuzGlob, err)
+	}
+	slices.Sort(images)
+	var inv []*extractor.Inventory
+	for _, image := range images {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		release := strings.TrimPrefix(path.Base(image), vmlinuzPrefix)
+		// Skip rescue images such as vmlinuz-0-rescue-<machine-id>, which don't
+		// carry a version in their name.
+		if !isRelease(release) {
+			continue
+		}
+		inv = append(inv, newInventory(release, upstreamVersion(release), image))
+	}
+	return inv, nil
+}
+
+// parseProcVersion returns the kernel release and the upstream version from
+// the content of /proc/version, e.g. "Linux version 6.1.0-18-amd64
+// (debian-kernel@...) ... #1 SMP PREEMPT_DYNAMIC Debian 6.1.76-1 (2024-02-01)".
+func parseProcVersion(content string) (release string, upstream string, err error) {
+	fields := strings.Fields(content)
+	if len(fields) < 3 || fields[0] != "Linux" || fields[1] != "version" || !isRelease(fields[2]) {
+		return "", "", fmt.Errorf("unexpected format of %s: %q", procVersionPath, content)
+	}
+	release = fields[2]
+	// The build info after the "#" may carry the distribution's package
+	// version. Anything before it, e.g. the compiler version, is ignored.
+	if i := strings.Index(content, "#"); i >= 0 {
+		if m := debianUpstreamRegex.FindStringSubmatch(content[i:]); m != nil {
+			return release, m[1], nil
+		}
+	}
+	return release, upstreamVersion(release), nil
+}
+
+// isRelease returns true if s looks like a kernel release, i.e. it starts with
+// a "<major>.<minor>" version.
+func isRelease(s string) bool {
+	version, _ := splitRelease(s)
+	major, rest, ok := strings.Cut(version, ".")
+	return ok && isDigits(major) && isDigits(strings.SplitN(rest, ".", 2)[0])
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// upstreamVersion returns the upstream version of a kernel release by
+// stripping its distribution specific suffix, e.g. "6.8.5-301.fc40.x86_64"
+// becomes "6.8.5". Debian and Ubuntu name their releases after the ABI
+// instead, e.g. "6.1.0-18-amd64" is built from 6.1.76, so an empty string is
+// returned for releases with a ".0" patch level and a suffix.
+func upstreamVersion(release string) string {
+	version, suffix := splitRelease(release)
+	if suffix != "" && strings.HasSuffix(version, ".0") {
+		return ""
+	}
+	return version
+}
+
+// splitRelease splits a kernel release into its version and the distribution
+// specific suffix, e.g. "6.1.0-18-amd64" into "6.1.0" and "-18-amd64".
+func splitRelease(release string) (version string, suffix string) {
+	if i := strings.IndexAny(release, "-+_~"); i >= 0 {
+		return release[:i], release[i:]
+	}
+	return release, ""
+}
+
+func newInventory(release, upstream, location string) *extractor.Inventory {
+	m := &Metadata{
+		Release:         release,
+		UpstreamVersion: upstream,
+	}
+	if upstream != "" {
+		m.CPE = fmt.Sprintf("cpe:2.3:o:linux:linux_kernel:%s:*:*:*:*:*:*:*", upstream)
+	}
+	return &extractor.Inventory{
+		Name:      kernelName,
+		Version:   release,
+		Metadata:  m,
+		Locations: extractor.LocationsFromPaths(location),
+	}
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:      purl.TypeGeneric,
+		Namespace: "linux",
+		Name:      i.Name,
+		Version:   i.Version,
+	}
+}
+
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeGeneric} }
+
+// ToCPEs returns the CPE of the kernel, if its upstream version is known.
+func (e Extractor) ToCPEs(i *extractor.Inventory) []string {
+	if m, ok := i.Metadata.(*Metadata); ok && m.CPE != "" {
+		return []string{m.CPE}
+	}
+	return nil
+}
+
+// Ecosystem returns no ecosystem since OSV doesn't track kernel releases by
+// version.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ standalone.Extractor = Extractor{}
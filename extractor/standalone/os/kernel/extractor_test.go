@@ -0,0 +1,180 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kernel_test
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/standalone"
+	"github.com/google/osv-scalibr/extractor/standalone/os/kernel"
+	"github.com/google/osv-scalibr/purl"
+)
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name          string
+		files         fstest.MapFS
+		wantInventory []*extractor.Inventory
+		wantErr       error
+	}{
+		{
+			name: "proc version",
+			files: fstest.MapFS{
+				"proc/version": {Data: []byte("Linux version 6.1.0-18-amd64 (debian-kernel@lists.debian.org) (gcc-12 (Debian 12.2.0-14) 12.2.0, GNU ld (GNU Binutils for Debian) 2.40) #1 SMP PREEMPT_DYNAMIC Debian 6.1.76-1 (2024-02-01)\n")},
+				// Ignored in favor of the running kernel.
+				"boot/vmlinuz-5.10.0-28-amd64": {},
+			},
+			wantInventory: []*extractor.Inventory{
+				{
+					Name:    "linux",
+					Version: "6.1.0-18-amd64",
+					Metadata: &kernel.Metadata{
+						Release:         "6.1.0-18-amd64",
+						UpstreamVersion: "6.1.76",
+						CPE:             "cpe:2.3:o:linux:linux_kernel:6.1.76:*:*:*:*:*:*:*",
+					},
+					Locations: extractor.LocationsFromPaths("proc/version"),
+				},
+			},
+		},
+		{
+			name: "vmlinuz fallback",
+			files: fstest.MapFS{
+				"boot/vmlinuz-6.8.0-31-generic":                          {},
+				"boot/vmlinuz-5.15.0-105-generic":                        {},
+				"boot/vmlinuz-0-rescue-3f1d9b0c2e6a4a2f9b7f0c1e5d8a7b6c": {},
+				"boot/config-6.8.0-31-generic":                           {},
+			},
+			wantInventory: []*extractor.Inventory{
+				{
+					Name:    "linux",
+					Version: "5.15.0-105-generic",
+					// The upstream version isn't part of the release.
+					Metadata: &kernel.Metadata{
+						Release: "5.15.0-105-generic",
+					},
+					Locations: extractor.LocationsFromPaths("boot/vmlinuz-5.15.0-105-generic"),
+				},
+				{
+					Name:    "linux",
+					Version: "6.8.0-31-generic",
+					Metadata: &kernel.Metadata{
+						Release: "6.8.0-31-generic",
+					},
+					Locations: extractor.LocationsFromPaths("boot/vmlinuz-6.8.0-31-generic"),
+				},
+			},
+		},
+		{
+			name: "fedora vmlinuz",
+			files: fstest.MapFS{
+				"boot/vmlinuz-6.8.5-301.fc40.x86_64": {},
+			},
+			wantInventory: []*extractor.Inventory{
+				{
+					Name:    "linux",
+					Version: "6.8.5-301.fc40.x86_64",
+					Metadata: &kernel.Metadata{
+						Release:         "6.8.5-301.fc40.x86_64",
+						UpstreamVersion: "6.8.5",
+						CPE:             "cpe:2.3:o:linux:linux_kernel:6.8.5:*:*:*:*:*:*:*",
+					},
+					Locations: extractor.LocationsFromPaths("boot/vmlinuz-6.8.5-301.fc40.x86_64"),
+				},
+			},
+		},
+		{
+			name: "proc version without distribution info",
+			files: fstest.MapFS{
+				"proc/version": {Data: []byte("Linux version 6.1.0-18-amd64 (builder@host) (gcc-12 (Debian 12.2.0-14) 12.2.0) #1 SMP PREEMPT_DYNAMIC\n")},
+			},
+			wantInventory: []*extractor.Inventory{
+				{
+					Name:    "linux",
+					Version: "6.1.0-18-amd64",
+					Metadata: &kernel.Metadata{
+						Release: "6.1.0-18-amd64",
+					},
+					Locations: extractor.LocationsFromPaths("proc/version"),
+				},
+			},
+		},
+		{
+			name: "vanilla proc version",
+			files: fstest.MapFS{
+				"proc/version": {Data: []byte("Linux version 6.9.3 (root@host) (gcc (GCC) 14.1.1) #1 SMP PREEMPT_DYNAMIC Mon Jun  3 12:00:00 UTC 2024\n")},
+			},
+			wantInventory: []*extractor.Inventory{
+				{
+					Name:    "linux",
+					Version: "6.9.3",
+					Metadata: &kernel.Metadata{
+						Release:         "6.9.3",
+						UpstreamVersion: "6.9.3",
+						CPE:             "cpe:2.3:o:linux:linux_kernel:6.9.3:*:*:*:*:*:*:*",
+					},
+					Locations: extractor.LocationsFromPaths("proc/version"),
+				},
+			},
+		},
+		{
+			name:  "no kernel found",
+			files: fstest.MapFS{"etc/hostname": {Data: []byte("host\n")}},
+		},
+		{
+			name: "malformed proc version",
+			files: fstest.MapFS{
+				"proc/version": {Data: []byte("garbage\n")},
+			},
+			wantErr: cmpopts.AnyError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := kernel.Extractor{}
+			input := &standalone.ScanInput{FS: tt.files, Root: "/"}
+			got, err := e.Extract(context.Background(), input)
+			if !cmp.Equal(err, tt.wantErr, cmpopts.EquateErrors()) {
+				t.Fatalf("Extract() error: got %v, want %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.wantInventory, got); diff != "" {
+				t.Errorf("Extract() (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := kernel.Extractor{}
+	i := &extractor.Inventory{
+		Name:    "linux",
+		Version: "6.1.0-18-amd64",
+	}
+	want := &purl.PackageURL{
+		Type:      purl.TypeGeneric,
+		Namespace: "linux",
+		Name:      "linux",
+		Version:   "6.1.0-18-amd64",
+	}
+	if diff := cmp.Diff(want, e.ToPURL(i)); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
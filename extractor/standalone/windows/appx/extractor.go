@@ -0,0 +1,266 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package appx extracts the AppX and MSIX packages installed on Windows from
+// the package repository in the registry.
+package appx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/google/osv-scalibr/common/windows/registry"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/log"
+	"github.com/google/osv-scalibr/purl"
+)
+
+const (
+	// Name of the AppX extractor.
+	Name = "windows/appx"
+
+	// hivePath is the location of the SOFTWARE hive relative to the scan root.
+	hivePath = "Windows/System32/config/SOFTWARE"
+	// hivePackagesPath is the key holding a subkey per installed package,
+	// relative to the root of the SOFTWARE hive.
+	hivePackagesPath = `Microsoft\Windows\CurrentVersion\AppModel\PackageRepository\Packages`
+	// registryLocation is the location reported for packages read from the
+	// registry rather than from a hive file. The package full name is appended.
+	registryLocation = `HKEY_LOCAL_MACHINE\SOFTWARE\` + hivePackagesPath
+)
+
+// Metadata holds additional information about an AppX or MSIX package.
+type Metadata struct {
+	// PackageFullName identifies the installed package, e.g.
+	// "Microsoft.WindowsCalculator_11.2210.0.0_x64__8wekyb3d8bbwe".
+	PackageFullName string `json:"packageFullName"`
+	// PackageFamilyName identifies the package across versions and
+	// architectures, e.g. "Microsoft.WindowsCalculator_8wekyb3d8bbwe".
+	PackageFamilyName string `json:"packageFamilyName"`
+	// Publisher is the distinguished name of the publisher, e.g.
+	// "CN=Microsoft Corporation, O=Microsoft Corporation, L=Redmond,
+	// S=Washington, C=US", if the registry records it.
+	Publisher string `json:"publisher,omitempty"`
+	// PublisherID is the hash of the publisher that is part of the package
+	// names, e.g. "8wekyb3d8bbwe".
+	PublisherID string `json:"publisherId"`
+	// Architecture is the processor architecture, e.g. "x64" or "neutral".
+	Architecture string `json:"architecture"`
+	// ResourceID distinguishes resource packages, e.g. "split.scale-100".
+	// Empty for most packages.
+	ResourceID string `json:"resourceId,omitempty"`
+	// InstallLocation is the directory the package is installed to.
+	InstallLocation string `json:"installLocation,omitempty"`
+}
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Registry is the SOFTWARE hive to read the packages from. If nil, the
+	// registry of the running system is used on Windows and the hive in
+	// Windows/System32/config/SOFTWARE of the scan root on other platforms.
+	Registry registry.Registry
+}
+
+// DefaultConfig returns the default configuration for the extractor.
+func DefaultConfig() Config {
+	return Config{
+		Registry: nil,
+	}
+}
+
+// Extractor extracts the installed AppX and MSIX packages.
+type Extractor struct {
+	registry registry.Registry
+}
+
+// New returns an AppX extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		registry: cfg.Registry,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// extractFromHive extracts the packages from the offline SOFTWARE hive below
+// root. Nothing is returned if there is no such hive.
+func extractFromHive(ctx context.Context, root string) ([]*extractor.Inventory, error) {
+	path := filepath.Join(root, filepath.FromSlash(hivePath))
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	reg, err := registry.NewFromFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("registry.NewFromFile(%s): %w", path, err)
+	}
+	defer reg.Close()
+	return extractFromRegistry(ctx, reg, func(string) string { return hivePath })
+}
+
+// extractFromRegistry extracts the packages from the given SOFTWARE hive. Each
+// package is reported at the location returned for its full name.
+func extractFromRegistry(ctx context.Context, reg registry.Registry, location func(fullName string) string) ([]*extractor.Inventory, error) {
+	key, err := registry.OpenKeyContext(ctx, reg, hivePackagesPath)
+	if err != nil {
+		return nil, fmt.Errorf("OpenKey(%s): %w", hivePackagesPath, err)
+	}
+	defer key.Close()
+	subkeys, err := registry.SubkeysContext(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("Subkeys(%s): %w", hivePackagesPath, err)
+	}
+	defer func() {
+		for _, sk := range subkeys {
+			sk.Close()
+		}
+	}()
+
+	var res []*extractor.Inventory
+	for _, sk := range subkeys {
+		values, err := readValues(ctx, sk)
+		if err != nil {
+			return nil, err
+		}
+		inv, err := newInventory(sk.Name(), values, location(sk.Name()))
+		if err != nil {
+			log.Warnf("%s: skipping package: %v", Name, err)
+			continue
+		}
+		res = append(res, inv)
+	}
+	return res, nil
+}
+
+// registryKeyLocation returns the location of the package's key in the
+// registry.
+func registryKeyLocation(fullName string) string {
+	return registryLocation + `\` + fullName
+}
+
+func readValues(ctx context.Context, key registry.Key) (map[string][]byte, error) {
+	values, err := registry.ValuesContext(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("Values(%s): %w", key.Name(), err)
+	}
+	m := make(map[string][]byte, len(values))
+	for _, v := range values {
+		data, err := v.Data()
+		if err != nil {
+			return nil, fmt.Errorf("Data(%s\\%s): %w", key.Name(), v.Name(), err)
+		}
+		m[v.Name()] = data
+	}
+	return m, nil
+}
+
+// newInventory returns the inventory of the package with the given full name
+// and registry values.
+func newInventory(fullName string, values map[string][]byte, location string) (*extractor.Inventory, error) {
+	// <Name>_<Version>_<Architecture>_<ResourceId>_<PublisherId>
+	parts := strings.Split(fullName, "_")
+	if len(parts) != 5 {
+		return nil, fmt.Errorf("malformed package full name %q", fullName)
+	}
+	name, version, arch, resourceID, publisherID := parts[0], parts[1], parts[2], parts[3], parts[4]
+	if err := validateVersion(version); err != nil {
+		return nil, fmt.Errorf("package %q: %w", fullName, err)
+	}
+	if data, ok := values["Version"]; ok {
+		// The packed version takes precedence over the one in the name.
+		v, err := decodeVersion(data)
+		if err != nil {
+			return nil, fmt.Errorf("package %q: %w", fullName, err)
+		}
+		version = v
+	}
+
+	return &extractor.Inventory{
+		Name:    name,
+		Version: version,
+		Metadata: &Metadata{
+			PackageFullName:   fullName,
+			PackageFamilyName: name + "_" + publisherID,
+			Publisher:         registry.DecodeString(values["Publisher"]),
+			PublisherID:       publisherID,
+			Architecture:      arch,
+			ResourceID:        resourceID,
+			InstallLocation:   registry.DecodeString(values["Path"]),
+		},
+		Locations: extractor.LocationsFromPaths(location),
+	}, nil
+}
+
+// validateVersion checks that the version has the Major.Minor.Build.Revision
+// form of package versions, with each part fitting into 16 bits.
+func validateVersion(version string) error {
+	parts := strings.Split(version, ".")
+	if len(parts) != 4 {
+		return fmt.Errorf("version %q doesn't have 4 parts", version)
+	}
+	for _, p := range parts {
+		if _, err := strconv.ParseUint(p, 10, 16); err != nil {
+			return fmt.Errorf("invalid version %q: %w", version, err)
+		}
+	}
+	return nil
+}
+
+// decodeVersion decodes a version stored as a REG_QWORD that packs the major,
+// minor, build and revision numbers into 16 bits each, e.g. 0x000B089200000000
+// for 11.2194.0.0.
+func decodeVersion(data []byte) (string, error) {
+	v, err := registry.DecodeQWORD(data)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d.%d.%d.%d", v>>48, v>>32&0xffff, v>>16&0xffff, v&0xffff), nil
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL. The
+// publisher ID is used as namespace, so that the namespace and name make up the
+// package family.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	m, ok := i.Metadata.(*Metadata)
+	if !ok {
+		return nil
+	}
+	return &purl.PackageURL{
+		Type:       purl.TypeAppx,
+		Namespace:  m.PublisherID,
+		Name:       i.Name,
+		Version:    i.Version,
+		Qualifiers: purl.QualifiersFromMap(map[string]string{purl.Arch: m.Architecture}),
+	}
+}
+
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeAppx} }
+
+// Ecosystem returns no ecosystem since OSV does not support AppX packages yet.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
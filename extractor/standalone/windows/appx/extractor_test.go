@@ -0,0 +1,186 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appx_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/standalone"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/appx"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/testing/mockregistry"
+)
+
+const (
+	packagesPath = `Microsoft\Windows\CurrentVersion\AppModel\PackageRepository\Packages`
+	calculator   = "Microsoft.WindowsCalculator_11.2210.0.0_x64__8wekyb3d8bbwe"
+	publisher    = "CN=Microsoft Corporation, O=Microsoft Corporation, L=Redmond, S=Washington, C=US"
+)
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name          string
+		reg           *mockregistry.MockRegistry
+		wantInventory []*extractor.Inventory
+	}{
+		{
+			name: "one package",
+			reg: mockregistry.NewBuilder().
+				Key(packagesPath+`\`+calculator).
+				Value("Path", mockregistry.SZ, `C:\Program Files\WindowsApps\`+calculator).
+				Value("Publisher", mockregistry.SZ, publisher).
+				Build(),
+			wantInventory: []*extractor.Inventory{{
+				Name:    "Microsoft.WindowsCalculator",
+				Version: "11.2210.0.0",
+				Metadata: &appx.Metadata{
+					PackageFullName:   calculator,
+					PackageFamilyName: "Microsoft.WindowsCalculator_8wekyb3d8bbwe",
+					Publisher:         publisher,
+					PublisherID:       "8wekyb3d8bbwe",
+					Architecture:      "x64",
+					InstallLocation:   `C:\Program Files\WindowsApps\` + calculator,
+				},
+				Locations: extractor.LocationsFromPaths(`HKEY_LOCAL_MACHINE\SOFTWARE\` + packagesPath + `\` + calculator),
+			}},
+		},
+		{
+			name: "packed version",
+			reg: mockregistry.NewBuilder().
+				Key(packagesPath+`\Contoso.App_1.2.3.4_neutral_split.scale-100_abcdefghijklm`).
+				Value("Version", mockregistry.QWORD, uint64(0x0001000200030005)).
+				Build(),
+			wantInventory: []*extractor.Inventory{{
+				Name:    "Contoso.App",
+				Version: "1.2.3.5",
+				Metadata: &appx.Metadata{
+					PackageFullName:   "Contoso.App_1.2.3.4_neutral_split.scale-100_abcdefghijklm",
+					PackageFamilyName: "Contoso.App_abcdefghijklm",
+					PublisherID:       "abcdefghijklm",
+					Architecture:      "neutral",
+					ResourceID:        "split.scale-100",
+				},
+				Locations: extractor.LocationsFromPaths(`HKEY_LOCAL_MACHINE\SOFTWARE\` + packagesPath + `\Contoso.App_1.2.3.4_neutral_split.scale-100_abcdefghijklm`),
+			}},
+		},
+		{
+			name: "malformed names are skipped",
+			reg: mockregistry.NewBuilder().
+				Key(packagesPath + `\NotAPackage`).
+				Key(packagesPath + `\Contoso.App_1.2_x64__abcdefghijklm`).
+				Key(packagesPath + `\Contoso.App_1.2.3.70000_x64__abcdefghijklm`).
+				Build(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := appx.New(appx.Config{Registry: tt.reg})
+			got, err := e.Extract(context.Background(), &standalone.ScanInput{})
+			if err != nil {
+				t.Fatalf("Extract(): %v", err)
+			}
+			if diff := cmp.Diff(tt.wantInventory, got); diff != "" {
+				t.Errorf("Extract() (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExtract_RegistryErrors(t *testing.T) {
+	errInjected := errors.New("injected")
+	tests := []struct {
+		name string
+		reg  *mockregistry.MockRegistry
+	}{
+		{
+			name: "open key fails",
+			reg: mockregistry.NewBuilder().
+				Key(packagesPath).
+				OpenKeyError(packagesPath, errInjected).
+				Build(),
+		},
+		{
+			name: "subkeys fail",
+			reg: mockregistry.NewBuilder().
+				Key(packagesPath).
+				SubkeysError(errInjected).
+				Build(),
+		},
+		{
+			name: "data fails",
+			reg: mockregistry.NewBuilder().
+				Key(packagesPath+`\`+calculator).
+				Value("Path", mockregistry.SZ, `C:\Program Files\WindowsApps`).
+				DataError("Path", errInjected).
+				Build(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := appx.New(appx.Config{Registry: tt.reg})
+			got, err := e.Extract(context.Background(), &standalone.ScanInput{})
+			if !errors.Is(err, errInjected) {
+				t.Errorf("Extract() error: got %v, want %v", err, errInjected)
+			}
+			if len(got) != 0 {
+				t.Errorf("Extract() returned %v, want no inventory", got)
+			}
+		})
+	}
+}
+
+func TestExtract_NoHive(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("reads the registry of the running system on Windows")
+	}
+	e := appx.New(appx.DefaultConfig())
+	got, err := e.Extract(context.Background(), &standalone.ScanInput{Root: t.TempDir()})
+	if err != nil {
+		t.Fatalf("Extract() returned error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("Extract() returned %v, want no inventory", got)
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	i := &extractor.Inventory{
+		Name:    "Microsoft.WindowsCalculator",
+		Version: "11.2210.0.0",
+		Metadata: &appx.Metadata{
+			PackageFullName:   calculator,
+			PackageFamilyName: "Microsoft.WindowsCalculator_8wekyb3d8bbwe",
+			PublisherID:       "8wekyb3d8bbwe",
+			Architecture:      "x64",
+		},
+	}
+	want := &purl.PackageURL{
+		Type:       purl.TypeAppx,
+		Namespace:  "8wekyb3d8bbwe",
+		Name:       "Microsoft.WindowsCalculator",
+		Version:    "11.2210.0.0",
+		Qualifiers: purl.QualifiersFromMap(map[string]string{purl.Arch: "x64"}),
+	}
+	if diff := cmp.Diff(want, appx.New(appx.DefaultConfig()).ToPURL(i)); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
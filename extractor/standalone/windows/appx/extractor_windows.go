@@ -0,0 +1,100 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+
+package appx
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/standalone"
+	"github.com/google/osv-scalibr/log"
+	"github.com/google/osv-scalibr/plugin"
+	"golang.org/x/sys/windows/registry"
+)
+
+// regPackagesPath is the package repository in the registry of the running
+// system.
+const regPackagesPath = `SOFTWARE\` + hivePackagesPath
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities {
+	return &plugin.Capabilities{RunningSystem: true}
+}
+
+// Extract the installed packages from the registry.
+func (e Extractor) Extract(ctx context.Context, input *standalone.ScanInput) ([]*extractor.Inventory, error) {
+	if e.registry != nil {
+		return extractFromRegistry(ctx, e.registry, registryKeyLocation)
+	}
+
+	key, err := registry.OpenKey(registry.LOCAL_MACHINE, regPackagesPath, registry.ENUMERATE_SUB_KEYS)
+	if err != nil {
+		return nil, err
+	}
+	defer key.Close()
+	fullNames, err := key.ReadSubKeyNames(0)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*extractor.Inventory
+	for _, fullName := range fullNames {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		values, err := readLiveValues(regPackagesPath + `\` + fullName)
+		if err != nil {
+			return nil, err
+		}
+		inv, err := newInventory(fullName, values, registryKeyLocation(fullName))
+		if err != nil {
+			log.Warnf("%s: skipping package: %v", Name, err)
+			continue
+		}
+		res = append(res, inv)
+	}
+	return res, nil
+}
+
+// readLiveValues returns the raw data of the values of the key at path.
+func readLiveValues(path string) (map[string][]byte, error) {
+	key, err := registry.OpenKey(registry.LOCAL_MACHINE, path, registry.QUERY_VALUE)
+	if err != nil {
+		return nil, err
+	}
+	defer key.Close()
+	names, err := key.ReadValueNames(0)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string][]byte, len(names))
+	for _, name := range names {
+		size, _, err := key.GetValue(name, nil)
+		if err != nil {
+			return nil, fmt.Errorf("GetValue(%s\\%s): %w", path, name, err)
+		}
+		data := make([]byte, size)
+		if _, _, err := key.GetValue(name, data); err != nil {
+			return nil, fmt.Errorf("GetValue(%s\\%s): %w", path, name, err)
+		}
+		values[name] = data
+	}
+	return values, nil
+}
+
+var _ standalone.Extractor = Extractor{}
@@ -21,4 +21,8 @@ type OSVersion struct {
 	Product string
 	// FullVersion is the full version of the OS version: Major.Minor.Build.Revision.
 	FullVersion string
+	// DisplayVersion is the feature update, e.g. "22H2".
+	DisplayVersion string
+	// CPE in the CPE 2.3 formatted string binding, if the product is known.
+	CPE string
 }
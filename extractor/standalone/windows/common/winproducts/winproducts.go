@@ -21,6 +21,10 @@ import (
 	"github.com/google/osv-scalibr/log"
 )
 
+// UnknownWindows is the product name returned for Windows versions that
+// aren't known.
+const UnknownWindows = "unknownWindows"
+
 var (
 	// windowsFlavorAndBuildToProductName maps a given Windows flavor and build number to a product
 	// name.
@@ -51,10 +55,10 @@ var (
 	}
 )
 
-// windowsFlavor returns the lowercase Windows flavor (server or client) of the current system
-// using the provided lowercase installType (found in the registry).
+// WindowsFlavor returns the lowercase Windows flavor (server or client) of the current system
+// using the provided installType (the InstallationType value found in the registry).
 // Defaults to "server" if we don't recognize the flavor, but log so that we can add it later.
-func windowsFlavor(installType string) string {
+func WindowsFlavor(installType string) string {
 	flavor := strings.ToLower(installType)
 
 	switch flavor {
@@ -73,12 +77,12 @@ func windowsFlavor(installType string) string {
 func WindowsProductFromVersion(flavor, imgVersion string) string {
 	knownVersions, ok := windowsFlavorAndBuildToProductName[flavor]
 	if !ok {
-		return "unknownWindows"
+		return UnknownWindows
 	}
 
 	imgVersionSplit := strings.Split(imgVersion, ".")
 	if len(imgVersionSplit) < 3 {
-		return "unknownWindows"
+		return UnknownWindows
 	}
 
 	version := strings.Join(imgVersionSplit[:3], ".")
@@ -86,5 +90,5 @@ func WindowsProductFromVersion(flavor, imgVersion string) string {
 		return productName
 	}
 
-	return "unknownWindows"
+	return UnknownWindows
 }
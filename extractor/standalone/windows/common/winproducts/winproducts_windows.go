@@ -27,14 +27,14 @@ const (
 func WindowsFlavorFromRegistry() string {
 	k, err := registry.OpenKey(registry.LOCAL_MACHINE, regRoot, registry.QUERY_VALUE)
 	if err != nil {
-		return windowsFlavor("server")
+		return WindowsFlavor("server")
 	}
 	defer k.Close()
 
 	value, _, err := k.GetStringValue(regKey)
 	if err != nil {
-		return windowsFlavor("server")
+		return WindowsFlavor("server")
 	}
 
-	return windowsFlavor(value)
+	return WindowsFlavor(value)
 }
@@ -77,3 +77,6 @@ func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 
 	return p
 }
+
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeGeneric} }
@@ -54,5 +54,8 @@ func (e *Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	return nil
 }
 
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e *Extractor) PURLTypes() []string { return []string{purl.TypeGooget, purl.TypeGeneric} }
+
 // Ecosystem returns no ecosystem since OSV does not support windows ospackages yet.
 func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
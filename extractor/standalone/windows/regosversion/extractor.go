@@ -0,0 +1,282 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package regosversion extracts the OS version (build, major, minor release) from the registry.
+package regosversion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/google/osv-scalibr/common/windows/registry"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/common/metadata"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/common/winproducts"
+	"github.com/google/osv-scalibr/purl"
+)
+
+const (
+	// Name of the Windows version extractor
+	Name = "windows/regosversion"
+
+	// hivePath is the location of the SOFTWARE hive relative to the scan root.
+	hivePath = "Windows/System32/config/SOFTWARE"
+	// hiveVersionPath is the CurrentVersion key relative to the root of the
+	// SOFTWARE hive.
+	hiveVersionPath = `Microsoft\Windows NT\CurrentVersion`
+	// registryLocation is the location reported for versions read from the
+	// registry rather than from a hive file.
+	registryLocation = `HKEY_LOCAL_MACHINE\SOFTWARE\Microsoft\Windows NT\CurrentVersion`
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Registry is the SOFTWARE hive to read the version from. If nil, the
+	// registry of the running system is used on Windows and the hive in
+	// Windows/System32/config/SOFTWARE of the scan root on other platforms.
+	Registry registry.Registry
+}
+
+// DefaultConfig returns the default configuration for the extractor.
+func DefaultConfig() Config {
+	return Config{
+		Registry: nil,
+	}
+}
+
+// Extractor provides a metadata extractor for the version of Windows.
+type Extractor struct {
+	registry registry.Registry
+}
+
+// New returns a Windows version extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		registry: cfg.Registry,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// extractFromHive extracts the Windows version from the offline SOFTWARE hive
+// below root. Nothing is returned if there is no such hive.
+func extractFromHive(ctx context.Context, root string) ([]*extractor.Inventory, error) {
+	path := filepath.Join(root, filepath.FromSlash(hivePath))
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	reg, err := registry.NewFromFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("registry.NewFromFile(%s): %w", path, err)
+	}
+	defer reg.Close()
+	return extractFromRegistry(ctx, reg, hivePath)
+}
+
+// extractFromRegistry extracts the Windows version from the given SOFTWARE hive.
+// The inventory is reported at location.
+func extractFromRegistry(ctx context.Context, reg registry.Registry, location string) ([]*extractor.Inventory, error) {
+	key, err := registry.OpenKeyContext(ctx, reg, hiveVersionPath)
+	if err != nil {
+		return nil, fmt.Errorf("OpenKey(%s): %w", hiveVersionPath, err)
+	}
+	defer key.Close()
+	values, err := readValues(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := windowsVersion(values)
+	if err != nil {
+		return nil, err
+	}
+	build := registry.DecodeString(values["CurrentBuild"])
+	if build == "" {
+		// Older releases only set CurrentBuildNumber.
+		build = registry.DecodeString(values["CurrentBuildNumber"])
+	}
+	if build == "" {
+		return nil, fmt.Errorf("%s: CurrentBuild not set", hiveVersionPath)
+	}
+	revision, err := windowsRevision(values)
+	if err != nil {
+		return nil, err
+	}
+	displayVersion := registry.DecodeString(values["DisplayVersion"])
+	if displayVersion == "" {
+		// DisplayVersion replaced ReleaseId with 20H2.
+		displayVersion = registry.DecodeString(values["ReleaseId"])
+	}
+
+	flavor := winproducts.WindowsFlavor(registry.DecodeString(values["InstallationType"]))
+	fullVersion := fmt.Sprintf("%s.%s.%d", version, build, revision)
+	return []*extractor.Inventory{
+		newInventory(flavor, fullVersion, registry.DecodeString(values["ProductName"]), displayVersion, location),
+	}, nil
+}
+
+func readValues(ctx context.Context, key registry.Key) (map[string][]byte, error) {
+	values, err := registry.ValuesContext(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("Values(%s): %w", hiveVersionPath, err)
+	}
+	m := make(map[string][]byte, len(values))
+	for _, v := range values {
+		data, err := v.Data()
+		if err != nil {
+			return nil, fmt.Errorf("Data(%s): %w", v.Name(), err)
+		}
+		m[v.Name()] = data
+	}
+	return m, nil
+}
+
+// windowsVersion returns the version of Windows (major and minor, e.g. 6.3 or 10.0).
+func windowsVersion(values map[string][]byte) (string, error) {
+	// recent version of Windows
+	major, majorErr := registry.DecodeDWORD(values["CurrentMajorVersionNumber"])
+	minor, minorErr := registry.DecodeDWORD(values["CurrentMinorVersionNumber"])
+	if majorErr == nil && minorErr == nil {
+		return fmt.Sprintf("%d.%d", major, minor), nil
+	}
+
+	// older versions of Windows
+	if v := registry.DecodeString(values["CurrentVersion"]); v != "" {
+		return v, nil
+	}
+	return "", fmt.Errorf("%s: CurrentVersion not set", hiveVersionPath)
+}
+
+// windowsRevision returns the revision within the current build.
+func windowsRevision(values map[string][]byte) (uint64, error) {
+	// recent version of Windows
+	if revision, err := registry.DecodeDWORD(values["UBR"]); err == nil {
+		return uint64(revision), nil
+	}
+	// on older version, we have to parse the BuildLabEx key
+	return parseBuildLabEx(registry.DecodeString(values["BuildLabEx"]))
+}
+
+// parseBuildLabEx returns the revision from a BuildLabEx value, e.g. 17514 for
+// "9600.17514.amd64fre.winblue_r5.141029-1500".
+func parseBuildLabEx(buildLabEx string) (uint64, error) {
+	buildLabParts := strings.Split(buildLabEx, ".")
+	if len(buildLabParts) < 2 {
+		return 0, fmt.Errorf("could not parse BuildLabEx: %q", buildLabEx)
+	}
+	return strconv.ParseUint(buildLabParts[1], 10, 64)
+}
+
+// newInventory returns the inventory of the Windows version. Builds that
+// winproducts doesn't know yet are named after the product name and the
+// feature update instead.
+func newInventory(flavor, fullVersion, productName, displayVersion, location string) *extractor.Inventory {
+	product := winproducts.WindowsProductFromVersion(flavor, fullVersion)
+	if product == winproducts.UnknownWindows {
+		product = productFromName(productName, fullVersion, displayVersion)
+	}
+	return &extractor.Inventory{
+		Name:    product,
+		Version: fullVersion,
+		Metadata: &metadata.OSVersion{
+			Product:        product,
+			FullVersion:    fullVersion,
+			DisplayVersion: displayVersion,
+			CPE:            toCPE(product, fullVersion),
+		},
+		Locations: extractor.LocationsFromPaths(location),
+	}
+}
+
+// productFromName derives the product from the product name, e.g. "Windows
+// 10 Pro" with display version "22H2" becomes "windows_10:22H2".
+func productFromName(productName, fullVersion, displayVersion string) string {
+	fields := strings.Fields(strings.ToLower(productName))
+	if len(fields) < 2 || fields[0] != "windows" {
+		return winproducts.UnknownWindows
+	}
+	product := "windows_" + fields[1]
+	if fields[1] == "server" && len(fields) > 2 {
+		product += "_" + fields[2]
+	}
+	// Windows 11 still reports itself as "Windows 10" in ProductName.
+	if parts := strings.Split(fullVersion, "."); len(parts) > 2 && product == "windows_10" {
+		if n, err := strconv.Atoi(parts[2]); err == nil && n >= 22000 {
+			product = "windows_11"
+		}
+	}
+	if displayVersion != "" {
+		product += ":" + displayVersion
+	}
+	return product
+}
+
+// toCPE returns the CPE of the product using the NVD naming, in which the
+// feature update is part of the product, e.g.
+// cpe:2.3:o:microsoft:windows_10_22h2:10.0.19045.4529:*:*:*:*:*:*:*
+func toCPE(product, fullVersion string) string {
+	if product == winproducts.UnknownWindows {
+		return ""
+	}
+	name, update, ok := strings.Cut(product, ":")
+	if ok {
+		name += "_" + strings.ToLower(update)
+	}
+	return fmt.Sprintf("cpe:2.3:o:microsoft:%s:%s:*:*:*:*:*:*:*", name, fullVersion)
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	m, ok := i.Metadata.(*metadata.OSVersion)
+	if !ok {
+		return nil
+	}
+	return &purl.PackageURL{
+		Type:      purl.TypeGeneric,
+		Namespace: "microsoft",
+		Name:      i.Name,
+		Qualifiers: purl.QualifiersFromMap(map[string]string{
+			purl.BuildNumber: m.FullVersion,
+		}),
+	}
+}
+
+// PURLTypes returns the PURL types of the inventory created by this extractor.
+func (e Extractor) PURLTypes() []string { return []string{purl.TypeGeneric} }
+
+// ToCPEs returns the CPE of the Windows product, if known.
+func (e Extractor) ToCPEs(i *extractor.Inventory) []string {
+	if m, ok := i.Metadata.(*metadata.OSVersion); ok && m.CPE != "" {
+		return []string{m.CPE}
+	}
+	return nil
+}
+
+// Ecosystem returns no ecosystem since OSV does not support windows regosversion yet.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
@@ -18,41 +18,22 @@ package regosversion
 
 import (
 	"context"
-	"fmt"
-	"runtime"
 
 	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/standalone"
-	"github.com/google/osv-scalibr/log"
 	"github.com/google/osv-scalibr/plugin"
-	"github.com/google/osv-scalibr/purl"
 )
 
-// Name of the Windows version extractor
-const Name = "windows/regosversion"
-
-// Extractor provides a metadata extractor for the version of Windows.
-type Extractor struct{}
-
-// Name of the extractor.
-func (e Extractor) Name() string { return Name }
-
-// Version of the extractor.
-func (e Extractor) Version() int { return 0 }
-
 // Requirements of the extractor.
 func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
 
-// Extract is a no-op for non-Windows platforms.
-func (e *Extractor) Extract(ctx context.Context, input *standalone.ScanInput) ([]*extractor.Inventory, error) {
-	return nil, fmt.Errorf("only supported on Windows")
-}
-
-// ToPURL converts an inventory created by this extractor into a PURL.
-func (e *Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
-	log.Warnf("Trying to use regosversion on %s, which is not supported", runtime.GOOS)
-	return nil
+// Extract the Windows version from the configured registry or the offline
+// SOFTWARE hive of the scanned system. Nothing is returned if there is no hive.
+func (e Extractor) Extract(ctx context.Context, input *standalone.ScanInput) ([]*extractor.Inventory, error) {
+	if e.registry != nil {
+		return extractFromRegistry(ctx, e.registry, registryLocation)
+	}
+	return extractFromHive(ctx, input.Root)
 }
 
-// Ecosystem returns no ecosystem since OSV does not support windows regosversion yet.
-func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+var _ standalone.Extractor = Extractor{}
@@ -0,0 +1,240 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package regosversion_test
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"runtime"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/common/windows/registry"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/standalone"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/common/metadata"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/regosversion"
+	"github.com/google/osv-scalibr/testing/mockregistry"
+)
+
+const currentVersionPath = `Microsoft\Windows NT\CurrentVersion`
+
+// sz encodes s as REG_SZ value data.
+func sz(name, s string) registry.Value {
+	var data []byte
+	for _, c := range utf16.Encode([]rune(s + "\x00")) {
+		data = binary.LittleEndian.AppendUint16(data, c)
+	}
+	return &mockregistry.MockValue{VName: name, VData: data}
+}
+
+func dword(name string, v uint32) registry.Value {
+	return &mockregistry.MockValue{VName: name, VData: binary.LittleEndian.AppendUint32(nil, v)}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name          string
+		values        []registry.Value
+		wantInventory []*extractor.Inventory
+		wantErr       error
+	}{
+		{
+			name: "windows 10 22H2",
+			values: []registry.Value{
+				sz("ProductName", "Windows 10 Pro"),
+				sz("InstallationType", "Client"),
+				sz("DisplayVersion", "22H2"),
+				sz("CurrentBuild", "19045"),
+				dword("UBR", 4529),
+				dword("CurrentMajorVersionNumber", 10),
+				dword("CurrentMinorVersionNumber", 0),
+			},
+			wantInventory: []*extractor.Inventory{
+				{
+					Name:    "windows_10:22H2",
+					Version: "10.0.19045.4529",
+					Metadata: &metadata.OSVersion{
+						Product:        "windows_10:22H2",
+						FullVersion:    "10.0.19045.4529",
+						DisplayVersion: "22H2",
+						CPE:            "cpe:2.3:o:microsoft:windows_10_22h2:10.0.19045.4529:*:*:*:*:*:*:*",
+					},
+					Locations: extractor.LocationsFromPaths(`HKEY_LOCAL_MACHINE\SOFTWARE\Microsoft\Windows NT\CurrentVersion`),
+				},
+			},
+		},
+		{
+			name: "server 2022",
+			values: []registry.Value{
+				sz("ProductName", "Windows Server 2022 Datacenter"),
+				sz("InstallationType", "Server Core"),
+				sz("DisplayVersion", "21H2"),
+				sz("CurrentBuild", "20348"),
+				dword("UBR", 2461),
+				dword("CurrentMajorVersionNumber", 10),
+				dword("CurrentMinorVersionNumber", 0),
+			},
+			wantInventory: []*extractor.Inventory{
+				{
+					Name:    "windows_server_2022",
+					Version: "10.0.20348.2461",
+					Metadata: &metadata.OSVersion{
+						Product:        "windows_server_2022",
+						FullVersion:    "10.0.20348.2461",
+						DisplayVersion: "21H2",
+						CPE:            "cpe:2.3:o:microsoft:windows_server_2022:10.0.20348.2461:*:*:*:*:*:*:*",
+					},
+					Locations: extractor.LocationsFromPaths(`HKEY_LOCAL_MACHINE\SOFTWARE\Microsoft\Windows NT\CurrentVersion`),
+				},
+			},
+		},
+		{
+			name: "unknown windows 11 build reported as windows 10",
+			values: []registry.Value{
+				sz("ProductName", "Windows 10 Enterprise"),
+				sz("InstallationType", "Client"),
+				sz("DisplayVersion", "24H2"),
+				sz("CurrentBuild", "26100"),
+				dword("UBR", 1742),
+				dword("CurrentMajorVersionNumber", 10),
+				dword("CurrentMinorVersionNumber", 0),
+			},
+			wantInventory: []*extractor.Inventory{
+				{
+					Name:    "windows_11:24H2",
+					Version: "10.0.26100.1742",
+					Metadata: &metadata.OSVersion{
+						Product:        "windows_11:24H2",
+						FullVersion:    "10.0.26100.1742",
+						DisplayVersion: "24H2",
+						CPE:            "cpe:2.3:o:microsoft:windows_11_24h2:10.0.26100.1742:*:*:*:*:*:*:*",
+					},
+					Locations: extractor.LocationsFromPaths(`HKEY_LOCAL_MACHINE\SOFTWARE\Microsoft\Windows NT\CurrentVersion`),
+				},
+			},
+		},
+		{
+			name: "windows 8.1 without UBR",
+			values: []registry.Value{
+				sz("ProductName", "Windows Server 2012 R2 Standard"),
+				sz("InstallationType", "Server"),
+				sz("CurrentVersion", "6.3"),
+				sz("CurrentBuildNumber", "9600"),
+				sz("BuildLabEx", "9600.17514.amd64fre.winblue_r5.141029-1500"),
+			},
+			wantInventory: []*extractor.Inventory{
+				{
+					Name:    "windows_server_2012:r2",
+					Version: "6.3.9600.17514",
+					Metadata: &metadata.OSVersion{
+						Product:     "windows_server_2012:r2",
+						FullVersion: "6.3.9600.17514",
+						CPE:         "cpe:2.3:o:microsoft:windows_server_2012_r2:6.3.9600.17514:*:*:*:*:*:*:*",
+					},
+					Locations: extractor.LocationsFromPaths(`HKEY_LOCAL_MACHINE\SOFTWARE\Microsoft\Windows NT\CurrentVersion`),
+				},
+			},
+		},
+		{
+			name: "missing build",
+			values: []registry.Value{
+				sz("ProductName", "Windows 10 Pro"),
+				dword("CurrentMajorVersionNumber", 10),
+				dword("CurrentMinorVersionNumber", 0),
+			},
+			wantErr: cmpopts.AnyError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &mockregistry.MockRegistry{
+				Keys: map[string]registry.Key{
+					currentVersionPath: &mockregistry.MockKey{KName: "CurrentVersion", KValues: tt.values},
+				},
+			}
+			e := regosversion.New(regosversion.Config{Registry: reg})
+			got, err := e.Extract(context.Background(), &standalone.ScanInput{})
+			if !cmp.Equal(err, tt.wantErr, cmpopts.EquateErrors()) {
+				t.Fatalf("Extract() error: got %v, want %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.wantInventory, got); diff != "" {
+				t.Errorf("Extract() (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExtract_RegistryErrors(t *testing.T) {
+	errInjected := errors.New("injected")
+	tests := []struct {
+		name string
+		reg  *mockregistry.MockRegistry
+	}{
+		{
+			name: "open key fails",
+			reg: mockregistry.NewBuilder().
+				Key(currentVersionPath).
+				OpenKeyError(currentVersionPath, errInjected).
+				Build(),
+		},
+		{
+			name: "values fail",
+			reg: mockregistry.NewBuilder().
+				Key(currentVersionPath).
+				ValuesError(errInjected).
+				Build(),
+		},
+		{
+			name: "data fails",
+			reg: mockregistry.NewBuilder().
+				Key(currentVersionPath).
+				Value("CurrentBuild", mockregistry.SZ, "19045").
+				DataError("CurrentBuild", errInjected).
+				Build(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := regosversion.New(regosversion.Config{Registry: tt.reg})
+			got, err := e.Extract(context.Background(), &standalone.ScanInput{})
+			if !errors.Is(err, errInjected) {
+				t.Errorf("Extract() error: got %v, want %v", err, errInjected)
+			}
+			if len(got) != 0 {
+				t.Errorf("Extract() returned %v, want no inventory", got)
+			}
+		})
+	}
+}
+
+func TestExtract_NoHive(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("reads the registry of the running system on Windows")
+	}
+	e := regosversion.New(regosversion.DefaultConfig())
+	got, err := e.Extract(context.Background(), &standalone.ScanInput{Root: t.TempDir()})
+	if err != nil {
+		t.Fatalf("Extract() returned error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("Extract() returned %v, want no inventory", got)
+	}
+}
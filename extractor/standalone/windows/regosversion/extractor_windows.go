@@ -14,46 +14,33 @@
 
 //go:build windows
 
-// Package regosversion extracts the OS version (build, major, minor release) from the registry.
 package regosversion
 
 import (
 	"context"
 	"fmt"
-	"strconv"
-	"strings"
 
 	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/standalone"
-	"github.com/google/osv-scalibr/extractor/standalone/windows/common/metadata"
 	"github.com/google/osv-scalibr/extractor/standalone/windows/common/winproducts"
 	"github.com/google/osv-scalibr/plugin"
-	"github.com/google/osv-scalibr/purl"
 	"golang.org/x/sys/windows/registry"
 )
 
-const (
-	// Name of the DISM patch level extractor
-	Name           = "windows/regosversion"
-	regVersionPath = `SOFTWARE\Microsoft\Windows NT\CurrentVersion`
-)
-
-// Extractor provides a metadata extractor for the DISM patch level on Windows.
-type Extractor struct{}
-
-// Name of the extractor.
-func (e Extractor) Name() string { return Name }
-
-// Version of the extractor.
-func (e Extractor) Version() int { return 0 }
+// regVersionPath is the CurrentVersion key in the registry of the running system.
+const regVersionPath = `SOFTWARE\Microsoft\Windows NT\CurrentVersion`
 
 // Requirements of the extractor.
 func (e Extractor) Requirements() *plugin.Capabilities {
 	return &plugin.Capabilities{RunningSystem: true}
 }
 
-// Extract the DISM patch level on Windows.
-func (e *Extractor) Extract(ctx context.Context, input *standalone.ScanInput) ([]*extractor.Inventory, error) {
+// Extract the Windows version from the registry.
+func (e Extractor) Extract(ctx context.Context, input *standalone.ScanInput) ([]*extractor.Inventory, error) {
+	if e.registry != nil {
+		return extractFromRegistry(ctx, e.registry, registryLocation)
+	}
+
 	key, err := registry.OpenKey(registry.LOCAL_MACHINE, regVersionPath, registry.QUERY_VALUE)
 	if err != nil {
 		return nil, err
@@ -76,19 +63,16 @@ func (e *Extractor) Extract(ctx context.Context, input *standalone.ScanInput) ([
 		return nil, err
 	}
 
+	productName, _, _ := key.GetStringValue("ProductName")
+	displayVersion, _, err := key.GetStringValue("DisplayVersion")
+	if err != nil {
+		// DisplayVersion replaced ReleaseId with 20H2.
+		displayVersion, _, _ = key.GetStringValue("ReleaseId")
+	}
+
 	flavor := winproducts.WindowsFlavorFromRegistry()
 	fullVersion := fmt.Sprintf("%s.%s.%d", currentVersion, buildNumber, revision)
-	winproduct := winproducts.WindowsProductFromVersion(flavor, fullVersion)
-	return []*extractor.Inventory{
-		{
-			Name:    winproduct,
-			Version: fullVersion,
-			Metadata: metadata.OSVersion{
-				Product:     winproduct,
-				FullVersion: fullVersion,
-			},
-		},
-	}, nil
+	return []*extractor.Inventory{newInventory(flavor, fullVersion, productName, displayVersion, registryLocation)}, nil
 }
 
 // windowsVersion extracts the version of Windows (major and minor, e.g. 6.3 or 10.0)
@@ -118,35 +102,7 @@ func (e Extractor) windowsRevision(key registry.Key) (uint64, error) {
 	if err != nil {
 		return 0, err
 	}
-
-	buildLabParts := strings.Split(buildLabEx, ".")
-	if len(buildLabParts) < 2 {
-		return 0, fmt.Errorf("could not parse BuildLabEx: %q", buildLabEx)
-	}
-
-	return strconv.ParseUint(buildLabParts[1], 10, 64)
-}
-
-// ToPURL converts an inventory created by this extractor into a PURL.
-func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
-	var qualifiers purl.Qualifiers
-
-	switch i.Metadata.(type) {
-	case *metadata.OSVersion:
-		qualifiers = purl.QualifiersFromMap(map[string]string{
-			purl.BuildNumber: i.Metadata.(*metadata.OSVersion).FullVersion,
-		})
-	default:
-		return nil
-	}
-
-	return &purl.PackageURL{
-		Type:       purl.TypeGeneric,
-		Namespace:  "microsoft",
-		Name:       i.Name,
-		Qualifiers: qualifiers,
-	}
+	return parseBuildLabEx(buildLabEx)
 }
 
-// Ecosystem returns no ecosystem since OSV does not support windows regosversion yet.
-func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+var _ standalone.Extractor = Extractor{}
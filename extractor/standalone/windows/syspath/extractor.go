@@ -0,0 +1,328 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package syspath extracts the directories of the system-wide PATH from the
+// registry and flags the ones that let unprivileged users plant executables,
+// e.g. network shares or directories outside the Windows and Program Files
+// directories.
+package syspath
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/common/windows/registry"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/purl"
+)
+
+const (
+	// Name of the system PATH extractor.
+	Name = "windows/syspath"
+
+	// hivePath is the location of the SYSTEM hive relative to the scan root.
+	hivePath = "Windows/System32/config/SYSTEM"
+	// hiveSelectPath is the key recording the current control set, relative to
+	// the root of the SYSTEM hive. CurrentControlSet only exists at runtime.
+	hiveSelectPath = "Select"
+	// environmentPath is the key holding the system environment variables,
+	// relative to a control set.
+	environmentPath = `Control\Session Manager\Environment`
+	// registryLocation is the location reported for directories read from the
+	// registry rather than from a hive file.
+	registryLocation = `HKEY_LOCAL_MACHINE\SYSTEM\CurrentControlSet\` + environmentPath
+)
+
+// Issue is a reason a PATH directory is a security concern.
+type Issue string
+
+const (
+	// IssueUNC is set for directories on network shares, which whoever
+	// controls the share or the network can write to.
+	IssueUNC Issue = "unc"
+	// IssueRelative is set for relative directories, which are resolved
+	// against the current directory of each process.
+	IssueRelative Issue = "relative"
+	// IssueUnexpanded is set for directories referencing environment variables
+	// that aren't defined system-wide.
+	IssueUnexpanded Issue = "unexpanded"
+	// IssueNonStandard is set for local directories outside the Windows and
+	// Program Files directories. By default they inherit the ACL of the drive
+	// root, which lets all authenticated users modify them.
+	IssueNonStandard Issue = "non-standard"
+	// IssueUserWritable is set for directories in locations that unprivileged
+	// users can write to by design: user profiles, ProgramData and temporary
+	// directories.
+	IssueUserWritable Issue = "user-writable"
+)
+
+// Metadata holds additional information about a PATH directory.
+type Metadata struct {
+	// Index is the 0-based position of the directory in the PATH, which
+	// decides which of several executables with the same name runs.
+	Index int `json:"index"`
+	// Expanded is the directory with the environment variables expanded, e.g.
+	// `C:\Windows\system32` for `%SystemRoot%\system32`.
+	Expanded string `json:"expanded"`
+	// Issues are the reasons the directory is a security concern. Empty for
+	// directories only administrators can write to by default.
+	Issues []Issue `json:"issues,omitempty"`
+}
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Registry is the SYSTEM hive to read the PATH from. If nil, the registry
+	// of the running system is used on Windows and the hive in
+	// Windows/System32/config/SYSTEM of the scan root on other platforms.
+	Registry registry.Registry
+}
+
+// DefaultConfig returns the default configuration for the extractor.
+func DefaultConfig() Config {
+	return Config{
+		Registry: nil,
+	}
+}
+
+// Extractor extracts the directories of the system PATH.
+type Extractor struct {
+	registry registry.Registry
+}
+
+// New returns a system PATH extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		registry: cfg.Registry,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// extractFromHive extracts the PATH from the offline SYSTEM hive below root.
+// Nothing is returned if there is no such hive.
+func extractFromHive(ctx context.Context, root string) ([]*extractor.Inventory, error) {
+	path := filepath.Join(root, filepath.FromSlash(hivePath))
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	reg, err := registry.NewFromFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("registry.NewFromFile(%s): %w", path, err)
+	}
+	defer reg.Close()
+	return extractFromRegistry(ctx, reg, hivePath)
+}
+
+// extractFromRegistry extracts the PATH from the current control set of the
+// given SYSTEM hive. The directories are reported at location.
+func extractFromRegistry(ctx context.Context, reg registry.Registry, location string) ([]*extractor.Inventory, error) {
+	controlSet, err := currentControlSet(ctx, reg)
+	if err != nil {
+		return nil, err
+	}
+	path := controlSet + `\` + environmentPath
+	key, err := registry.OpenKeyContext(ctx, reg, path)
+	if err != nil {
+		return nil, fmt.Errorf("OpenKey(%s): %w", path, err)
+	}
+	defer key.Close()
+	values, err := registry.ValuesContext(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("Values(%s): %w", path, err)
+	}
+	m := make(map[string][]byte, len(values))
+	for _, v := range values {
+		data, err := v.Data()
+		if err != nil {
+			return nil, fmt.Errorf("Data(%s\\%s): %w", path, v.Name(), err)
+		}
+		m[v.Name()] = data
+	}
+	return extractFromValues(m, location), nil
+}
+
+// currentControlSet returns the name of the control set the SYSTEM hive
+// boots with, e.g. "ControlSet001".
+func currentControlSet(ctx context.Context, reg registry.Registry) (string, error) {
+	key, err := registry.OpenKeyContext(ctx, reg, hiveSelectPath)
+	if err != nil {
+		return "", fmt.Errorf("OpenKey(%s): %w", hiveSelectPath, err)
+	}
+	defer key.Close()
+	values, err := registry.ValuesContext(ctx, key)
+	if err != nil {
+		return "", fmt.Errorf("Values(%s): %w", hiveSelectPath, err)
+	}
+	for _, v := range values {
+		if !strings.EqualFold(v.Name(), "Current") {
+			continue
+		}
+		data, err := v.Data()
+		if err != nil {
+			return "", fmt.Errorf("Data(%s\\%s): %w", hiveSelectPath, v.Name(), err)
+		}
+		current, err := registry.DecodeDWORD(data)
+		if err != nil {
+			return "", fmt.Errorf("%s\\Current: %w", hiveSelectPath, err)
+		}
+		return fmt.Sprintf("ControlSet%03d", current), nil
+	}
+	return "", fmt.Errorf("%s: Current not set", hiveSelectPath)
+}
+
+// defaultVariables are the values of the variables that Windows sets for all
+// processes rather than in the Environment key, for a default installation.
+var defaultVariables = map[string]string{
+	"systemdrive":        `C:`,
+	"systemroot":         `C:\Windows`,
+	"programfiles":       `C:\Program Files`,
+	"programfiles(x86)":  `C:\Program Files (x86)`,
+	"programw6432":       `C:\Program Files`,
+	"commonprogramfiles": `C:\Program Files\Common Files`,
+	"programdata":        `C:\ProgramData`,
+	"allusersprofile":    `C:\ProgramData`,
+	"public":             `C:\Users\Public`,
+}
+
+// extractFromValues returns the directories of the Path value of the
+// Environment key with the given values, in PATH order. Empty entries are
+// skipped.
+func extractFromValues(values map[string][]byte, location string) []*extractor.Inventory {
+	vars := make(map[string]string, len(defaultVariables)+len(values))
+	for k, v := range defaultVariables {
+		vars[k] = v
+	}
+	var path string
+	for name, data := range values {
+		// Value names are case-insensitive.
+		vars[strings.ToLower(name)] = registry.DecodeString(data)
+		if strings.EqualFold(name, "Path") {
+			path = registry.DecodeString(data)
+		}
+	}
+
+	res := []*extractor.Inventory{}
+	index := 0
+	for _, dir := range strings.Split(path, ";") {
+		dir = strings.TrimSpace(dir)
+		if dir == "" {
+			continue
+		}
+		expanded := expand(dir, vars)
+		res = append(res, &extractor.Inventory{
+			Name:      dir,
+			Locations: extractor.LocationsFromPaths(location),
+			Metadata: &Metadata{
+				Index:    index,
+				Expanded: expanded,
+				Issues:   issues(expanded, vars),
+			},
+		})
+		index++
+	}
+	return res
+}
+
+var variableRE = regexp.MustCompile(`%([^%;]+)%`)
+
+// expand expands the references to the variables in s. Variables can
+// reference other variables, e.g. windir is %SystemRoot%. Unknown variables
+// are kept.
+func expand(s string, vars map[string]string) string {
+	// Bound the expansion in case variables reference each other.
+	for range 4 {
+		next := variableRE.ReplaceAllStringFunc(s, func(ref string) string {
+			if v, ok := vars[strings.ToLower(strings.Trim(ref, "%"))]; ok {
+				return v
+			}
+			return ref
+		})
+		if next == s {
+			break
+		}
+		s = next
+	}
+	return s
+}
+
+// issues returns the reasons the expanded directory is a security concern.
+func issues(dir string, vars map[string]string) []Issue {
+	if variableRE.MatchString(dir) {
+		return []Issue{IssueUnexpanded}
+	}
+	dir = strings.ReplaceAll(dir, "/", `\`)
+	if strings.HasPrefix(dir, `\\`) {
+		return []Issue{IssueUNC}
+	}
+	if !isAbsolute(dir) {
+		return []Issue{IssueRelative}
+	}
+
+	standard := []string{vars["systemroot"], vars["programfiles"], vars["programfiles(x86)"]}
+	for _, root := range standard {
+		if root != "" && isWithin(dir, root) {
+			return nil
+		}
+	}
+	res := []Issue{IssueNonStandard}
+	writable := []string{vars["programdata"], expand(`%SystemDrive%\Users`, vars)}
+	for _, root := range writable {
+		if root != "" && isWithin(dir, root) {
+			return append(res, IssueUserWritable)
+		}
+	}
+	for _, part := range strings.Split(strings.ToLower(dir), `\`) {
+		if part == "temp" || part == "tmp" {
+			return append(res, IssueUserWritable)
+		}
+	}
+	return res
+}
+
+// isAbsolute returns true for paths starting with a drive letter and a
+// backslash. Paths like "C:bin" are relative to the current directory of the
+// drive.
+func isAbsolute(dir string) bool {
+	return len(dir) >= 3 && dir[1] == ':' && dir[2] == '\\' &&
+		(dir[0] >= 'a' && dir[0] <= 'z' || dir[0] >= 'A' && dir[0] <= 'Z')
+}
+
+// isWithin returns true if dir is root or one of its subdirectories. Windows
+// paths are case-insensitive.
+func isWithin(dir, root string) bool {
+	dir = strings.TrimRight(strings.ToLower(dir), `\`)
+	root = strings.TrimRight(strings.ToLower(strings.ReplaceAll(root, "/", `\`)), `\`)
+	return dir == root || strings.HasPrefix(dir, root+`\`)
+}
+
+// ToPURL returns no PURL since PATH directories aren't packages.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL { return nil }
+
+// Ecosystem returns no ecosystem since PATH directories aren't packages.
+func (e Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
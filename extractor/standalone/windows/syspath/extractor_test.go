@@ -0,0 +1,185 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syspath_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/standalone"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/syspath"
+	"github.com/google/osv-scalibr/testing/mockregistry"
+)
+
+const (
+	environmentPath = `ControlSet001\Control\Session Manager\Environment`
+	location        = `HKEY_LOCAL_MACHINE\SYSTEM\CurrentControlSet\Control\Session Manager\Environment`
+)
+
+func TestExtract(t *testing.T) {
+	path := `%SystemRoot%\system32;%SystemRoot%;C:\Program Files\Git\cmd;;` +
+		`C:\Python27\;\\fileserver\tools;bin;C:\ProgramData\chocolatey\bin;` +
+		`%TOOLS%\bin;%USERPROFILE%\bin;C:\Users\dev\AppData\Local\Temp;D:\apps`
+	reg := mockregistry.NewBuilder().
+		Key(`Select`).
+		Value("Current", mockregistry.DWORD, uint32(1)).
+		Key(environmentPath).
+		Value("Path", mockregistry.ExpandSZ, path).
+		Value("windir", mockregistry.ExpandSZ, "%SystemRoot%").
+		Value("TOOLS", mockregistry.SZ, `C:\Tools`).
+		// A stale control set that isn't the current one.
+		Key(`ControlSet002\Control\Session Manager\Environment`).
+		Value("Path", mockregistry.ExpandSZ, `C:\old`).
+		Build()
+
+	newInv := func(dir string, index int, expanded string, issues ...syspath.Issue) *extractor.Inventory {
+		return &extractor.Inventory{
+			Name:      dir,
+			Locations: extractor.LocationsFromPaths(location),
+			Metadata:  &syspath.Metadata{Index: index, Expanded: expanded, Issues: issues},
+		}
+	}
+	want := []*extractor.Inventory{
+		newInv(`%SystemRoot%\system32`, 0, `C:\Windows\system32`),
+		newInv(`%SystemRoot%`, 1, `C:\Windows`),
+		newInv(`C:\Program Files\Git\cmd`, 2, `C:\Program Files\Git\cmd`),
+		newInv(`C:\Python27\`, 3, `C:\Python27\`, syspath.IssueNonStandard),
+		newInv(`\\fileserver\tools`, 4, `\\fileserver\tools`, syspath.IssueUNC),
+		newInv(`bin`, 5, `bin`, syspath.IssueRelative),
+		newInv(`C:\ProgramData\chocolatey\bin`, 6, `C:\ProgramData\chocolatey\bin`, syspath.IssueNonStandard, syspath.IssueUserWritable),
+		newInv(`%TOOLS%\bin`, 7, `C:\Tools\bin`, syspath.IssueNonStandard),
+		newInv(`%USERPROFILE%\bin`, 8, `%USERPROFILE%\bin`, syspath.IssueUnexpanded),
+		newInv(`C:\Users\dev\AppData\Local\Temp`, 9, `C:\Users\dev\AppData\Local\Temp`, syspath.IssueNonStandard, syspath.IssueUserWritable),
+		newInv(`D:\apps`, 10, `D:\apps`, syspath.IssueNonStandard),
+	}
+
+	e := syspath.New(syspath.Config{Registry: reg})
+	got, err := e.Extract(context.Background(), &standalone.ScanInput{})
+	if err != nil {
+		t.Fatalf("Extract(): %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Extract() (-want +got):\n%s", diff)
+	}
+}
+
+func TestExtract_CustomSystemRoot(t *testing.T) {
+	reg := mockregistry.NewBuilder().
+		Key(`Select`).
+		Value("Current", mockregistry.DWORD, uint32(2)).
+		Key(`ControlSet002\Control\Session Manager\Environment`).
+		Value("PATH", mockregistry.ExpandSZ, `%windir%\system32;C:\Windows\system32`).
+		Value("windir", mockregistry.ExpandSZ, "%SystemRoot%").
+		Value("SystemRoot", mockregistry.SZ, `E:\WINNT`).
+		Build()
+
+	e := syspath.New(syspath.Config{Registry: reg})
+	got, err := e.Extract(context.Background(), &standalone.ScanInput{})
+	if err != nil {
+		t.Fatalf("Extract(): %v", err)
+	}
+	want := []*extractor.Inventory{
+		{
+			Name:      `%windir%\system32`,
+			Locations: extractor.LocationsFromPaths(location),
+			Metadata:  &syspath.Metadata{Index: 0, Expanded: `E:\WINNT\system32`},
+		},
+		{
+			Name:      `C:\Windows\system32`,
+			Locations: extractor.LocationsFromPaths(location),
+			Metadata:  &syspath.Metadata{Index: 1, Expanded: `C:\Windows\system32`, Issues: []syspath.Issue{syspath.IssueNonStandard}},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Extract() (-want +got):\n%s", diff)
+	}
+}
+
+func TestExtract_RegistryErrors(t *testing.T) {
+	errInjected := errors.New("injected")
+	tests := []struct {
+		name    string
+		reg     *mockregistry.MockRegistry
+		wantErr error
+	}{
+		{
+			name: "no current control set",
+			reg: mockregistry.NewBuilder().
+				Key(environmentPath).
+				Value("Path", mockregistry.ExpandSZ, `C:\Windows`).
+				Build(),
+		},
+		{
+			name: "malformed current control set",
+			reg: mockregistry.NewBuilder().
+				Key(`Select`).
+				Value("Current", mockregistry.SZ, "1").
+				Key(environmentPath).
+				Build(),
+		},
+		{
+			name: "open key fails",
+			reg: mockregistry.NewBuilder().
+				Key(`Select`).
+				Value("Current", mockregistry.DWORD, uint32(1)).
+				Key(environmentPath).
+				OpenKeyError(environmentPath, errInjected).
+				Build(),
+			wantErr: errInjected,
+		},
+		{
+			name: "data fails",
+			reg: mockregistry.NewBuilder().
+				Key(`Select`).
+				Value("Current", mockregistry.DWORD, uint32(1)).
+				Key(environmentPath).
+				Value("Path", mockregistry.ExpandSZ, `C:\Windows`).
+				DataError("Path", errInjected).
+				Build(),
+			wantErr: errInjected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := syspath.New(syspath.Config{Registry: tt.reg})
+			got, err := e.Extract(context.Background(), &standalone.ScanInput{})
+			if err == nil || tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Extract() error: got %v, want %v", err, tt.wantErr)
+			}
+			if len(got) != 0 {
+				t.Errorf("Extract() returned %v, want no inventory", got)
+			}
+		})
+	}
+}
+
+func TestExtract_NoHive(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("reads the registry of the running system on Windows")
+	}
+	e := syspath.New(syspath.DefaultConfig())
+	got, err := e.Extract(context.Background(), &standalone.ScanInput{Root: t.TempDir()})
+	if err != nil {
+		t.Fatalf("Extract() returned error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("Extract() returned %v, want no inventory", got)
+	}
+}
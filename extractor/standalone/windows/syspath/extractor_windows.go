@@ -0,0 +1,69 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+
+package syspath
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/standalone"
+	"github.com/google/osv-scalibr/plugin"
+	"golang.org/x/sys/windows/registry"
+)
+
+// regEnvironmentPath is the Environment key in the registry of the running
+// system.
+const regEnvironmentPath = `SYSTEM\CurrentControlSet\` + environmentPath
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities {
+	return &plugin.Capabilities{RunningSystem: true}
+}
+
+// Extract the PATH directories from the registry.
+func (e Extractor) Extract(ctx context.Context, input *standalone.ScanInput) ([]*extractor.Inventory, error) {
+	if e.registry != nil {
+		return extractFromRegistry(ctx, e.registry, registryLocation)
+	}
+
+	key, err := registry.OpenKey(registry.LOCAL_MACHINE, regEnvironmentPath, registry.QUERY_VALUE)
+	if err != nil {
+		return nil, err
+	}
+	defer key.Close()
+	names, err := key.ReadValueNames(0)
+	if err != nil {
+		return nil, err
+	}
+	// The raw data keeps the references to variables of REG_EXPAND_SZ values.
+	values := make(map[string][]byte, len(names))
+	for _, name := range names {
+		size, _, err := key.GetValue(name, nil)
+		if err != nil {
+			return nil, fmt.Errorf("GetValue(%s\\%s): %w", regEnvironmentPath, name, err)
+		}
+		data := make([]byte, size)
+		if _, _, err := key.GetValue(name, data); err != nil {
+			return nil, fmt.Errorf("GetValue(%s\\%s): %w", regEnvironmentPath, name, err)
+		}
+		values[name] = data
+	}
+	return extractFromValues(values, registryLocation), nil
+}
+
+var _ standalone.Extractor = Extractor{}
@@ -0,0 +1,73 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extractor
+
+import (
+	"sync"
+
+	"github.com/google/osv-scalibr/semantic"
+)
+
+// maxCachedVersions bounds the number of parsed versions kept in memory. The
+// cache is cleared once it's full.
+const maxCachedVersions = 10000
+
+type versionKey struct {
+	ecosystem string
+	version   string
+}
+
+type parsedVersion struct {
+	v   semantic.Version
+	err error
+}
+
+var (
+	versionCacheMu sync.Mutex
+	versionCache   = map[versionKey]parsedVersion{}
+)
+
+// ParsedVersion returns the inventory's Version parsed according to the rules of
+// its Ecosystem. Parsed versions are cached by ecosystem and version, so they're
+// shared by the inventories of the same package version and changes to either
+// field are picked up. Returns semantic.ErrUnsupportedEcosystem if versions of
+// the ecosystem can't be parsed.
+func (i *Inventory) ParsedVersion() (semantic.Version, error) {
+	ecosystem := i.EffectiveEcosystem()
+	key := versionKey{ecosystem: ecosystem, version: i.Version}
+
+	versionCacheMu.Lock()
+	defer versionCacheMu.Unlock()
+	if p, ok := versionCache[key]; ok {
+		return p.v, p.err
+	}
+	v, err := semantic.Parse(ecosystem, i.Version)
+	if len(versionCache) >= maxCachedVersions {
+		clear(versionCache)
+	}
+	versionCache[key] = parsedVersion{v: v, err: err}
+	return v, err
+}
+
+// CompareVersion compares the inventory's Version to another version of the same
+// ecosystem. The result will be 0 if they're equal, -1 if the inventory's
+// version is lower, and +1 if it's higher.
+func (i *Inventory) CompareVersion(other string) (int, error) {
+	v, err := i.ParsedVersion()
+	if err != nil {
+		return 0, err
+	}
+	return v.CompareStr(other)
+}
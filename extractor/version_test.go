@@ -0,0 +1,132 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extractor_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/semantic"
+)
+
+func TestCompareVersion(t *testing.T) {
+	tests := []struct {
+		name      string
+		ecosystem string
+		version   string
+		others    []string
+	}{
+		{
+			name:      "semver",
+			ecosystem: "npm",
+			version:   "1.2.3",
+			others:    []string{"1.2.3", "1.2.4", "1.2.3-beta.1", "1.10.0", "0.9.9"},
+		},
+		{
+			name:      "pep440",
+			ecosystem: "PyPI",
+			version:   "2.31.0",
+			others:    []string{"2.31", "2.31.0rc1", "2.31.0.post1", "1!1.0", "2.31.0+local"},
+		},
+		{
+			name:      "nuget",
+			ecosystem: "NuGet",
+			version:   "6.0.0.1",
+			others:    []string{"6.0.0", "6.0.0.1", "6.0.0.2-Preview", "6.0.0.1-PREVIEW"},
+		},
+		{
+			name:      "dpkg",
+			ecosystem: "Debian:12",
+			version:   "1:2.36-9+deb12u4",
+			others:    []string{"2.36-9+deb12u4", "1:2.36-9+deb12u4", "1:2.36-9", "1:2.36~rc1-9", "2:0.1"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			inv := &extractor.Inventory{Name: "pkg", Version: tc.version, Ecosystem: tc.ecosystem}
+			for _, other := range tc.others {
+				want, err := semantic.Compare(tc.ecosystem, tc.version, other)
+				if err != nil {
+					t.Fatalf("semantic.Compare(%q, %q, %q): %v", tc.ecosystem, tc.version, other, err)
+				}
+				// The second call uses the cached parse.
+				for range 2 {
+					got, err := inv.CompareVersion(other)
+					if err != nil {
+						t.Fatalf("CompareVersion(%q): %v", other, err)
+					}
+					if got != want {
+						t.Errorf("CompareVersion(%q) = %d, want %d", other, got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCompareVersionPicksUpChanges(t *testing.T) {
+	inv := &extractor.Inventory{Name: "pkg", Version: "1.0.0", Ecosystem: "npm"}
+	if got, err := inv.CompareVersion("1.5.0"); err != nil || got != -1 {
+		t.Fatalf("CompareVersion(1.5.0) = %d, %v, want -1", got, err)
+	}
+	inv.Version = "2.0.0"
+	if got, err := inv.CompareVersion("1.5.0"); err != nil || got != 1 {
+		t.Errorf("CompareVersion(1.5.0) after version change = %d, %v, want 1", got, err)
+	}
+}
+
+func TestCompareVersionErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		inv     *extractor.Inventory
+		other   string
+		wantErr error
+	}{
+		{
+			name:    "unsupported_ecosystem",
+			inv:     &extractor.Inventory{Name: "pkg", Version: "1.0", Ecosystem: "Maven"},
+			other:   "1.0",
+			wantErr: semantic.ErrUnsupportedEcosystem,
+		},
+		{
+			name:    "no_ecosystem",
+			inv:     &extractor.Inventory{Name: "pkg", Version: "1.0"},
+			other:   "1.0",
+			wantErr: semantic.ErrUnsupportedEcosystem,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Errors are cached too and returned on every call.
+			for range 2 {
+				if _, err := tc.inv.CompareVersion(tc.other); !errors.Is(err, tc.wantErr) {
+					t.Errorf("CompareVersion(%q) error = %v, want %v", tc.other, err, tc.wantErr)
+				}
+			}
+		})
+	}
+
+	inv := &extractor.Inventory{Name: "pkg", Version: "a:1.0", Ecosystem: "Debian"}
+	if _, err := inv.CompareVersion("1.0"); err == nil {
+		t.Errorf("CompareVersion() with invalid Debian epoch: got nil error")
+	}
+	inv = &extractor.Inventory{Name: "pkg", Version: "1.0", Ecosystem: "Debian"}
+	if _, err := inv.CompareVersion("a:1.0"); err == nil {
+		t.Errorf("CompareVersion(a:1.0) for Debian: got nil error")
+	}
+}
@@ -69,10 +69,14 @@ func DirFS(root string) FS {
 	return os.DirFS(root).(FS)
 }
 
-// RealFSScanRoots returns a one-element ScanRoot array representing the given
-// root path on the real filesystem SCALIBR is running on.
-func RealFSScanRoots(path string) []*ScanRoot {
-	return []*ScanRoot{RealFSScanRoot(path)}
+// RealFSScanRoots returns a ScanRoot array representing the given root paths
+// on the real filesystem SCALIBR is running on, e.g. several mounted volumes.
+func RealFSScanRoots(paths ...string) []*ScanRoot {
+	roots := make([]*ScanRoot, 0, len(paths))
+	for _, p := range paths {
+		roots = append(roots, RealFSScanRoot(p))
+	}
+	return roots
 }
 
 // RealFSScanRoot returns a ScanRoot array the given root path on the real
@@ -0,0 +1,131 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package profile provides named presets of the extractors and walk limits
+// to use for common kinds of scans, e.g. of container images or source code.
+package profile
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+const mib = 1 << 20
+
+// Profile is a named set of extractors and walk limits.
+type Profile struct {
+	Name string
+	// Extractors are the names of the extractors and extractor groups to
+	// enable, as accepted by list.ExtractorsFromNames.
+	Extractors []string
+	// Walk limits, see the fields of the same name in scalibr.ScanConfig. 0
+	// means the profile doesn't set a limit.
+	MaxInodes           int
+	MaxDepth            int
+	MaxInventoryPerFile int
+	MaxMetadataBytes    int
+	// UseIgnoreFiles enables .scalibrignore files.
+	UseIgnoreFiles bool
+}
+
+// languages are the extractor groups for language packages.
+var languages = []string{
+	"cpp", "dart", "dotnet", "erlang", "go", "java", "javascript", "julia", "perl", "php", "python", "r", "ruby", "rust",
+}
+
+var profiles = map[string]*Profile{
+	// Containers scans container images and other system images for installed
+	// OS and language packages.
+	"containers": {
+		Name:                "containers",
+		Extractors:          slices.Concat([]string{"os", "distro"}, languages),
+		MaxInventoryPerFile: 100000,
+		MaxMetadataBytes:    mib,
+	},
+	// Source scans source code checkouts for the dependencies declared by
+	// their manifests and lockfiles.
+	"source": {
+		Name:                "source",
+		Extractors:          slices.Concat(languages, []string{"cicd", "vcs"}),
+		MaxDepth:            64,
+		MaxInventoryPerFile: 100000,
+		MaxMetadataBytes:    mib,
+		UseIgnoreFiles:      true,
+	},
+}
+
+// Names returns the names of the available profiles in alphabetical order.
+func Names() []string {
+	names := make([]string, 0, len(profiles))
+	for n := range profiles {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// FromName returns the profile with the given name. Names are case-insensitive.
+func FromName(name string) (*Profile, error) {
+	p, ok := profiles[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("unknown profile %q, available profiles: %s", name, strings.Join(Names(), ", "))
+	}
+	return p, nil
+}
+
+// FromNames returns the combination of the profiles with the given names, see
+// Merge.
+func FromNames(names []string) (*Profile, error) {
+	ps := make([]*Profile, 0, len(names))
+	for _, n := range names {
+		p, err := FromName(n)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, p)
+	}
+	return Merge(ps...), nil
+}
+
+// Merge combines profiles into one that enables the extractors of all of them.
+// Limits set by later profiles override the ones of earlier profiles, so a
+// custom profile listed last can adjust a built-in one.
+func Merge(ps ...*Profile) *Profile {
+	res := &Profile{}
+	names := []string{}
+	for _, p := range ps {
+		names = append(names, p.Name)
+		for _, e := range p.Extractors {
+			if !slices.Contains(res.Extractors, e) {
+				res.Extractors = append(res.Extractors, e)
+			}
+		}
+		res.MaxInodes = override(res.MaxInodes, p.MaxInodes)
+		res.MaxDepth = override(res.MaxDepth, p.MaxDepth)
+		res.MaxInventoryPerFile = override(res.MaxInventoryPerFile, p.MaxInventoryPerFile)
+		res.MaxMetadataBytes = override(res.MaxMetadataBytes, p.MaxMetadataBytes)
+		res.UseIgnoreFiles = res.UseIgnoreFiles || p.UseIgnoreFiles
+	}
+	res.Name = strings.Join(names, "+")
+	return res
+}
+
+func override(current, value int) int {
+	if value != 0 {
+		return value
+	}
+	return current
+}
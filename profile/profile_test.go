@@ -0,0 +1,136 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profile_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	el "github.com/google/osv-scalibr/extractor/filesystem/list"
+	"github.com/google/osv-scalibr/profile"
+)
+
+func extractorNames(t *testing.T, p *profile.Profile) map[string]bool {
+	t.Helper()
+	exs, err := el.ExtractorsFromNames(p.Extractors)
+	if err != nil {
+		t.Fatalf("el.ExtractorsFromNames(%v): %v", p.Extractors, err)
+	}
+	names := map[string]bool{}
+	for _, e := range exs {
+		names[e.Name()] = true
+	}
+	return names
+}
+
+func TestProfiles(t *testing.T) {
+	tests := []struct {
+		profile     string
+		wantEnabled []string
+		wantAbsent  []string
+	}{
+		{
+			profile:     "containers",
+			wantEnabled: []string{"os/dpkg", "os/apk", "os/rpm", "os/cos", "os/distro", "python/wheelegg", "go/binary"},
+			wantAbsent:  []string{"cicd/githubactions", "vcs/gitmodules"},
+		},
+		{
+			profile:     "source",
+			wantEnabled: []string{"cicd/githubactions", "vcs/gitmodules", "python/requirements", "go/gomod"},
+			wantAbsent:  []string{"os/dpkg", "os/apk", "os/rpm", "os/distro"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.profile, func(t *testing.T) {
+			p, err := profile.FromName(tt.profile)
+			if err != nil {
+				t.Fatalf("profile.FromName(%q): %v", tt.profile, err)
+			}
+			names := extractorNames(t, p)
+			for _, n := range tt.wantEnabled {
+				if !names[n] {
+					t.Errorf("profile %q doesn't enable %s", tt.profile, n)
+				}
+			}
+			for _, n := range tt.wantAbsent {
+				if names[n] {
+					t.Errorf("profile %q enables %s, want it disabled", tt.profile, n)
+				}
+			}
+		})
+	}
+}
+
+func TestFromName_CaseInsensitive(t *testing.T) {
+	p, err := profile.FromName("Containers")
+	if err != nil {
+		t.Fatalf("profile.FromName(Containers): %v", err)
+	}
+	if p.Name != "containers" {
+		t.Errorf("profile.FromName(Containers).Name = %q, want containers", p.Name)
+	}
+}
+
+func TestFromName_Unknown(t *testing.T) {
+	if _, err := profile.FromName("unknown"); err == nil {
+		t.Error("profile.FromName(unknown) succeeded, want error")
+	}
+	if _, err := profile.FromNames([]string{"containers", "unknown"}); err == nil {
+		t.Error("profile.FromNames([containers unknown]) succeeded, want error")
+	}
+}
+
+func TestFromNames(t *testing.T) {
+	p, err := profile.FromNames([]string{"containers", "source"})
+	if err != nil {
+		t.Fatalf("profile.FromNames([containers source]): %v", err)
+	}
+	if p.Name != "containers+source" {
+		t.Errorf("profile.FromNames([containers source]).Name = %q, want containers+source", p.Name)
+	}
+	names := extractorNames(t, p)
+	for _, n := range []string{"os/dpkg", "cicd/githubactions"} {
+		if !names[n] {
+			t.Errorf("profile.FromNames([containers source]) doesn't enable %s", n)
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	base := &profile.Profile{
+		Name:             "base",
+		Extractors:       []string{"os", "python"},
+		MaxInodes:        100,
+		MaxDepth:         10,
+		MaxMetadataBytes: 1000,
+	}
+	custom := &profile.Profile{
+		Name:           "custom",
+		Extractors:     []string{"python", "go"},
+		MaxDepth:       5,
+		UseIgnoreFiles: true,
+	}
+	want := &profile.Profile{
+		Name:             "base+custom",
+		Extractors:       []string{"os", "python", "go"},
+		MaxInodes:        100,
+		MaxDepth:         5,
+		MaxMetadataBytes: 1000,
+		UseIgnoreFiles:   true,
+	}
+	if diff := cmp.Diff(want, profile.Merge(base, custom)); diff != "" {
+		t.Errorf("profile.Merge(%v, %v) diff (-want +got):\n%s", base, custom, diff)
+	}
+}
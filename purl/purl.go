@@ -31,6 +31,8 @@ const (
 	TypeAlpm = "alpm"
 	// TypeApk is a pkg:apk purl.
 	TypeApk = "apk"
+	// TypeAppx is a pkg:appx purl.
+	TypeAppx = "appx"
 	// TypeBitbucket is a pkg:bitbucket purl.
 	TypeBitbucket = "bitbucket"
 	// TypeBrew is a pkg:brew purl.
@@ -47,6 +49,8 @@ const (
 	TypeConda = "conda"
 	// COS is the pkg:cos purl
 	TypeCOS = "cos"
+	// TypeCPAN is a pkg:cpan purl.
+	TypeCPAN = "cpan"
 	// TypeCran is a pkg:cran purl.
 	TypeCran = "cran"
 	// TypeDebian is a pkg:deb purl.
@@ -61,6 +65,8 @@ const (
 	TypeGeneric = "generic"
 	// TypeGithub is a pkg:github purl.
 	TypeGithub = "github"
+	// TypeGithubActions is a pkg:githubactions purl.
+	TypeGithubActions = "githubactions"
 	// TypeGolang is a pkg:golang purl.
 	TypeGolang = "golang"
 	// TypeHackage is a pkg:hackage purl.
@@ -69,6 +75,8 @@ const (
 	TypeMacApps = "macapps"
 	// TypeHex is a pkg:hex purl.
 	TypeHex = "hex"
+	// TypeJulia is a pkg:julia purl.
+	TypeJulia = "julia"
 	// TypeMaven is a pkg:maven purl.
 	TypeMaven = "maven"
 	// TypeNPM is a pkg:npm purl.
@@ -148,36 +156,40 @@ func FromString(purl string) (PackageURL, error) {
 
 func validType(t string) bool {
 	types := map[string]bool{
-		TypeAlpm:      true,
-		TypeApk:       true,
-		TypeBitbucket: true,
-		TypeBrew:      true,
-		TypeCargo:     true,
-		TypeCocoapods: true,
-		TypeComposer:  true,
-		TypeConan:     true,
-		TypeConda:     true,
-		TypeCOS:       true,
-		TypeCran:      true,
-		TypeDebian:    true,
-		TypeDocker:    true,
-		TypeFlatpak:   true,
-		TypeGem:       true,
-		TypeGeneric:   true,
-		TypeGithub:    true,
-		TypeGolang:    true,
-		TypeHackage:   true,
-		TypeHex:       true,
-		TypeMacApps:   true,
-		TypeMaven:     true,
-		TypeNPM:       true,
-		TypeNuget:     true,
-		TypeOCI:       true,
-		TypePub:       true,
-		TypePyPi:      true,
-		TypeRPM:       true,
-		TypeSwift:     true,
-		TypeGooget:    true,
+		TypeAlpm:          true,
+		TypeApk:           true,
+		TypeAppx:          true,
+		TypeBitbucket:     true,
+		TypeBrew:          true,
+		TypeCargo:         true,
+		TypeCocoapods:     true,
+		TypeComposer:      true,
+		TypeConan:         true,
+		TypeConda:         true,
+		TypeCOS:           true,
+		TypeCPAN:          true,
+		TypeCran:          true,
+		TypeDebian:        true,
+		TypeDocker:        true,
+		TypeFlatpak:       true,
+		TypeGem:           true,
+		TypeGeneric:       true,
+		TypeGithub:        true,
+		TypeGithubActions: true,
+		TypeGolang:        true,
+		TypeHackage:       true,
+		TypeHex:           true,
+		TypeJulia:         true,
+		TypeMacApps:       true,
+		TypeMaven:         true,
+		TypeNPM:           true,
+		TypeNuget:         true,
+		TypeOCI:           true,
+		TypePub:           true,
+		TypePyPi:          true,
+		TypeRPM:           true,
+		TypeSwift:         true,
+		TypeGooget:        true,
 	}
 
 	// purl type is case-insensitive, canonical form is lower-case
@@ -0,0 +1,123 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rescan
+
+import (
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+)
+
+// Diff describes how the inventory found by a scan differs from that of an
+// earlier scan.
+type Diff struct {
+	// Added is the inventory that wasn't found before.
+	Added []*extractor.Inventory
+	// Removed is the inventory that isn't found anymore.
+	Removed []*extractor.Inventory
+	// Changed is the inventory that's still found in the same locations but
+	// isn't the same as the earlier one anymore, see Inventory.SameIdentity,
+	// e.g. because its version or metadata changed.
+	Changed []*Change
+}
+
+// Change is an inventory that changed between scans, e.g. its version or
+// metadata.
+type Change struct {
+	Old *extractor.Inventory
+	New *extractor.Inventory
+}
+
+// Empty returns true if the scans found the same inventory.
+func (d *Diff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Compare returns the differences between the inventory found by an earlier
+// scan and that found by a later one. Inventory is identified by its
+// extractor, name and locations, so e.g. a package upgraded in a lockfile
+// counts as changed while a package moved to another file counts as removed
+// and added.
+func Compare(before, after []*extractor.Inventory) *Diff {
+	d := &Diff{}
+
+	// Inventory that's identical in both scans isn't reported.
+	unmatched := make(map[string][]*extractor.Inventory)
+	for _, i := range before {
+		k := key(i)
+		unmatched[k] = append(unmatched[k], i)
+	}
+	var remaining []*extractor.Inventory
+	for _, i := range after {
+		k := key(i)
+		if idx := indexOfEqual(unmatched[k], i); idx >= 0 {
+			unmatched[k] = append(unmatched[k][:idx], unmatched[k][idx+1:]...)
+			continue
+		}
+		remaining = append(remaining, i)
+	}
+
+	// The rest of the later inventory either replaced an earlier one with the
+	// same identity or was added.
+	for _, i := range remaining {
+		k := key(i)
+		if len(unmatched[k]) == 0 {
+			d.Added = append(d.Added, i)
+			continue
+		}
+		d.Changed = append(d.Changed, &Change{Old: unmatched[k][0], New: i})
+		unmatched[k] = unmatched[k][1:]
+	}
+
+	// Keep the order of the earlier scan for the removed inventory.
+	for _, i := range before {
+		k := key(i)
+		if idx := indexOfSame(unmatched[k], i); idx >= 0 {
+			d.Removed = append(d.Removed, i)
+			unmatched[k] = append(unmatched[k][:idx], unmatched[k][idx+1:]...)
+		}
+	}
+	return d
+}
+
+// key identifies an inventory across scans.
+func key(i *extractor.Inventory) string {
+	ex := ""
+	if i.Extractor != nil {
+		ex = i.Extractor.Name()
+	}
+	return strings.Join(append([]string{ex, i.Name}, i.LocationPaths()...), "\x00")
+}
+
+// indexOfEqual returns the index of the inventory in inv that's equal to i,
+// or -1 if there is none.
+func indexOfEqual(inv []*extractor.Inventory, i *extractor.Inventory) int {
+	for idx, o := range inv {
+		if o.SameIdentity(i) {
+			return idx
+		}
+	}
+	return -1
+}
+
+// indexOfSame returns the index of i in inv, or -1 if it isn't in it.
+func indexOfSame(inv []*extractor.Inventory, i *extractor.Inventory) int {
+	for idx, o := range inv {
+		if o == i {
+			return idx
+		}
+	}
+	return -1
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rescan_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/rescan"
+)
+
+func inv(name, version, path string) *extractor.Inventory {
+	return &extractor.Inventory{Name: name, Version: version, Locations: extractor.LocationsFromPaths(path)}
+}
+
+func TestCompare(t *testing.T) {
+	lodash := inv("lodash", "4.17.20", "package-lock.json")
+	lodashUpgraded := inv("lodash", "4.17.21", "package-lock.json")
+	lodashMoved := inv("lodash", "4.17.20", "sub/package-lock.json")
+	express := inv("express", "4.18.2", "package-lock.json")
+	debug2 := inv("debug", "2.6.9", "package-lock.json")
+	debug4 := inv("debug", "4.3.4", "package-lock.json")
+	debug4Upgraded := inv("debug", "4.3.5", "package-lock.json")
+
+	tests := []struct {
+		desc   string
+		before []*extractor.Inventory
+		after  []*extractor.Inventory
+		want   *rescan.Diff
+	}{
+		{
+			desc:   "no changes",
+			before: []*extractor.Inventory{lodash, express},
+			after:  []*extractor.Inventory{inv("express", "4.18.2", "package-lock.json"), inv("lodash", "4.17.20", "package-lock.json")},
+			want:   &rescan.Diff{},
+		},
+		{
+			desc:  "first scan",
+			after: []*extractor.Inventory{lodash, express},
+			want:  &rescan.Diff{Added: []*extractor.Inventory{lodash, express}},
+		},
+		{
+			desc:   "added and removed",
+			before: []*extractor.Inventory{lodash},
+			after:  []*extractor.Inventory{express},
+			want: &rescan.Diff{
+				Added:   []*extractor.Inventory{express},
+				Removed: []*extractor.Inventory{lodash},
+			},
+		},
+		{
+			desc:   "upgraded",
+			before: []*extractor.Inventory{lodash, express},
+			after:  []*extractor.Inventory{lodashUpgraded, express},
+			want:   &rescan.Diff{Changed: []*rescan.Change{{Old: lodash, New: lodashUpgraded}}},
+		},
+		{
+			desc:   "metadata changed",
+			before: []*extractor.Inventory{{Name: "lodash", Version: "1", Metadata: &struct{ Hash string }{"a"}}},
+			after:  []*extractor.Inventory{{Name: "lodash", Version: "1", Metadata: &struct{ Hash string }{"b"}}},
+			want: &rescan.Diff{Changed: []*rescan.Change{{
+				Old: &extractor.Inventory{Name: "lodash", Version: "1", Metadata: &struct{ Hash string }{"a"}},
+				New: &extractor.Inventory{Name: "lodash", Version: "1", Metadata: &struct{ Hash string }{"b"}},
+			}}},
+		},
+		{
+			desc:   "moved to another file",
+			before: []*extractor.Inventory{lodash},
+			after:  []*extractor.Inventory{lodashMoved},
+			want: &rescan.Diff{
+				Added:   []*extractor.Inventory{lodashMoved},
+				Removed: []*extractor.Inventory{lodash},
+			},
+		},
+		{
+			desc:   "several versions in the same file",
+			before: []*extractor.Inventory{debug2, debug4},
+			after:  []*extractor.Inventory{debug2, debug4Upgraded},
+			want:   &rescan.Diff{Changed: []*rescan.Change{{Old: debug4, New: debug4Upgraded}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got := rescan.Compare(tt.before, tt.after)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Compare() returned unexpected diff (-want +got):\n%s", diff)
+			}
+			if got.Empty() != (len(tt.want.Added)+len(tt.want.Removed)+len(tt.want.Changed) == 0) {
+				t.Errorf("Compare().Empty() = %t for %+v", got.Empty(), got)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rescan re-runs a scan periodically and reports how the found
+// inventory changed since the previous run, for environments where filesystem
+// notifications aren't available.
+package rescan
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	scalibr "github.com/google/osv-scalibr"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/log"
+	"github.com/google/osv-scalibr/plugin"
+)
+
+// Config is the configuration for the Scheduler.
+type Config struct {
+	// ScanConfig is the config of each scan. Its Cache is replaced by the
+	// scheduler's own.
+	ScanConfig *scalibr.ScanConfig
+	// Interval is the time between the end of a scan and the start of the next.
+	Interval time.Duration
+}
+
+// Result is the result of a single scan run by the scheduler.
+type Result struct {
+	Scan *scalibr.ScanResult
+	// Diff is the change in inventory since the previous complete scan. The
+	// first scan reports all of its inventory as added. Nil if the scan failed
+	// or timed out.
+	Diff *Diff
+}
+
+// Scheduler re-runs a scan at a fixed interval. Files that didn't change
+// between scans are not parsed again.
+type Scheduler struct {
+	scanConfig scalibr.ScanConfig
+	interval   time.Duration
+	previous   []*extractor.Inventory
+}
+
+// New returns a scheduler for the given config.
+func New(cfg Config) (*Scheduler, error) {
+	if cfg.ScanConfig == nil {
+		return nil, errors.New("no scan config specified")
+	}
+	if cfg.Interval <= 0 {
+		return nil, errors.New("the rescan interval must be positive")
+	}
+	s := &Scheduler{
+		scanConfig: *cfg.ScanConfig,
+		interval:   cfg.Interval,
+	}
+	s.scanConfig.Cache = filesystem.NewCache()
+	return s, nil
+}
+
+// Run scans right away and then every interval until ctx is cancelled, passing
+// the result of each scan to fn. It returns the context's error.
+func (s *Scheduler) Run(ctx context.Context, fn func(*Result)) error {
+	for {
+		fn(s.RunOnce(ctx))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.interval):
+		}
+	}
+}
+
+// RunOnce runs a single scan and compares its inventory with that of the
+// previous complete scan.
+func (s *Scheduler) RunOnce(ctx context.Context) *Result {
+	sr := scalibr.New().Scan(ctx, &s.scanConfig)
+	if sr.Status.Status == plugin.ScanStatusFailed || sr.TimedOut {
+		// Comparing incomplete results would report missing inventory as removed.
+		log.Warnf("Rescan didn't complete, keeping the inventory of the previous scan: %s", sr.Status.FailureReason)
+		return &Result{Scan: sr}
+	}
+
+	d := Compare(s.previous, sr.Inventories)
+	s.previous = sr.Inventories
+	return &Result{Scan: sr, Diff: d}
+}
+
+// CacheHits returns the number of times a file's inventory was reused from an
+// earlier scan instead of being extracted again.
+func (s *Scheduler) CacheHits() int {
+	return s.scanConfig.Cache.Hits()
+}
@@ -0,0 +1,220 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rescan_test
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	scalibr "github.com/google/osv-scalibr"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/rescan"
+)
+
+// versionFileExtractor extracts a package named after each .version file,
+// with the file's content as its version.
+type versionFileExtractor struct {
+	extractCalls int
+}
+
+func (e *versionFileExtractor) Name() string                            { return "version-file" }
+func (e *versionFileExtractor) Version() int                            { return 0 }
+func (e *versionFileExtractor) Requirements() *plugin.Capabilities      { return &plugin.Capabilities{} }
+func (e *versionFileExtractor) Ecosystem(i *extractor.Inventory) string { return "" }
+func (e *versionFileExtractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{Type: purl.TypeGeneric, Name: i.Name, Version: i.Version}
+}
+
+func (e *versionFileExtractor) FileRequired(path string, _ fs.FileInfo) bool {
+	return strings.HasSuffix(path, ".version")
+}
+
+func (e *versionFileExtractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	e.extractCalls++
+	content, err := io.ReadAll(input.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return []*extractor.Inventory{{
+		Name:      strings.TrimSuffix(filepath.Base(input.Path), ".version"),
+		Version:   strings.TrimSpace(string(content)),
+		Locations: extractor.LocationsFromPaths(input.Path),
+	}}, nil
+}
+
+// writeFile writes the file with a modification time that differs from that
+// of earlier writes even on filesystems with a coarse timestamp resolution.
+func writeFile(t *testing.T, path, content string, modTime time.Time) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("os.WriteFile(%s): %v", path, err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("os.Chtimes(%s): %v", path, err)
+	}
+}
+
+type entry struct {
+	Name    string
+	Version string
+}
+
+func entries(inv []*extractor.Inventory) []entry {
+	var res []entry
+	for _, i := range inv {
+		res = append(res, entry{i.Name, i.Version})
+	}
+	return res
+}
+
+func TestRunOnce(t *testing.T) {
+	dir := t.TempDir()
+	modTime := time.Now().Add(-time.Hour)
+	writeFile(t, filepath.Join(dir, "lodash.version"), "4.17.20", modTime)
+	writeFile(t, filepath.Join(dir, "express.version"), "4.18.2", modTime)
+
+	ex := &versionFileExtractor{}
+	s, err := rescan.New(rescan.Config{
+		ScanConfig: &scalibr.ScanConfig{
+			FilesystemExtractors: []filesystem.Extractor{ex},
+			ScanRoots:            []*scalibrfs.ScanRoot{{FS: scalibrfs.DirFS(dir), Path: dir}},
+		},
+		Interval: time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("rescan.New(): %v", err)
+	}
+
+	first := s.RunOnce(context.Background())
+	if first.Scan.Status.Status != plugin.ScanStatusSucceeded {
+		t.Fatalf("RunOnce(): %v", first.Scan.Status)
+	}
+	wantAdded := []entry{{"express", "4.18.2"}, {"lodash", "4.17.20"}}
+	if diff := cmp.Diff(wantAdded, entries(first.Diff.Added)); diff != "" {
+		t.Errorf("RunOnce() first scan added (-want +got):\n%s", diff)
+	}
+
+	// Upgrade lodash and add another package.
+	writeFile(t, filepath.Join(dir, "lodash.version"), "4.17.21", modTime.Add(time.Minute))
+	writeFile(t, filepath.Join(dir, "debug.version"), "4.3.4", modTime)
+	second := s.RunOnce(context.Background())
+
+	if diff := cmp.Diff([]entry{{"debug", "4.3.4"}}, entries(second.Diff.Added)); diff != "" {
+		t.Errorf("RunOnce() second scan added (-want +got):\n%s", diff)
+	}
+	if len(second.Diff.Removed) != 0 {
+		t.Errorf("RunOnce() second scan removed %v, want nothing", entries(second.Diff.Removed))
+	}
+	if len(second.Diff.Changed) != 1 {
+		t.Fatalf("RunOnce() second scan changed %d inventories, want 1", len(second.Diff.Changed))
+	}
+	change := second.Diff.Changed[0]
+	gotChange := []entry{{change.Old.Name, change.Old.Version}, {change.New.Name, change.New.Version}}
+	wantChange := []entry{{"lodash", "4.17.20"}, {"lodash", "4.17.21"}}
+	if diff := cmp.Diff(wantChange, gotChange); diff != "" {
+		t.Errorf("RunOnce() second scan change (-want +got):\n%s", diff)
+	}
+
+	// express.version was unchanged and is served from the cache, only the
+	// changed and new files are parsed again.
+	if ex.extractCalls != 4 {
+		t.Errorf("Extract() called %d times, want 4", ex.extractCalls)
+	}
+	if s.CacheHits() != 1 {
+		t.Errorf("CacheHits() = %d, want 1", s.CacheHits())
+	}
+	wantInv := []entry{{"debug", "4.3.4"}, {"express", "4.18.2"}, {"lodash", "4.17.21"}}
+	if diff := cmp.Diff(wantInv, entries(second.Scan.Inventories)); diff != "" {
+		t.Errorf("RunOnce() second scan inventory (-want +got):\n%s", diff)
+	}
+}
+
+func TestRunOnce_FailedScanKeepsPrevious(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "lodash.version"), "4.17.20", time.Now())
+	s, err := rescan.New(rescan.Config{
+		ScanConfig: &scalibr.ScanConfig{
+			FilesystemExtractors: []filesystem.Extractor{&versionFileExtractor{}},
+			ScanRoots:            []*scalibrfs.ScanRoot{{FS: scalibrfs.DirFS(dir), Path: dir}},
+		},
+		Interval: time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("rescan.New(): %v", err)
+	}
+	s.RunOnce(context.Background())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if got := s.RunOnce(ctx); got.Diff != nil {
+		t.Errorf("RunOnce() with a cancelled context returned diff %+v, want nil", got.Diff)
+	}
+
+	if got := s.RunOnce(context.Background()); !got.Diff.Empty() {
+		t.Errorf("RunOnce() after a failed scan returned diff %+v, want empty", got.Diff)
+	}
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "lodash.version"), "4.17.20", time.Now())
+	s, err := rescan.New(rescan.Config{
+		ScanConfig: &scalibr.ScanConfig{
+			FilesystemExtractors: []filesystem.Extractor{&versionFileExtractor{}},
+			ScanRoots:            []*scalibrfs.ScanRoot{{FS: scalibrfs.DirFS(dir), Path: dir}},
+		},
+		Interval: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("rescan.New(): %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runs := 0
+	err = s.Run(ctx, func(*rescan.Result) {
+		runs++
+		if runs == 3 {
+			cancel()
+		}
+	})
+	if err != context.Canceled {
+		t.Errorf("Run() returned %v, want %v", err, context.Canceled)
+	}
+	if runs != 3 {
+		t.Errorf("Run() scanned %d times, want 3", runs)
+	}
+}
+
+func TestNew_InvalidConfig(t *testing.T) {
+	for _, cfg := range []rescan.Config{
+		{Interval: time.Minute},
+		{ScanConfig: &scalibr.ScanConfig{}},
+	} {
+		if _, err := rescan.New(cfg); err == nil {
+			t.Errorf("rescan.New(%+v) succeeded, want error", cfg)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sbomdiff compares the packages found by two scans, e.g. of a
+// repository before and after a change or of two releases.
+package sbomdiff
+
+import (
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+)
+
+// InventoryDelta describes a package that differs between two scans.
+type InventoryDelta struct {
+	// Old is the package as found by the earlier scan, nil if it was added.
+	Old *extractor.Inventory
+	// New is the package as found by the later scan, nil if it was removed.
+	New *extractor.Inventory
+}
+
+// Diff returns the packages that were added, removed or changed to another
+// version between an earlier and a later scan. Packages are identified like
+// Inventory.Key does, but without their version: by their PURL if the
+// extractor creates one, and by their ecosystem and name otherwise. Unlike
+// rescan.Compare, where packages are found doesn't matter, so a package moved
+// to another file is unchanged.
+//
+// If a package is found several times, copies at the same version are
+// matched first and the remaining ones are paired up in order as version
+// changes. Added and changed packages are in the order of the later scan,
+// removed ones in the order of the earlier scan.
+func Diff(old, new []*extractor.Inventory) (added, removed, changed []*InventoryDelta) {
+	unmatched := make(map[string][]*extractor.Inventory)
+	for _, i := range old {
+		k := packageKey(i)
+		unmatched[k] = append(unmatched[k], i)
+	}
+
+	// Packages found at the same version by both scans aren't reported.
+	var remaining []*extractor.Inventory
+	for _, i := range new {
+		k := packageKey(i)
+		if idx := indexOfVersion(unmatched[k], i.Version); idx >= 0 {
+			unmatched[k] = append(unmatched[k][:idx], unmatched[k][idx+1:]...)
+			continue
+		}
+		remaining = append(remaining, i)
+	}
+
+	for _, i := range remaining {
+		k := packageKey(i)
+		if len(unmatched[k]) == 0 {
+			added = append(added, &InventoryDelta{New: i})
+			continue
+		}
+		changed = append(changed, &InventoryDelta{Old: unmatched[k][0], New: i})
+		unmatched[k] = unmatched[k][1:]
+	}
+
+	for _, i := range old {
+		k := packageKey(i)
+		if idx := indexOfSame(unmatched[k], i); idx >= 0 {
+			removed = append(removed, &InventoryDelta{Old: i})
+			unmatched[k] = append(unmatched[k][:idx], unmatched[k][idx+1:]...)
+		}
+	}
+	return added, removed, changed
+}
+
+// packageKey identifies a package regardless of its version.
+func packageKey(i *extractor.Inventory) string {
+	if i.Extractor != nil {
+		if p := i.Extractor.ToPURL(i); p != nil {
+			p.Version = ""
+			return p.String()
+		}
+	}
+	return strings.Join([]string{i.EffectiveEcosystem(), i.Name}, "\x00")
+}
+
+// indexOfVersion returns the index of the inventory in inv at the given
+// version, or -1 if there is none.
+func indexOfVersion(inv []*extractor.Inventory, version string) int {
+	for idx, i := range inv {
+		if i.Version == version {
+			return idx
+		}
+	}
+	return -1
+}
+
+// indexOfSame returns the index of i in inv, or -1 if it isn't in it.
+func indexOfSame(inv []*extractor.Inventory, i *extractor.Inventory) int {
+	for idx, o := range inv {
+		if o == i {
+			return idx
+		}
+	}
+	return -1
+}
@@ -0,0 +1,135 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbomdiff_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/sbomdiff"
+	"github.com/google/osv-scalibr/testing/fakeextractor"
+)
+
+func inv(name, version, path string) *extractor.Inventory {
+	return &extractor.Inventory{Name: name, Version: version, Ecosystem: "npm", Locations: extractor.LocationsFromPaths(path)}
+}
+
+func TestDiff(t *testing.T) {
+	lodash := inv("lodash", "4.17.20", "package-lock.json")
+	lodashUpgraded := inv("lodash", "4.17.21", "package-lock.json")
+	lodashMoved := inv("lodash", "4.17.20", "sub/package-lock.json")
+	express := inv("express", "4.18.2", "package-lock.json")
+	expressDowngraded := inv("express", "4.17.1", "package-lock.json")
+	debug2 := inv("debug", "2.6.9", "package-lock.json")
+	debug4 := inv("debug", "4.3.4", "a/package-lock.json")
+	debug4Upgraded := inv("debug", "4.3.5", "a/package-lock.json")
+	pypiLodash := &extractor.Inventory{Name: "lodash", Version: "4.17.20", Ecosystem: "PyPI"}
+
+	tests := []struct {
+		desc        string
+		old         []*extractor.Inventory
+		new         []*extractor.Inventory
+		wantAdded   []*sbomdiff.InventoryDelta
+		wantRemoved []*sbomdiff.InventoryDelta
+		wantChanged []*sbomdiff.InventoryDelta
+	}{
+		{
+			desc: "no changes",
+			old:  []*extractor.Inventory{lodash, express},
+			new:  []*extractor.Inventory{inv("express", "4.18.2", "package-lock.json"), inv("lodash", "4.17.20", "package-lock.json")},
+		},
+		{
+			desc:      "added",
+			old:       []*extractor.Inventory{lodash},
+			new:       []*extractor.Inventory{lodash, express},
+			wantAdded: []*sbomdiff.InventoryDelta{{New: express}},
+		},
+		{
+			desc:        "removed",
+			old:         []*extractor.Inventory{lodash, express},
+			new:         []*extractor.Inventory{express},
+			wantRemoved: []*sbomdiff.InventoryDelta{{Old: lodash}},
+		},
+		{
+			desc: "version bumps",
+			old:  []*extractor.Inventory{lodash, express},
+			new:  []*extractor.Inventory{expressDowngraded, lodashUpgraded},
+			wantChanged: []*sbomdiff.InventoryDelta{
+				{Old: express, New: expressDowngraded},
+				{Old: lodash, New: lodashUpgraded},
+			},
+		},
+		{
+			desc: "moved to another file",
+			old:  []*extractor.Inventory{lodash},
+			new:  []*extractor.Inventory{lodashMoved},
+		},
+		{
+			desc:        "same name in other ecosystem",
+			old:         []*extractor.Inventory{lodash},
+			new:         []*extractor.Inventory{pypiLodash},
+			wantAdded:   []*sbomdiff.InventoryDelta{{New: pypiLodash}},
+			wantRemoved: []*sbomdiff.InventoryDelta{{Old: lodash}},
+		},
+		{
+			desc:        "several versions of a package",
+			old:         []*extractor.Inventory{debug2, debug4},
+			new:         []*extractor.Inventory{debug4Upgraded, inv("debug", "2.6.9", "package-lock.json")},
+			wantChanged: []*sbomdiff.InventoryDelta{{Old: debug4, New: debug4Upgraded}},
+		},
+		{
+			desc:        "copy of a package removed",
+			old:         []*extractor.Inventory{debug4, debug4Upgraded, inv("debug", "4.3.4", "b/package-lock.json")},
+			new:         []*extractor.Inventory{debug4Upgraded, debug4},
+			wantRemoved: []*sbomdiff.InventoryDelta{{Old: inv("debug", "4.3.4", "b/package-lock.json")}},
+		},
+		{
+			desc:      "first scan",
+			new:       []*extractor.Inventory{lodash, express},
+			wantAdded: []*sbomdiff.InventoryDelta{{New: lodash}, {New: express}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			added, removed, changed := sbomdiff.Diff(tt.old, tt.new)
+			if diff := cmp.Diff(tt.wantAdded, added); diff != "" {
+				t.Errorf("Diff() added (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantRemoved, removed); diff != "" {
+				t.Errorf("Diff() removed (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantChanged, changed); diff != "" {
+				t.Errorf("Diff() changed (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffByPURL(t *testing.T) {
+	// Inventory with a PURL is identified by it rather than by its ecosystem.
+	fake := fakeextractor.New("fake", 1, nil, nil)
+	old := &extractor.Inventory{Name: "django", Version: "4.2.0", Ecosystem: "PyPI", Extractor: fake}
+	new := &extractor.Inventory{Name: "django", Version: "4.2.1", Extractor: fake}
+
+	added, removed, changed := sbomdiff.Diff([]*extractor.Inventory{old}, []*extractor.Inventory{new})
+	if len(added) != 0 || len(removed) != 0 {
+		t.Errorf("Diff() added %v and removed %v, want neither", added, removed)
+	}
+	if len(changed) != 1 || changed[0].Old != old || changed[0].New != new {
+		t.Errorf("Diff() changed %v, want %v -> %v", changed, old, new)
+	}
+}
@@ -23,7 +23,6 @@ import (
 	"fmt"
 	"regexp"
 	"slices"
-	"sort"
 	"time"
 
 	"github.com/google/osv-scalibr/detector"
@@ -33,12 +32,17 @@ import (
 	scalibrfs "github.com/google/osv-scalibr/fs"
 	"github.com/google/osv-scalibr/inventoryindex"
 	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/profile"
 	"github.com/google/osv-scalibr/stats"
 
 	el "github.com/google/osv-scalibr/extractor/filesystem/list"
 	sl "github.com/google/osv-scalibr/extractor/standalone/list"
 )
 
+// ScannerVersion is the version of the SCALIBR library, recorded in the
+// results of each scan.
+const ScannerVersion = "0.1.0"
+
 var (
 	errNoScanRoot            = fmt.Errorf("no scan root specified")
 	errFilesWithSeveralRoots = fmt.Errorf("can't extract specific files with several scan roots")
@@ -53,9 +57,20 @@ func New() *Scanner { return &Scanner{} }
 // ScanConfig stores the config settings of a scan run such as the plugins to
 // use and the dir to consider the root of the scanned system.
 type ScanConfig struct {
+	// The extractors to run. They're used as given, so embedders can pass their
+	// own extractors here without registering them in extractor/filesystem/list
+	// or extractor/standalone/list. The lists are only consulted for extractors
+	// that enabled detectors require and that aren't given here: an extractor
+	// given here takes precedence over a listed extractor with the same name.
 	FilesystemExtractors []filesystem.Extractor
 	StandaloneExtractors []standalone.Extractor
 	Detectors            []detector.Detector
+	// Optional: Names of the profiles to apply, e.g. "containers" or "source",
+	// see the profile package. Their extractors are enabled in addition to the
+	// ones given above and their limits are used for the limits that aren't set
+	// in this config. Several profiles are combined, with the limits of later
+	// ones taking precedence.
+	Profiles []string
 	// Capabilities that the scanning environment satisfies, e.g. whether there's
 	// network access. Some plugins can only run if certain requirements are met.
 	Capabilities *plugin.Capabilities
@@ -76,21 +91,124 @@ type ScanConfig struct {
 	DirsToSkip []string
 	// Optional: If the regex matches a directory, it will be skipped.
 	SkipDirRegex *regexp.Regexp
+	// Optional: If true, files and directories matching the gitignore-style
+	// patterns of .scalibrignore files are skipped. An ignore file applies to
+	// the directory it's in and everything below it.
+	UseIgnoreFiles bool
 	// Optional: stats allows to enter a metric hook. If left nil, no metrics will be recorded.
 	Stats stats.Collector
 	// Optional: Whether to read symlinks.
 	ReadSymlinks bool
 	// Optional: Limit for visited inodes. If 0, no limit is applied.
 	MaxInodes int
+	// Optional: Limit for how deep the walk descends below the scan root. Files
+	// directly in the scan root are at depth 1, files in its subdirectories at
+	// depth 2 and so on. If 0, no limit is applied.
+	MaxDepth int
+	// Optional: Limit for the inventory extracted from a single file. If 0, no
+	// limit is applied.
+	MaxInventoryPerFile int
+	// Optional: Limit for the size of each inventory's metadata in its JSON
+	// encoding. Larger metadata is shortened or dropped and the inventory is
+	// annotated with extractor.MetadataTruncated. If 0, no limit is applied.
+	MaxMetadataBytes int
+	// Optional: Limits for following the files referenced by extracted files,
+	// e.g. `-r` lines of requirements.txt files. Extractors return the
+	// inventory found up to the limit along with a
+	// filesystem.IncludeLimitError. If unset, no limits are applied.
+	IncludeLimits filesystem.IncludeLimits
+	// Optional: Cache of the inventory extracted by previous scans. Files that
+	// haven't changed since are not extracted again.
+	Cache *filesystem.Cache
+	// Optional: If true, filesystem extractors that declare file hints are
+	// only asked about files matching them, which speeds up walks with many
+	// extractors.
+	UseFileHints bool
+	// Optional: If true, image, video and audio files are passed to the
+	// filesystem extractors too instead of being skipped by their extension
+	// and magic bytes.
+	DisableMediaFileSkipping bool
+	// Optional: If true, the filesystem walk stays on the device of each scan
+	// root and doesn't descend into other filesystems mounted below it, e.g.
+	// when scanning a mounted snapshot. Only supported on Unix.
+	OneFileSystem bool
+	// Optional: If true, /dev, /proc and /sys directly in a scan root are
+	// skipped so that scans of root filesystems don't read kernel interfaces.
+	SkipPseudoFilesystems bool
+	// Optional: If true, packages that are declared by a manifest or lockfile
+	// and installed at a different version are linked to each other with a
+	// discrepancy relationship, which helps detecting drift. Both inventories
+	// are kept in the results.
+	LinkDiscrepancies bool
+	// Optional: Limit for the wall-clock duration of the scan. Once it's
+	// exceeded, running extractors are cancelled and the scan returns the
+	// inventory found so far with TimedOut set. If 0, no limit is applied.
+	Timeout time.Duration
 	// Optional: By default, inventories stores a path relative to the scan root. If StoreAbsolutePath
 	// is set, the absolute path is stored instead.
 	StoreAbsolutePath bool
 	// Optional: If true, print a detailed analysis of the duration of each extractor.
 	PrintDurationAnalysis bool
+	// Optional: PURL types (e.g. "deb" or "npm") of the inventory to report. If
+	// set, inventory of other types is dropped from the scan result. Detectors
+	// still get to see all extracted inventory.
+	IncludeTypes []string
+	// Optional: PURL types of the inventory to drop from the scan result.
+	// Applied after IncludeTypes.
+	ExcludeTypes []string
+	// Optional: Whether to drop inventory without a PURL from the scan result
+	// if IncludeTypes or ExcludeTypes are set. By default it's kept.
+	DropInventoryWithoutPURL bool
+	// Optional: Minimum confidence of the inventory to report, from 1 to 100.
+	// Inventory that heuristic extractors found with a lower
+	// extractor.Inventory.Confidence is dropped before the detectors run.
+	// Inventory without a confidence counts as extractor.ConfidenceMax. If 0,
+	// no inventory is dropped.
+	MinConfidence extractor.Confidence
+	// Optional: If true, inventories of the same package that an extractor
+	// found in several files, e.g. lockfiles of different projects, are merged
+	// into one item listing all their Locations, see extractor.MergeLocations.
+	// By default each file's inventory is reported as a separate item, which
+	// attributes packages to the projects declaring them.
+	MergeLocations bool
+}
+
+// ApplyProfiles adds the extractors and limits of the config's Profiles to the
+// config. Extractors and limits set explicitly in the config are kept.
+func (cfg *ScanConfig) ApplyProfiles() error {
+	if len(cfg.Profiles) == 0 {
+		return nil
+	}
+	p, err := profile.FromNames(cfg.Profiles)
+	if err != nil {
+		return err
+	}
+	exs, err := el.ExtractorsFromNames(p.Extractors)
+	if err != nil {
+		return fmt.Errorf("profile %q: %w", p.Name, err)
+	}
+	enabled := map[string]bool{}
+	for _, e := range cfg.FilesystemExtractors {
+		enabled[e.Name()] = true
+	}
+	for _, e := range exs {
+		if !enabled[e.Name()] {
+			enabled[e.Name()] = true
+			cfg.FilesystemExtractors = append(cfg.FilesystemExtractors, e)
+		}
+	}
+	cfg.MaxInodes = cmp.Or(cfg.MaxInodes, p.MaxInodes)
+	cfg.MaxDepth = cmp.Or(cfg.MaxDepth, p.MaxDepth)
+	cfg.MaxInventoryPerFile = cmp.Or(cfg.MaxInventoryPerFile, p.MaxInventoryPerFile)
+	cfg.MaxMetadataBytes = cmp.Or(cfg.MaxMetadataBytes, p.MaxMetadataBytes)
+	cfg.UseIgnoreFiles = cfg.UseIgnoreFiles || p.UseIgnoreFiles
+	return nil
 }
 
 // EnableRequiredExtractors adds those extractors to the config that are required by enabled
-// detectors but have not been explicitly enabled.
+// detectors but have not been explicitly enabled. They're looked up by name in
+// the extractor lists. Explicitly enabled extractors are kept as they are, even
+// if a listed extractor has the same name.
 func (cfg *ScanConfig) EnableRequiredExtractors() error {
 	enabledExtractors := map[string]struct{}{}
 	for _, e := range cfg.FilesystemExtractors {
@@ -156,6 +274,27 @@ type ScanResult struct {
 	PluginStatus []*plugin.Status
 	Inventories  []*extractor.Inventory
 	Findings     []*detector.Finding
+	// TimedOut is true if the scan exceeded ScanConfig.Timeout, in which case
+	// the results are incomplete.
+	TimedOut bool
+	// Provenance describes how the scan was run. Converters include it in
+	// their outputs so that shared results can be audited.
+	Provenance *Provenance
+}
+
+// Provenance describes the scanner and configuration that produced a scan
+// result.
+type Provenance struct {
+	// ScannerVersion is the version of SCALIBR that ran the scan.
+	ScannerVersion string
+	StartTime      time.Time
+	// ScanRoots are the paths of the scanned directories. Virtual scan roots
+	// without a path are left out.
+	ScanRoots []string
+	// Extractors and Detectors are the sorted names of the enabled plugins,
+	// including the extractors required by the enabled detectors.
+	Extractors []string
+	Detectors  []string
 }
 
 // LINT.ThenChange(/binary/proto/scan_result.proto)
@@ -173,7 +312,9 @@ func (Scanner) Scan(ctx context.Context, config *ScanConfig) (sr *ScanResult) {
 		Inventories: []*extractor.Inventory{},
 		Findings:    []*detector.Finding{},
 	}
-	if err := config.EnableRequiredExtractors(); err != nil {
+	if err := config.ApplyProfiles(); err != nil {
+		sro.Err = err
+	} else if err := config.EnableRequiredExtractors(); err != nil {
 		sro.Err = err
 	} else if err := config.ValidatePluginRequirements(); err != nil {
 		sro.Err = err
@@ -182,23 +323,51 @@ func (Scanner) Scan(ctx context.Context, config *ScanConfig) (sr *ScanResult) {
 	} else if len(config.FilesToExtract) > 0 && len(config.ScanRoots) > 1 {
 		sro.Err = errFilesWithSeveralRoots
 	}
+	sro.Provenance = newProvenance(config, sro.StartTime)
 	if sro.Err != nil {
 		sro.EndTime = time.Now()
 		return newScanResult(sro)
 	}
+	parentCtx := ctx
+	if config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, config.Timeout)
+		defer cancel()
+	}
+	// timedOut returns true if the scan's own timeout rather than the caller
+	// cancelled the scan.
+	timedOut := func() bool {
+		return config.Timeout > 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) && parentCtx.Err() == nil
+	}
+
 	extractorConfig := &filesystem.Config{
-		Stats:                 config.Stats,
-		ReadSymlinks:          config.ReadSymlinks,
-		Extractors:            config.FilesystemExtractors,
-		FilesToExtract:        config.FilesToExtract,
-		DirsToSkip:            config.DirsToSkip,
-		SkipDirRegex:          config.SkipDirRegex,
-		ScanRoots:             config.ScanRoots,
-		MaxInodes:             config.MaxInodes,
-		StoreAbsolutePath:     config.StoreAbsolutePath,
-		PrintDurationAnalysis: config.PrintDurationAnalysis,
+		Stats:                    config.Stats,
+		ReadSymlinks:             config.ReadSymlinks,
+		Extractors:               config.FilesystemExtractors,
+		FilesToExtract:           config.FilesToExtract,
+		DirsToSkip:               config.DirsToSkip,
+		SkipDirRegex:             config.SkipDirRegex,
+		UseIgnoreFiles:           config.UseIgnoreFiles,
+		ScanRoots:                config.ScanRoots,
+		MaxInodes:                config.MaxInodes,
+		MaxDepth:                 config.MaxDepth,
+		MaxInventoryPerFile:      config.MaxInventoryPerFile,
+		MaxMetadataBytes:         config.MaxMetadataBytes,
+		IncludeLimits:            config.IncludeLimits,
+		StoreAbsolutePath:        config.StoreAbsolutePath,
+		PrintDurationAnalysis:    config.PrintDurationAnalysis,
+		Cache:                    config.Cache,
+		UseFileHints:             config.UseFileHints,
+		DisableMediaFileSkipping: config.DisableMediaFileSkipping,
+		OneFileSystem:            config.OneFileSystem,
+		SkipPseudoFilesystems:    config.SkipPseudoFilesystems,
 	}
 	inventories, extractorStatus, err := filesystem.Run(ctx, extractorConfig)
+	if timedOut() && inventories != nil {
+		sro.Inventories = filterByPURLType(mergeLocations(filterByConfidence(inventories, config), config), config)
+		sro.ExtractorStatus = extractorStatus
+		return newTimedOutScanResult(sro, config.Timeout)
+	}
 	if err != nil {
 		sro.Err = err
 		sro.EndTime = time.Now()
@@ -213,14 +382,22 @@ func (Scanner) Scan(ctx context.Context, config *ScanConfig) (sr *ScanResult) {
 		ScanRoot:   &scalibrfs.ScanRoot{FS: sysroot.FS, Path: sysroot.Path},
 	}
 	standaloneInv, standaloneStatus, err := standalone.Run(ctx, standaloneCfg)
+	if timedOut() {
+		sro.Inventories = filterByPURLType(mergeLocations(filterByConfidence(append(sro.Inventories, standaloneInv...), config), config), config)
+		sro.ExtractorStatus = append(sro.ExtractorStatus, standaloneStatus...)
+		return newTimedOutScanResult(sro, config.Timeout)
+	}
 	if err != nil {
 		sro.Err = err
 		sro.EndTime = time.Now()
 		return newScanResult(sro)
 	}
 
-	sro.Inventories = append(sro.Inventories, standaloneInv...)
+	sro.Inventories = mergeLocations(filterByConfidence(append(sro.Inventories, standaloneInv...), config), config)
 	sro.ExtractorStatus = append(sro.ExtractorStatus, standaloneStatus...)
+	if config.LinkDiscrepancies {
+		extractor.LinkDiscrepancies(sro.Inventories)
+	}
 
 	ix, err := inventoryindex.New(sro.Inventories)
 	if err != nil {
@@ -234,6 +411,10 @@ func (Scanner) Scan(ctx context.Context, config *ScanConfig) (sr *ScanResult) {
 	)
 	sro.Findings = findings
 	sro.DetectorStatus = detectorStatus
+	sro.Inventories = filterByPURLType(sro.Inventories, config)
+	if timedOut() {
+		return newTimedOutScanResult(sro, config.Timeout)
+	}
 	if err != nil {
 		sro.Err = err
 	}
@@ -242,6 +423,85 @@ func (Scanner) Scan(ctx context.Context, config *ScanConfig) (sr *ScanResult) {
 	return newScanResult(sro)
 }
 
+// mergeLocations merges the inventories of the same package if the config
+// asks for it.
+func mergeLocations(inv []*extractor.Inventory, config *ScanConfig) []*extractor.Inventory {
+	if !config.MergeLocations {
+		return inv
+	}
+	return extractor.MergeLocations(inv)
+}
+
+// filterByPURLType drops the inventory whose PURL type isn't selected by the
+// IncludeTypes and ExcludeTypes of the config.
+func filterByPURLType(inv []*extractor.Inventory, config *ScanConfig) []*extractor.Inventory {
+	if len(config.IncludeTypes) == 0 && len(config.ExcludeTypes) == 0 {
+		return inv
+	}
+	var res []*extractor.Inventory
+	for _, i := range inv {
+		p := i.Extractor.ToPURL(i)
+		if p == nil {
+			if !config.DropInventoryWithoutPURL {
+				res = append(res, i)
+			}
+			continue
+		}
+		if len(config.IncludeTypes) > 0 && !slices.Contains(config.IncludeTypes, p.Type) {
+			continue
+		}
+		if slices.Contains(config.ExcludeTypes, p.Type) {
+			continue
+		}
+		res = append(res, i)
+	}
+	return res
+}
+
+// filterByConfidence drops the inventory found with less than the
+// MinConfidence of the config.
+func filterByConfidence(inv []*extractor.Inventory, config *ScanConfig) []*extractor.Inventory {
+	if config.MinConfidence <= extractor.ConfidenceUnset {
+		return inv
+	}
+	var res []*extractor.Inventory
+	for _, i := range inv {
+		if i.EffectiveConfidence() >= config.MinConfidence {
+			res = append(res, i)
+		}
+	}
+	return res
+}
+
+// newProvenance returns the provenance of a scan run with the given config.
+func newProvenance(config *ScanConfig, startTime time.Time) *Provenance {
+	p := &Provenance{
+		ScannerVersion: ScannerVersion,
+		StartTime:      startTime,
+		ScanRoots:      []string{},
+		Extractors:     []string{},
+		Detectors:      []string{},
+	}
+	for _, r := range config.ScanRoots {
+		if !r.IsVirtual() {
+			p.ScanRoots = append(p.ScanRoots, r.Path)
+		}
+	}
+	for _, e := range config.FilesystemExtractors {
+		p.Extractors = append(p.Extractors, e.Name())
+	}
+	for _, e := range config.StandaloneExtractors {
+		p.Extractors = append(p.Extractors, e.Name())
+	}
+	for _, d := range config.Detectors {
+		p.Detectors = append(p.Detectors, d.Name())
+	}
+	slices.Sort(p.Extractors)
+	p.Extractors = slices.Compact(p.Extractors)
+	slices.Sort(p.Detectors)
+	return p
+}
+
 type newScanResultOptions struct {
 	StartTime       time.Time
 	EndTime         time.Time
@@ -249,6 +509,7 @@ type newScanResultOptions struct {
 	Inventories     []*extractor.Inventory
 	DetectorStatus  []*plugin.Status
 	Findings        []*detector.Finding
+	Provenance      *Provenance
 	Err             error
 }
 
@@ -261,6 +522,8 @@ func newScanResult(o *newScanResultOptions) *ScanResult {
 		status.Status = plugin.ScanStatusSucceeded
 	}
 	r := &ScanResult{
+		Version:      ScannerVersion,
+		Provenance:   o.Provenance,
 		StartTime:    o.StartTime,
 		EndTime:      o.EndTime,
 		Status:       status,
@@ -274,6 +537,19 @@ func newScanResult(o *newScanResultOptions) *ScanResult {
 	return r
 }
 
+// newTimedOutScanResult returns the partial results of a scan that exceeded
+// its timeout.
+func newTimedOutScanResult(o *newScanResultOptions, timeout time.Duration) *ScanResult {
+	o.EndTime = time.Now()
+	r := newScanResult(o)
+	r.Status = &plugin.ScanStatus{
+		Status:        plugin.ScanStatusPartiallySucceeded,
+		FailureReason: fmt.Sprintf("scan timed out after %s", timeout),
+	}
+	r.TimedOut = true
+	return r
+}
+
 func hasFailedPlugins(statuses []*plugin.Status) bool {
 	for _, s := range statuses {
 		if s.Status.Status != plugin.ScanStatusSucceeded {
@@ -286,7 +562,7 @@ func hasFailedPlugins(statuses []*plugin.Status) bool {
 // sortResults sorts the result to make the output deterministic and diffable.
 func sortResults(results *ScanResult) {
 	for _, inventory := range results.Inventories {
-		sort.Strings(inventory.Locations)
+		slices.SortFunc(inventory.Locations, cmpLocations)
 	}
 
 	slices.SortFunc(results.PluginStatus, cmpStatus)
@@ -300,15 +576,23 @@ func CmpInventories(a, b *extractor.Inventory) int {
 		cmp.Compare(a.Name, b.Name),
 		cmp.Compare(a.Version, b.Version),
 		cmp.Compare(a.Extractor.Name(), b.Extractor.Name()),
+		cmp.Compare(a.ScanRoot, b.ScanRoot),
 	)
 	if res != 0 {
 		return res
 	}
-	aloc := fmt.Sprintf("%v", a.Locations)
-	bloc := fmt.Sprintf("%v", b.Locations)
+	aloc := fmt.Sprintf("%v", a.LocationPaths())
+	bloc := fmt.Sprintf("%v", b.LocationPaths())
 	return cmp.Compare(aloc, bloc)
 }
 
+func cmpLocations(a, b extractor.Location) int {
+	return cmp.Or(
+		cmp.Compare(a.Path, b.Path),
+		cmp.Compare(a.Reason, b.Reason),
+	)
+}
+
 func cmpStatus(a, b *plugin.Status) int {
 	return cmpString(a.Name, b.Name)
 }
@@ -20,7 +20,10 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	scalibr "github.com/google/osv-scalibr"
 
@@ -29,6 +32,10 @@ import (
 	"github.com/google/osv-scalibr/detector"
 	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/cpp/vendoredheaders"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/packageslockjson"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/nodemodules"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/packagelockjson"
 	scalibrfs "github.com/google/osv-scalibr/fs"
 	"github.com/google/osv-scalibr/inventoryindex"
 	"github.com/google/osv-scalibr/plugin"
@@ -60,8 +67,9 @@ func TestScan(t *testing.T) {
 	)
 	inventory := &extractor.Inventory{
 		Name:      invName,
-		Locations: []string{"file.txt"},
+		Locations: extractor.LocationsFromPaths("file.txt"),
 		Extractor: fakeExtractor,
+		ScanRoot:  tmp,
 	}
 	finding := &detector.Finding{Adv: &detector.Advisory{ID: &detector.AdvisoryID{Reference: "CVE-1234"}}}
 
@@ -177,13 +185,439 @@ func TestScan(t *testing.T) {
 			tc.want.StartTime = got.StartTime
 			tc.want.EndTime = got.EndTime
 
-			if diff := cmp.Diff(tc.want, got, fe.AllowUnexported); diff != "" {
+			// The provenance is checked by TestScan_Provenance.
+			ignoreProvenance := cmpopts.IgnoreFields(scalibr.ScanResult{}, "Version", "Provenance")
+			if diff := cmp.Diff(tc.want, got, fe.AllowUnexported, ignoreProvenance); diff != "" {
 				t.Errorf("scalibr.New().Scan(%v): unexpected diff (-want +got):\n%s", tc.cfg, diff)
 			}
 		})
 	}
 }
 
+// typedExtractor reports its inventory with the given PURL type, or without a
+// PURL if the type is empty.
+type typedExtractor struct {
+	filesystem.Extractor
+	purlType string
+}
+
+func (e typedExtractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	if e.purlType == "" {
+		return nil
+	}
+	return &purl.PackageURL{Type: e.purlType, Name: i.Name}
+}
+
+func TestScan_CustomExtractor(t *testing.T) {
+	tmp := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmp, "vendor.lock"), []byte("lock"), 0644); err != nil {
+		t.Fatalf("os.WriteFile(): %v", err)
+	}
+	// The extractor isn't part of extractor/filesystem/list.
+	custom := fe.New("vendor/proprietary", 1, []string{"vendor.lock"}, map[string]fe.NamesErr{
+		"vendor.lock": {Names: []string{"internal-lib"}},
+	})
+	cfg := &scalibr.ScanConfig{
+		FilesystemExtractors: []filesystem.Extractor{custom},
+		Detectors: []detector.Detector{
+			fd.NewWithOptions(fd.WithName("detector"), fd.WithRequiredExtractors("vendor/proprietary")),
+		},
+		ScanRoots: []*scalibrfs.ScanRoot{{FS: scalibrfs.DirFS(tmp), Path: tmp}},
+	}
+
+	got := scalibr.New().Scan(context.Background(), cfg)
+
+	if got.Status.Status != plugin.ScanStatusSucceeded {
+		t.Fatalf("Scan() status: %v", got.Status)
+	}
+	if len(got.Inventories) != 1 || got.Inventories[0].Name != "internal-lib" {
+		t.Fatalf("Scan() inventories: %v, want internal-lib from the custom extractor", got.Inventories)
+	}
+	if got.Inventories[0].Extractor != custom {
+		t.Errorf("Scan() inventory extractor: %v, want the custom extractor", got.Inventories[0].Extractor)
+	}
+}
+
+func TestScan_Provenance(t *testing.T) {
+	tmp := t.TempDir()
+	cfg := &scalibr.ScanConfig{
+		FilesystemExtractors: []filesystem.Extractor{
+			fe.New("python/wheelegg", 1, nil, nil),
+			fe.New("dotnet/packageslockjson", 1, nil, nil),
+		},
+		Detectors: []detector.Detector{fd.New("detector", 2, nil, nil)},
+		ScanRoots: []*scalibrfs.ScanRoot{{FS: scalibrfs.DirFS(tmp), Path: tmp}},
+	}
+
+	got := scalibr.New().Scan(context.Background(), cfg)
+
+	if got.Version != scalibr.ScannerVersion {
+		t.Errorf("Scan() returned version %q, want %q", got.Version, scalibr.ScannerVersion)
+	}
+	want := &scalibr.Provenance{
+		ScannerVersion: scalibr.ScannerVersion,
+		StartTime:      got.StartTime,
+		ScanRoots:      []string{tmp},
+		Extractors:     []string{"dotnet/packageslockjson", "python/wheelegg"},
+		Detectors:      []string{"detector"},
+	}
+	if diff := cmp.Diff(want, got.Provenance); diff != "" {
+		t.Errorf("Scan() provenance (-want +got):\n%s", diff)
+	}
+}
+
+func TestScan_FilterByPURLType(t *testing.T) {
+	tmp := t.TempDir()
+	tmpRoot := []*scalibrfs.ScanRoot{{FS: scalibrfs.DirFS(tmp), Path: tmp}}
+	for _, f := range []string{"deb.txt", "npm.txt", "pypi.txt", "none.txt"} {
+		if err := os.WriteFile(filepath.Join(tmp, f), []byte("Content"), 0644); err != nil {
+			t.Fatalf("os.WriteFile(%s): %v", f, err)
+		}
+	}
+	newExtractor := func(purlType, file, name string) filesystem.Extractor {
+		return typedExtractor{
+			Extractor: fe.New(name+"-extractor", 1, []string{file}, map[string]fe.NamesErr{file: {Names: []string{name}}}),
+			purlType:  purlType,
+		}
+	}
+	extractors := []filesystem.Extractor{
+		newExtractor(purl.TypeDebian, "deb.txt", "libc6"),
+		newExtractor(purl.TypeNPM, "npm.txt", "lodash"),
+		newExtractor(purl.TypePyPi, "pypi.txt", "requests"),
+		newExtractor("", "none.txt", "unknown"),
+	}
+
+	testCases := []struct {
+		desc         string
+		includeTypes []string
+		excludeTypes []string
+		dropNoPURL   bool
+		want         []string
+	}{
+		{
+			desc: "no filter",
+			want: []string{"libc6", "lodash", "requests", "unknown"},
+		},
+		{
+			desc:         "include OS packages",
+			includeTypes: []string{purl.TypeDebian},
+			want:         []string{"libc6", "unknown"},
+		},
+		{
+			desc:         "exclude language packages",
+			excludeTypes: []string{purl.TypeNPM, purl.TypePyPi},
+			want:         []string{"libc6", "unknown"},
+		},
+		{
+			desc:         "include and exclude",
+			includeTypes: []string{purl.TypeNPM, purl.TypePyPi},
+			excludeTypes: []string{purl.TypePyPi},
+			want:         []string{"lodash", "unknown"},
+		},
+		{
+			desc:         "drop inventory without PURL",
+			includeTypes: []string{purl.TypeDebian},
+			dropNoPURL:   true,
+			want:         []string{"libc6"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := &scalibr.ScanConfig{
+				FilesystemExtractors:     extractors,
+				ScanRoots:                tmpRoot,
+				IncludeTypes:             tc.includeTypes,
+				ExcludeTypes:             tc.excludeTypes,
+				DropInventoryWithoutPURL: tc.dropNoPURL,
+			}
+			got := scalibr.New().Scan(context.Background(), cfg)
+			if got.Status.Status != plugin.ScanStatusSucceeded {
+				t.Fatalf("scalibr.New().Scan(): %v", got.Status)
+			}
+			var names []string
+			for _, i := range got.Inventories {
+				names = append(names, i.Name)
+			}
+			if diff := cmp.Diff(tc.want, names); diff != "" {
+				t.Errorf("scalibr.New().Scan() inventory (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestScan_MinConfidence(t *testing.T) {
+	tmp := t.TempDir()
+	files := map[string]string{
+		"zlib.h":   `#define ZLIB_VERSION "1.2.11"`,
+		"lock.txt": "Content",
+	}
+	for f, content := range files {
+		if err := os.WriteFile(filepath.Join(tmp, f), []byte(content), 0644); err != nil {
+			t.Fatalf("os.WriteFile(%s): %v", f, err)
+		}
+	}
+	extractors := []filesystem.Extractor{
+		vendoredheaders.New(vendoredheaders.DefaultConfig()),
+		fe.New("lockfile", 1, []string{"lock.txt"}, map[string]fe.NamesErr{"lock.txt": {Names: []string{"lodash"}}}),
+	}
+
+	testCases := []struct {
+		desc          string
+		minConfidence extractor.Confidence
+		want          []string
+	}{
+		{
+			desc: "no threshold",
+			want: []string{"lodash", "zlib"},
+		},
+		{
+			desc:          "heuristic inventory at the threshold",
+			minConfidence: extractor.ConfidenceMedium,
+			want:          []string{"lodash", "zlib"},
+		},
+		{
+			desc:          "heuristic inventory below the threshold",
+			minConfidence: extractor.ConfidenceHigh,
+			want:          []string{"lodash"},
+		},
+		{
+			desc:          "inventory without confidence is kept",
+			minConfidence: extractor.ConfidenceMax,
+			want:          []string{"lodash"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := &scalibr.ScanConfig{
+				FilesystemExtractors: extractors,
+				ScanRoots:            []*scalibrfs.ScanRoot{{FS: scalibrfs.DirFS(tmp), Path: tmp}},
+				MinConfidence:        tc.minConfidence,
+			}
+			got := scalibr.New().Scan(context.Background(), cfg)
+			if got.Status.Status != plugin.ScanStatusSucceeded {
+				t.Fatalf("scalibr.New().Scan(): %v", got.Status)
+			}
+			var names []string
+			for _, i := range got.Inventories {
+				names = append(names, i.Name)
+			}
+			if diff := cmp.Diff(tc.want, names, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("scalibr.New().Scan() inventory (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestScan_MergeLocations(t *testing.T) {
+	tmp := t.TempDir()
+	lockfile := func(deps string) string {
+		return `{"version": 1, "dependencies": {"net6.0": {` + deps + `}}}`
+	}
+	files := map[string]string{
+		"a/packages.lock.json": lockfile(`
+			"Newtonsoft.Json": {"type": "Direct", "requested": "[13.0.3, )", "resolved": "13.0.3"},
+			"Serilog": {"type": "Direct", "requested": "[3.1.1, )", "resolved": "3.1.1"}`),
+		"b/packages.lock.json": lockfile(`
+			"Newtonsoft.Json": {"type": "Direct", "requested": "[13.0.3, )", "resolved": "13.0.3"}`),
+	}
+	for f, content := range files {
+		path := filepath.Join(tmp, filepath.FromSlash(f))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("os.MkdirAll(%s): %v", filepath.Dir(path), err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("os.WriteFile(%s): %v", f, err)
+		}
+	}
+
+	testCases := []struct {
+		desc           string
+		mergeLocations bool
+		want           map[string][]string
+	}{
+		{
+			desc: "one item per location by default",
+			want: map[string][]string{
+				"Newtonsoft.Json": {"a/packages.lock.json", "b/packages.lock.json"},
+				"Serilog":         {"a/packages.lock.json"},
+			},
+		},
+		{
+			desc:           "merged locations",
+			mergeLocations: true,
+			want: map[string][]string{
+				"Newtonsoft.Json": {"a/packages.lock.json,b/packages.lock.json"},
+				"Serilog":         {"a/packages.lock.json"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := &scalibr.ScanConfig{
+				FilesystemExtractors: []filesystem.Extractor{packageslockjson.New(packageslockjson.DefaultConfig())},
+				ScanRoots:            []*scalibrfs.ScanRoot{{FS: scalibrfs.DirFS(tmp), Path: tmp}},
+				MergeLocations:       tc.mergeLocations,
+			}
+			got := scalibr.New().Scan(context.Background(), cfg)
+			if got.Status.Status != plugin.ScanStatusSucceeded {
+				t.Fatalf("scalibr.New().Scan(): %v", got.Status)
+			}
+			// Maps the package names to the locations of each of their items.
+			locations := map[string][]string{}
+			for _, i := range got.Inventories {
+				var paths []string
+				for _, l := range i.Locations {
+					paths = append(paths, l.Path)
+				}
+				slices.Sort(paths)
+				locations[i.Name] = append(locations[i.Name], strings.Join(paths, ","))
+			}
+			if diff := cmp.Diff(tc.want, locations, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("scalibr.New().Scan() locations (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestScan_LinkDiscrepancies(t *testing.T) {
+	tmp := t.TempDir()
+	files := map[string]string{
+		"package-lock.json": `{
+			"lockfileVersion": 3,
+			"packages": {
+				"": {"name": "app"},
+				"node_modules/lodash": {"version": "4.17.20"},
+				"node_modules/semver": {"version": "7.6.0"}
+			}
+		}`,
+		// lodash was updated in node_modules without updating the lockfile.
+		"node_modules/lodash/package.json": `{"name": "lodash", "version": "4.17.21"}`,
+		"node_modules/semver/package.json": `{"name": "semver", "version": "7.6.0"}`,
+	}
+	for f, content := range files {
+		path := filepath.Join(tmp, filepath.FromSlash(f))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("os.MkdirAll(%s): %v", filepath.Dir(path), err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("os.WriteFile(%s): %v", f, err)
+		}
+	}
+
+	cfg := &scalibr.ScanConfig{
+		FilesystemExtractors: []filesystem.Extractor{
+			packagelockjson.New(packagelockjson.DefaultConfig()),
+			nodemodules.New(nodemodules.DefaultConfig()),
+		},
+		ScanRoots:         []*scalibrfs.ScanRoot{{FS: scalibrfs.DirFS(tmp), Path: tmp}},
+		LinkDiscrepancies: true,
+	}
+	got := scalibr.New().Scan(context.Background(), cfg)
+	if got.Status.Status != plugin.ScanStatusSucceeded {
+		t.Fatalf("scalibr.New().Scan(): %v", got.Status)
+	}
+
+	// Maps the packages to the ones they're linked to.
+	links := map[string][]string{}
+	for _, i := range got.Inventories {
+		id := i.Name + "@" + i.Version
+		links[id] = []string{}
+		for _, r := range i.Relationships {
+			if r.Type == extractor.RelationshipDiscrepancy {
+				links[id] = append(links[id], r.Other.Name+"@"+r.Other.Version)
+			}
+		}
+	}
+	want := map[string][]string{
+		"lodash@4.17.20": {"lodash@4.17.21"},
+		"lodash@4.17.21": {"lodash@4.17.20"},
+		"semver@7.6.0":   {},
+	}
+	if diff := cmp.Diff(want, links); diff != "" {
+		t.Errorf("scalibr.New().Scan() discrepancies (-want +got):\n%s", diff)
+	}
+}
+
+// slowExtractor returns an inventory for the first file it extracts and
+// blocks on all others until its context is cancelled.
+type slowExtractor struct {
+	filesystem.Extractor
+	calls int
+}
+
+func (e *slowExtractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	e.calls++
+	if e.calls == 1 {
+		return []*extractor.Inventory{{Name: "fast", Locations: extractor.LocationsFromPaths(input.Path)}}, nil
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(time.Minute):
+		return nil, errors.New("context wasn't cancelled")
+	}
+}
+
+func TestScan_Timeout(t *testing.T) {
+	tmp := t.TempDir()
+	for _, f := range []string{"a.txt", "b.txt"} {
+		if err := os.WriteFile(filepath.Join(tmp, f), []byte("Content"), 0644); err != nil {
+			t.Fatalf("os.WriteFile(%s): %v", f, err)
+		}
+	}
+	ex := &slowExtractor{Extractor: fe.New("slow", 1, []string{"a.txt", "b.txt"}, nil)}
+	cfg := &scalibr.ScanConfig{
+		FilesystemExtractors: []filesystem.Extractor{ex},
+		ScanRoots:            []*scalibrfs.ScanRoot{{FS: scalibrfs.DirFS(tmp), Path: tmp}},
+		Timeout:              100 * time.Millisecond,
+	}
+
+	start := time.Now()
+	got := scalibr.New().Scan(context.Background(), cfg)
+	if elapsed := time.Since(start); elapsed > 30*time.Second {
+		t.Errorf("scalibr.New().Scan() took %s, want it to stop after the timeout", elapsed)
+	}
+
+	if !got.TimedOut {
+		t.Errorf("scalibr.New().Scan() TimedOut = false, want true")
+	}
+	if got.Status.Status != plugin.ScanStatusPartiallySucceeded {
+		t.Errorf("scalibr.New().Scan() status = %v, want %v", got.Status.Status, plugin.ScanStatusPartiallySucceeded)
+	}
+	var names []string
+	for _, i := range got.Inventories {
+		names = append(names, i.Name)
+	}
+	if diff := cmp.Diff([]string{"fast"}, names); diff != "" {
+		t.Errorf("scalibr.New().Scan() inventory (-want +got):\n%s", diff)
+	}
+}
+
+func TestScan_CancelledIsNotTimeout(t *testing.T) {
+	tmp := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmp, "file.txt"), []byte("Content"), 0644); err != nil {
+		t.Fatalf("os.WriteFile(file.txt): %v", err)
+	}
+	cfg := &scalibr.ScanConfig{
+		FilesystemExtractors: []filesystem.Extractor{fe.New("fake", 1, []string{"file.txt"}, nil)},
+		ScanRoots:            []*scalibrfs.ScanRoot{{FS: scalibrfs.DirFS(tmp), Path: tmp}},
+		Timeout:              time.Minute,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	got := scalibr.New().Scan(ctx, cfg)
+	if got.TimedOut {
+		t.Errorf("scalibr.New().Scan() TimedOut = true, want false")
+	}
+	if got.Status.Status != plugin.ScanStatusFailed {
+		t.Errorf("scalibr.New().Scan() status = %v, want %v", got.Status.Status, plugin.ScanStatusFailed)
+	}
+}
+
 func withDetectorName(f *detector.Finding, det string) *detector.Finding {
 	copy := *f
 	copy.Detectors = []string{det}
@@ -220,6 +654,18 @@ func TestEnableRequiredExtractors(t *testing.T) {
 			},
 			wantExtractors: []string{"bar/baz"},
 		},
+		{
+			name: "explicit extractor takes precedence over listed one",
+			cfg: scalibr.ScanConfig{
+				Detectors: []detector.Detector{
+					fd.NewWithOptions(fd.WithName("foo"), fd.WithRequiredExtractors("python/wheelegg")),
+				},
+				FilesystemExtractors: []filesystem.Extractor{
+					fe.New("python/wheelegg", 0, nil, nil),
+				},
+			},
+			wantExtractors: []string{"python/wheelegg"},
+		},
 		{
 			name: "auto-loaded required extractor",
 			cfg: scalibr.ScanConfig{
@@ -267,6 +713,49 @@ func TestEnableRequiredExtractors(t *testing.T) {
 	}
 }
 
+func TestApplyProfiles(t *testing.T) {
+	dpkg := fe.New("os/dpkg", 0, nil, nil)
+	cfg := scalibr.ScanConfig{
+		Profiles:             []string{"containers"},
+		FilesystemExtractors: []filesystem.Extractor{dpkg},
+		MaxInventoryPerFile:  10,
+	}
+	if err := cfg.ApplyProfiles(); err != nil {
+		t.Fatalf("ApplyProfiles(): %v", err)
+	}
+
+	dpkgCount := 0
+	names := map[string]bool{}
+	for _, e := range cfg.FilesystemExtractors {
+		names[e.Name()] = true
+		if e.Name() == "os/dpkg" {
+			dpkgCount++
+			if e != dpkg {
+				t.Errorf("ApplyProfiles() replaced the explicitly configured os/dpkg extractor")
+			}
+		}
+	}
+	if dpkgCount != 1 {
+		t.Errorf("ApplyProfiles() enabled os/dpkg %d times, want 1", dpkgCount)
+	}
+	if !names["os/apk"] {
+		t.Errorf("ApplyProfiles() didn't enable os/apk")
+	}
+	if cfg.MaxInventoryPerFile != 10 {
+		t.Errorf("ApplyProfiles() changed MaxInventoryPerFile to %d, want 10", cfg.MaxInventoryPerFile)
+	}
+	if cfg.MaxMetadataBytes == 0 {
+		t.Errorf("ApplyProfiles() didn't set MaxMetadataBytes")
+	}
+}
+
+func TestApplyProfiles_Unknown(t *testing.T) {
+	cfg := scalibr.ScanConfig{Profiles: []string{"unknown"}}
+	if err := cfg.ApplyProfiles(); err == nil {
+		t.Error("ApplyProfiles() succeeded for an unknown profile, want error")
+	}
+}
+
 type fakeExNeedsNetwork struct {
 }
 
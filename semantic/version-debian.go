@@ -0,0 +1,192 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semantic
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+func splitAround(s string, sep string, reverse bool) (string, string) {
+	var i int
+
+	if reverse {
+		i = strings.LastIndex(s, sep)
+	} else {
+		i = strings.Index(s, sep)
+	}
+
+	if i == -1 {
+		return s, ""
+	}
+
+	return s[:i], s[i+1:]
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isAlpha(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// dpkgOrder returns the sort weight of a character in a non-digit run, where
+// 0 stands for the end of the run. Tilde sorts before everything (even the end
+// of the run), followed by letters and then all other characters.
+func dpkgOrder(s string, i int) int {
+	if i >= len(s) {
+		return 0
+	}
+	c := s[i]
+	switch {
+	case isDigit(c):
+		return 0
+	case isAlpha(c):
+		return int(c)
+	case c == '~':
+		return -1
+	default:
+		return int(c) + 256
+	}
+}
+
+// verrevcmp compares the upstream version or revision parts of two Debian
+// versions. It's a port of verrevcmp() from dpkg's lib/dpkg/version.c.
+func verrevcmp(a, b string) int {
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		firstDiff := 0
+
+		// Compare the non-digit runs character by character.
+		for (i < len(a) && !isDigit(a[i])) || (j < len(b) && !isDigit(b[j])) {
+			ac := dpkgOrder(a, i)
+			bc := dpkgOrder(b, j)
+			if ac != bc {
+				return sign(ac - bc)
+			}
+			i++
+			j++
+		}
+
+		// Compare the digit runs numerically, ignoring leading zeros.
+		for i < len(a) && a[i] == '0' {
+			i++
+		}
+		for j < len(b) && b[j] == '0' {
+			j++
+		}
+		for i < len(a) && isDigit(a[i]) && j < len(b) && isDigit(b[j]) {
+			if firstDiff == 0 {
+				firstDiff = int(a[i]) - int(b[j])
+			}
+			i++
+			j++
+		}
+		if i < len(a) && isDigit(a[i]) {
+			return 1
+		}
+		if j < len(b) && isDigit(b[j]) {
+			return -1
+		}
+		if firstDiff != 0 {
+			return sign(firstDiff)
+		}
+	}
+
+	return 0
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// dpkgCompare compares two Debian versions the same way
+// "dpkg --compare-versions" does, returning -1, 0 or +1.
+// A version with a malformed epoch is compared as if its epoch was 0; use
+// parseDebianVersion to reject such versions upfront.
+func dpkgCompare(a, b string) int {
+	va, err := parseDebianVersion(a)
+	if err != nil {
+		va = debianVersion{epoch: big.NewInt(0), upstream: a}
+	}
+	vb, err := parseDebianVersion(b)
+	if err != nil {
+		vb = debianVersion{epoch: big.NewInt(0), upstream: b}
+	}
+
+	return va.Compare(vb)
+}
+
+type debianVersion struct {
+	epoch    *big.Int
+	upstream string
+	revision string
+}
+
+func (v debianVersion) Compare(w debianVersion) int {
+	if diff := v.epoch.Cmp(w.epoch); diff != 0 {
+		return diff
+	}
+	if diff := verrevcmp(v.upstream, w.upstream); diff != 0 {
+		return diff
+	}
+	if diff := verrevcmp(v.revision, w.revision); diff != 0 {
+		return diff
+	}
+
+	return 0
+}
+
+func (v debianVersion) CompareStr(str string) (int, error) {
+	w, err := parseDebianVersion(str)
+	if err != nil {
+		return 0, err
+	}
+
+	return v.Compare(w), nil
+}
+
+func parseDebianVersion(str string) (debianVersion, error) {
+	var upstream, revision string
+
+	str = strings.TrimSpace(str)
+	epoch := big.NewInt(0)
+
+	if strings.Contains(str, ":") {
+		e, rest := splitAround(str, ":", false)
+		var ok bool
+		if epoch, ok = convertToBigInt(e); !ok || epoch.Sign() < 0 {
+			return debianVersion{}, fmt.Errorf("invalid epoch in Debian version %q", str)
+		}
+		str = rest
+	}
+
+	if strings.Contains(str, "-") {
+		upstream, revision = splitAround(str, "-", true)
+	} else {
+		upstream = str
+	}
+
+	return debianVersion{epoch, upstream, revision}, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semantic
+
+import "testing"
+
+func TestDpkgCompare(t *testing.T) {
+	// Pairs taken from dpkg's own test suite (scripts/t/Dpkg_Version.t) plus a
+	// few real-world distro versions, verified with "dpkg --compare-versions".
+	tests := []struct {
+		a    string
+		b    string
+		want int
+	}{
+		{a: "1.0-1", b: "2.0-2", want: -1},
+		{a: "2.2~rc-4", b: "2.2-1", want: -1},
+		{a: "2.2-1", b: "2.2~rc-4", want: 1},
+		{a: "1.0000-1", b: "1.0-1", want: 0},
+		{a: "1", b: "0:1", want: 0},
+		{a: "0", b: "0:0-0", want: 0},
+		{a: "2:2.5", b: "1:7.5", want: 1},
+		{a: "1:0foo", b: "0foo", want: 1},
+		{a: "0:0foo", b: "0foo", want: 0},
+		{a: "0foo", b: "0foo", want: 0},
+		{a: "0foo-0", b: "0foo", want: 0},
+		{a: "0foo", b: "0foo-0", want: 0},
+		{a: "0foo", b: "0fo", want: 1},
+		{a: "0foo-0", b: "0foo+", want: -1},
+		{a: "0foo~1", b: "0foo", want: -1},
+		{a: "0foo~foo+Bar", b: "0foo~foo+bar", want: -1},
+		{a: "0foo~~", b: "0foo~", want: -1},
+		{a: "1~", b: "1", want: -1},
+		{a: "12345+that-really-is-some-ver-0", b: "12345+that-really-is-some-ver-10", want: -1},
+		{a: "0foo-0", b: "0foo-01", want: -1},
+		{a: "0foo.bar", b: "0foobar", want: 1},
+		{a: "0foo.bar", b: "0foo1bar", want: 1},
+		{a: "0foo.bar", b: "0foo0bar", want: 1},
+		{a: "0foo1bar-1", b: "0foobar-1", want: -1},
+		{a: "0foo2.0", b: "0foo2", want: 1},
+		{a: "0foo2.0.0", b: "0foo2.10.0", want: -1},
+		{a: "0foo2.0", b: "0foo2.0.0", want: -1},
+		{a: "0foo2.0", b: "0foo2.10", want: -1},
+		{a: "0foo2.1", b: "0foo2.10", want: -1},
+		{a: "1.09", b: "1.9", want: 0},
+		{a: "1.0.8+nmu1", b: "1.0.8", want: 1},
+		{a: "3.11", b: "3.10+nmu1", want: 1},
+		{a: "0.9j-20080306-4", b: "0.9i-20080306-4", want: 1},
+		{a: "0.9j-20080306-4", b: "0.9j-20080306-4", want: 0},
+		{a: "0.9j-20080306-4", b: "0.9j-20080307-4", want: -1},
+		{a: "0.9j-20080306-4", b: "0.9j-20080306-5", want: -1},
+		{a: "1.2.0~b7-1", b: "1.2.0~b6-1", want: 1},
+		{a: "1.011-1", b: "1.06-2", want: 1},
+		{a: "0.0.9+dfsg1-1", b: "0.0.8+dfsg1-3", want: 1},
+		{a: "4.6.99+svn6582-1", b: "4.6.99+svn6496-1", want: 1},
+		{a: "53", b: "52", want: 1},
+		{a: "0.9.9~pre122-1", b: "0.9.9~pre111-1", want: 1},
+		{a: "2:2.3.2-2+lenny2", b: "2:2.3.2-2", want: 1},
+		{a: "1:3.8.1-1", b: "3.8.GA-1", want: 1},
+		{a: "1.0.1+gtk+2.0", b: "1.0.1", want: 1},
+		{a: "1.0~rc1", b: "1.0", want: -1},
+		{a: "1.0a", b: "1.0+", want: -1},
+		{a: "1.0A", b: "1.0a", want: -1},
+		{a: "1.0", b: "1.0-0", want: 0},
+		{a: "1.0~", b: "1.0~~", want: 1},
+		{a: "10:1.0", b: "9:9.9", want: 1},
+		{a: "1.0-1ubuntu0.1", b: "1.0-1", want: 1},
+		{a: "1.0-1~bpo11+1", b: "1.0-1", want: -1},
+		{a: "2.36-9+deb12u4", b: "2.36-9+deb12u10", want: -1},
+		{a: "1:9.18.28-0ubuntu0.22.04.1", b: "1:9.18.18-0ubuntu0.22.04.2", want: 1},
+	}
+
+	for _, tc := range tests {
+		if got := dpkgCompare(tc.a, tc.b); got != tc.want {
+			t.Errorf("dpkgCompare(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+		if got := dpkgCompare(tc.b, tc.a); got != -tc.want {
+			t.Errorf("dpkgCompare(%q, %q) = %d, want %d", tc.b, tc.a, got, -tc.want)
+		}
+	}
+}
+
+func TestParseDebianVersionInvalidEpoch(t *testing.T) {
+	for _, v := range []string{"x:1.0", ":1.0", "-1:1.0"} {
+		if _, err := parseDebianVersion(v); err == nil {
+			t.Errorf("parseDebianVersion(%q) returned no error", v)
+		}
+	}
+}
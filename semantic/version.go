@@ -0,0 +1,94 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package semantic provides ecosystem-aware version comparison, as needed for
+// matching extracted inventory against advisories that specify affected
+// version ranges.
+package semantic
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ErrUnsupportedEcosystem is returned when versions of an ecosystem can't be compared.
+var ErrUnsupportedEcosystem = errors.New("unsupported ecosystem")
+
+// Version is a version parsed according to the rules of its ecosystem.
+type Version interface {
+	// CompareStr parses str as a version of the same ecosystem and compares it
+	// to this version. The result will be 0 if v == str, -1 if v < str, and +1
+	// if v > str.
+	CompareStr(str string) (int, error)
+}
+
+// Parse parses a version of the given OSV ecosystem, so that it can be compared
+// to other versions repeatedly without being parsed again.
+// Ecosystem suffixes such as the release in "Debian:12" are ignored.
+func Parse(ecosystem, str string) (Version, error) {
+	eco, _, _ := strings.Cut(ecosystem, ":")
+
+	switch eco {
+	case "npm", "crates.io", "Go", "Hex", "Pub", "ConanCenter":
+		return parseSemverVersion(str), nil
+	case "NuGet":
+		return parseNuGetVersion(str), nil
+	case "PyPI":
+		return parsePyPIVersion(str), nil
+	case "Debian", "Ubuntu":
+		return parseDebianVersion(str)
+	}
+
+	return nil, fmt.Errorf("%w: %q", ErrUnsupportedEcosystem, ecosystem)
+}
+
+// Compare returns an integer comparing two versions of the given OSV ecosystem.
+// The result will be 0 if a == b, -1 if a < b, and +1 if a > b.
+// Ecosystem suffixes such as the release in "Debian:12" are ignored.
+func Compare(ecosystem, a, b string) (int, error) {
+	v, err := Parse(ecosystem, a)
+	if err != nil {
+		return 0, err
+	}
+	return v.CompareStr(b)
+}
+
+// components are the numeric parts of a version, e.g. [1, 2, 3] for "1.2.3".
+type components []*big.Int
+
+// Fetch returns the nth component, or 0 if there are fewer than n+1 components.
+func (c *components) Fetch(n int) *big.Int {
+	if len(*c) <= n {
+		return big.NewInt(0)
+	}
+
+	return (*c)[n]
+}
+
+// Cmp compares the components numerically, padding the shorter one with zeros.
+func (c *components) Cmp(b components) int {
+	numberOfComponents := maxInt(len(*c), len(b))
+
+	for i := 0; i < numberOfComponents; i++ {
+		diff := c.Fetch(i).Cmp(b.Fetch(i))
+
+		if diff != 0 {
+			return diff
+		}
+	}
+
+	return 0
+}
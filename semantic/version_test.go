@@ -0,0 +1,197 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semantic_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/osv-scalibr/semantic"
+)
+
+type compareTest struct {
+	a    string
+	b    string
+	want int
+}
+
+func runCompareTests(t *testing.T, ecosystem string, tests []compareTest) {
+	t.Helper()
+	for _, tc := range tests {
+		got, err := semantic.Compare(ecosystem, tc.a, tc.b)
+		if err != nil {
+			t.Errorf("Compare(%q, %q, %q): %v", ecosystem, tc.a, tc.b, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("Compare(%q, %q, %q) = %d, want %d", ecosystem, tc.a, tc.b, got, tc.want)
+		}
+
+		// Comparison should be antisymmetric.
+		got, err = semantic.Compare(ecosystem, tc.b, tc.a)
+		if err != nil {
+			t.Errorf("Compare(%q, %q, %q): %v", ecosystem, tc.b, tc.a, err)
+			continue
+		}
+		if got != -tc.want {
+			t.Errorf("Compare(%q, %q, %q) = %d, want %d", ecosystem, tc.b, tc.a, got, -tc.want)
+		}
+	}
+}
+
+func TestCompareSemver(t *testing.T) {
+	tests := []compareTest{
+		{a: "1.0.0", b: "1.0.0", want: 0},
+		{a: "1.0.0", b: "1.0.1", want: -1},
+		{a: "1.10.0", b: "1.9.0", want: 1},
+		{a: "2.0.0", b: "1.99.99", want: 1},
+		{a: "v1.2.3", b: "1.2.3", want: 0},
+		{a: "1.2", b: "1.2.0", want: 0},
+		// Pre-releases sort before the release.
+		{a: "1.0.0-alpha", b: "1.0.0", want: -1},
+		// Pre-release precedence examples from https://semver.org/#spec-item-11
+		{a: "1.0.0-alpha", b: "1.0.0-alpha.1", want: -1},
+		{a: "1.0.0-alpha.1", b: "1.0.0-alpha.beta", want: -1},
+		{a: "1.0.0-alpha.beta", b: "1.0.0-beta", want: -1},
+		{a: "1.0.0-beta", b: "1.0.0-beta.2", want: -1},
+		{a: "1.0.0-beta.2", b: "1.0.0-beta.11", want: -1},
+		{a: "1.0.0-beta.11", b: "1.0.0-rc.1", want: -1},
+		{a: "1.0.0-rc.1", b: "1.0.0", want: -1},
+		// Build metadata is ignored.
+		{a: "1.0.0+build.1", b: "1.0.0+build.2", want: 0},
+		{a: "1.0.0-rc.1+build.1", b: "1.0.0-rc.1", want: 0},
+	}
+
+	for _, eco := range []string{"npm", "crates.io", "Go"} {
+		t.Run(eco, func(t *testing.T) {
+			runCompareTests(t, eco, tests)
+		})
+	}
+}
+
+func TestCompareNuGet(t *testing.T) {
+	runCompareTests(t, "NuGet", []compareTest{
+		{a: "1.0.0", b: "1.0.0", want: 0},
+		{a: "1.0", b: "1.0.0.0", want: 0},
+		{a: "1.0.0.1", b: "1.0.0", want: 1},
+		{a: "1.0.0.10", b: "1.0.0.9", want: 1},
+		{a: "4.5.0-preview1", b: "4.5.0", want: -1},
+		{a: "4.5.0-preview1", b: "4.5.0-preview2", want: -1},
+		// Pre-release labels are case insensitive.
+		{a: "1.0.0-BETA", b: "1.0.0-beta", want: 0},
+		{a: "1.0.0-Alpha", b: "1.0.0-beta", want: -1},
+		{a: "1.0.0-rc.1", b: "1.0.0-rc.2", want: -1},
+		{a: "1.0.0+metadata", b: "1.0.0", want: 0},
+	})
+}
+
+func TestComparePyPI(t *testing.T) {
+	runCompareTests(t, "PyPI", []compareTest{
+		{a: "1.0", b: "1.0.0", want: 0},
+		{a: "1.0", b: "1.1", want: -1},
+		{a: "1.10", b: "1.9", want: 1},
+		// Pre-, post- and dev-release ordering from PEP 440.
+		{a: "1.0.dev0", b: "1.0a0", want: -1},
+		{a: "1.0a1", b: "1.0a2", want: -1},
+		{a: "1.0a2", b: "1.0b1", want: -1},
+		{a: "1.0b1", b: "1.0rc1", want: -1},
+		{a: "1.0rc1", b: "1.0", want: -1},
+		{a: "1.0", b: "1.0.post1", want: -1},
+		{a: "1.0.post1.dev1", b: "1.0.post1", want: -1},
+		{a: "1.0.post1", b: "1.1.dev0", want: -1},
+		// Alternative spellings are normalized.
+		{a: "1.0alpha1", b: "1.0a1", want: 0},
+		{a: "1.0-beta.2", b: "1.0b2", want: 0},
+		{a: "1.0c1", b: "1.0rc1", want: 0},
+		{a: "1.0-1", b: "1.0.post1", want: 0},
+		{a: "1.0.RC1", b: "1.0rc1", want: 0},
+		// Epochs take precedence over everything else.
+		{a: "1!1.0", b: "2.0", want: 1},
+		{a: "1!1.0", b: "2!0.1", want: -1},
+		{a: "0!1.0", b: "1.0", want: 0},
+		// Local versions sort after the public version.
+		{a: "1.0+local", b: "1.0", want: 1},
+		{a: "1.0+abc.5", b: "1.0+abc.10", want: -1},
+		{a: "1.0+5", b: "1.0+abc", want: 1},
+		// Legacy versions sort before PEP 440 versions.
+		{a: "french toast", b: "0.0.1", want: -1},
+	})
+}
+
+func TestCompareDebian(t *testing.T) {
+	tests := []compareTest{
+		{a: "1.0", b: "1.0", want: 0},
+		{a: "1.0", b: "1.0-0", want: 0},
+		{a: "1.0-1", b: "1.0-2", want: -1},
+		{a: "1.2.3", b: "1.10", want: -1},
+		// Tilde sorts before anything, even the end of the version.
+		{a: "1.0~rc1", b: "1.0", want: -1},
+		{a: "1.0~rc1", b: "1.0~rc2", want: -1},
+		{a: "1.0~~", b: "1.0~", want: -1},
+		// Letters sort before non-letters.
+		{a: "1.0a", b: "1.0+", want: -1},
+		{a: "1.0", b: "1.0a", want: -1},
+		{a: "1.0+dfsg", b: "1.0", want: 1},
+		// Epochs take precedence over everything else.
+		{a: "1:0.1", b: "2.0", want: 1},
+		{a: "0:2.0", b: "2.0", want: 0},
+		{a: "1:1.0", b: "2:0.1", want: -1},
+		// The revision is split off at the last hyphen.
+		{a: "1.0-rc-1", b: "1.0-rc-2", want: -1},
+		{a: "2.30-0ubuntu2", b: "2.30-0ubuntu10", want: -1},
+	}
+
+	for _, eco := range []string{"Debian", "Debian:12", "Ubuntu:22.04"} {
+		t.Run(eco, func(t *testing.T) {
+			runCompareTests(t, eco, tests)
+		})
+	}
+}
+
+func TestCompareErrors(t *testing.T) {
+	tests := []struct {
+		desc      string
+		ecosystem string
+		a         string
+		b         string
+		wantErr   error
+	}{
+		{
+			desc:      "unsupported ecosystem",
+			ecosystem: "Unknown",
+			a:         "1.0",
+			b:         "2.0",
+			wantErr:   semantic.ErrUnsupportedEcosystem,
+		},
+		{
+			desc:      "invalid Debian epoch",
+			ecosystem: "Debian",
+			a:         "x:1.0",
+			b:         "1.0",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := semantic.Compare(tc.ecosystem, tc.a, tc.b)
+			if err == nil {
+				t.Fatalf("Compare(%q, %q, %q) returned no error", tc.ecosystem, tc.a, tc.b)
+			}
+			if tc.wantErr != nil && !errors.Is(err, tc.wantErr) {
+				t.Errorf("Compare(%q, %q, %q) returned error %v, want %v", tc.ecosystem, tc.a, tc.b, err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/osv-scalibr/plugin"
+)
+
+// FileRequiredEvent is a buffered AfterFileRequired call.
+type FileRequiredEvent struct {
+	PluginName string
+	Stats      *FileRequiredStats
+}
+
+// FileExtractedEvent is a buffered AfterFileExtracted call.
+type FileExtractedEvent struct {
+	PluginName string
+	Stats      *FileExtractedStats
+}
+
+// FilesBatch holds the per-file events buffered by a BatchingCollector, in the
+// order they were reported.
+type FilesBatch struct {
+	Required  []FileRequiredEvent
+	Extracted []FileExtractedEvent
+}
+
+// BatchCollector is implemented by collectors that aggregate per-file events
+// and prefer receiving them in batches, e.g. to take their lock once per batch
+// instead of once per file.
+type BatchCollector interface {
+	Collector
+	// AfterFilesBatch is called with the AfterFileRequired and
+	// AfterFileExtracted events buffered since the last batch.
+	AfterFilesBatch(batch *FilesBatch)
+}
+
+// DefaultBatchSize is the number of per-file events a BatchingCollector
+// buffers by default before flushing them.
+const DefaultBatchSize = 1000
+
+// BatchingCollector wraps a BatchCollector, buffers its AfterFileRequired and
+// AfterFileExtracted events and passes them on in batches through
+// AfterFilesBatch. All other calls are forwarded as they are.
+//
+// Since extractors report per-file events to the collector of their own
+// config, the BatchingCollector needs to be passed to the extractors as well as
+// to the scan config. Pending events are flushed when the filesystem walk or
+// the scan finishes, or when Flush is called.
+type BatchingCollector struct {
+	c         BatchCollector
+	batchSize int
+
+	mu    sync.Mutex
+	batch FilesBatch
+}
+
+// NewBatchingCollector returns a Collector that reports per-file events to c in
+// batches of batchSize events. If batchSize is 0 or less, DefaultBatchSize is
+// used.
+func NewBatchingCollector(c BatchCollector, batchSize int) *BatchingCollector {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	return &BatchingCollector{c: c, batchSize: batchSize}
+}
+
+// AfterFileRequired implements Collector by buffering the event.
+func (b *BatchingCollector) AfterFileRequired(pluginName string, filestats *FileRequiredStats) {
+	b.mu.Lock()
+	b.batch.Required = append(b.batch.Required, FileRequiredEvent{PluginName: pluginName, Stats: filestats})
+	full := b.takeFullBatch()
+	b.mu.Unlock()
+	b.send(full)
+}
+
+// AfterFileExtracted implements Collector by buffering the event.
+func (b *BatchingCollector) AfterFileExtracted(pluginName string, filestats *FileExtractedStats) {
+	b.mu.Lock()
+	b.batch.Extracted = append(b.batch.Extracted, FileExtractedEvent{PluginName: pluginName, Stats: filestats})
+	full := b.takeFullBatch()
+	b.mu.Unlock()
+	b.send(full)
+}
+
+// Flush passes on all buffered events.
+func (b *BatchingCollector) Flush() {
+	b.mu.Lock()
+	batch := b.takeBatch()
+	b.mu.Unlock()
+	b.send(batch)
+}
+
+// takeFullBatch returns the buffered events if there are batchSize of them and
+// nil otherwise. Must be called with mu held.
+func (b *BatchingCollector) takeFullBatch() *FilesBatch {
+	if len(b.batch.Required)+len(b.batch.Extracted) < b.batchSize {
+		return nil
+	}
+	return b.takeBatch()
+}
+
+// takeBatch returns the buffered events, or nil if there are none, and resets
+// the buffer. Must be called with mu held.
+func (b *BatchingCollector) takeBatch() *FilesBatch {
+	if len(b.batch.Required) == 0 && len(b.batch.Extracted) == 0 {
+		return nil
+	}
+	batch := b.batch
+	b.batch = FilesBatch{}
+	return &batch
+}
+
+// send passes the batch on outside of the lock so that the next batch can be
+// buffered while the wrapped collector handles this one.
+func (b *BatchingCollector) send(batch *FilesBatch) {
+	if batch != nil {
+		b.c.AfterFilesBatch(batch)
+	}
+}
+
+// AfterInodeVisited implements Collector by forwarding to the wrapped collector.
+func (b *BatchingCollector) AfterInodeVisited(path string) {
+	b.c.AfterInodeVisited(path)
+}
+
+// AfterExtractorRun implements Collector by forwarding to the wrapped collector.
+func (b *BatchingCollector) AfterExtractorRun(name string, runtime time.Duration, err error) {
+	b.c.AfterExtractorRun(name, runtime, err)
+}
+
+// AfterDetectorRun implements Collector by forwarding to the wrapped collector.
+func (b *BatchingCollector) AfterDetectorRun(name string, runtime time.Duration, err error) {
+	b.c.AfterDetectorRun(name, runtime, err)
+}
+
+// AfterScan implements Collector by flushing the buffered events and then
+// forwarding to the wrapped collector. This covers scans that stop before the
+// filesystem walk finishes, e.g. because they time out.
+func (b *BatchingCollector) AfterScan(runtime time.Duration, status *plugin.ScanStatus) {
+	b.Flush()
+	b.c.AfterScan(runtime, status)
+}
+
+// AfterResultsExported implements Collector by forwarding to the wrapped collector.
+func (b *BatchingCollector) AfterResultsExported(destination string, bytes int, err error) {
+	b.c.AfterResultsExported(destination, bytes, err)
+}
+
+// AfterFileWarnings implements Collector by forwarding to the wrapped collector.
+func (b *BatchingCollector) AfterFileWarnings(pluginName string, filestats *FileWarningsStats) {
+	b.c.AfterFileWarnings(pluginName, filestats)
+}
+
+// ScanFinished implements Collector by flushing the buffered events and then
+// forwarding to the wrapped collector, so that it has seen all per-file events
+// of the walk when it gets the totals.
+func (b *BatchingCollector) ScanFinished(stats *ScanFinishedStats) {
+	b.Flush()
+	b.c.ScanFinished(stats)
+}
+
+// MaxRSS implements Collector by forwarding to the wrapped collector.
+func (b *BatchingCollector) MaxRSS(maxRSS int64) {
+	b.c.MaxRSS(maxRSS)
+}
@@ -0,0 +1,123 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/stats"
+)
+
+// totalsCollector counts the per-file events by plugin and result, both from
+// the per-file hooks and from batches.
+type totalsCollector struct {
+	stats.NoopCollector
+	mu      sync.Mutex
+	totals  map[string]int
+	batches int
+}
+
+func newTotalsCollector() *totalsCollector {
+	return &totalsCollector{totals: map[string]int{}}
+}
+
+func (c *totalsCollector) AfterFileRequired(pluginName string, filestats *stats.FileRequiredStats) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.totals[pluginName+"/"+string(filestats.Result)]++
+}
+
+func (c *totalsCollector) AfterFileExtracted(pluginName string, filestats *stats.FileExtractedStats) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.totals[pluginName+"/"+string(filestats.Result)]++
+}
+
+func (c *totalsCollector) AfterFilesBatch(batch *stats.FilesBatch) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.batches++
+	for _, e := range batch.Required {
+		c.totals[e.PluginName+"/"+string(e.Stats.Result)]++
+	}
+	for _, e := range batch.Extracted {
+		c.totals[e.PluginName+"/"+string(e.Stats.Result)]++
+	}
+}
+
+// reportFiles reports the events of a scan from several goroutines.
+func reportFiles(c stats.Collector) {
+	const goroutines = 8
+	const files = 125
+	var wg sync.WaitGroup
+	for g := range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			plugin := fmt.Sprintf("ex%d", g%3)
+			for i := range files {
+				path := fmt.Sprintf("dir%d/file%d", g, i)
+				result := stats.FileRequiredResultOK
+				if i%5 == 0 {
+					result = stats.FileRequiredResultSizeLimitExceeded
+				}
+				c.AfterFileRequired(plugin, &stats.FileRequiredStats{Path: path, Result: result})
+				if result == stats.FileRequiredResultOK {
+					c.AfterFileExtracted(plugin, &stats.FileExtractedStats{Path: path, Result: stats.FileExtractedResultSuccess})
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	c.ScanFinished(&stats.ScanFinishedStats{})
+}
+
+func TestBatchingCollector_SameTotals(t *testing.T) {
+	perFile := newTotalsCollector()
+	reportFiles(perFile)
+
+	batched := newTotalsCollector()
+	// The batch size doesn't divide the number of events, so the last batch
+	// is only sent when the scan finishes.
+	reportFiles(stats.NewBatchingCollector(batched, 64))
+
+	if diff := cmp.Diff(perFile.totals, batched.totals); diff != "" {
+		t.Errorf("batched totals differ from per-file totals (-per-file +batched):\n%s", diff)
+	}
+	// 8 goroutines × (125 required + 100 extracted) events in batches of 64.
+	if want := 29; batched.batches != want {
+		t.Errorf("got %d batches, want %d", batched.batches, want)
+	}
+}
+
+func TestBatchingCollector_Flush(t *testing.T) {
+	c := newTotalsCollector()
+	b := stats.NewBatchingCollector(c, 0)
+	b.AfterFileRequired("ex", &stats.FileRequiredStats{Path: "a", Result: stats.FileRequiredResultOK})
+	if c.batches != 0 {
+		t.Fatalf("got %d batches before flushing, want 0", c.batches)
+	}
+	b.Flush()
+	b.Flush()
+	if c.batches != 1 {
+		t.Errorf("got %d batches after flushing, want 1", c.batches)
+	}
+	if want := map[string]int{"ex/" + string(stats.FileRequiredResultOK): 1}; !cmp.Equal(want, c.totals) {
+		t.Errorf("got totals %v, want %v", c.totals, want)
+	}
+}
@@ -24,6 +24,12 @@ import (
 
 // Collector is a component which is notified when certain events occur. It can be implemented with
 // different metric backends to enable monitoring of Scalibr.
+//
+// Collectors may be called concurrently once extraction runs in parallel, so
+// implementations must be safe for concurrent use. Collectors that aren't can
+// be wrapped with NewSyncCollector. Collectors that aggregate the per-file
+// events can implement BatchCollector and be wrapped with NewBatchingCollector
+// to receive them in batches instead.
 type Collector interface {
 	AfterInodeVisited(path string)
 	AfterExtractorRun(name string, runtime time.Duration, err error)
@@ -48,6 +54,15 @@ type Collector interface {
 	// for metric collection.
 	AfterFileExtracted(pluginName string, filestats *FileExtractedStats)
 
+	// AfterFileWarnings is called by the filesystem handling code after an
+	// extractor recorded warnings for a file with ScanInput.Warnf. It's called
+	// once per file and extractor with all of the file's warnings.
+	AfterFileWarnings(pluginName string, filestats *FileWarningsStats)
+
+	// ScanFinished is called once the filesystem walk is done, with totals
+	// across all files of all scan roots.
+	ScanFinished(s *ScanFinishedStats)
+
 	// MaxRSS is called when the scan is finished. It is used to report the maximum resident
 	// memory usage of the scan.
 	MaxRSS(maxRSS int64)
@@ -77,5 +92,11 @@ func (c NoopCollector) AfterFileRequired(pluginName string, filestats *FileRequi
 // AfterFileExtracted implements Collector by doing nothing.
 func (c NoopCollector) AfterFileExtracted(pluginName string, filestats *FileExtractedStats) {}
 
+// AfterFileWarnings implements Collector by doing nothing.
+func (c NoopCollector) AfterFileWarnings(pluginName string, filestats *FileWarningsStats) {}
+
+// ScanFinished implements Collector by doing nothing.
+func (c NoopCollector) ScanFinished(s *ScanFinishedStats) {}
+
 // MaxRSS implements Collector by doing nothing.
 func (c NoopCollector) MaxRSS(maxRSS int64) {}
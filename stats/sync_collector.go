@@ -0,0 +1,106 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/osv-scalibr/plugin"
+)
+
+// SyncCollector wraps a Collector and serializes all calls to it, making it
+// safe to use from multiple goroutines. Use it for collectors that keep
+// unsynchronized state, e.g. plain maps.
+type SyncCollector struct {
+	mu sync.Mutex
+	c  Collector
+}
+
+// NewSyncCollector returns a Collector that forwards all calls to c one at a
+// time.
+func NewSyncCollector(c Collector) *SyncCollector {
+	return &SyncCollector{c: c}
+}
+
+// AfterInodeVisited implements Collector by forwarding to the wrapped collector.
+func (s *SyncCollector) AfterInodeVisited(path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.AfterInodeVisited(path)
+}
+
+// AfterExtractorRun implements Collector by forwarding to the wrapped collector.
+func (s *SyncCollector) AfterExtractorRun(name string, runtime time.Duration, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.AfterExtractorRun(name, runtime, err)
+}
+
+// AfterDetectorRun implements Collector by forwarding to the wrapped collector.
+func (s *SyncCollector) AfterDetectorRun(name string, runtime time.Duration, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.AfterDetectorRun(name, runtime, err)
+}
+
+// AfterScan implements Collector by forwarding to the wrapped collector.
+func (s *SyncCollector) AfterScan(runtime time.Duration, status *plugin.ScanStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.AfterScan(runtime, status)
+}
+
+// AfterResultsExported implements Collector by forwarding to the wrapped collector.
+func (s *SyncCollector) AfterResultsExported(destination string, bytes int, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.AfterResultsExported(destination, bytes, err)
+}
+
+// AfterFileRequired implements Collector by forwarding to the wrapped collector.
+func (s *SyncCollector) AfterFileRequired(pluginName string, filestats *FileRequiredStats) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.AfterFileRequired(pluginName, filestats)
+}
+
+// AfterFileExtracted implements Collector by forwarding to the wrapped collector.
+func (s *SyncCollector) AfterFileExtracted(pluginName string, filestats *FileExtractedStats) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.AfterFileExtracted(pluginName, filestats)
+}
+
+// AfterFileWarnings implements Collector by forwarding to the wrapped collector.
+func (s *SyncCollector) AfterFileWarnings(pluginName string, filestats *FileWarningsStats) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.AfterFileWarnings(pluginName, filestats)
+}
+
+// ScanFinished implements Collector by forwarding to the wrapped collector.
+func (s *SyncCollector) ScanFinished(stats *ScanFinishedStats) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.ScanFinished(stats)
+}
+
+// MaxRSS implements Collector by forwarding to the wrapped collector.
+func (s *SyncCollector) MaxRSS(maxRSS int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.MaxRSS(maxRSS)
+}
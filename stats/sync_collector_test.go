@@ -0,0 +1,68 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/google/osv-scalibr/stats"
+)
+
+// mapCollector records visited inodes in a plain map, so concurrent calls to
+// it race unless they are serialized.
+type mapCollector struct {
+	stats.NoopCollector
+	visited map[string]int
+}
+
+func (c *mapCollector) AfterInodeVisited(path string) {
+	c.visited[path]++
+}
+
+func (c *mapCollector) AfterFileRequired(pluginName string, filestats *stats.FileRequiredStats) {
+	c.visited[filestats.Path]++
+}
+
+func TestSyncCollector_Concurrent(t *testing.T) {
+	const goroutines = 10
+	const calls = 100
+	mc := &mapCollector{visited: map[string]int{}}
+	c := stats.NewSyncCollector(mc)
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < calls; i++ {
+				path := fmt.Sprintf("file%d", i)
+				c.AfterInodeVisited(path)
+				c.AfterFileRequired("ex", &stats.FileRequiredStats{Path: path})
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(mc.visited) != calls {
+		t.Errorf("got %d distinct paths, want %d", len(mc.visited), calls)
+	}
+	for path, got := range mc.visited {
+		if want := 2 * goroutines; got != want {
+			t.Errorf("path %q recorded %d times, want %d", path, got, want)
+		}
+	}
+}
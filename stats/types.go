@@ -17,6 +17,8 @@ package stats
 // FileRequiredStats is a struct containing stats about a file that was
 // required or skipped by a plugin.
 type FileRequiredStats struct {
+	// Extractor is the name of the extractor that evaluated the file.
+	Extractor     string
 	Path          string
 	Result        FileRequiredResult
 	FileSizeBytes int64
@@ -35,6 +37,33 @@ const (
 	FileRequiredResultSizeLimitExceeded FileRequiredResult = "FILE_REQUIRED_RESULT_SIZE_LIMIT_EXCEEDED"
 )
 
+// ScanFinishedStats holds totals over all files seen during a filesystem walk.
+// They are computed by the framework from the results of `FileRequired`, so
+// collectors don't need to aggregate per-file events themselves.
+type ScanFinishedStats struct {
+	// FilesRequired is the number of files at least one extractor required.
+	FilesRequired int
+	// FilesSizeExceeded is the number of files no extractor required only
+	// because they exceeded an extractor's maximum file size.
+	FilesSizeExceeded int
+	// FilesNotRequired is the number of files no extractor required for any
+	// other reason.
+	FilesNotRequired int
+	// DirsDepthExceeded is the number of directories that weren't walked
+	// because the files inside them exceed the maximum walk depth.
+	DirsDepthExceeded int
+	// FilesSkippedMedia is the number of image, video and audio files that
+	// were skipped without asking the extractors.
+	FilesSkippedMedia int
+}
+
+// FileWarningsStats holds the warnings an extractor recorded for a file, i.e.
+// recoverable anomalies that didn't fail the extraction.
+type FileWarningsStats struct {
+	Path     string
+	Warnings []string
+}
+
 // FileExtractedStats is a struct containing stats about a file that was extracted. If
 // the file was skipped due to an error during extraction, `Error` will be
 // populated.
@@ -64,4 +93,21 @@ const (
 	// FileExtractedResultErrorMemoryLimitExceeded indicates that the extraction
 	// failed because the memory limit inside the plugin was exceeded.
 	FileExtractedResultErrorMemoryLimitExceeded = "FILE_EXTRACTED_RESULT_ERROR_MEMORY_LIMIT_EXCEEDED"
+
+	// FileExtractedResultErrorSizeLimitExceeded indicates that the extraction
+	// failed because more data than the maximum file size was read, e.g. because
+	// the file grew after its size was checked.
+	FileExtractedResultErrorSizeLimitExceeded FileExtractedResult = "FILE_EXTRACTED_RESULT_ERROR_SIZE_LIMIT_EXCEEDED"
+
+	// FileExtractedResultTruncated indicates that the file declared more
+	// inventory than the plugin allows, so only part of it was returned.
+	FileExtractedResultTruncated FileExtractedResult = "FILE_EXTRACTED_RESULT_TRUNCATED"
+
+	// FileExtractedResultNotText indicates that the plugin skipped the file
+	// because its content doesn't look like the text format it parses.
+	FileExtractedResultNotText FileExtractedResult = "FILE_EXTRACTED_RESULT_NOT_TEXT"
+
+	// FileExtractedResultEmpty indicates that the file was empty, so the plugin
+	// returned no inventory without parsing it.
+	FileExtractedResultEmpty FileExtractedResult = "FILE_EXTRACTED_RESULT_EMPTY"
 )
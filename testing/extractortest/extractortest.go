@@ -0,0 +1,138 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package extractortest checks that filesystem extractors satisfy the invariants
+// the core library relies on.
+package extractortest
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/purl"
+)
+
+// Validate runs the extractor over every file in testdataDir and checks that:
+//   - FileRequired and Extract don't panic,
+//   - every extracted inventory has a name and at least one location,
+//   - ToPURL returns a PURL for every extracted inventory that survives a
+//     round trip through its string form.
+//
+// Extraction errors are allowed since test data usually includes malformed
+// files.
+//
+// Failures are reported through t with the path of the offending file.
+func Validate(t testing.TB, e filesystem.Extractor, testdataDir string) {
+	t.Helper()
+
+	fsys := scalibrfs.DirFS(testdataDir)
+	err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		validateFile(t, e, fsys, testdataDir, path)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("fs.WalkDir(%s): %v", testdataDir, err)
+	}
+}
+
+func validateFile(t testing.TB, e filesystem.Extractor, fsys scalibrfs.FS, root, path string) {
+	t.Helper()
+
+	info, err := fs.Stat(fsys, path)
+	if err != nil {
+		t.Fatalf("fs.Stat(%s): %v", path, err)
+	}
+
+	var required bool
+	if !noPanic(t, "FileRequired("+path+")", func() { required = e.FileRequired(path, info) }) || !required {
+		return
+	}
+
+	f, err := os.Open(filepath.Join(root, path))
+	if err != nil {
+		t.Fatalf("os.Open(%s): %v", path, err)
+	}
+	defer f.Close()
+
+	input := &filesystem.ScanInput{
+		FS:     fsys,
+		Path:   path,
+		Root:   root,
+		Reader: f,
+		Info:   info,
+	}
+	var inv []*extractor.Inventory
+	if !noPanic(t, "Extract("+path+")", func() { inv, _ = e.Extract(context.Background(), input) }) {
+		return
+	}
+
+	for _, i := range inv {
+		if i == nil {
+			t.Errorf("Extract(%s) returned a nil inventory", path)
+			continue
+		}
+		if i.Name == "" {
+			t.Errorf("Extract(%s) returned an inventory without a name: %+v", path, i)
+		}
+		if len(i.Locations) == 0 {
+			t.Errorf("Extract(%s) returned %q without locations", path, i.Name)
+		}
+		validatePURL(t, e, path, i)
+	}
+}
+
+func validatePURL(t testing.TB, e filesystem.Extractor, path string, i *extractor.Inventory) {
+	t.Helper()
+
+	var p *purl.PackageURL
+	if !noPanic(t, "ToPURL("+path+")", func() { p = e.ToPURL(i) }) {
+		return
+	}
+	if p == nil {
+		t.Errorf("ToPURL(%q) from %s returned nil", i.Name, path)
+		return
+	}
+	if p.Name == "" {
+		t.Errorf("ToPURL(%q) from %s returned a PURL without a name: %v", i.Name, path, p)
+	}
+	if _, err := purl.FromString(p.String()); err != nil {
+		t.Errorf("ToPURL(%q) from %s returned an invalid PURL %q: %v", i.Name, path, p.String(), err)
+	}
+}
+
+// noPanic calls f and reports a test error if it panics. It returns whether f
+// completed normally.
+func noPanic(t testing.TB, name string, f func()) (ok bool) {
+	t.Helper()
+	defer func() {
+		if r := recover(); r != nil {
+			t.Errorf("%s panicked: %v", name, r)
+			ok = false
+		}
+	}()
+	f()
+	return true
+}
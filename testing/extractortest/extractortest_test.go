@@ -0,0 +1,155 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extractortest_test
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/testing/extractortest"
+	fe "github.com/google/osv-scalibr/testing/fakeextractor"
+)
+
+func TestValidate(t *testing.T) {
+	dir := writeTestdata(t)
+	e := fe.New("fake", 1, []string{"a/required.txt"}, map[string]fe.NamesErr{
+		"a/required.txt": {Names: []string{"software"}},
+	})
+	extractortest.Validate(t, e, dir)
+}
+
+func TestValidate_ReportsBrokenExtractors(t *testing.T) {
+	dir := writeTestdata(t)
+	tests := []struct {
+		name string
+		e    filesystem.Extractor
+		want string
+	}{
+		{
+			name: "FileRequired panics",
+			e:    &brokenExtractor{panicFileRequired: true},
+			want: "FileRequired(a/required.txt) panicked",
+		},
+		{
+			name: "Extract panics",
+			e:    &brokenExtractor{panicExtract: true},
+			want: "Extract(a/required.txt) panicked",
+		},
+		{
+			name: "inventory without name",
+			e:    &brokenExtractor{inv: &extractor.Inventory{Locations: extractor.LocationsFromPaths("a/required.txt")}},
+			want: "Extract(a/required.txt) returned an inventory without a name",
+		},
+		{
+			name: "inventory without locations",
+			e:    &brokenExtractor{inv: &extractor.Inventory{Name: "software"}},
+			want: `Extract(a/required.txt) returned "software" without locations`,
+		},
+		{
+			name: "invalid PURL",
+			e: &brokenExtractor{
+				inv:  &extractor.Inventory{Name: "software", Locations: extractor.LocationsFromPaths("a/required.txt")},
+				purl: &purl.PackageURL{Name: "software"},
+			},
+			want: `ToPURL("software") from a/required.txt returned an invalid PURL`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := &recordingTB{TB: t}
+			extractortest.Validate(rec, tc.e, dir)
+			if len(rec.errors) == 0 {
+				t.Fatalf("Validate() reported no errors, want %q", tc.want)
+			}
+			found := slices.ContainsFunc(rec.errors, func(err string) bool {
+				return strings.Contains(err, tc.want)
+			})
+			if !found {
+				t.Errorf("Validate() reported %q, want an error containing %q", rec.errors, tc.want)
+			}
+		})
+	}
+}
+
+// recordingTB records reported errors instead of failing the test.
+type recordingTB struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+// brokenExtractor violates the invariants checked by Validate.
+type brokenExtractor struct {
+	panicFileRequired bool
+	panicExtract      bool
+	inv               *extractor.Inventory
+	purl              *purl.PackageURL
+}
+
+func (e *brokenExtractor) Name() string                       { return "broken" }
+func (e *brokenExtractor) Version() int                       { return 0 }
+func (e *brokenExtractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+func (e *brokenExtractor) FileRequired(path string, _ fs.FileInfo) bool {
+	if e.panicFileRequired {
+		panic("FileRequired")
+	}
+	return path == "a/required.txt"
+}
+
+func (e *brokenExtractor) Extract(_ context.Context, _ *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	if e.panicExtract {
+		panic("Extract")
+	}
+	return []*extractor.Inventory{e.inv}, nil
+}
+
+func (e *brokenExtractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	if e.purl != nil {
+		return e.purl
+	}
+	return &purl.PackageURL{Type: purl.TypePyPi, Name: i.Name}
+}
+
+func (e *brokenExtractor) Ecosystem(_ *extractor.Inventory) string { return "PyPI" }
+
+func writeTestdata(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	for _, p := range []string{"a/required.txt", "b/ignored.txt"} {
+		path := filepath.Join(dir, p)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("os.MkdirAll(%s): %v", path, err)
+		}
+		if err := os.WriteFile(path, []byte("content"), 0644); err != nil {
+			t.Fatalf("os.WriteFile(%s): %v", path, err)
+		}
+	}
+	return dir
+}
@@ -25,8 +25,8 @@ import (
 // tests with cmp.Diff to disregard the order in which the Inventories
 // are reported.
 func InventoryCmpLess(a, b *extractor.Inventory) bool {
-	aLoc := fmt.Sprintf("%v", a.Locations)
-	bLoc := fmt.Sprintf("%v", b.Locations)
+	aLoc := fmt.Sprintf("%v", a.LocationPaths())
+	bLoc := fmt.Sprintf("%v", b.LocationPaths())
 
 	var aExtr, bExtr string
 	if a.Extractor != nil {
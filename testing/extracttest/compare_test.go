@@ -38,12 +38,12 @@ func TestInventoryCmpLess(t *testing.T) {
 				a: &extractor.Inventory{
 					Name:      "a",
 					Version:   "2.0.0",
-					Locations: []string{"aaa/bbb"},
+					Locations: extractor.LocationsFromPaths("aaa/bbb"),
 				},
 				b: &extractor.Inventory{
 					Name:      "a",
 					Version:   "1.0.0",
-					Locations: []string{"ccc/ddd"},
+					Locations: extractor.LocationsFromPaths("ccc/ddd"),
 				},
 			},
 			want: true,
@@ -54,12 +54,12 @@ func TestInventoryCmpLess(t *testing.T) {
 				a: &extractor.Inventory{
 					Name:      "a",
 					Version:   "2.0.0",
-					Locations: []string{"aaa/bbb"},
+					Locations: extractor.LocationsFromPaths("aaa/bbb"),
 				},
 				b: &extractor.Inventory{
 					Name:      "a",
 					Version:   "1.0.0",
-					Locations: []string{"aaa/bbb"},
+					Locations: extractor.LocationsFromPaths("aaa/bbb"),
 				},
 			},
 			want: false,
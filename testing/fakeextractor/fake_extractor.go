@@ -106,7 +106,7 @@ func (e *fakeExtractor) Extract(ctx context.Context, input *filesystem.ScanInput
 	for _, name := range namesErr.Names {
 		invs = append(invs, &extractor.Inventory{
 			Name:      name,
-			Locations: []string{path},
+			Locations: extractor.LocationsFromPaths(path),
 		})
 	}
 
@@ -134,10 +134,10 @@ func TestExtract(t *testing.T) {
 	name2 := "another package"
 	multipleInventories := []*extractor.Inventory{{
 		Name:      name1,
-		Locations: []string{"some path"},
+		Locations: extractor.LocationsFromPaths("some path"),
 	}, {
 		Name:      name2,
-		Locations: []string{"some path"},
+		Locations: extractor.LocationsFromPaths("some path"),
 	}}
 
 	type args struct {
@@ -0,0 +1,125 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package golden compares extracted inventory against JSON golden files.
+//
+// Run the tests with -update to rewrite the golden files from the current
+// extraction results, e.g.
+//
+//	go test ./extractor/filesystem/language/dotnet/packageslockjson/... -update
+package golden
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files with the current results")
+
+// inventory is the JSON representation of an extractor.Inventory in golden
+// files. The Extractor is recorded by name since the plugin itself can't be
+// serialized, and related inventories by their name and version to avoid
+// cycles.
+type inventory struct {
+	Name          string                          `json:"name"`
+	Namespace     string                          `json:"namespace,omitempty"`
+	Version       string                          `json:"version,omitempty"`
+	Qualifiers    map[string]string               `json:"qualifiers,omitempty"`
+	SourceCode    *extractor.SourceCodeIdentifier `json:"source_code,omitempty"`
+	Locations     []extractor.Location            `json:"locations,omitempty"`
+	Extractor     string                          `json:"extractor,omitempty"`
+	ScanRoot      string                          `json:"scan_root,omitempty"`
+	Ecosystem     string                          `json:"ecosystem,omitempty"`
+	Metadata      extractor.Metadata              `json:"metadata,omitempty"`
+	Annotations   []extractor.Annotation          `json:"annotations,omitempty"`
+	Confidence    extractor.Confidence            `json:"confidence,omitempty"`
+	Relationships []relationship                  `json:"relationships,omitempty"`
+}
+
+type relationship struct {
+	Type    extractor.RelationshipType `json:"type"`
+	Name    string                     `json:"name"`
+	Version string                     `json:"version,omitempty"`
+}
+
+// Marshal returns the golden file representation of the inventory.
+func Marshal(inv []*extractor.Inventory) ([]byte, error) {
+	out := make([]inventory, 0, len(inv))
+	for _, i := range inv {
+		g := inventory{
+			Name:        i.Name,
+			Namespace:   i.Namespace,
+			Version:     i.Version,
+			Qualifiers:  i.Qualifiers,
+			SourceCode:  i.SourceCode,
+			Locations:   i.Locations,
+			ScanRoot:    i.ScanRoot,
+			Ecosystem:   i.Ecosystem,
+			Metadata:    i.Metadata,
+			Annotations: i.Annotations,
+			Confidence:  i.Confidence,
+		}
+		if i.Extractor != nil {
+			g.Extractor = i.Extractor.Name()
+		}
+		for _, r := range i.Relationships {
+			rel := relationship{Type: r.Type}
+			if r.Other != nil {
+				rel.Name, rel.Version = r.Other.Name, r.Other.Version
+			}
+			g.Relationships = append(g.Relationships, rel)
+		}
+		out = append(out, g)
+	}
+	b, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+// CompareInventory checks that the inventory matches the contents of the
+// golden file at path. With -update the golden file is overwritten instead.
+func CompareInventory(t *testing.T, path string, got []*extractor.Inventory) {
+	t.Helper()
+
+	gotJSON, err := Marshal(got)
+	if err != nil {
+		t.Fatalf("golden.Marshal(): %v", err)
+	}
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("os.MkdirAll(%s): %v", filepath.Dir(path), err)
+		}
+		if err := os.WriteFile(path, gotJSON, 0644); err != nil {
+			t.Fatalf("os.WriteFile(%s): %v", path, err)
+		}
+		return
+	}
+
+	wantJSON, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("os.ReadFile(%s): %v (run with -update to create it)", path, err)
+	}
+	if diff := cmp.Diff(string(wantJSON), string(gotJSON)); diff != "" {
+		t.Errorf("inventory differs from golden file %s (run with -update to accept) (-want +got):\n%s", path, diff)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package golden_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/testing/fakeextractor"
+	"github.com/google/osv-scalibr/testing/golden"
+)
+
+func TestMarshal(t *testing.T) {
+	inv := []*extractor.Inventory{{
+		Name:      "software",
+		Version:   "1.0",
+		Locations: extractor.LocationsFromPaths("dir/file"),
+		Extractor: fakeextractor.New("fake-extractor", 1, nil, nil),
+		Metadata:  map[string]string{"key": "value"},
+	}}
+	inv = append(inv, &extractor.Inventory{
+		Name:          "@scope/other",
+		Namespace:     "@scope",
+		Version:       "2.0",
+		Qualifiers:    map[string]string{"arch": "amd64"},
+		Ecosystem:     "npm",
+		Confidence:    extractor.ConfidenceLow,
+		Relationships: []extractor.Relationship{{Type: extractor.RelationshipDiscrepancy, Other: inv[0]}},
+	})
+	want := `[
+  {
+    "name": "software",
+    "version": "1.0",
+    "locations": [
+      {
+        "path": "dir/file"
+      }
+    ],
+    "extractor": "fake-extractor",
+    "metadata": {
+      "key": "value"
+    }
+  },
+  {
+    "name": "@scope/other",
+    "namespace": "@scope",
+    "version": "2.0",
+    "qualifiers": {
+      "arch": "amd64"
+    },
+    "ecosystem": "npm",
+    "confidence": 25,
+    "relationships": [
+      {
+        "type": "discrepancy",
+        "name": "software",
+        "version": "1.0"
+      }
+    ]
+  }
+]
+`
+
+	got, err := golden.Marshal(inv)
+	if err != nil {
+		t.Fatalf("golden.Marshal(): %v", err)
+	}
+	if diff := cmp.Diff(want, string(got)); diff != "" {
+		t.Errorf("golden.Marshal() (-want +got):\n%s", diff)
+	}
+}
+
+func TestCompareInventory(t *testing.T) {
+	inv := []*extractor.Inventory{{Name: "software", Version: "1.0", Locations: extractor.LocationsFromPaths("dir/file")}}
+	b, err := golden.Marshal(inv)
+	if err != nil {
+		t.Fatalf("golden.Marshal(): %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "inventory.golden.json")
+	if err := os.WriteFile(path, b, 0644); err != nil {
+		t.Fatalf("os.WriteFile(%s): %v", path, err)
+	}
+
+	golden.CompareInventory(t, path, inv)
+}
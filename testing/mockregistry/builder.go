@@ -0,0 +1,174 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mockregistry
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/google/osv-scalibr/common/windows/registry"
+)
+
+// ValueType is the type of a registry value. It determines how the Builder
+// encodes the value data.
+type ValueType int
+
+const (
+	// SZ is a string value, passed to the Builder as a string.
+	SZ ValueType = iota
+	// DWORD is a 32-bit number, passed to the Builder as a uint32.
+	DWORD
+	// QWORD is a 64-bit number, passed to the Builder as a uint64.
+	QWORD
+	// BINARY is raw data, passed to the Builder as a []byte.
+	BINARY
+	// ExpandSZ is a REG_EXPAND_SZ string with unexpanded references to
+	// environment variables, passed to the Builder as a string.
+	ExpandSZ
+)
+
+// Builder builds a MockRegistry from key paths, e.g.
+//
+//	reg := NewBuilder().
+//		Key(`Microsoft\Windows NT\CurrentVersion`).
+//		Value("CurrentBuild", SZ, "19045").
+//		Value("UBR", DWORD, uint32(4529)).
+//		Build()
+//
+// Every intermediate key of a path is created as well and wired up as a
+// subkey of its parent, so all of them can be opened and enumerated.
+type Builder struct {
+	keys     map[string]*MockKey
+	openErrs map[string]error
+	current  *MockKey
+}
+
+// NewBuilder returns a Builder for an empty registry.
+func NewBuilder() *Builder {
+	return &Builder{keys: map[string]*MockKey{}, openErrs: map[string]error{}}
+}
+
+// Key creates the key at the given backslash separated path, along with its
+// parents. Following calls to Value add values to this key.
+func (b *Builder) Key(path string) *Builder {
+	var parent *MockKey
+	var current string
+	for _, name := range strings.Split(path, `\`) {
+		if current == "" {
+			current = name
+		} else {
+			current += `\` + name
+		}
+		key, ok := b.keys[current]
+		if !ok {
+			key = &MockKey{KName: name}
+			b.keys[current] = key
+			if parent != nil {
+				parent.KSubkeys = append(parent.KSubkeys, key)
+			}
+		}
+		parent = key
+	}
+	b.current = parent
+	return b
+}
+
+// Value adds a value to the key selected by the last call to Key. The data
+// must have the Go type documented for the ValueType.
+func (b *Builder) Value(name string, typ ValueType, data any) *Builder {
+	key := b.selected()
+	key.KValues = append(key.KValues, &MockValue{VName: name, VData: encode(typ, data)})
+	return b
+}
+
+// OpenKeyError makes OpenKey fail with err for the given path.
+func (b *Builder) OpenKeyError(path string, err error) *Builder {
+	b.openErrs[path] = err
+	return b
+}
+
+// SubkeysError makes enumerating the subkeys of the key selected by the last
+// call to Key fail with err.
+func (b *Builder) SubkeysError(err error) *Builder {
+	b.selected().KSubkeysErr = err
+	return b
+}
+
+// ValuesError makes enumerating the values of the key selected by the last
+// call to Key fail with err.
+func (b *Builder) ValuesError(err error) *Builder {
+	b.selected().KValuesErr = err
+	return b
+}
+
+// DataError makes reading the data of the named value of the key selected by
+// the last call to Key fail with err.
+func (b *Builder) DataError(name string, err error) *Builder {
+	for _, v := range b.selected().KValues {
+		if mv, ok := v.(*MockValue); ok && mv.VName == name {
+			mv.VDataErr = err
+			return b
+		}
+	}
+	panic(fmt.Sprintf("mockregistry: no value %q in key %q", name, b.current.KName))
+}
+
+func (b *Builder) selected() *MockKey {
+	if b.current == nil {
+		panic("mockregistry: no key selected, call Key first")
+	}
+	return b.current
+}
+
+// Build returns the registry containing every key created so far.
+func (b *Builder) Build() *MockRegistry {
+	keys := make(map[string]registry.Key, len(b.keys))
+	for path, key := range b.keys {
+		keys[path] = key
+	}
+	openErrs := make(map[string]error, len(b.openErrs))
+	for path, err := range b.openErrs {
+		openErrs[path] = err
+	}
+	return &MockRegistry{Keys: keys, OpenKeyErrors: openErrs}
+}
+
+func encode(typ ValueType, data any) []byte {
+	switch d := data.(type) {
+	case string:
+		if typ == SZ || typ == ExpandSZ {
+			var out []byte
+			for _, c := range utf16.Encode([]rune(d + "\x00")) {
+				out = binary.LittleEndian.AppendUint16(out, c)
+			}
+			return out
+		}
+	case uint32:
+		if typ == DWORD {
+			return binary.LittleEndian.AppendUint32(nil, d)
+		}
+	case uint64:
+		if typ == QWORD {
+			return binary.LittleEndian.AppendUint64(nil, d)
+		}
+	case []byte:
+		if typ == BINARY {
+			return d
+		}
+	}
+	panic(fmt.Sprintf("mockregistry: unsupported data %T for value type %d", data, typ))
+}
@@ -0,0 +1,173 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mockregistry_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/common/windows/registry"
+	"github.com/google/osv-scalibr/testing/mockregistry"
+)
+
+func TestBuilder_OpenKey(t *testing.T) {
+	reg := mockregistry.NewBuilder().
+		Key(`A\B\C`).
+		Value("Name", mockregistry.SZ, "x").
+		Value("Path", mockregistry.ExpandSZ, "%y").
+		Value("Count", mockregistry.DWORD, uint32(7)).
+		Value("Big", mockregistry.QWORD, uint64(8)).
+		Key(`A\D`).
+		Value("Raw", mockregistry.BINARY, []byte{1, 2}).
+		Build()
+
+	for _, path := range []string{`A`, `A\B`, `A\B\C`, `A\D`} {
+		if _, err := reg.OpenKey(path); err != nil {
+			t.Errorf("OpenKey(%q): %v", path, err)
+		}
+	}
+	if _, err := reg.OpenKey(`A\C`); err == nil {
+		t.Errorf("OpenKey(%q) succeeded, want error", `A\C`)
+	}
+
+	key, err := reg.OpenKey(`A\B\C`)
+	if err != nil {
+		t.Fatalf("OpenKey(%q): %v", `A\B\C`, err)
+	}
+	values, err := key.Values()
+	if err != nil {
+		t.Fatalf("Values(): %v", err)
+	}
+	got := map[string]string{}
+	for _, v := range values {
+		data, err := v.Data()
+		if err != nil {
+			t.Fatalf("Data(): %v", err)
+		}
+		got[v.Name()] = string(data)
+	}
+	want := map[string]string{
+		"Name":  "x\x00\x00\x00",
+		"Path":  "%\x00y\x00\x00\x00",
+		"Count": "\x07\x00\x00\x00",
+		"Big":   "\x08\x00\x00\x00\x00\x00\x00\x00",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Values() of %q (-want +got):\n%s", `A\B\C`, diff)
+	}
+	if s := registry.DecodeString([]byte(got["Name"])); s != "x" {
+		t.Errorf("DecodeString(Name) = %q, want %q", s, "x")
+	}
+}
+
+func TestBuilder_Subkeys(t *testing.T) {
+	reg := mockregistry.NewBuilder().
+		Key(`A\B\C`).
+		Key(`A\B\D`).
+		Key(`A\E`).
+		Build()
+
+	root, err := reg.OpenKey("A")
+	if err != nil {
+		t.Fatalf("OpenKey(A): %v", err)
+	}
+
+	// Walk the tree through Subkeys() only.
+	got := map[string][]string{}
+	var walk func(path string, k registry.Key)
+	walk = func(path string, k registry.Key) {
+		subkeys, err := k.Subkeys()
+		if err != nil {
+			t.Fatalf("Subkeys() of %q: %v", path, err)
+		}
+		for _, s := range subkeys {
+			got[path] = append(got[path], s.Name())
+			walk(path+`\`+s.Name(), s)
+		}
+	}
+	walk("A", root)
+
+	want := map[string][]string{
+		`A`:   {"B", "E"},
+		`A\B`: {"C", "D"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Subkeys() tree (-want +got):\n%s", diff)
+	}
+
+	names, err := root.SubkeyNames()
+	if err != nil {
+		t.Fatalf("SubkeyNames(): %v", err)
+	}
+	if diff := cmp.Diff([]string{"B", "E"}, names); diff != "" {
+		t.Errorf("SubkeyNames() (-want +got):\n%s", diff)
+	}
+}
+
+func TestBuilder_InjectedErrors(t *testing.T) {
+	errOpen := errors.New("open")
+	errSubkeys := errors.New("subkeys")
+	errValues := errors.New("values")
+	errData := errors.New("data")
+	reg := mockregistry.NewBuilder().
+		Key(`A\Locked`).
+		OpenKeyError(`A\Locked`, errOpen).
+		Key(`A\B`).
+		SubkeysError(errSubkeys).
+		ValuesError(errValues).
+		Key(`A\C`).
+		Value("Good", mockregistry.SZ, "x").
+		Value("Bad", mockregistry.SZ, "y").
+		DataError("Bad", errData).
+		Build()
+
+	if _, err := reg.OpenKey(`A\Locked`); !errors.Is(err, errOpen) {
+		t.Errorf("OpenKey(%q) error: got %v, want %v", `A\Locked`, err, errOpen)
+	}
+
+	b, err := reg.OpenKey(`A\B`)
+	if err != nil {
+		t.Fatalf("OpenKey(%q): %v", `A\B`, err)
+	}
+	if _, err := b.Subkeys(); !errors.Is(err, errSubkeys) {
+		t.Errorf("Subkeys() error: got %v, want %v", err, errSubkeys)
+	}
+	if _, err := b.SubkeyNames(); !errors.Is(err, errSubkeys) {
+		t.Errorf("SubkeyNames() error: got %v, want %v", err, errSubkeys)
+	}
+	if _, err := b.Values(); !errors.Is(err, errValues) {
+		t.Errorf("Values() error: got %v, want %v", err, errValues)
+	}
+
+	c, err := reg.OpenKey(`A\C`)
+	if err != nil {
+		t.Fatalf("OpenKey(%q): %v", `A\C`, err)
+	}
+	values, err := c.Values()
+	if err != nil {
+		t.Fatalf("Values(): %v", err)
+	}
+	for _, v := range values {
+		_, err := v.Data()
+		var want error
+		if v.Name() == "Bad" {
+			want = errData
+		}
+		if !errors.Is(err, want) {
+			t.Errorf("Data() of %q error: got %v, want %v", v.Name(), err, want)
+		}
+	}
+}
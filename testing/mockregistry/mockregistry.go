@@ -16,7 +16,10 @@
 package mockregistry
 
 import (
+	"context"
 	"errors"
+	"hash/fnv"
+	"math/rand"
 
 	"github.com/google/osv-scalibr/common/windows/registry"
 )
@@ -28,17 +31,36 @@ var (
 // MockRegistry mocks registry access.
 type MockRegistry struct {
 	Keys map[string]registry.Key
+	// OpenKeyErrors are returned by OpenKey for the given paths, even if the
+	// key exists in Keys.
+	OpenKeyErrors map[string]error
+	// ShuffleSeed shuffles the order in which the keys opened from the registry
+	// return their subkeys and values if non-zero, like real registries return
+	// them in no particular order. The order only depends on the seed and the
+	// key name so that tests stay reproducible.
+	ShuffleSeed int64
 }
 
 // OpenKey open the requested registry key.
 func (o *MockRegistry) OpenKey(path string) (registry.Key, error) {
+	if err, ok := o.OpenKeyErrors[path]; ok {
+		return nil, err
+	}
 	if key, ok := o.Keys[path]; ok {
+		if o.ShuffleSeed != 0 {
+			return &shuffledKey{Key: key, seed: o.ShuffleSeed}, nil
+		}
 		return key, nil
 	}
 
 	return nil, errFailedToOpenKey
 }
 
+// OpenKeyContext opens the requested registry key. The context is ignored.
+func (o *MockRegistry) OpenKeyContext(_ context.Context, path string) (registry.Key, error) {
+	return o.OpenKey(path)
+}
+
 // Close does nothing when mocking.
 func (o *MockRegistry) Close() error {
 	return nil
@@ -46,10 +68,17 @@ func (o *MockRegistry) Close() error {
 
 // MockKey mocks a registry.Key.
 type MockKey struct {
-	KName      string
+	KName string
+	// KNameRaw is returned by NameRaw if set, e.g. for names that aren't valid
+	// UTF-16. Otherwise NameRaw encodes KName.
+	KNameRaw   []byte
 	KClassName string
 	KSubkeys   []registry.Key
 	KValues    []registry.Value
+	// KSubkeysErr is returned by Subkeys and SubkeyNames if set.
+	KSubkeysErr error
+	// KValuesErr is returned by Values if set.
+	KValuesErr error
 }
 
 // Name returns the name of the key.
@@ -57,6 +86,14 @@ func (o *MockKey) Name() string {
 	return o.KName
 }
 
+// NameRaw returns the name of the key as UTF-16LE.
+func (o *MockKey) NameRaw() []byte {
+	if o.KNameRaw != nil {
+		return o.KNameRaw
+	}
+	return registry.EncodeName(o.KName)
+}
+
 // Close does nothing when mocking.
 func (o *MockKey) Close() error {
 	return nil
@@ -64,6 +101,9 @@ func (o *MockKey) Close() error {
 
 // SubkeyNames returns the names of the subkeys of the key.
 func (o *MockKey) SubkeyNames() ([]string, error) {
+	if o.KSubkeysErr != nil {
+		return nil, o.KSubkeysErr
+	}
 	var names []string
 	for _, subkey := range o.KSubkeys {
 		names = append(names, subkey.Name())
@@ -74,9 +114,17 @@ func (o *MockKey) SubkeyNames() ([]string, error) {
 
 // Subkeys returns the subkeys of the key.
 func (o *MockKey) Subkeys() ([]registry.Key, error) {
+	if o.KSubkeysErr != nil {
+		return nil, o.KSubkeysErr
+	}
 	return o.KSubkeys, nil
 }
 
+// SubkeysContext returns the subkeys of the key. The context is ignored.
+func (o *MockKey) SubkeysContext(_ context.Context) ([]registry.Key, error) {
+	return o.Subkeys()
+}
+
 // ClassName returns the class name of the key.
 func (o *MockKey) ClassName() ([]byte, error) {
 	return []byte(o.KClassName), nil
@@ -84,13 +132,26 @@ func (o *MockKey) ClassName() ([]byte, error) {
 
 // Values returns the different values contained in the key.
 func (o *MockKey) Values() ([]registry.Value, error) {
+	if o.KValuesErr != nil {
+		return nil, o.KValuesErr
+	}
 	return o.KValues, nil
 }
 
+// ValuesContext returns the values of the key. The context is ignored.
+func (o *MockKey) ValuesContext(_ context.Context) ([]registry.Value, error) {
+	return o.Values()
+}
+
 // MockValue mocks a registry.Value.
 type MockValue struct {
 	VName string
-	VData []byte
+	// VNameRaw is returned by NameRaw if set, e.g. for names that aren't valid
+	// UTF-16. Otherwise NameRaw encodes VName.
+	VNameRaw []byte
+	VData    []byte
+	// VDataErr is returned by Data if set.
+	VDataErr error
 }
 
 // Name returns the name of the value.
@@ -98,7 +159,74 @@ func (o *MockValue) Name() string {
 	return o.VName
 }
 
+// NameRaw returns the name of the value as UTF-16LE.
+func (o *MockValue) NameRaw() []byte {
+	if o.VNameRaw != nil {
+		return o.VNameRaw
+	}
+	return registry.EncodeName(o.VName)
+}
+
 // Data returns the data contained in the value.
 func (o *MockValue) Data() ([]byte, error) {
+	if o.VDataErr != nil {
+		return nil, o.VDataErr
+	}
 	return o.VData, nil
 }
+
+// shuffledKey returns the subkeys and values of the wrapped key in a
+// pseudo-random order.
+type shuffledKey struct {
+	registry.Key
+	seed int64
+}
+
+// Subkeys returns the shuffled subkeys of the key, which shuffle their own
+// subkeys and values as well.
+func (k *shuffledKey) Subkeys() ([]registry.Key, error) {
+	subkeys, err := k.Key.Subkeys()
+	if err != nil {
+		return nil, err
+	}
+	shuffled := make([]registry.Key, 0, len(subkeys))
+	for _, s := range subkeys {
+		shuffled = append(shuffled, &shuffledKey{Key: s, seed: k.seed})
+	}
+	shuffle(k.rand(), shuffled)
+	return shuffled, nil
+}
+
+// SubkeyNames returns the names of the subkeys in the same order as Subkeys.
+func (k *shuffledKey) SubkeyNames() ([]string, error) {
+	subkeys, err := k.Subkeys()
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, s := range subkeys {
+		names = append(names, s.Name())
+	}
+	return names, nil
+}
+
+// Values returns the shuffled values of the key.
+func (k *shuffledKey) Values() ([]registry.Value, error) {
+	values, err := k.Key.Values()
+	if err != nil {
+		return nil, err
+	}
+	shuffled := append([]registry.Value(nil), values...)
+	shuffle(k.rand(), shuffled)
+	return shuffled, nil
+}
+
+func (k *shuffledKey) rand() *rand.Rand {
+	h := fnv.New64a()
+	h.Write([]byte(k.Name()))
+	return rand.New(rand.NewSource(k.seed ^ int64(h.Sum64())))
+}
+
+func shuffle[T any](r *rand.Rand, s []T) {
+	r.Shuffle(len(s), func(i, j int) { s[i], s[j] = s[j], s[i] })
+}
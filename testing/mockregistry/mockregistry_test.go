@@ -0,0 +1,136 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mockregistry_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/testing/mockregistry"
+)
+
+func newRegistry() *mockregistry.MockRegistry {
+	return mockregistry.NewBuilder().
+		Key(`Root\A`).Key(`Root\B`).Key(`Root\C`).Key(`Root\D`).Key(`Root\E`).
+		Key(`Root`).
+		Value("1", mockregistry.SZ, "").
+		Value("2", mockregistry.SZ, "").
+		Value("3", mockregistry.SZ, "").
+		Value("4", mockregistry.SZ, "").
+		Value("5", mockregistry.SZ, "").
+		Build()
+}
+
+// order returns the names of the subkeys and values of the Root key.
+func order(t *testing.T, reg *mockregistry.MockRegistry) (subkeys, values []string) {
+	t.Helper()
+	key, err := reg.OpenKey("Root")
+	if err != nil {
+		t.Fatalf("OpenKey(Root): %v", err)
+	}
+	keys, err := key.Subkeys()
+	if err != nil {
+		t.Fatalf("Subkeys(): %v", err)
+	}
+	for _, k := range keys {
+		subkeys = append(subkeys, k.Name())
+	}
+	names, err := key.SubkeyNames()
+	if err != nil {
+		t.Fatalf("SubkeyNames(): %v", err)
+	}
+	if diff := cmp.Diff(subkeys, names); diff != "" {
+		t.Errorf("SubkeyNames() differs from Subkeys() (-Subkeys +SubkeyNames):\n%s", diff)
+	}
+	vals, err := key.Values()
+	if err != nil {
+		t.Fatalf("Values(): %v", err)
+	}
+	for _, v := range vals {
+		values = append(values, v.Name())
+	}
+	return subkeys, values
+}
+
+func TestOpenKey_StableByDefault(t *testing.T) {
+	subkeys, values := order(t, newRegistry())
+	if diff := cmp.Diff([]string{"A", "B", "C", "D", "E"}, subkeys); diff != "" {
+		t.Errorf("Subkeys() (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"1", "2", "3", "4", "5"}, values); diff != "" {
+		t.Errorf("Values() (-want +got):\n%s", diff)
+	}
+}
+
+func TestOpenKey_Shuffle(t *testing.T) {
+	reg := newRegistry()
+	reg.ShuffleSeed = 42
+	subkeys, values := order(t, reg)
+	if diff := cmp.Diff([]string{"E", "A", "C", "D", "B"}, subkeys); diff != "" {
+		t.Errorf("Subkeys() (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"5", "1", "3", "4", "2"}, values); diff != "" {
+		t.Errorf("Values() (-want +got):\n%s", diff)
+	}
+
+	// The same seed always results in the same order.
+	again := newRegistry()
+	again.ShuffleSeed = 42
+	subkeysAgain, valuesAgain := order(t, again)
+	if diff := cmp.Diff(subkeys, subkeysAgain); diff != "" {
+		t.Errorf("Subkeys() with the same seed (-first +second):\n%s", diff)
+	}
+	if diff := cmp.Diff(values, valuesAgain); diff != "" {
+		t.Errorf("Values() with the same seed (-first +second):\n%s", diff)
+	}
+}
+
+func TestNameRaw(t *testing.T) {
+	// An unpaired surrogate can't be represented by the string name.
+	unpaired := []byte{'a', 0, 0x3d, 0xd8}
+	tests := []struct {
+		desc    string
+		key     *mockregistry.MockKey
+		value   *mockregistry.MockValue
+		wantKey []byte
+		wantVal []byte
+	}{
+		{
+			desc:    "encoded from non-ASCII names",
+			key:     &mockregistry.MockKey{KName: "Café"},
+			value:   &mockregistry.MockValue{VName: "Größe"},
+			wantKey: []byte{'C', 0, 'a', 0, 'f', 0, 0xe9, 0},
+			wantVal: []byte{'G', 0, 'r', 0, 0xf6, 0, 0xdf, 0, 'e', 0},
+		},
+		{
+			desc:    "explicit raw names",
+			key:     &mockregistry.MockKey{KName: "a�", KNameRaw: unpaired},
+			value:   &mockregistry.MockValue{VName: "a�", VNameRaw: unpaired},
+			wantKey: unpaired,
+			wantVal: unpaired,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			if diff := cmp.Diff(tc.wantKey, tc.key.NameRaw()); diff != "" {
+				t.Errorf("MockKey.NameRaw() (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantVal, tc.value.NameRaw()); diff != "" {
+				t.Errorf("MockValue.NameRaw() (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
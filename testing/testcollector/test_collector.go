@@ -16,14 +16,21 @@
 // stores recorded metrics for verification in tests.
 package testcollector
 
-import "github.com/google/osv-scalibr/stats"
+import (
+	"sync"
+
+	"github.com/google/osv-scalibr/stats"
+)
 
 // Collector implements the stats.Collector interface and simply stores metrics
-// by path.
+// by path. It is safe for concurrent use.
 type Collector struct {
 	stats.NoopCollector
+	mu                 sync.Mutex
 	fileRequiredStats  map[string]*stats.FileRequiredStats
 	fileExtractedStats map[string]*stats.FileExtractedStats
+	fileWarnings       map[string][]string
+	scanFinishedStats  *stats.ScanFinishedStats
 }
 
 // New returns a new test Collector with maps initialized.
@@ -31,31 +38,87 @@ func New() *Collector {
 	return &Collector{
 		fileRequiredStats:  make(map[string]*stats.FileRequiredStats),
 		fileExtractedStats: make(map[string]*stats.FileExtractedStats),
+		fileWarnings:       make(map[string][]string),
 	}
 }
 
 // AfterFileRequired stores the metrics for calls to `FileRequired`.
 func (c *Collector) AfterFileRequired(name string, filestats *stats.FileRequiredStats) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.fileRequiredStats[filestats.Path] = filestats
 }
 
 // AfterFileExtracted stores the metrics for calls to `Extract`.
 func (c *Collector) AfterFileExtracted(name string, filestats *stats.FileExtractedStats) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.fileExtractedStats[filestats.Path] = filestats
 }
 
+// AfterFileWarnings stores the warnings extractors recorded for a file.
+func (c *Collector) AfterFileWarnings(name string, filestats *stats.FileWarningsStats) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.fileWarnings[filestats.Path] = append(c.fileWarnings[filestats.Path], filestats.Warnings...)
+}
+
+// ScanFinished stores the totals reported at the end of the filesystem walk.
+func (c *Collector) ScanFinished(s *stats.ScanFinishedStats) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.scanFinishedStats = s
+}
+
+// ScanFinishedStats returns the totals reported at the end of the filesystem
+// walk, or nil if the walk didn't finish.
+func (c *Collector) ScanFinishedStats() *stats.ScanFinishedStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.scanFinishedStats
+}
+
+// FileRequiredResultCount returns the number of paths for which the given
+// result was recorded.
+func (c *Collector) FileRequiredResultCount(result stats.FileRequiredResult) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	count := 0
+	for _, filestats := range c.fileRequiredStats {
+		if filestats.Result == result {
+			count++
+		}
+	}
+	return count
+}
+
 // FileRequiredResult returns the result metric for a given path, if found.
 // Otherwise, returns an empty string.
 func (c *Collector) FileRequiredResult(path string) stats.FileRequiredResult {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	if filestats, ok := c.fileRequiredStats[path]; ok {
 		return filestats.Result
 	}
 	return ""
 }
 
+// FileRequiredExtractor returns the name of the extractor that evaluated a
+// given path, if found. Otherwise, returns an empty string.
+func (c *Collector) FileRequiredExtractor(path string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if filestats, ok := c.fileRequiredStats[path]; ok {
+		return filestats.Extractor
+	}
+	return ""
+}
+
 // FileExtractedResult returns the result metric for a given path, if found.
 // Otherwise, returns an empty string.
 func (c *Collector) FileExtractedResult(path string) stats.FileExtractedResult {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	if filestats, ok := c.fileExtractedStats[path]; ok {
 		return filestats.Result
 	}
@@ -65,8 +128,17 @@ func (c *Collector) FileExtractedResult(path string) stats.FileExtractedResult {
 // FileExtractedFileSize returns the file size recorded for a given path, if
 // found. Otherwise, returns 0.
 func (c *Collector) FileExtractedFileSize(path string) int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	if filestats, ok := c.fileExtractedStats[path]; ok {
 		return filestats.FileSizeBytes
 	}
 	return 0
 }
+
+// FileWarnings returns the warnings recorded for a given path, if any.
+func (c *Collector) FileWarnings(path string) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.fileWarnings[path]
+}
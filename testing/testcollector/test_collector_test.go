@@ -15,6 +15,8 @@
 package testcollector_test
 
 import (
+	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/google/osv-scalibr/stats"
@@ -30,6 +32,7 @@ func TestCollector(t *testing.T) {
 		{
 			name: "file required stats",
 			fileRequiredStats: &stats.FileRequiredStats{
+				Extractor:     "test",
 				Path:          "testdata/required.txt",
 				Result:        stats.FileRequiredResultOK,
 				FileSizeBytes: 1000,
@@ -75,6 +78,11 @@ func TestCollector(t *testing.T) {
 				if gotResult != tt.fileRequiredStats.Result {
 					t.Errorf("FileRequiredResult(%s) = %v, want %v", tt.fileRequiredStats.Path, gotResult, tt.fileRequiredStats.Result)
 				}
+
+				gotExtractor := collector.FileRequiredExtractor(tt.fileRequiredStats.Path)
+				if gotExtractor != tt.fileRequiredStats.Extractor {
+					t.Errorf("FileRequiredExtractor(%s) = %v, want %v", tt.fileRequiredStats.Path, gotExtractor, tt.fileRequiredStats.Extractor)
+				}
 			}
 
 			if tt.fileExtractedStats != nil {
@@ -91,3 +99,22 @@ func TestCollector(t *testing.T) {
 		})
 	}
 }
+
+func TestCollector_Concurrent(t *testing.T) {
+	collector := testcollector.New()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			path := fmt.Sprintf("file%d", i)
+			collector.AfterFileRequired("test", &stats.FileRequiredStats{Path: path, Result: stats.FileRequiredResultOK})
+			collector.FileRequiredResult(path)
+		}(i)
+	}
+	wg.Wait()
+
+	if got := collector.FileRequiredResultCount(stats.FileRequiredResultOK); got != 10 {
+		t.Errorf("FileRequiredResultCount(%v) = %d, want 10", stats.FileRequiredResultOK, got)
+	}
+}